@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"manabu-service/common/response"
 	"manabu-service/config"
@@ -76,6 +77,34 @@ var command = &cobra.Command{
 			&models.Exercise{},
 			&models.ExerciseQuestion{},
 			&models.UserCourseProgress{},
+			&models.ExampleSentence{},
+			&models.Translation{},
+			&models.Exam{},
+			&models.ExamSection{},
+			&models.ExamAttempt{},
+			&models.ExamAttemptAnswer{},
+			&models.PlacementTest{},
+			&models.PlacementTestAnswer{},
+			&models.ExerciseAttempt{},
+			&models.SpeakingSubmission{},
+			&models.Notification{},
+			&models.Media{},
+			&models.ContentWorkflow{},
+			&models.ContentWorkflowTransition{},
+			&models.ContentWorkflowComment{},
+			&models.PublishSchedule{},
+			&models.ContentRevision{},
+			&models.CoursePrerequisite{},
+			&models.LessonVocabulary{},
+			&models.VocabularyNote{},
+			&models.VocabularyNoteUpvote{},
+			&models.Deck{},
+			&models.DeckVocabulary{},
+			&models.DeckCard{},
+			&models.DeckSubscription{},
+			&models.UserDeckCardStatus{},
+			&models.BookmarkFolder{},
+			&models.Bookmark{},
 		)
 		if err != nil {
 			panic(err)
@@ -86,6 +115,16 @@ var command = &cobra.Command{
 		service := services.NewServiceRegistry(repository)
 		controller := controllers.NewControllerRegistry(service)
 
+		// Apply scheduled publishing in the background; advisory locks keep instances from racing
+		schedulerInterval := time.Duration(config.Config.SchedulerIntervalSecond) * time.Second
+		if schedulerInterval <= 0 {
+			schedulerInterval = time.Minute
+		}
+		go service.GetPublishSchedule().Run(context.Background(), schedulerInterval)
+
+		// Purge content that has outlived the trash retention window
+		go service.GetTrash().Run(context.Background(), time.Hour)
+
 		router := gin.Default()
 		router.Use(middlewares.HandlePanic())
 		router.NoRoute(func(c *gin.Context) {
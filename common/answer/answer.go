@@ -0,0 +1,124 @@
+package answer
+
+import (
+	"manabu-service/constants"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Result is the outcome of grading a submitted answer.
+type Result struct {
+	Correct bool
+	// BlankResults holds the result of each blank for fill_blank questions
+	BlankResults []bool
+}
+
+// toHiraganaRune folds katakana into hiragana.
+func toHiraganaRune(r rune) rune {
+	if r >= 'ァ' && r <= 'ヶ' {
+		return r - 0x60
+	}
+	return r
+}
+
+// Normalize prepares a free-text answer for comparison at the given strictness.
+// Non-strict levels fold full-width/half-width variants (NFKC), case and
+// katakana into hiragana, and drop whitespace and punctuation.
+func Normalize(input, strictness string) string {
+	input = strings.TrimSpace(input)
+	if strictness == constants.AnswerStrictnessStrict {
+		return input
+	}
+
+	input = strings.ToLower(norm.NFKC.String(input))
+
+	var builder strings.Builder
+	for _, r := range input {
+		if unicode.IsSpace(r) || unicode.IsPunct(r) {
+			continue
+		}
+		builder.WriteRune(toHiraganaRune(r))
+	}
+	return builder.String()
+}
+
+// Matches reports whether the input matches any of the accepted answers at the given strictness.
+// Lenient matching also converts romaji input to hiragana before comparing.
+func Matches(input string, accepted []string, strictness string) bool {
+	normalized := Normalize(input, strictness)
+	if normalized == "" {
+		return false
+	}
+
+	romaji := ""
+	if strictness == constants.AnswerStrictnessLenient {
+		romaji = Normalize(ToHiragana(strings.ToLower(norm.NFKC.String(input))), strictness)
+	}
+
+	for _, candidate := range accepted {
+		expected := Normalize(candidate, strictness)
+		if expected == normalized || (romaji != "" && expected == romaji) {
+			return true
+		}
+	}
+	return false
+}
+
+// Grade compares a submitted answer with the correct answer of a question.
+// Speaking questions cannot be graded automatically.
+func Grade(questionType string, correct *dto.QuestionAnswer, submitted *dto.SubmittedAnswer, strictness string) (*Result, error) {
+	if correct == nil {
+		return nil, errConstant.ErrInvalidQuestionAnswer
+	}
+	if submitted == nil {
+		return nil, errConstant.ErrInvalidSubmittedAnswer
+	}
+	if strictness == "" {
+		strictness = constants.DefaultAnswerStrictness
+	}
+
+	switch questionType {
+	case "multiple_choice", "listening":
+		if submitted.Key == "" {
+			return nil, errConstant.ErrInvalidSubmittedAnswer
+		}
+		return &Result{Correct: submitted.Key == correct.Key}, nil
+
+	case "fill_blank":
+		if len(submitted.Blanks) != len(correct.Blanks) {
+			return nil, errConstant.ErrInvalidSubmittedAnswer
+		}
+		result := &Result{Correct: true, BlankResults: make([]bool, len(correct.Blanks))}
+		for i, accepted := range correct.Blanks {
+			result.BlankResults[i] = Matches(submitted.Blanks[i], accepted, strictness)
+			result.Correct = result.Correct && result.BlankResults[i]
+		}
+		return result, nil
+
+	case "matching":
+		if len(submitted.Pairs) != len(correct.Pairs) {
+			return nil, errConstant.ErrInvalidSubmittedAnswer
+		}
+		expected := make(map[string]string, len(correct.Pairs))
+		for _, pair := range correct.Pairs {
+			expected[pair.Left] = pair.Right
+		}
+		for _, pair := range submitted.Pairs {
+			if expected[pair.Left] != pair.Right {
+				return &Result{Correct: false}, nil
+			}
+			// Each left item counts once
+			delete(expected, pair.Left)
+		}
+		return &Result{Correct: true}, nil
+
+	case "speaking":
+		return nil, errConstant.ErrAnswerRequiresReview
+	}
+
+	return nil, errConstant.ErrInvalidQuestionType
+}
@@ -0,0 +1,75 @@
+package answer
+
+import (
+	"manabu-service/constants"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToHiragana(t *testing.T) {
+	assert.Equal(t, "がくせい", ToHiragana("gakusei"))
+	assert.Equal(t, "がっこう", ToHiragana("gakkou"))
+	assert.Equal(t, "しんぶん", ToHiragana("shinbun"))
+	assert.Equal(t, "こんにちわ", ToHiragana("konnichiwa"))
+	assert.Equal(t, "きっぷ", ToHiragana("kippu"))
+}
+
+func TestNormalize(t *testing.T) {
+	assert.Equal(t, "がくせい", Normalize(" ガクセイ。", constants.AnswerStrictnessNormal))
+	assert.Equal(t, "がくせい", Normalize("ｶﾞｸｾｲ", constants.AnswerStrictnessNormal))
+	assert.Equal(t, "abc", Normalize("ＡＢＣ", constants.AnswerStrictnessNormal))
+	assert.Equal(t, "ガクセイ。", Normalize(" ガクセイ。", constants.AnswerStrictnessStrict))
+}
+
+func TestMatches(t *testing.T) {
+	accepted := []string{"がくせい", "学生"}
+
+	assert.True(t, Matches("学生", accepted, constants.AnswerStrictnessStrict))
+	assert.False(t, Matches("ガクセイ", accepted, constants.AnswerStrictnessStrict))
+
+	assert.True(t, Matches("ガクセイ", accepted, constants.AnswerStrictnessNormal))
+	assert.False(t, Matches("gakusei", accepted, constants.AnswerStrictnessNormal))
+
+	assert.True(t, Matches("gakusei", accepted, constants.AnswerStrictnessLenient))
+	assert.True(t, Matches("ｇａｋｕｓｅｉ", accepted, constants.AnswerStrictnessLenient))
+	assert.False(t, Matches("sensei", accepted, constants.AnswerStrictnessLenient))
+	assert.False(t, Matches("", accepted, constants.AnswerStrictnessLenient))
+}
+
+func TestGrade(t *testing.T) {
+	correct := &dto.QuestionAnswer{Blanks: [][]string{{"がくせい"}, {"です"}}}
+
+	result, err := Grade("fill_blank", correct, &dto.SubmittedAnswer{Blanks: []string{"gakusei", "desu"}}, constants.AnswerStrictnessLenient)
+	assert.NoError(t, err)
+	assert.True(t, result.Correct)
+	assert.Equal(t, []bool{true, true}, result.BlankResults)
+
+	result, err = Grade("fill_blank", correct, &dto.SubmittedAnswer{Blanks: []string{"ガクセイ", "だ"}}, constants.AnswerStrictnessNormal)
+	assert.NoError(t, err)
+	assert.False(t, result.Correct)
+	assert.Equal(t, []bool{true, false}, result.BlankResults)
+
+	_, err = Grade("fill_blank", correct, &dto.SubmittedAnswer{Blanks: []string{"がくせい"}}, constants.AnswerStrictnessNormal)
+	assert.Equal(t, errConstant.ErrInvalidSubmittedAnswer, err)
+
+	result, err = Grade("multiple_choice", &dto.QuestionAnswer{Key: "a"}, &dto.SubmittedAnswer{Key: "b"}, "")
+	assert.NoError(t, err)
+	assert.False(t, result.Correct)
+
+	_, err = Grade("speaking", &dto.QuestionAnswer{Text: "こんにちは"}, &dto.SubmittedAnswer{}, "")
+	assert.Equal(t, errConstant.ErrAnswerRequiresReview, err)
+}
+
+func TestDecode(t *testing.T) {
+	options := `{"choices":[{"key":"a","text":"あ"}]}`
+	assert.Equal(t, "a", DecodeOptions(&options).Choices[0].Key)
+	assert.Nil(t, DecodeOptions(nil))
+	malformed := "{"
+	assert.Nil(t, DecodeOptions(&malformed))
+
+	assert.Equal(t, &dto.QuestionAnswer{Key: "a"}, DecodeCorrectAnswer(`{"key":"a"}`))
+	assert.Nil(t, DecodeCorrectAnswer("{"))
+}
@@ -0,0 +1,27 @@
+package answer
+
+import (
+	"encoding/json"
+	"manabu-service/domain/dto"
+)
+
+// DecodeOptions parses the stored JSONB options of a question, returning nil when absent or malformed
+func DecodeOptions(raw *string) *dto.QuestionOptions {
+	if raw == nil || *raw == "" {
+		return nil
+	}
+	var options dto.QuestionOptions
+	if err := json.Unmarshal([]byte(*raw), &options); err != nil {
+		return nil
+	}
+	return &options
+}
+
+// DecodeCorrectAnswer parses the stored JSONB correct answer of a question, returning nil when malformed
+func DecodeCorrectAnswer(raw string) *dto.QuestionAnswer {
+	var correct dto.QuestionAnswer
+	if err := json.Unmarshal([]byte(raw), &correct); err != nil {
+		return nil
+	}
+	return &correct
+}
@@ -0,0 +1,121 @@
+package answer
+
+import "strings"
+
+// romajiTable maps romaji syllables (Hepburn, Kunrei and Nihon-shiki spellings) to hiragana.
+var romajiTable = map[string]string{
+	"a": "あ", "i": "い", "u": "う", "e": "え", "o": "お",
+	"ka": "か", "ki": "き", "ku": "く", "ke": "け", "ko": "こ",
+	"ga": "が", "gi": "ぎ", "gu": "ぐ", "ge": "げ", "go": "ご",
+	"sa": "さ", "shi": "し", "si": "し", "su": "す", "se": "せ", "so": "そ",
+	"za": "ざ", "ji": "じ", "zi": "じ", "zu": "ず", "ze": "ぜ", "zo": "ぞ",
+	"ta": "た", "chi": "ち", "ti": "ち", "tsu": "つ", "tu": "つ", "te": "て", "to": "と",
+	"da": "だ", "di": "ぢ", "du": "づ", "de": "で", "do": "ど",
+	"na": "な", "ni": "に", "nu": "ぬ", "ne": "ね", "no": "の",
+	"ha": "は", "hi": "ひ", "fu": "ふ", "hu": "ふ", "he": "へ", "ho": "ほ",
+	"ba": "ば", "bi": "び", "bu": "ぶ", "be": "べ", "bo": "ぼ",
+	"pa": "ぱ", "pi": "ぴ", "pu": "ぷ", "pe": "ぺ", "po": "ぽ",
+	"ma": "ま", "mi": "み", "mu": "む", "me": "め", "mo": "も",
+	"ya": "や", "yu": "ゆ", "yo": "よ",
+	"ra": "ら", "ri": "り", "ru": "る", "re": "れ", "ro": "ろ",
+	"la": "ら", "li": "り", "lu": "る", "le": "れ", "lo": "ろ",
+	"wa": "わ", "wi": "ゐ", "we": "ゑ", "wo": "を",
+	"kya": "きゃ", "kyu": "きゅ", "kyo": "きょ",
+	"gya": "ぎゃ", "gyu": "ぎゅ", "gyo": "ぎょ",
+	"sha": "しゃ", "shu": "しゅ", "sho": "しょ", "sya": "しゃ", "syu": "しゅ", "syo": "しょ",
+	"ja": "じゃ", "ju": "じゅ", "jo": "じょ", "jya": "じゃ", "jyu": "じゅ", "jyo": "じょ",
+	"zya": "じゃ", "zyu": "じゅ", "zyo": "じょ",
+	"cha": "ちゃ", "chu": "ちゅ", "cho": "ちょ", "tya": "ちゃ", "tyu": "ちゅ", "tyo": "ちょ",
+	"cya": "ちゃ", "cyu": "ちゅ", "cyo": "ちょ",
+	"nya": "にゃ", "nyu": "にゅ", "nyo": "にょ",
+	"hya": "ひゃ", "hyu": "ひゅ", "hyo": "ひょ",
+	"bya": "びゃ", "byu": "びゅ", "byo": "びょ",
+	"pya": "ぴゃ", "pyu": "ぴゅ", "pyo": "ぴょ",
+	"mya": "みゃ", "myu": "みゅ", "myo": "みょ",
+	"rya": "りゃ", "ryu": "りゅ", "ryo": "りょ",
+	"fa": "ふぁ", "fi": "ふぃ", "fe": "ふぇ", "fo": "ふぉ",
+	"she": "しぇ", "je": "じぇ", "che": "ちぇ",
+	"vu": "ゔ",
+	"-":  "ー",
+}
+
+// macrons expands Hepburn long vowels so they can be converted syllable by syllable.
+var macrons = strings.NewReplacer("ā", "aa", "ī", "ii", "ū", "uu", "ē", "ee", "ō", "ou", "â", "aa", "î", "ii", "û", "uu", "ê", "ee", "ô", "ou")
+
+func isVowel(c byte) bool {
+	return c == 'a' || c == 'i' || c == 'u' || c == 'e' || c == 'o'
+}
+
+func isLatinLetter(c byte) bool {
+	return c >= 'a' && c <= 'z'
+}
+
+// ToHiragana converts romaji in lowercase input to hiragana. Characters that are not
+// part of a romaji syllable are kept, so mixed input such as "たべmasu" converts cleanly.
+func ToHiragana(input string) string {
+	input = macrons.Replace(input)
+
+	var builder strings.Builder
+	for i := 0; i < len(input); {
+		c := input[i]
+
+		if !isLatinLetter(c) && c != '-' {
+			builder.WriteByte(c)
+			i++
+			continue
+		}
+
+		// Doubled consonants become a small tsu (kitte → きって, matcha → まっちゃ)
+		if i+1 < len(input) && isLatinLetter(c) && !isVowel(c) && c != 'n' &&
+			(input[i+1] == c || (c == 't' && input[i+1] == 'c')) {
+			builder.WriteString("っ")
+			i++
+			continue
+		}
+
+		// Syllabic n: n', nn, or n before a consonant or at the end
+		if c == 'n' {
+			next := byte(0)
+			if i+1 < len(input) {
+				next = input[i+1]
+			}
+			switch {
+			case next == '\'':
+				builder.WriteString("ん")
+				i += 2
+				continue
+			case next == 'n':
+				builder.WriteString("ん")
+				// Keep the second n when it starts the next syllable (konnichiwa → こんにちわ)
+				if i+2 < len(input) && (isVowel(input[i+2]) || input[i+2] == 'y') {
+					i++
+				} else {
+					i += 2
+				}
+				continue
+			case !isVowel(next) && next != 'y':
+				builder.WriteString("ん")
+				i++
+				continue
+			}
+		}
+
+		matched := false
+		for length := 3; length >= 1; length-- {
+			if i+length > len(input) {
+				continue
+			}
+			if kana, ok := romajiTable[input[i:i+length]]; ok {
+				builder.WriteString(kana)
+				i += length
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			builder.WriteByte(c)
+			i++
+		}
+	}
+	return builder.String()
+}
@@ -0,0 +1,192 @@
+// Package bundle reads and writes course bundles: zip archives holding a course manifest
+// and the media files it references under media/.
+package bundle
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"manabu-service/constants"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"path"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ManifestName is the name of the manifest written by exports. Imports also accept
+// a YAML manifest named course.yaml or course.yml with the same keys.
+const ManifestName = "course.json"
+
+// MediaDir is the folder of the archive holding media files
+const MediaDir = "media/"
+
+// manifestNames are the accepted manifest file names
+var manifestNames = map[string]bool{
+	ManifestName:  true,
+	"course.yaml": true,
+	"course.yml":  true,
+}
+
+// IsMediaPath reports whether p is a clean path of a file inside the media folder
+func IsMediaPath(p string) bool {
+	if !strings.HasPrefix(p, MediaDir) || strings.Contains(p, "\\") {
+		return false
+	}
+	return path.Clean(p) == p && path.Dir(p) == strings.TrimSuffix(MediaDir, "/")
+}
+
+// Write writes a bundle with the manifest and the media files keyed by their path in the archive
+func Write(w io.Writer, manifest *dto.CourseBundle, files map[string][]byte) error {
+	archive := zip.NewWriter(w)
+
+	content, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	file, err := archive.Create(ManifestName)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(content); err != nil {
+		return err
+	}
+
+	// Write media in manifest order so archives of the same course are identical
+	for _, media := range manifest.Media {
+		content, ok := files[media.Path]
+		if !ok {
+			continue
+		}
+		file, err := archive.CreateHeader(&zip.FileHeader{Name: media.Path, Method: zip.Store})
+		if err != nil {
+			return err
+		}
+		if _, err := file.Write(content); err != nil {
+			return err
+		}
+	}
+
+	return archive.Close()
+}
+
+// Read parses a bundle and returns its manifest with the media files it declares, keyed by path.
+// Only the structure of the archive and the format version are checked here; the content of
+// the manifest is validated by the importer. Archives with more than MaxCourseBundleEntries
+// files or inflating past MaxCourseBundleSize in total are refused.
+func Read(data []byte) (*dto.CourseBundle, map[string][]byte, error) {
+	return read(data, constants.MaxCourseBundleSize, constants.MaxCourseBundleEntries)
+}
+
+// read parses a bundle, refusing archives with more than maxEntries files or whose manifest
+// and media inflate past maxTotalSize bytes
+func read(data []byte, maxTotalSize int64, maxEntries int) (*dto.CourseBundle, map[string][]byte, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, nil, errConstant.ErrInvalidCourseBundle
+	}
+	if len(archive.File) > maxEntries {
+		return nil, nil, errConstant.ErrCourseBundleTooLarge
+	}
+
+	entries := make(map[string]*zip.File, len(archive.File))
+	var manifestFile *zip.File
+	for _, file := range archive.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+		if manifestNames[file.Name] {
+			if manifestFile != nil {
+				return nil, nil, errConstant.ErrInvalidCourseBundle
+			}
+			manifestFile = file
+			continue
+		}
+		entries[file.Name] = file
+	}
+	if manifestFile == nil {
+		return nil, nil, errConstant.ErrInvalidCourseBundle
+	}
+
+	content, err := readFile(manifestFile, min(constants.MaxCourseBundleManifestSize, maxTotalSize))
+	if err != nil {
+		return nil, nil, err
+	}
+	remaining := maxTotalSize - int64(len(content))
+	manifest, err := decodeManifest(manifestFile.Name, content)
+	if err != nil {
+		return nil, nil, err
+	}
+	if manifest.FormatVersion != constants.CourseBundleFormatVersion {
+		return nil, nil, errConstant.ErrUnsupportedCourseBundleVersion
+	}
+
+	files := make(map[string][]byte, len(manifest.Media))
+	for _, media := range manifest.Media {
+		if !IsMediaPath(media.Path) {
+			return nil, nil, errConstant.ErrInvalidCourseBundle
+		}
+		if _, ok := files[media.Path]; ok {
+			return nil, nil, errConstant.ErrInvalidCourseBundle
+		}
+		entry, ok := entries[media.Path]
+		if !ok {
+			return nil, nil, errConstant.ErrInvalidCourseBundle
+		}
+		// Media share the size budget so many small entries cannot inflate past it together
+		content, err := readFile(entry, remaining)
+		if err != nil {
+			return nil, nil, err
+		}
+		remaining -= int64(len(content))
+		files[media.Path] = content
+	}
+
+	return manifest, files, nil
+}
+
+// decodeManifest parses a JSON or YAML manifest. YAML is converted to JSON first so both
+// formats use the JSON keys of the manifest.
+func decodeManifest(name string, content []byte) (*dto.CourseBundle, error) {
+	if name != ManifestName {
+		var document interface{}
+		if err := yaml.Unmarshal(content, &document); err != nil {
+			return nil, errConstant.ErrInvalidCourseBundle
+		}
+		converted, err := json.Marshal(document)
+		if err != nil {
+			return nil, errConstant.ErrInvalidCourseBundle
+		}
+		content = converted
+	}
+
+	var manifest dto.CourseBundle
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return nil, errConstant.ErrInvalidCourseBundle
+	}
+	return &manifest, nil
+}
+
+// readFile reads an archive entry, refusing entries that inflate past maxSize
+func readFile(file *zip.File, maxSize int64) ([]byte, error) {
+	if file.UncompressedSize64 > uint64(maxSize) {
+		return nil, errConstant.ErrCourseBundleTooLarge
+	}
+
+	body, err := file.Open()
+	if err != nil {
+		return nil, errConstant.ErrInvalidCourseBundle
+	}
+	defer body.Close()
+
+	content, err := io.ReadAll(io.LimitReader(body, maxSize+1))
+	if err != nil {
+		return nil, errConstant.ErrInvalidCourseBundle
+	}
+	if int64(len(content)) > maxSize {
+		return nil, errConstant.ErrCourseBundleTooLarge
+	}
+	return content, nil
+}
@@ -0,0 +1,162 @@
+package bundle
+
+import (
+	"archive/zip"
+	"bytes"
+	"manabu-service/constants"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"testing"
+)
+
+func testManifest() *dto.CourseBundle {
+	return &dto.CourseBundle{
+		FormatVersion: constants.CourseBundleFormatVersion,
+		Course: dto.BundleCourse{
+			Title:     "Introduction to Japanese",
+			JlptLevel: "N5",
+			Thumbnail: "media/cover.png",
+		},
+		Media: []dto.BundleMedia{
+			{Path: "media/cover.png", Kind: "image", ContentType: "image/png"},
+		},
+	}
+}
+
+func writeZip(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for name, content := range files {
+		file, err := archive.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := file.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestWriteReadRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	media := map[string][]byte{"media/cover.png": []byte("png")}
+	if err := Write(&buf, testManifest(), media); err != nil {
+		t.Fatal(err)
+	}
+
+	manifest, files, err := Read(buf.Bytes())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if manifest.Course.Title != "Introduction to Japanese" || manifest.Course.Thumbnail != "media/cover.png" {
+		t.Fatalf("unexpected manifest: %+v", manifest.Course)
+	}
+	if string(files["media/cover.png"]) != "png" {
+		t.Fatalf("expected media file, got %q", files["media/cover.png"])
+	}
+}
+
+func TestReadYAMLManifest(t *testing.T) {
+	data := writeZip(t, map[string]string{
+		"course.yaml": "formatVersion: 1\ncourse:\n  title: Introduction to Japanese\n  jlptLevel: N5\n  lessons:\n    - title: Hiragana\n      orderIndex: 1\n",
+	})
+
+	manifest, _, err := Read(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if manifest.Course.JlptLevel != "N5" || len(manifest.Course.Lessons) != 1 || manifest.Course.Lessons[0].OrderIndex != 1 {
+		t.Fatalf("unexpected manifest: %+v", manifest.Course)
+	}
+}
+
+func TestReadRejectsUnsupportedVersion(t *testing.T) {
+	data := writeZip(t, map[string]string{"course.json": `{"formatVersion": 99}`})
+
+	if _, _, err := Read(data); err != errConstant.ErrUnsupportedCourseBundleVersion {
+		t.Fatalf("expected unsupported version, got %v", err)
+	}
+}
+
+func TestReadRejectsMissingMedia(t *testing.T) {
+	data := writeZip(t, map[string]string{
+		"course.json": `{"formatVersion": 1, "media": [{"path": "media/cover.png", "kind": "image", "contentType": "image/png"}]}`,
+	})
+
+	if _, _, err := Read(data); err != errConstant.ErrInvalidCourseBundle {
+		t.Fatalf("expected invalid bundle, got %v", err)
+	}
+}
+
+func TestReadRejectsInvalidArchives(t *testing.T) {
+	cases := map[string][]byte{
+		"not a zip":        []byte("course"),
+		"missing manifest": writeZip(t, map[string]string{"media/cover.png": "png"}),
+		"path traversal": writeZip(t, map[string]string{
+			"course.json": `{"formatVersion": 1, "media": [{"path": "media/../secret", "kind": "image", "contentType": "image/png"}]}`,
+			"secret":      "x",
+		}),
+	}
+
+	for name, data := range cases {
+		if _, _, err := Read(data); err != errConstant.ErrInvalidCourseBundle {
+			t.Errorf("%s: expected invalid bundle, got %v", name, err)
+		}
+	}
+}
+
+func TestReadLimitsTotalInflatedSize(t *testing.T) {
+	manifest := `{"formatVersion": 1, "course": {"title": "Kana"}, "media": [` +
+		`{"path": "media/a.png", "kind": "image"}, {"path": "media/b.png", "kind": "image"}]}`
+	media := string(bytes.Repeat([]byte("x"), 1000))
+	data := writeZip(t, map[string]string{
+		ManifestName:  manifest,
+		"media/a.png": media,
+		"media/b.png": media,
+	})
+
+	// Each entry fits the limit on its own, but not together
+	if _, _, err := read(data, int64(len(manifest))+1500, 10); err != errConstant.ErrCourseBundleTooLarge {
+		t.Fatalf("expected ErrCourseBundleTooLarge, got %v", err)
+	}
+	if _, files, err := read(data, int64(len(manifest))+2000, 10); err != nil || len(files) != 2 {
+		t.Fatalf("expected both media files within the limit, got %d files and %v", len(files), err)
+	}
+}
+
+func TestReadLimitsEntries(t *testing.T) {
+	data := writeZip(t, map[string]string{
+		ManifestName:  `{"formatVersion": 1, "course": {"title": "Kana"}}`,
+		"media/a.png": "a",
+		"media/b.png": "b",
+	})
+
+	if _, _, err := read(data, constants.MaxCourseBundleSize, 2); err != errConstant.ErrCourseBundleTooLarge {
+		t.Fatalf("expected ErrCourseBundleTooLarge, got %v", err)
+	}
+	if _, _, err := read(data, constants.MaxCourseBundleSize, 3); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestIsMediaPath(t *testing.T) {
+	cases := map[string]bool{
+		"media/cover.png":   true,
+		"media/a/cover.png": false,
+		"media/../x":        false,
+		"cover.png":         false,
+		"media/":            false,
+		"media\\cover.png":  false,
+	}
+
+	for p, expected := range cases {
+		if IsMediaPath(p) != expected {
+			t.Errorf("IsMediaPath(%q): expected %v", p, expected)
+		}
+	}
+}
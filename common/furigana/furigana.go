@@ -0,0 +1,339 @@
+package furigana
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+// maxWordLength is the longest dictionary word (in runes) looked up at a single position.
+const maxWordLength = 8
+
+// maxAlignLength bounds the reading length accepted by Align.
+const maxAlignLength = 2000
+
+// Segment is a piece of text with an optional ruby reading.
+// Reading is empty for kana, latin text and kanji that could not be resolved.
+type Segment struct {
+	Text    string
+	Reading string
+}
+
+// Dictionary maps Japanese words to their kana readings.
+type Dictionary struct {
+	words map[string]string
+	stems map[string]string
+}
+
+func NewDictionary() *Dictionary {
+	return &Dictionary{
+		words: make(map[string]string),
+		stems: make(map[string]string),
+	}
+}
+
+// Add registers a word and its reading. Words ending in okurigana (e.g. 食べる)
+// also register their kanji stem so conjugated forms (食べます) can be annotated.
+func (d *Dictionary) Add(word, reading string) {
+	if word == "" || reading == "" || !HasKanji(word) {
+		return
+	}
+	if _, exists := d.words[word]; !exists {
+		d.words[word] = reading
+	}
+
+	segments, ok := Align(word, reading)
+	if !ok || len(segments) < 2 || segments[0].Reading == "" {
+		return
+	}
+	if _, exists := d.stems[segments[0].Text]; !exists {
+		d.stems[segments[0].Text] = segments[0].Reading
+	}
+}
+
+// Len returns the number of words in the dictionary.
+func (d *Dictionary) Len() int {
+	return len(d.words)
+}
+
+func isKanji(r rune) bool {
+	return unicode.Is(unicode.Han, r) || r == '々' || r == '〆' || r == 'ヶ'
+}
+
+func isKana(r rune) bool {
+	return unicode.Is(unicode.Hiragana, r) || unicode.Is(unicode.Katakana, r) || r == 'ー'
+}
+
+// toHiragana folds katakana into hiragana so readings can be compared regardless of script.
+func toHiragana(r rune) rune {
+	if r >= 'ァ' && r <= 'ヶ' {
+		return r - 0x60
+	}
+	return r
+}
+
+// HasKanji reports whether the text contains at least one kanji.
+func HasKanji(text string) bool {
+	for _, r := range text {
+		if isKanji(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// group is a run of characters that are either all kanji or all non-kanji.
+type group struct {
+	runes []rune
+	kanji bool
+}
+
+func splitGroups(text []rune) []group {
+	groups := make([]group, 0)
+	for _, r := range text {
+		kanji := isKanji(r)
+		if len(groups) > 0 && groups[len(groups)-1].kanji == kanji {
+			groups[len(groups)-1].runes = append(groups[len(groups)-1].runes, r)
+			continue
+		}
+		groups = append(groups, group{runes: []rune{r}, kanji: kanji})
+	}
+	return groups
+}
+
+// Align splits text into segments and assigns each kanji run the part of the
+// kana reading it corresponds to, e.g. 食べる + たべる gives 食(た) べる.
+// It returns false when the kana in the text cannot be matched against the reading.
+// When several alignments fit, earlier kanji runs get the shortest readings.
+func Align(text, reading string) ([]Segment, bool) {
+	textRunes := []rune(text)
+	readingRunes := []rune(reading)
+	if len(textRunes) == 0 || len(readingRunes) == 0 || len(readingRunes) > maxAlignLength {
+		return nil, false
+	}
+
+	groups := splitGroups(textRunes)
+	readings, ok := alignGroups(groups, readingRunes)
+	if !ok {
+		return nil, false
+	}
+
+	segments := make([]Segment, 0, len(groups))
+	for i, g := range groups {
+		segment := Segment{Text: string(g.runes)}
+		if g.kanji {
+			segment.Reading = readings[i]
+		}
+		segments = append(segments, segment)
+	}
+	return segments, true
+}
+
+// alignGroups assigns each kanji run its part of the reading. It first works out,
+// from the last group backwards, at which reading positions each group can start
+// and still consume the rest of the reading, then walks forward giving each kanji
+// run the shortest reading that keeps the alignment possible. This takes time
+// proportional to the number of groups times the reading length, plus the cost of
+// matching kana runs.
+func alignGroups(groups []group, reading []rune) ([]string, bool) {
+	// Every kanji run consumes at least one kana
+	kanjiGroups := 0
+	for _, g := range groups {
+		if g.kanji {
+			kanjiGroups++
+		}
+	}
+	if kanjiGroups > len(reading) {
+		return nil, false
+	}
+
+	// canFinish[gi][ri] reports whether groups[gi:] can consume exactly reading[ri:]
+	canFinish := make([][]bool, len(groups)+1)
+	canFinish[len(groups)] = make([]bool, len(reading)+1)
+	canFinish[len(groups)][len(reading)] = true
+	for gi := len(groups) - 1; gi >= 0; gi-- {
+		canFinish[gi] = make([]bool, len(reading)+1)
+		next := canFinish[gi+1]
+		if !groups[gi].kanji {
+			for ri := 0; ri <= len(reading); ri++ {
+				end, ok := matchKana(groups[gi].runes, reading, ri)
+				canFinish[gi][ri] = ok && next[end]
+			}
+			continue
+		}
+
+		// A kanji run starting at ri reads reading[ri:end] for any end within the
+		// kana run starting at ri, so it can finish if it can from ri+1 or the next
+		// group can from ri+1
+		for ri := len(reading) - 1; ri >= 0; ri-- {
+			canFinish[gi][ri] = isKana(reading[ri]) && (next[ri+1] || canFinish[gi][ri+1])
+		}
+	}
+	if !canFinish[0][0] {
+		return nil, false
+	}
+
+	readings := make([]string, len(groups))
+	ri := 0
+	for gi, g := range groups {
+		if !g.kanji {
+			ri, _ = matchKana(g.runes, reading, ri)
+			continue
+		}
+		end := ri + 1
+		for !canFinish[gi+1][end] {
+			end++
+		}
+		readings[gi] = string(reading[ri:end])
+		ri = end
+	}
+	return readings, true
+}
+
+// matchKana consumes a non-kanji run from the reading. Kana must match (ignoring
+// hiragana/katakana differences); other characters such as punctuation or spaces
+// are consumed when present in the reading and skipped otherwise.
+func matchKana(text []rune, reading []rune, ri int) (int, bool) {
+	for _, r := range text {
+		if isKana(r) {
+			if ri >= len(reading) || toHiragana(reading[ri]) != toHiragana(r) {
+				return ri, false
+			}
+			ri++
+			continue
+		}
+		if ri < len(reading) && reading[ri] == r {
+			ri++
+			continue
+		}
+		if unicode.IsSpace(r) || unicode.IsPunct(r) {
+			continue
+		}
+		return ri, false
+	}
+
+	// Readings often omit spacing and punctuation present in the text
+	for ri < len(reading) && (unicode.IsSpace(reading[ri]) || unicode.IsPunct(reading[ri])) {
+		ri++
+	}
+	return ri, true
+}
+
+// Annotate segments free text using the dictionary. At each kanji the longest
+// dictionary word is used; otherwise a matching okurigana stem is tried, and
+// unknown kanji are left without a reading.
+func Annotate(text string, dictionary *Dictionary) []Segment {
+	runes := []rune(text)
+	segments := make([]Segment, 0)
+	var plain strings.Builder
+
+	flush := func() {
+		if plain.Len() > 0 {
+			segments = append(segments, Segment{Text: plain.String()})
+			plain.Reset()
+		}
+	}
+
+	for i := 0; i < len(runes); {
+		if !isKanji(runes[i]) {
+			plain.WriteRune(runes[i])
+			i++
+			continue
+		}
+
+		if word, reading, ok := longestWord(runes, i, dictionary); ok {
+			flush()
+			if aligned, ok := Align(word, reading); ok {
+				segments = append(segments, aligned...)
+			} else {
+				segments = append(segments, Segment{Text: word, Reading: reading})
+			}
+			i += len([]rune(word))
+			continue
+		}
+
+		run := kanjiRun(runes, i)
+		if reading, ok := dictionary.stems[string(run)]; ok && i+len(run) < len(runes) && isKana(runes[i+len(run)]) {
+			flush()
+			segments = append(segments, Segment{Text: string(run), Reading: reading})
+			i += len(run)
+			continue
+		}
+
+		plain.WriteString(string(run))
+		i += len(run)
+	}
+	flush()
+
+	return segments
+}
+
+func longestWord(runes []rune, start int, dictionary *Dictionary) (string, string, bool) {
+	end := start + maxWordLength
+	if end > len(runes) {
+		end = len(runes)
+	}
+	for ; end > start; end-- {
+		word := string(runes[start:end])
+		if reading, ok := dictionary.words[word]; ok {
+			return word, reading, true
+		}
+	}
+	return "", "", false
+}
+
+func kanjiRun(runes []rune, start int) []rune {
+	end := start
+	for end < len(runes) && isKanji(runes[end]) {
+		end++
+	}
+	return runes[start:end]
+}
+
+// Candidates returns the lookup keys needed to build a dictionary for the text:
+// every substring starting at a kanji (up to maxWordLength runes) and every
+// maximal kanji run, used as a prefix to find okurigana words.
+func Candidates(text string) (words []string, prefixes []string) {
+	runes := []rune(text)
+	seenWords := make(map[string]bool)
+	seenPrefixes := make(map[string]bool)
+
+	for i := 0; i < len(runes); i++ {
+		if !isKanji(runes[i]) {
+			continue
+		}
+		for end := i + 1; end <= len(runes) && end-i <= maxWordLength; end++ {
+			word := string(runes[i:end])
+			if !seenWords[word] {
+				seenWords[word] = true
+				words = append(words, word)
+			}
+		}
+		if i == 0 || !isKanji(runes[i-1]) {
+			prefix := string(kanjiRun(runes, i))
+			if !seenPrefixes[prefix] {
+				seenPrefixes[prefix] = true
+				prefixes = append(prefixes, prefix)
+			}
+		}
+	}
+
+	return words, prefixes
+}
+
+// ToHTML renders segments as HTML ruby markup, escaping all text.
+func ToHTML(segments []Segment) string {
+	var builder strings.Builder
+	for _, segment := range segments {
+		if segment.Reading == "" {
+			builder.WriteString(html.EscapeString(segment.Text))
+			continue
+		}
+		builder.WriteString("<ruby>")
+		builder.WriteString(html.EscapeString(segment.Text))
+		builder.WriteString("<rt>")
+		builder.WriteString(html.EscapeString(segment.Reading))
+		builder.WriteString("</rt></ruby>")
+	}
+	return builder.String()
+}
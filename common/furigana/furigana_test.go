@@ -0,0 +1,70 @@
+package furigana
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlign(t *testing.T) {
+	segments, ok := Align("食べる", "たべる")
+	assert.True(t, ok)
+	assert.Equal(t, []Segment{{Text: "食", Reading: "た"}, {Text: "べる"}}, segments)
+
+	segments, ok = Align("日本語を勉強します。", "にほんごをべんきょうします")
+	assert.True(t, ok)
+	assert.Equal(t, []Segment{
+		{Text: "日本語", Reading: "にほんご"},
+		{Text: "を"},
+		{Text: "勉強", Reading: "べんきょう"},
+		{Text: "します。"},
+	}, segments)
+
+	segments, ok = Align("聞き手", "キキテ")
+	assert.True(t, ok)
+	assert.Equal(t, []Segment{{Text: "聞", Reading: "キ"}, {Text: "き"}, {Text: "手", Reading: "テ"}}, segments)
+
+	_, ok = Align("食べる", "のむ")
+	assert.False(t, ok)
+}
+
+func TestAlignAdversarialInput(t *testing.T) {
+	// Every kanji can take any number of あ, which made backtracking exponential
+	n := 600
+	text := strings.Repeat("日あ", n) + "日い"
+	reading := strings.Repeat("あ", 3*n)
+
+	start := time.Now()
+	_, ok := Align(text, reading)
+	assert.False(t, ok)
+
+	segments, ok := Align(text, strings.Repeat("あ", 3*n-1)+"い")
+	assert.True(t, ok)
+	assert.Len(t, segments, 2*n+2)
+	assert.Equal(t, "あ", segments[0].Reading)
+	assert.Equal(t, strings.Repeat("あ", n-1), segments[2*n].Reading)
+
+	assert.Less(t, time.Since(start), 2*time.Second)
+}
+
+func TestAnnotate(t *testing.T) {
+	dictionary := NewDictionary()
+	dictionary.Add("日本", "にほん")
+	dictionary.Add("日本語", "にほんご")
+	dictionary.Add("食べる", "たべる")
+
+	segments := Annotate("日本語で寿司を食べます", dictionary)
+	assert.Equal(t, []Segment{
+		{Text: "日本語", Reading: "にほんご"},
+		{Text: "で寿司を"},
+		{Text: "食", Reading: "た"},
+		{Text: "べます"},
+	}, segments)
+}
+
+func TestToHTML(t *testing.T) {
+	html := ToHTML([]Segment{{Text: "犬", Reading: "いぬ"}, {Text: "が<好き>"}})
+	assert.Equal(t, "<ruby>犬<rt>いぬ</rt></ruby>が&lt;好き&gt;", html)
+}
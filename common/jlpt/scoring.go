@@ -0,0 +1,129 @@
+package jlpt
+
+import (
+	"manabu-service/constants"
+	"math"
+)
+
+// Scoring section names as reported on JLPT score reports
+const (
+	ScoringLanguageKnowledge        = "language_knowledge"
+	ScoringLanguageKnowledgeReading = "language_knowledge_reading"
+	ScoringReading                  = "reading"
+	ScoringListening                = "listening"
+)
+
+// ScoringGroup is a scored section of the JLPT made of one or more exam sections.
+// A learner must reach PassScore in every group regardless of the total score.
+type ScoringGroup struct {
+	Name      string
+	Sections  []string
+	MaxScore  int
+	PassScore int
+}
+
+// ScoringRule holds the scoring sections and overall pass mark of a JLPT level.
+type ScoringRule struct {
+	Groups    []ScoringGroup
+	PassScore int
+}
+
+// N1 to N3 score language knowledge, reading and listening separately;
+// N4 and N5 combine language knowledge and reading.
+var (
+	separateReadingGroups = []ScoringGroup{
+		{Name: ScoringLanguageKnowledge, Sections: []string{constants.ExamSectionVocabulary, constants.ExamSectionGrammar}, MaxScore: 60, PassScore: 19},
+		{Name: ScoringReading, Sections: []string{constants.ExamSectionReading}, MaxScore: 60, PassScore: 19},
+		{Name: ScoringListening, Sections: []string{constants.ExamSectionListening}, MaxScore: 60, PassScore: 19},
+	}
+	combinedReadingGroups = []ScoringGroup{
+		{Name: ScoringLanguageKnowledgeReading, Sections: []string{constants.ExamSectionVocabulary, constants.ExamSectionGrammar, constants.ExamSectionReading}, MaxScore: 120, PassScore: 38},
+		{Name: ScoringListening, Sections: []string{constants.ExamSectionListening}, MaxScore: 60, PassScore: 19},
+	}
+)
+
+// scoringRules maps JLPT level codes to their official scoring rules
+var scoringRules = map[string]ScoringRule{
+	"N1": {Groups: separateReadingGroups, PassScore: 100},
+	"N2": {Groups: separateReadingGroups, PassScore: 90},
+	"N3": {Groups: separateReadingGroups, PassScore: 95},
+	"N4": {Groups: combinedReadingGroups, PassScore: 90},
+	"N5": {Groups: combinedReadingGroups, PassScore: 80},
+}
+
+// RuleFor returns the scoring rule of a JLPT level code such as "N3".
+func RuleFor(levelCode string) (ScoringRule, bool) {
+	rule, ok := scoringRules[levelCode]
+	return rule, ok
+}
+
+// SectionPoints is the raw result of an exam section.
+type SectionPoints struct {
+	Earned   int
+	Possible int
+}
+
+// GroupScore is the scaled score of a scoring group.
+type GroupScore struct {
+	Name      string
+	Score     int
+	MaxScore  int
+	PassScore int
+	Passed    bool
+}
+
+// Result is the scaled outcome of an exam.
+type Result struct {
+	Groups     []GroupScore
+	TotalScore int
+	MaxScore   int
+	PassScore  int
+	Passed     bool
+}
+
+// MaxScore returns the highest total score of the rule.
+func (r ScoringRule) MaxScore() int {
+	total := 0
+	for _, group := range r.Groups {
+		total += group.MaxScore
+	}
+	return total
+}
+
+// Score scales the raw points of each exam section to the JLPT scoring groups.
+// The exam passes when the total reaches the pass mark and every group reaches its sectional pass mark.
+func (r ScoringRule) Score(points map[string]SectionPoints) Result {
+	result := Result{
+		Groups:    make([]GroupScore, 0, len(r.Groups)),
+		MaxScore:  r.MaxScore(),
+		PassScore: r.PassScore,
+		Passed:    true,
+	}
+
+	for _, group := range r.Groups {
+		earned, possible := 0, 0
+		for _, section := range group.Sections {
+			earned += points[section].Earned
+			possible += points[section].Possible
+		}
+
+		score := 0
+		if possible > 0 {
+			score = int(math.Round(float64(earned) / float64(possible) * float64(group.MaxScore)))
+		}
+
+		groupScore := GroupScore{
+			Name:      group.Name,
+			Score:     score,
+			MaxScore:  group.MaxScore,
+			PassScore: group.PassScore,
+			Passed:    score >= group.PassScore,
+		}
+		result.Groups = append(result.Groups, groupScore)
+		result.TotalScore += score
+		result.Passed = result.Passed && groupScore.Passed
+	}
+
+	result.Passed = result.Passed && result.TotalScore >= r.PassScore
+	return result
+}
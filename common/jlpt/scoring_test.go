@@ -0,0 +1,50 @@
+package jlpt
+
+import (
+	"manabu-service/constants"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScoreSeparateReading(t *testing.T) {
+	rule, ok := RuleFor("N3")
+	assert.True(t, ok)
+	assert.Equal(t, 180, rule.MaxScore())
+
+	result := rule.Score(map[string]SectionPoints{
+		constants.ExamSectionVocabulary: {Earned: 80, Possible: 100},
+		constants.ExamSectionGrammar:    {Earned: 70, Possible: 100},
+		constants.ExamSectionReading:    {Earned: 30, Possible: 60},
+		constants.ExamSectionListening:  {Earned: 40, Possible: 60},
+	})
+	assert.Equal(t, []GroupScore{
+		{Name: ScoringLanguageKnowledge, Score: 45, MaxScore: 60, PassScore: 19, Passed: true},
+		{Name: ScoringReading, Score: 30, MaxScore: 60, PassScore: 19, Passed: true},
+		{Name: ScoringListening, Score: 40, MaxScore: 60, PassScore: 19, Passed: true},
+	}, result.Groups)
+	assert.Equal(t, 115, result.TotalScore)
+	assert.True(t, result.Passed)
+}
+
+func TestScoreSectionalFail(t *testing.T) {
+	rule, _ := RuleFor("N5")
+	assert.Equal(t, 180, rule.MaxScore())
+
+	// A high total does not pass when listening is below its sectional pass mark
+	result := rule.Score(map[string]SectionPoints{
+		constants.ExamSectionVocabulary: {Earned: 100, Possible: 100},
+		constants.ExamSectionGrammar:    {Earned: 100, Possible: 100},
+		constants.ExamSectionReading:    {Earned: 100, Possible: 100},
+		constants.ExamSectionListening:  {Earned: 10, Possible: 60},
+	})
+	assert.Len(t, result.Groups, 2)
+	assert.Equal(t, 130, result.TotalScore)
+	assert.False(t, result.Groups[1].Passed)
+	assert.False(t, result.Passed)
+}
+
+func TestRuleForUnknownLevel(t *testing.T) {
+	_, ok := RuleFor("N6")
+	assert.False(t, ok)
+}
@@ -0,0 +1,88 @@
+package locale
+
+import (
+	"manabu-service/constants"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// IsSupported reports whether the locale is one of the supported locales.
+func IsSupported(locale string) bool {
+	return slices.Contains(constants.SupportedLocales, locale)
+}
+
+// normalize reduces a language tag such as "id-ID" or "en_US" to its primary language subtag.
+func normalize(tag string) string {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		tag = tag[:i]
+	}
+	return tag
+}
+
+type weightedTag struct {
+	tag     string
+	quality float64
+}
+
+// parseAcceptLanguage returns the language tags of an Accept-Language header ordered by quality.
+func parseAcceptLanguage(header string) []string {
+	weighted := make([]weightedTag, 0)
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(strings.TrimSpace(part), ";")
+		if fields[0] == "" {
+			continue
+		}
+
+		quality := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if value, ok := strings.CutPrefix(param, "q="); ok {
+				if q, err := strconv.ParseFloat(value, 64); err == nil {
+					quality = q
+				}
+			}
+		}
+		if quality <= 0 {
+			continue
+		}
+		weighted = append(weighted, weightedTag{tag: fields[0], quality: quality})
+	}
+
+	sort.SliceStable(weighted, func(i, j int) bool {
+		return weighted[i].quality > weighted[j].quality
+	})
+
+	tags := make([]string, 0, len(weighted))
+	for _, w := range weighted {
+		tags = append(tags, w.tag)
+	}
+	return tags
+}
+
+// Negotiate resolves the response locale from the ?lang= query parameter, then the
+// Accept-Language header, falling back to the default locale. The chosen locale
+// is advertised through the Content-Language response header.
+func Negotiate(ctx *gin.Context) string {
+	locale := constants.DefaultLocale
+
+	candidates := make([]string, 0)
+	if lang := ctx.Query("lang"); lang != "" {
+		candidates = append(candidates, lang)
+	}
+	candidates = append(candidates, parseAcceptLanguage(ctx.GetHeader(constants.AcceptLanguage))...)
+
+	for _, candidate := range candidates {
+		if normalized := normalize(candidate); IsSupported(normalized) {
+			locale = normalized
+			break
+		}
+	}
+
+	ctx.Header(constants.ContentLanguage, locale)
+	return locale
+}
@@ -0,0 +1,201 @@
+// Package markdown sanitises the markdown written by authors and renders it to safe HTML.
+//
+// Only a small subset of markdown is supported: headings, paragraphs, block quotes, ordered
+// and unordered lists, fenced code blocks, horizontal rules, emphasis, strong text, inline
+// code and links. Raw HTML is never passed through: the renderer escapes every character of
+// the source, so its output is safe to embed even for markdown that was not sanitised.
+package markdown
+
+import (
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	// htmlTag matches raw HTML tags and comments, which authors cannot use
+	htmlTag = regexp.MustCompile(`(?s)<!--.*?-->|</?[A-Za-z][A-Za-z0-9-]*(\s[^<>]*)?/?>`)
+
+	heading     = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	orderedItem = regexp.MustCompile(`^\d{1,9}[.)]\s+(.*)$`)
+	bulletItem  = regexp.MustCompile(`^[-*+]\s+(.*)$`)
+	rule        = regexp.MustCompile(`^(\*\s*){3,}$|^(-\s*){3,}$|^(_\s*){3,}$`)
+
+	strong   = regexp.MustCompile(`\*\*(\S(?:.*?\S)?)\*\*|__(\S(?:.*?\S)?)__`)
+	emphasis = regexp.MustCompile(`\*(\S(?:.*?\S)?)\*|\b_(\S(?:.*?\S)?)_\b`)
+	link     = regexp.MustCompile(`\[([^\[\]]+)\]\(([^()\s]+)\)`)
+)
+
+// allowedSchemes are the URL schemes links may use. Relative links starting with / are allowed too.
+var allowedSchemes = []string{"http://", "https://", "mailto:"}
+
+// Sanitize cleans markdown before it is stored: line endings are normalised, control
+// characters other than tabs and newlines are removed and raw HTML tags are stripped.
+// Tags are stripped until none are left, so removing one cannot assemble another.
+func Sanitize(source string) string {
+	source = strings.ReplaceAll(source, "\r\n", "\n")
+	source = strings.ReplaceAll(source, "\r", "\n")
+	source = strings.Map(func(r rune) rune {
+		if r == '\n' || r == '\t' {
+			return r
+		}
+		if r < 0x20 || r == 0x7f {
+			return -1
+		}
+		return r
+	}, source)
+	for {
+		stripped := htmlTag.ReplaceAllString(source, "")
+		if stripped == source {
+			break
+		}
+		source = stripped
+	}
+	return strings.TrimSpace(source)
+}
+
+// Render converts markdown to HTML
+func Render(source string) string {
+	lines := strings.Split(strings.ReplaceAll(source, "\r\n", "\n"), "\n")
+	var out strings.Builder
+
+	for i := 0; i < len(lines); {
+		line := strings.TrimRight(lines[i], " \t")
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case trimmed == "":
+			i++
+
+		case strings.HasPrefix(trimmed, "```"):
+			i++
+			var code []string
+			for i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), "```") {
+				code = append(code, lines[i])
+				i++
+			}
+			i++ // closing fence
+			out.WriteString("<pre><code>")
+			out.WriteString(html.EscapeString(strings.Join(code, "\n")))
+			out.WriteString("</code></pre>\n")
+
+		case heading.MatchString(trimmed):
+			match := heading.FindStringSubmatch(trimmed)
+			level := string(rune('0' + len(match[1])))
+			out.WriteString("<h" + level + ">" + renderInline(match[2]) + "</h" + level + ">\n")
+			i++
+
+		case rule.MatchString(trimmed):
+			out.WriteString("<hr>\n")
+			i++
+
+		case strings.HasPrefix(trimmed, ">"):
+			var quote []string
+			for i < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i]), ">") {
+				quote = append(quote, strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(lines[i]), ">")))
+				i++
+			}
+			out.WriteString("<blockquote>\n")
+			out.WriteString(Render(strings.Join(quote, "\n")))
+			out.WriteString("\n</blockquote>\n")
+
+		case bulletItem.MatchString(trimmed), orderedItem.MatchString(trimmed):
+			item, tag := bulletItem, "ul"
+			if !bulletItem.MatchString(trimmed) {
+				item, tag = orderedItem, "ol"
+			}
+			out.WriteString("<" + tag + ">\n")
+			for i < len(lines) && item.MatchString(strings.TrimSpace(lines[i])) {
+				match := item.FindStringSubmatch(strings.TrimSpace(lines[i]))
+				out.WriteString("<li>" + renderInline(match[1]) + "</li>\n")
+				i++
+			}
+			out.WriteString("</" + tag + ">\n")
+
+		default:
+			var paragraph []string
+			for i < len(lines) && isParagraphLine(lines[i]) {
+				paragraph = append(paragraph, strings.TrimSpace(lines[i]))
+				i++
+			}
+			out.WriteString("<p>" + renderInline(strings.Join(paragraph, "\n")) + "</p>\n")
+		}
+	}
+
+	return strings.TrimSuffix(out.String(), "\n")
+}
+
+// isParagraphLine reports whether a line continues a paragraph rather than starting another block
+func isParagraphLine(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	return trimmed != "" &&
+		!strings.HasPrefix(trimmed, "```") &&
+		!strings.HasPrefix(trimmed, ">") &&
+		!heading.MatchString(trimmed) &&
+		!rule.MatchString(trimmed) &&
+		!bulletItem.MatchString(trimmed) &&
+		!orderedItem.MatchString(trimmed)
+}
+
+// renderInline escapes text and renders inline code, links, strong text and emphasis.
+// The contents of inline code are left as they are.
+func renderInline(text string) string {
+	parts := strings.Split(text, "`")
+	var out strings.Builder
+	for i, part := range parts {
+		// Odd parts are inside backticks, unless the last backtick is unmatched
+		if i%2 == 1 && i < len(parts)-1 {
+			out.WriteString("<code>" + html.EscapeString(part) + "</code>")
+			continue
+		}
+		if i%2 == 1 {
+			out.WriteString("`")
+		}
+		out.WriteString(renderText(part))
+	}
+	return out.String()
+}
+
+// renderText escapes text and renders links, strong text and emphasis.
+// Links are set aside while emphasis is rendered so their targets are never changed;
+// the placeholders contain < and >, which cannot occur in escaped text.
+func renderText(text string) string {
+	escaped := html.EscapeString(text)
+
+	var links []string
+	escaped = link.ReplaceAllStringFunc(escaped, func(match string) string {
+		parts := link.FindStringSubmatch(match)
+		label, href := renderEmphasis(parts[1]), parts[2]
+		if !isAllowedURL(html.UnescapeString(href)) {
+			return label
+		}
+		links = append(links, `<a href="`+href+`" rel="nofollow noopener">`+label+`</a>`)
+		return "<" + strconv.Itoa(len(links)-1) + ">"
+	})
+	escaped = renderEmphasis(escaped)
+	for i, anchor := range links {
+		escaped = strings.Replace(escaped, "<"+strconv.Itoa(i)+">", anchor, 1)
+	}
+	return strings.ReplaceAll(escaped, "\n", "<br>\n")
+}
+
+// renderEmphasis renders strong text and emphasis in escaped text
+func renderEmphasis(escaped string) string {
+	escaped = strong.ReplaceAllString(escaped, "<strong>$1$2</strong>")
+	return emphasis.ReplaceAllString(escaped, "<em>$1$2</em>")
+}
+
+// isAllowedURL reports whether a link target uses an allowed scheme or is a relative path
+func isAllowedURL(href string) bool {
+	if strings.HasPrefix(href, "/") && !strings.HasPrefix(href, "//") {
+		return true
+	}
+	lower := strings.ToLower(href)
+	for _, scheme := range allowedSchemes {
+		if strings.HasPrefix(lower, scheme) && len(lower) > len(scheme) {
+			return true
+		}
+	}
+	return false
+}
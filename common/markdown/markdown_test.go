@@ -0,0 +1,48 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitize(t *testing.T) {
+	source := "  Hello <script>alert(1)</script>**world**\r\n<!-- note -->1 < 2 > 0\x00  "
+
+	assert.Equal(t, "Hello alert(1)**world**\n1 < 2 > 0", Sanitize(source))
+}
+
+func TestSanitizeNestedTags(t *testing.T) {
+	assert.Equal(t, "alert(1)", Sanitize("<scr<script>ipt>alert(1)</script>"))
+	assert.Equal(t, "x", Sanitize("<<script>script>x"))
+}
+
+func TestRenderBlocks(t *testing.T) {
+	source := "# Hiragana\n\nThere are **46** basic characters.\nLearn them *first*.\n\n- あ a\n- い i\n\n1. Read\n2. Write\n\n> Practice daily\n\n---\n\n```\n<b>code</b>\n```"
+
+	expected := "<h1>Hiragana</h1>\n" +
+		"<p>There are <strong>46</strong> basic characters.<br>\nLearn them <em>first</em>.</p>\n" +
+		"<ul>\n<li>あ a</li>\n<li>い i</li>\n</ul>\n" +
+		"<ol>\n<li>Read</li>\n<li>Write</li>\n</ol>\n" +
+		"<blockquote>\n<p>Practice daily</p>\n</blockquote>\n" +
+		"<hr>\n" +
+		"<pre><code>&lt;b&gt;code&lt;/b&gt;</code></pre>"
+	assert.Equal(t, expected, Render(source))
+}
+
+func TestRenderEscapesHTML(t *testing.T) {
+	assert.Equal(t, "<p>&lt;img src=x onerror=&#34;alert(1)&#34;&gt;</p>", Render(`<img src=x onerror="alert(1)">`))
+	assert.Equal(t, "<p>Use <code>&lt;ruby&gt;</code> and `</p>", Render("Use `<ruby>` and `"))
+}
+
+func TestRenderLinks(t *testing.T) {
+	assert.Equal(t, `<p><a href="https://example.com/a?b=1&amp;c=2" rel="nofollow noopener">guide</a></p>`, Render("[guide](https://example.com/a?b=1&c=2)"))
+	assert.Equal(t, `<p><a href="/lessons/2" rel="nofollow noopener">next</a></p>`, Render("[next](/lessons/2)"))
+	assert.Equal(t, "<p>click</p>", Render("[click](javascript:void)"))
+	assert.Equal(t, "<p>click</p>", Render("[click](//evil.example.com)"))
+}
+
+func TestRenderEmphasisKeepsLinkTargets(t *testing.T) {
+	assert.Equal(t, `<p><a href="http://a/**b**" rel="nofollow noopener"><strong>x</strong></a></p>`, Render("[**x**](http://a/**b**)"))
+	assert.Equal(t, `<p><strong>see <a href="https://example.com/_a_b_" rel="nofollow noopener">notes</a></strong></p>`, Render("**see [notes](https://example.com/_a_b_)**"))
+}
@@ -0,0 +1,97 @@
+// Package placement estimates a learner's JLPT level from an adaptive sequence of answers.
+//
+// The test climbs the levels in increasing level order, starting at the easiest one.
+// A level is passed once PassCorrect answers at that level are correct and failed once
+// FailWrong answers are wrong, so every level is decided within QuestionsPerLevel questions.
+// Passing moves the test one level up; failing (or passing the hardest level) ends it.
+//
+// JLPT level orders count down with difficulty (N5 is 5, N1 is 1), so level orders are
+// put easiest first with EasiestFirst before they are evaluated.
+package placement
+
+import "sort"
+
+const (
+	// PassCorrect is the number of correct answers needed to pass a level
+	PassCorrect = 3
+	// FailWrong is the number of wrong answers that fails a level
+	FailWrong = 2
+	// QuestionsPerLevel is the most questions served at a single level
+	QuestionsPerLevel = PassCorrect + FailWrong - 1
+)
+
+// Response is a graded answer to a question of the given level order.
+type Response struct {
+	LevelOrder int
+	Correct    bool
+}
+
+// Decision is the state of a placement test after replaying its responses.
+// While the test is running, NextLevelOrder is the level of the next question.
+// Once Finished, EstimatedLevelOrder is the hardest level passed, or the easiest
+// level when none was passed.
+type Decision struct {
+	Finished            bool
+	NextLevelOrder      int
+	EstimatedLevelOrder int
+	LevelsPassed        int
+}
+
+// EasiestFirst returns the level orders sorted from the easiest level (the highest
+// order) to the hardest level (the lowest order).
+func EasiestFirst(levelOrders []int) []int {
+	sorted := append([]int(nil), levelOrders...)
+	sort.Sort(sort.Reverse(sort.IntSlice(sorted)))
+	return sorted
+}
+
+// Evaluate replays the responses against the level orders (sorted easiest first)
+// and decides whether the test continues and at which level. Responses at a level
+// other than the current one are ignored.
+func Evaluate(levelOrders []int, responses []Response) Decision {
+	if len(levelOrders) == 0 {
+		return Decision{Finished: true}
+	}
+
+	current, correct, wrong := 0, 0, 0
+	for _, response := range responses {
+		if response.LevelOrder != levelOrders[current] {
+			continue
+		}
+		if response.Correct {
+			correct++
+		} else {
+			wrong++
+		}
+
+		switch {
+		case correct >= PassCorrect:
+			if current == len(levelOrders)-1 {
+				return finished(levelOrders, current+1)
+			}
+			current, correct, wrong = current+1, 0, 0
+		case wrong >= FailWrong:
+			return finished(levelOrders, current)
+		}
+	}
+
+	return Decision{NextLevelOrder: levelOrders[current], LevelsPassed: current}
+}
+
+// Stop ends a running test early, e.g. when no question is left at the current level,
+// keeping the levels passed so far.
+func Stop(levelOrders []int, responses []Response) Decision {
+	decision := Evaluate(levelOrders, responses)
+	if decision.Finished || len(levelOrders) == 0 {
+		return decision
+	}
+	return finished(levelOrders, decision.LevelsPassed)
+}
+
+func finished(levelOrders []int, levelsPassed int) Decision {
+	estimated := levelOrders[0]
+	if levelsPassed > 0 {
+		estimated = levelOrders[levelsPassed-1]
+	}
+	return Decision{Finished: true, EstimatedLevelOrder: estimated, LevelsPassed: levelsPassed}
+}
@@ -0,0 +1,107 @@
+package placement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var levels = []int{1, 2, 3, 4, 5}
+
+func responses(levelOrder int, answers ...bool) []Response {
+	result := make([]Response, 0, len(answers))
+	for _, correct := range answers {
+		result = append(result, Response{LevelOrder: levelOrder, Correct: correct})
+	}
+	return result
+}
+
+func TestEvaluateStartsAtEasiestLevel(t *testing.T) {
+	decision := Evaluate(levels, nil)
+	assert.False(t, decision.Finished)
+	assert.Equal(t, 1, decision.NextLevelOrder)
+}
+
+func TestEvaluateClimbsAndStopsOnFailure(t *testing.T) {
+	answers := responses(1, true, true, true)
+	answers = append(answers, responses(2, true, false, true, true)...)
+	answers = append(answers, responses(3, false, true)...)
+
+	decision := Evaluate(levels, answers)
+	assert.False(t, decision.Finished)
+	assert.Equal(t, 3, decision.NextLevelOrder)
+
+	answers = append(answers, responses(3, false)...)
+	decision = Evaluate(levels, answers)
+	assert.True(t, decision.Finished)
+	assert.Equal(t, 2, decision.EstimatedLevelOrder)
+	assert.Equal(t, 2, decision.LevelsPassed)
+}
+
+func TestEvaluateFailingFirstLevelEstimatesEasiest(t *testing.T) {
+	decision := Evaluate(levels, responses(1, false, false))
+	assert.True(t, decision.Finished)
+	assert.Equal(t, 1, decision.EstimatedLevelOrder)
+	assert.Equal(t, 0, decision.LevelsPassed)
+}
+
+func TestEvaluatePassingHardestLevel(t *testing.T) {
+	var answers []Response
+	for _, level := range levels {
+		answers = append(answers, responses(level, true, true, true)...)
+	}
+
+	decision := Evaluate(levels, answers)
+	assert.True(t, decision.Finished)
+	assert.Equal(t, 5, decision.EstimatedLevelOrder)
+}
+
+func TestStopKeepsLevelsPassed(t *testing.T) {
+	answers := responses(1, true, true, true)
+	answers = append(answers, responses(2, true)...)
+
+	decision := Stop(levels, answers)
+	assert.True(t, decision.Finished)
+	assert.Equal(t, 1, decision.EstimatedLevelOrder)
+}
+
+// seededLevels is the order of the JLPT levels as seeded and listed by the repository:
+// N1 has level order 1 and N5 has level order 5.
+var seededLevels = []int{1, 2, 3, 4, 5}
+
+func TestEasiestFirstStartsAtN5(t *testing.T) {
+	levelOrders := EasiestFirst(seededLevels)
+
+	decision := Evaluate(levelOrders, nil)
+	assert.False(t, decision.Finished)
+	assert.Equal(t, 5, decision.NextLevelOrder)
+	// The level orders are not sorted in place
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, seededLevels)
+}
+
+func TestEasiestFirstBeginnerEstimatesN5(t *testing.T) {
+	levelOrders := EasiestFirst(seededLevels)
+
+	decision := Evaluate(levelOrders, responses(5, false, false))
+	assert.True(t, decision.Finished)
+	assert.Equal(t, 5, decision.EstimatedLevelOrder)
+	assert.Equal(t, 0, decision.LevelsPassed)
+
+	// A test stopped without answers places the learner at N5
+	decision = Stop(levelOrders, nil)
+	assert.True(t, decision.Finished)
+	assert.Equal(t, 5, decision.EstimatedLevelOrder)
+}
+
+func TestEasiestFirstClimbsTowardsN1(t *testing.T) {
+	levelOrders := EasiestFirst(seededLevels)
+
+	answers := responses(5, true, true, true)
+	answers = append(answers, responses(4, true, true, true)...)
+	answers = append(answers, responses(3, false, false)...)
+
+	decision := Evaluate(levelOrders, answers)
+	assert.True(t, decision.Finished)
+	assert.Equal(t, 4, decision.EstimatedLevelOrder)
+	assert.Equal(t, 2, decision.LevelsPassed)
+}
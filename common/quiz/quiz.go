@@ -0,0 +1,202 @@
+// Package quiz generates multiple-choice vocabulary questions with distractors.
+//
+// Distractors are drawn from a pool of other vocabulary, preferring words of the same JLPT
+// level and part of speech as the word asked about, so wrong choices look plausible.
+package quiz
+
+import (
+	"fmt"
+	"manabu-service/domain/dto"
+	"math/rand/v2"
+)
+
+// Kinds of generated questions
+const (
+	// KindMeaning shows the meaning and asks for the word
+	KindMeaning = "meaning"
+	// KindReading shows the word and asks for its kana reading
+	KindReading = "reading"
+	// KindListening plays the audio of the word and asks for the word
+	KindListening = "listening"
+)
+
+// Kinds lists every kind of question, in the order they are tried for a word
+var Kinds = []string{KindMeaning, KindReading, KindListening}
+
+// choiceKeys are the keys given to the choices of a question, in order
+var choiceKeys = []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"}
+
+// MaxChoices is the largest number of choices of a generated question
+var MaxChoices = len(choiceKeys)
+
+// Word is a vocabulary word questions are generated from
+type Word struct {
+	ID           uint
+	Word         string
+	Reading      string
+	Meaning      string
+	PartOfSpeech string
+	JlptLevelID  uint
+	AudioURL     string
+}
+
+// Question is a generated multiple-choice question. QuestionType is the exercise question type
+// it is stored as: listening for listening questions and multiple_choice otherwise.
+type Question struct {
+	VocabularyID uint
+	Kind         string
+	QuestionType string
+	QuestionText string
+	AudioURL     string
+	Choices      []dto.QuestionChoice
+	AnswerKey    string
+}
+
+// Generator builds questions from a pool of distractor words
+type Generator struct {
+	rand    *rand.Rand
+	pool    []Word
+	choices int
+}
+
+// NewGenerator returns a generator of questions with the given number of choices, drawing
+// distractors from pool with r
+func NewGenerator(r *rand.Rand, pool []Word, choices int) *Generator {
+	if choices < 2 {
+		choices = 2
+	}
+	if choices > MaxChoices {
+		choices = MaxChoices
+	}
+	return &Generator{rand: r, pool: pool, choices: choices}
+}
+
+// Applies reports whether a question of the given kind can be asked about a word
+func Applies(word Word, kind string) bool {
+	switch kind {
+	case KindMeaning:
+		return word.Word != "" && word.Meaning != ""
+	case KindReading:
+		return word.Reading != "" && word.Reading != word.Word
+	case KindListening:
+		return word.Word != "" && word.AudioURL != ""
+	default:
+		return false
+	}
+}
+
+// answer returns the text of the correct choice of a question of the given kind about a word
+func answer(word Word, kind string) string {
+	if kind == KindReading {
+		return word.Reading
+	}
+	return word.Word
+}
+
+// Question generates a question of the given kind about a word. It reports false when the
+// kind does not apply to the word or the pool has no distractor for it.
+func (g *Generator) Question(word Word, kind string) (Question, bool) {
+	if !Applies(word, kind) {
+		return Question{}, false
+	}
+
+	correct := answer(word, kind)
+	distractors := g.distractors(word, kind, correct)
+	if len(distractors) == 0 {
+		return Question{}, false
+	}
+
+	texts := append([]string{correct}, distractors...)
+	g.rand.Shuffle(len(texts), func(i, j int) { texts[i], texts[j] = texts[j], texts[i] })
+
+	question := Question{
+		VocabularyID: word.ID,
+		Kind:         kind,
+		QuestionType: "multiple_choice",
+		Choices:      make([]dto.QuestionChoice, len(texts)),
+	}
+	for i, text := range texts {
+		question.Choices[i] = dto.QuestionChoice{Key: choiceKeys[i], Text: text}
+		if text == correct {
+			question.AnswerKey = choiceKeys[i]
+		}
+	}
+
+	switch kind {
+	case KindMeaning:
+		question.QuestionText = fmt.Sprintf("Which word means: %s?", word.Meaning)
+	case KindReading:
+		question.QuestionText = fmt.Sprintf("How is %s read?", word.Word)
+	case KindListening:
+		question.QuestionType = "listening"
+		question.QuestionText = "Which word do you hear?"
+		question.AudioURL = word.AudioURL
+	}
+
+	return question, true
+}
+
+// distractors picks up to choices-1 wrong answers for a question about word. Words of the same
+// JLPT level and part of speech come first, then words sharing one of them, then the rest.
+func (g *Generator) distractors(word Word, kind, correct string) []string {
+	tiers := make([][]string, 4)
+	seen := map[string]bool{correct: true}
+	for _, candidate := range g.pool {
+		if candidate.ID == word.ID || !Applies(candidate, kind) {
+			continue
+		}
+		text := answer(candidate, kind)
+		if seen[text] {
+			continue
+		}
+		seen[text] = true
+
+		tier := 3
+		sameLevel := candidate.JlptLevelID == word.JlptLevelID
+		samePartOfSpeech := word.PartOfSpeech != "" && candidate.PartOfSpeech == word.PartOfSpeech
+		switch {
+		case sameLevel && samePartOfSpeech:
+			tier = 0
+		case sameLevel:
+			tier = 1
+		case samePartOfSpeech:
+			tier = 2
+		}
+		tiers[tier] = append(tiers[tier], text)
+	}
+
+	distractors := make([]string, 0, g.choices-1)
+	for _, tier := range tiers {
+		g.rand.Shuffle(len(tier), func(i, j int) { tier[i], tier[j] = tier[j], tier[i] })
+		for _, text := range tier {
+			if len(distractors) == g.choices-1 {
+				return distractors
+			}
+			distractors = append(distractors, text)
+		}
+	}
+	return distractors
+}
+
+// Generate builds up to count questions about words, in order, cycling through kinds so
+// consecutive questions vary. A word is skipped when none of the kinds can be asked about it.
+func (g *Generator) Generate(words []Word, kinds []string, count int) []Question {
+	questions := make([]Question, 0, count)
+	next := 0
+	for _, word := range words {
+		if len(questions) == count {
+			break
+		}
+		for tried := 0; tried < len(kinds); tried++ {
+			kind := kinds[(next+tried)%len(kinds)]
+			question, ok := g.Question(word, kind)
+			if !ok {
+				continue
+			}
+			questions = append(questions, question)
+			next = (next + tried + 1) % len(kinds)
+			break
+		}
+	}
+	return questions
+}
@@ -0,0 +1,88 @@
+package quiz
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+var pool = []Word{
+	{ID: 1, Word: "食べる", Reading: "たべる", Meaning: "to eat", PartOfSpeech: "verb", JlptLevelID: 5, AudioURL: "/a/1.mp3"},
+	{ID: 2, Word: "飲む", Reading: "のむ", Meaning: "to drink", PartOfSpeech: "verb", JlptLevelID: 5},
+	{ID: 3, Word: "見る", Reading: "みる", Meaning: "to see", PartOfSpeech: "verb", JlptLevelID: 5},
+	{ID: 4, Word: "本", Reading: "ほん", Meaning: "book", PartOfSpeech: "noun", JlptLevelID: 5},
+	{ID: 5, Word: "調べる", Reading: "しらべる", Meaning: "to investigate", PartOfSpeech: "verb", JlptLevelID: 4},
+	{ID: 6, Word: "経済", Reading: "けいざい", Meaning: "economy", PartOfSpeech: "noun", JlptLevelID: 3, AudioURL: "/a/6.mp3"},
+}
+
+func newGenerator(choices int) *Generator {
+	return NewGenerator(rand.New(rand.NewPCG(1, 2)), pool, choices)
+}
+
+func TestQuestionPrefersSameLevelAndPartOfSpeech(t *testing.T) {
+	question, ok := newGenerator(3).Question(pool[0], KindMeaning)
+	if !ok {
+		t.Fatal("expected a question")
+	}
+	if len(question.Choices) != 3 {
+		t.Fatalf("expected 3 choices, got %v", question.Choices)
+	}
+
+	texts := map[string]string{}
+	for _, choice := range question.Choices {
+		texts[choice.Text] = choice.Key
+	}
+	if texts["食べる"] != question.AnswerKey {
+		t.Fatalf("expected the answer key to point at the word, got %q in %v", question.AnswerKey, question.Choices)
+	}
+	if _, ok := texts["飲む"]; !ok {
+		t.Fatalf("expected same level verbs as distractors, got %v", question.Choices)
+	}
+	if _, ok := texts["見る"]; !ok {
+		t.Fatalf("expected same level verbs as distractors, got %v", question.Choices)
+	}
+}
+
+func TestQuestionKinds(t *testing.T) {
+	generator := newGenerator(4)
+
+	reading, ok := generator.Question(pool[3], KindReading)
+	if !ok || reading.QuestionType != "multiple_choice" {
+		t.Fatalf("expected a multiple choice reading question, got %+v", reading)
+	}
+	for _, choice := range reading.Choices {
+		if choice.Key == reading.AnswerKey && choice.Text != "ほん" {
+			t.Fatalf("expected the reading as answer, got %q", choice.Text)
+		}
+	}
+
+	listening, ok := generator.Question(pool[0], KindListening)
+	if !ok || listening.QuestionType != "listening" || listening.AudioURL != "/a/1.mp3" {
+		t.Fatalf("expected a listening question with audio, got %+v", listening)
+	}
+
+	if _, ok := generator.Question(pool[1], KindListening); ok {
+		t.Fatal("expected no listening question for a word without audio")
+	}
+}
+
+func TestQuestionWithoutDistractors(t *testing.T) {
+	generator := NewGenerator(rand.New(rand.NewPCG(1, 2)), pool[:1], 4)
+	if _, ok := generator.Question(pool[0], KindMeaning); ok {
+		t.Fatal("expected no question when the pool has no other words")
+	}
+}
+
+func TestGenerateCyclesKinds(t *testing.T) {
+	questions := newGenerator(4).Generate(pool, Kinds, 4)
+	if len(questions) != 4 {
+		t.Fatalf("expected 4 questions, got %d", len(questions))
+	}
+	if questions[0].Kind != KindMeaning || questions[1].Kind != KindReading {
+		t.Fatalf("expected kinds to alternate, got %s and %s", questions[0].Kind, questions[1].Kind)
+	}
+	for _, question := range questions {
+		if question.AnswerKey == "" {
+			t.Fatalf("expected every question to have an answer, got %+v", question)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+// Package revision compares content snapshots stored as JSON objects.
+package revision
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// Change is the previous and current value of a snapshot field
+type Change struct {
+	From interface{} `json:"from"`
+	To   interface{} `json:"to"`
+}
+
+// Diff compares two JSON object snapshots field by field and returns the fields whose
+// values differ. An empty previous snapshot compares as an object without fields.
+func Diff(previous, current string) (map[string]Change, error) {
+	before := map[string]interface{}{}
+	if previous != "" {
+		if err := json.Unmarshal([]byte(previous), &before); err != nil {
+			return nil, err
+		}
+	}
+	after := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(current), &after); err != nil {
+		return nil, err
+	}
+
+	changes := make(map[string]Change)
+	for field, value := range after {
+		if old, ok := before[field]; !ok || !reflect.DeepEqual(old, value) {
+			changes[field] = Change{From: before[field], To: value}
+		}
+	}
+	for field, old := range before {
+		if _, ok := after[field]; !ok {
+			changes[field] = Change{From: old, To: nil}
+		}
+	}
+
+	return changes, nil
+}
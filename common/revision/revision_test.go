@@ -0,0 +1,40 @@
+package revision
+
+import "testing"
+
+func TestDiffReportsChangedAddedAndRemovedFields(t *testing.T) {
+	changes, err := Diff(
+		`{"title":"Greetings","difficulty":1,"options":{"choices":["a","b"]},"removed":"x"}`,
+		`{"title":"Greetings 2","difficulty":1,"options":{"choices":["a","c"]},"added":true}`,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(changes) != 4 {
+		t.Fatalf("expected 4 changes, got %v", changes)
+	}
+	if changes["title"].From != "Greetings" || changes["title"].To != "Greetings 2" {
+		t.Fatalf("unexpected title change %+v", changes["title"])
+	}
+	if _, ok := changes["difficulty"]; ok {
+		t.Fatal("unchanged field reported")
+	}
+	if changes["removed"].To != nil || changes["added"].From != nil {
+		t.Fatalf("unexpected added/removed changes %+v %+v", changes["added"], changes["removed"])
+	}
+}
+
+func TestDiffAgainstEmptySnapshot(t *testing.T) {
+	changes, err := Diff("", `{"title":"Greetings"}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 || changes["title"].From != nil {
+		t.Fatalf("expected the title to be added, got %v", changes)
+	}
+
+	if _, err := Diff("{", `{}`); err == nil {
+		t.Fatal("expected an error for a malformed snapshot")
+	}
+}
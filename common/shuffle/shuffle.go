@@ -0,0 +1,73 @@
+// Package shuffle builds the question and option order of an exercise attempt.
+//
+// Every permutation is derived from the attempt seed with a PCG generator, whose
+// output is specified by the standard library, so the server reproduces the exact
+// variant a learner saw from the seed alone.
+package shuffle
+
+import (
+	"manabu-service/domain/dto"
+	"math/rand/v2"
+	"slices"
+)
+
+// newRand returns the generator of a seed and stream (0 for the question draw,
+// the question ID for its options)
+func newRand(seed int64, stream uint64) *rand.Rand {
+	return rand.New(rand.NewPCG(uint64(seed), stream))
+}
+
+// NewSeed returns a random seed for a new attempt
+func NewSeed() int64 {
+	return rand.Int64()
+}
+
+// Draw picks the questions of an attempt from the pool, given in stored order.
+// A poolSize of 0 (or larger than the pool) keeps every question. Drawn questions
+// keep their stored order unless shuffleQuestions is set.
+func Draw(seed int64, questionIDs []uint, poolSize int, shuffleQuestions bool) []uint {
+	count := len(questionIDs)
+	if poolSize > 0 && poolSize < count {
+		count = poolSize
+	}
+	if count == len(questionIDs) && !shuffleQuestions {
+		return slices.Clone(questionIDs)
+	}
+
+	perm := newRand(seed, 0).Perm(len(questionIDs))[:count]
+	if !shuffleQuestions {
+		slices.Sort(perm)
+	}
+
+	drawn := make([]uint, 0, count)
+	for _, index := range perm {
+		drawn = append(drawn, questionIDs[index])
+	}
+	return drawn
+}
+
+// Options returns a copy of a question's options with choices and matching columns
+// shuffled for the attempt. Options are graded by key, so the order does not affect grading.
+func Options(seed int64, questionID uint, options *dto.QuestionOptions) *dto.QuestionOptions {
+	if options == nil {
+		return nil
+	}
+
+	r := newRand(seed, uint64(questionID))
+	shuffled := *options
+	shuffled.Choices = shuffleChoices(r, options.Choices)
+	shuffled.Left = shuffleChoices(r, options.Left)
+	shuffled.Right = shuffleChoices(r, options.Right)
+	return &shuffled
+}
+
+func shuffleChoices(r *rand.Rand, choices []dto.QuestionChoice) []dto.QuestionChoice {
+	if choices == nil {
+		return nil
+	}
+	shuffled := slices.Clone(choices)
+	r.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+	return shuffled
+}
@@ -0,0 +1,65 @@
+package shuffle
+
+import (
+	"manabu-service/domain/dto"
+	"slices"
+	"testing"
+)
+
+var pool = []uint{11, 12, 13, 14, 15, 16, 17, 18}
+
+func TestDrawIsReproducible(t *testing.T) {
+	first := Draw(42, pool, 5, true)
+	second := Draw(42, pool, 5, true)
+	if !slices.Equal(first, second) {
+		t.Fatalf("expected the same draw for the same seed, got %v and %v", first, second)
+	}
+	if len(first) != 5 {
+		t.Fatalf("expected 5 questions, got %v", first)
+	}
+}
+
+func TestDrawKeepsStoredOrderWithoutShuffling(t *testing.T) {
+	drawn := Draw(7, pool, 4, false)
+	if len(drawn) != 4 || !slices.IsSorted(drawn) {
+		t.Fatalf("expected 4 questions in stored order, got %v", drawn)
+	}
+
+	all := Draw(7, pool, 0, false)
+	if !slices.Equal(all, pool) {
+		t.Fatalf("expected the whole pool in stored order, got %v", all)
+	}
+}
+
+func TestDrawShufflesWholePool(t *testing.T) {
+	drawn := Draw(3, pool, 0, true)
+	sorted := slices.Clone(drawn)
+	slices.Sort(sorted)
+	if !slices.Equal(sorted, pool) {
+		t.Fatalf("expected every question once, got %v", drawn)
+	}
+}
+
+func TestOptionsAreReproducibleAndKeepKeys(t *testing.T) {
+	options := &dto.QuestionOptions{
+		Choices: []dto.QuestionChoice{{Key: "a", Text: "あ"}, {Key: "b", Text: "い"}, {Key: "c", Text: "う"}, {Key: "d", Text: "え"}},
+	}
+
+	first := Options(42, 11, options)
+	second := Options(42, 11, options)
+	if !slices.Equal(first.Choices, second.Choices) {
+		t.Fatalf("expected the same option order for the same seed, got %v and %v", first.Choices, second.Choices)
+	}
+
+	keys := make([]string, 0, len(first.Choices))
+	for _, choice := range first.Choices {
+		keys = append(keys, choice.Key)
+	}
+	slices.Sort(keys)
+	if !slices.Equal(keys, []string{"a", "b", "c", "d"}) {
+		t.Fatalf("expected every option once, got %v", first.Choices)
+	}
+	if options.Choices[0].Key != "a" {
+		t.Fatalf("expected the stored options to be left untouched")
+	}
+}
@@ -0,0 +1,44 @@
+package storage
+
+import (
+	"context"
+	"io"
+	errConstant "manabu-service/constants/error"
+	"strings"
+	"testing"
+)
+
+func TestLocalStoragePutGetDelete(t *testing.T) {
+	ctx := context.Background()
+	store := NewLocalStorage(t.TempDir())
+
+	if err := store.Put(ctx, "recordings/a/b.webm", strings.NewReader("audio"), "audio/webm"); err != nil {
+		t.Fatalf("put: %v", err)
+	}
+
+	file, err := store.Get(ctx, "recordings/a/b.webm")
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	content, _ := io.ReadAll(file)
+	file.Close()
+	if string(content) != "audio" {
+		t.Fatalf("expected stored content, got %q", content)
+	}
+
+	if err := store.Delete(ctx, "recordings/a/b.webm"); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, err := store.Get(ctx, "recordings/a/b.webm"); err != errConstant.ErrStorageObjectNotFound {
+		t.Fatalf("expected not found after delete, got %v", err)
+	}
+}
+
+func TestLocalStorageRejectsEscapingKeys(t *testing.T) {
+	store := NewLocalStorage(t.TempDir())
+	for _, key := range []string{"", "../secret", "a/../../secret"} {
+		if err := store.Put(context.Background(), key, strings.NewReader(""), ""); err != errConstant.ErrInvalidStorageKey {
+			t.Fatalf("expected invalid key for %q, got %v", key, err)
+		}
+	}
+}
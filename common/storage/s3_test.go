@@ -0,0 +1,117 @@
+package storage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"manabu-service/config"
+	errConstant "manabu-service/constants/error"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// newFakeS3 starts an in-memory stand-in for an S3-compatible server using path-style addressing
+func newFakeS3(t *testing.T) *httptest.Server {
+	var mu sync.Mutex
+	objects := map[string][]byte{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=minio/") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		sum := sha256.Sum256(body)
+		if r.Header.Get("X-Amz-Content-Sha256") != hex.EncodeToString(sum[:]) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.Method {
+		case http.MethodPut:
+			objects[r.URL.Path] = body
+		case http.MethodGet:
+			content, ok := objects[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write(content)
+		case http.MethodDelete:
+			delete(objects, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestS3StoragePutGetDelete(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeS3(t)
+	store, err := NewS3Storage(config.S3Storage{
+		Endpoint:        server.URL,
+		Bucket:          "manabu",
+		AccessKeyID:     "minio",
+		SecretAccessKey: "minio-secret",
+		UsePathStyle:    true,
+	})
+	if err != nil {
+		t.Fatalf("new: %v", err)
+	}
+
+	if err := store.Put(ctx, "media/a/b.png", strings.NewReader("image"), "image/png"); err != nil {
+		t.Fatalf("put: %v", err)
+	}
+
+	file, err := store.Get(ctx, "media/a/b.png")
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	content, _ := io.ReadAll(file)
+	file.Close()
+	if string(content) != "image" {
+		t.Fatalf("expected stored content, got %q", content)
+	}
+
+	if err := store.Delete(ctx, "media/a/b.png"); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, err := store.Get(ctx, "media/a/b.png"); err != errConstant.ErrStorageObjectNotFound {
+		t.Fatalf("expected not found after delete, got %v", err)
+	}
+}
+
+func TestS3StorageObjectURL(t *testing.T) {
+	store, err := NewS3Storage(config.S3Storage{
+		Region:          "ap-northeast-1",
+		Bucket:          "manabu",
+		AccessKeyID:     "key",
+		SecretAccessKey: "secret",
+	})
+	if err != nil {
+		t.Fatalf("new: %v", err)
+	}
+
+	if got := store.objectURL("media/a b.png").String(); got != "https://manabu.s3.ap-northeast-1.amazonaws.com/media/a%20b.png" {
+		t.Fatalf("unexpected virtual-hosted URL %s", got)
+	}
+
+	store.usePathStyle = true
+	if got := store.objectURL("media/b.png").String(); got != "https://s3.ap-northeast-1.amazonaws.com/manabu/media/b.png" {
+		t.Fatalf("unexpected path-style URL %s", got)
+	}
+}
+
+func TestNewS3StorageRequiresBucketAndCredentials(t *testing.T) {
+	if _, err := NewS3Storage(config.S3Storage{Bucket: "manabu"}); err != errConstant.ErrStorageNotConfigured {
+		t.Fatalf("expected not configured, got %v", err)
+	}
+}
@@ -0,0 +1,57 @@
+// Package storage stores uploaded files such as audio recordings behind a common interface
+// so the backend can be chosen by configuration.
+package storage
+
+import (
+	"context"
+	"io"
+	"manabu-service/config"
+	errConstant "manabu-service/constants/error"
+	"path"
+	"strings"
+)
+
+// Storage drivers
+const (
+	DriverLocal = "local"
+	DriverS3    = "s3"
+)
+
+// defaultLocalPath is used when no local storage path is configured
+const defaultLocalPath = "./uploads"
+
+// Storage stores files under slash-separated keys.
+type Storage interface {
+	// Put stores the content of body under key, replacing any existing file.
+	Put(ctx context.Context, key string, body io.Reader, contentType string) error
+
+	// Get opens the file stored under key. The caller must close it.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// Delete removes the file stored under key. Deleting a missing file is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// New returns the storage backend selected by the configuration
+func New(cfg config.Storage) (Storage, error) {
+	switch cfg.Driver {
+	case "", DriverLocal:
+		path := cfg.LocalPath
+		if path == "" {
+			path = defaultLocalPath
+		}
+		return NewLocalStorage(path), nil
+	case DriverS3:
+		return NewS3Storage(cfg.S3)
+	default:
+		return nil, errConstant.ErrUnsupportedStorageDriver
+	}
+}
+
+// checkKey rejects empty keys and keys that could escape the storage root
+func checkKey(key string) error {
+	if key == "" || path.Clean("/"+key) == "/" || strings.Contains(key, "..") {
+		return errConstant.ErrInvalidStorageKey
+	}
+	return nil
+}
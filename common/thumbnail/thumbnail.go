@@ -0,0 +1,81 @@
+// Package thumbnail scales uploaded images down to small JPEG previews.
+package thumbnail
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+)
+
+// jpegQuality is the JPEG quality of generated thumbnails
+const jpegQuality = 80
+
+// Resize scales the image down with a box filter so it fits within maxSize x maxSize,
+// keeping its aspect ratio. Images that already fit are copied unscaled.
+func Resize(src image.Image, maxSize int) *image.RGBA {
+	bounds := src.Bounds()
+	srcWidth, srcHeight := bounds.Dx(), bounds.Dy()
+
+	dstWidth, dstHeight := srcWidth, srcHeight
+	if srcWidth > maxSize || srcHeight > maxSize {
+		if srcWidth >= srcHeight {
+			dstWidth = maxSize
+			dstHeight = max(1, srcHeight*maxSize/srcWidth)
+		} else {
+			dstHeight = maxSize
+			dstWidth = max(1, srcWidth*maxSize/srcHeight)
+		}
+	}
+
+	// Convert once so pixels can be read directly instead of through the color interface
+	rgba := image.NewRGBA(image.Rect(0, 0, srcWidth, srcHeight))
+	draw.Draw(rgba, rgba.Bounds(), src, bounds.Min, draw.Src)
+	if dstWidth == srcWidth && dstHeight == srcHeight {
+		return rgba
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+	for dy := 0; dy < dstHeight; dy++ {
+		y0 := dy * srcHeight / dstHeight
+		y1 := max(y0+1, (dy+1)*srcHeight/dstHeight)
+		for dx := 0; dx < dstWidth; dx++ {
+			x0 := dx * srcWidth / dstWidth
+			x1 := max(x0+1, (dx+1)*srcWidth/dstWidth)
+
+			// Average every source pixel covered by the destination pixel
+			var r, g, b, a, count int
+			for y := y0; y < y1; y++ {
+				row := rgba.Pix[y*rgba.Stride:]
+				for x := x0; x < x1; x++ {
+					r += int(row[x*4])
+					g += int(row[x*4+1])
+					b += int(row[x*4+2])
+					a += int(row[x*4+3])
+					count++
+				}
+			}
+			dst.SetRGBA(dx, dy, color.RGBA{
+				R: uint8(r / count),
+				G: uint8(g / count),
+				B: uint8(b / count),
+				A: uint8(a / count),
+			})
+		}
+	}
+	return dst
+}
+
+// JPEG encodes the image as a JPEG. Transparent areas are flattened onto white.
+func JPEG(img image.Image) ([]byte, error) {
+	flattened := image.NewRGBA(img.Bounds())
+	draw.Draw(flattened, flattened.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.Draw(flattened, flattened.Bounds(), img, img.Bounds().Min, draw.Over)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, flattened, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,61 @@
+package thumbnail
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"testing"
+)
+
+func TestResizeKeepsAspectRatio(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 1000, 500))
+	thumb := Resize(src, 320)
+
+	if thumb.Bounds().Dx() != 320 || thumb.Bounds().Dy() != 160 {
+		t.Fatalf("expected 320x160, got %v", thumb.Bounds())
+	}
+}
+
+func TestResizeDoesNotUpscale(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 100, 50))
+	thumb := Resize(src, 320)
+
+	if thumb.Bounds().Dx() != 100 || thumb.Bounds().Dy() != 50 {
+		t.Fatalf("expected 100x50, got %v", thumb.Bounds())
+	}
+}
+
+func TestResizeAveragesPixels(t *testing.T) {
+	// Alternating black and white columns average to grey
+	src := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 4; x++ {
+			if x%2 == 0 {
+				src.SetRGBA(x, y, color.RGBA{A: 255})
+			} else {
+				src.SetRGBA(x, y, color.RGBA{R: 255, G: 255, B: 255, A: 255})
+			}
+		}
+	}
+
+	thumb := Resize(src, 2)
+	if got := thumb.RGBAAt(0, 0); got.R != 127 || got.A != 255 {
+		t.Fatalf("expected grey, got %v", got)
+	}
+}
+
+func TestJPEGFlattensTransparency(t *testing.T) {
+	data, err := JPEG(image.NewRGBA(image.Rect(0, 0, 8, 8)))
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+
+	decoded, err := jpeg.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if r, _, _, _ := decoded.At(4, 4).RGBA(); r>>8 < 250 {
+		t.Fatalf("expected transparent pixels to become white, got red %d", r>>8)
+	}
+}
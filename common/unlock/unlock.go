@@ -0,0 +1,99 @@
+// Package unlock works out which lessons of a course are locked for a learner.
+package unlock
+
+import (
+	"fmt"
+	"manabu-service/domain/models"
+	"strings"
+)
+
+// Reasons reported with the lock state of a lesson. ReasonOpen is used for lessons without
+// a rule and for the first lesson; the other reasons name the rule that locks or unlocked it.
+const (
+	ReasonOpen                = "open"
+	ReasonCoursePrerequisites = "course_prerequisites"
+	ReasonPreviousLesson      = models.LessonUnlockRulePreviousLesson
+	ReasonPreviousScore       = models.LessonUnlockRulePreviousScore
+)
+
+// Lesson is a lesson of the course with its unlock rule, in course order
+type Lesson struct {
+	ID       uint
+	Rule     string
+	MinScore int
+}
+
+// Progress is what a learner has done that unlock rules depend on
+type Progress struct {
+	// MissingCourses are the titles of the prerequisite courses the learner has not completed
+	MissingCourses []string
+
+	// CompletedLessons is the number of lessons of the course the learner completed, in order
+	CompletedLessons int
+
+	// CourseCompleted is set when the learner completed the course
+	CourseCompleted bool
+
+	// Scores holds the score percentage of the learner on the exercises of each lesson.
+	// Lessons without exercises are absent.
+	Scores map[uint]float64
+}
+
+// State is the lock state of a lesson
+type State struct {
+	Locked  bool
+	Reason  string
+	Message string
+}
+
+// Evaluate returns the lock state of each lesson. Every lesson is locked while a prerequisite
+// course is incomplete. The first lesson and lessons without a rule are otherwise open.
+// A lesson with the previous_score rule whose previous lesson has no exercises falls back to
+// the previous_lesson rule.
+func Evaluate(lessons []Lesson, progress Progress) []State {
+	states := make([]State, len(lessons))
+
+	if len(progress.MissingCourses) > 0 {
+		message := fmt.Sprintf("Complete the prerequisite courses first: %s", strings.Join(progress.MissingCourses, ", "))
+		for i := range states {
+			states[i] = State{Locked: true, Reason: ReasonCoursePrerequisites, Message: message}
+		}
+		return states
+	}
+
+	for i, lesson := range lessons {
+		if i == 0 || lesson.Rule == "" || lesson.Rule == models.LessonUnlockRuleNone {
+			states[i] = State{Reason: ReasonOpen}
+			continue
+		}
+
+		previous := lessons[i-1]
+		previousCompleted := progress.CourseCompleted || progress.CompletedLessons >= i
+
+		score, hasExercises := progress.Scores[previous.ID]
+		if lesson.Rule == models.LessonUnlockRulePreviousScore && hasExercises {
+			if score >= float64(lesson.MinScore) {
+				states[i] = State{Reason: ReasonPreviousScore}
+				continue
+			}
+			states[i] = State{
+				Locked:  true,
+				Reason:  ReasonPreviousScore,
+				Message: fmt.Sprintf("Score at least %d%% on the exercises of the previous lesson to unlock this lesson (current score %.0f%%)", lesson.MinScore, score),
+			}
+			continue
+		}
+
+		if previousCompleted {
+			states[i] = State{Reason: ReasonPreviousLesson}
+			continue
+		}
+		states[i] = State{
+			Locked:  true,
+			Reason:  ReasonPreviousLesson,
+			Message: "Complete the previous lesson to unlock this lesson",
+		}
+	}
+
+	return states
+}
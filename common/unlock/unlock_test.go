@@ -0,0 +1,77 @@
+package unlock
+
+import (
+	"manabu-service/domain/models"
+	"testing"
+)
+
+func TestEvaluateLocksEverythingWithoutPrerequisites(t *testing.T) {
+	lessons := []Lesson{{ID: 1}, {ID: 2, Rule: models.LessonUnlockRuleNone}}
+
+	states := Evaluate(lessons, Progress{MissingCourses: []string{"Japanese Basics"}})
+
+	for i, state := range states {
+		if !state.Locked || state.Reason != ReasonCoursePrerequisites {
+			t.Fatalf("lesson %d: expected locked by prerequisites, got %+v", i, state)
+		}
+	}
+}
+
+func TestEvaluatePreviousLesson(t *testing.T) {
+	lessons := []Lesson{
+		{ID: 1, Rule: models.LessonUnlockRulePreviousLesson},
+		{ID: 2, Rule: models.LessonUnlockRulePreviousLesson},
+		{ID: 3, Rule: models.LessonUnlockRulePreviousLesson},
+	}
+
+	states := Evaluate(lessons, Progress{CompletedLessons: 1})
+
+	if states[0].Locked || states[0].Reason != ReasonOpen {
+		t.Fatalf("expected first lesson open, got %+v", states[0])
+	}
+	if states[1].Locked {
+		t.Fatalf("expected second lesson unlocked, got %+v", states[1])
+	}
+	if !states[2].Locked || states[2].Reason != ReasonPreviousLesson {
+		t.Fatalf("expected third lesson locked, got %+v", states[2])
+	}
+
+	states = Evaluate(lessons, Progress{CourseCompleted: true})
+	if states[2].Locked {
+		t.Fatalf("expected completed course to unlock every lesson, got %+v", states[2])
+	}
+}
+
+func TestEvaluatePreviousScore(t *testing.T) {
+	lessons := []Lesson{
+		{ID: 1},
+		{ID: 2, Rule: models.LessonUnlockRulePreviousScore, MinScore: 80},
+	}
+
+	states := Evaluate(lessons, Progress{Scores: map[uint]float64{1: 79.5}})
+	if !states[1].Locked || states[1].Reason != ReasonPreviousScore {
+		t.Fatalf("expected lesson locked by score, got %+v", states[1])
+	}
+
+	states = Evaluate(lessons, Progress{Scores: map[uint]float64{1: 80}})
+	if states[1].Locked {
+		t.Fatalf("expected lesson unlocked by score, got %+v", states[1])
+	}
+}
+
+func TestEvaluatePreviousScoreWithoutExercises(t *testing.T) {
+	lessons := []Lesson{
+		{ID: 1},
+		{ID: 2, Rule: models.LessonUnlockRulePreviousScore, MinScore: 80},
+	}
+
+	states := Evaluate(lessons, Progress{})
+	if !states[1].Locked || states[1].Reason != ReasonPreviousLesson {
+		t.Fatalf("expected fallback to the previous lesson rule, got %+v", states[1])
+	}
+
+	states = Evaluate(lessons, Progress{CompletedLessons: 1})
+	if states[1].Locked {
+		t.Fatalf("expected lesson unlocked, got %+v", states[1])
+	}
+}
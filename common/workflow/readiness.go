@@ -0,0 +1,119 @@
+package workflow
+
+import (
+	"fmt"
+	"manabu-service/domain/models"
+)
+
+// Readiness issue codes reported when content cannot be published
+const (
+	IssueNotApproved        = "not_approved"
+	IssueParentNotPublished = "parent_not_published"
+	IssueNoLessons          = "no_lessons"
+	IssueNoQuestions        = "no_questions"
+)
+
+// Node is a content item of the course → lesson → exercise → question hierarchy
+// with its workflow status and children in order.
+type Node struct {
+	ContentType string
+	ContentID   uint
+	Title       string
+	Status      string
+	Children    []*Node
+}
+
+// Issue is a rule that blocks publishing a content item
+type Issue struct {
+	ContentType string
+	ContentID   uint
+	Title       string
+	Code        string
+	Message     string
+}
+
+// requiredChild names the child type content must have at least one published item of, or
+// one approved item published with it in cascade mode, before it can be published, so
+// learners never open an empty course or exercise
+var requiredChild = map[string]struct {
+	ContentType string
+	Code        string
+}{
+	models.ContentTypeCourse:   {models.ContentTypeLesson, IssueNoLessons},
+	models.ContentTypeExercise: {models.ContentTypeExerciseQuestion, IssueNoQuestions},
+}
+
+// isReady reports whether content is published, or is published along with its parent
+// because it is approved and the parent is published in cascade mode
+func isReady(status string, cascade bool) bool {
+	return status == models.WorkflowStatusPublished || (cascade && status == models.WorkflowStatusApproved)
+}
+
+// Plan works out what publishing root does and what blocks it. Root must be approved and,
+// unless it is a course, its parent must be published. In cascade mode the approved
+// descendants of root are published with it; descendants in other statuses are left as
+// they are, together with their children. Content can be published when no issues are returned.
+func Plan(root *Node, parentPublished bool, cascade bool) ([]*Node, []Issue) {
+	publish := make([]*Node, 0)
+	issues := make([]Issue, 0)
+
+	addIssue := func(node *Node, code, message string) {
+		issues = append(issues, Issue{
+			ContentType: node.ContentType,
+			ContentID:   node.ContentID,
+			Title:       node.Title,
+			Code:        code,
+			Message:     message,
+		})
+	}
+
+	var visit func(node *Node)
+	visit = func(node *Node) {
+		if node.Status == models.WorkflowStatusApproved {
+			publish = append(publish, node)
+
+			if required, ok := requiredChild[node.ContentType]; ok {
+				hasReadyChild := false
+				for _, child := range node.Children {
+					if isReady(child.Status, cascade) {
+						hasReadyChild = true
+						break
+					}
+				}
+				if !hasReadyChild {
+					message := fmt.Sprintf("%s needs at least one published %s, or an approved one published with cascade", node.ContentType, required.ContentType)
+					if cascade {
+						message = fmt.Sprintf("%s needs at least one approved or published %s", node.ContentType, required.ContentType)
+					}
+					addIssue(node, required.Code, message)
+				}
+			}
+		}
+
+		if !cascade {
+			return
+		}
+		for _, child := range node.Children {
+			if child.Status == models.WorkflowStatusApproved || child.Status == models.WorkflowStatusPublished {
+				visit(child)
+			}
+		}
+	}
+
+	switch root.Status {
+	case models.WorkflowStatusApproved:
+		if !parentPublished {
+			addIssue(root, IssueParentNotPublished, fmt.Sprintf("the parent of this %s must be published first", root.ContentType))
+		}
+		visit(root)
+	case models.WorkflowStatusPublished:
+		// Published content can still cascade to children approved since
+		if cascade {
+			visit(root)
+		}
+	default:
+		addIssue(root, IssueNotApproved, fmt.Sprintf("%s must be approved before it is published", root.ContentType))
+	}
+
+	return publish, issues
+}
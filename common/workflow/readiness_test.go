@@ -0,0 +1,87 @@
+package workflow
+
+import (
+	"manabu-service/domain/models"
+	"testing"
+)
+
+func node(contentType string, id uint, status string, children ...*Node) *Node {
+	return &Node{ContentType: contentType, ContentID: id, Status: status, Children: children}
+}
+
+func TestPlanBlocksEmptyCourseAndUnpublishedParent(t *testing.T) {
+	course := node(models.ContentTypeCourse, 1, models.WorkflowStatusApproved,
+		node(models.ContentTypeLesson, 10, models.WorkflowStatusDraft))
+	publish, issues := Plan(course, true, false)
+	if len(issues) != 1 || issues[0].Code != IssueNoLessons {
+		t.Fatalf("expected a no_lessons issue, got %+v", issues)
+	}
+	if len(publish) != 1 {
+		t.Fatalf("expected only the course to be published, got %d items", len(publish))
+	}
+
+	lesson := node(models.ContentTypeLesson, 10, models.WorkflowStatusApproved)
+	if _, issues := Plan(lesson, false, false); len(issues) != 1 || issues[0].Code != IssueParentNotPublished {
+		t.Fatalf("expected a parent_not_published issue, got %+v", issues)
+	}
+
+	draft := node(models.ContentTypeExerciseQuestion, 100, models.WorkflowStatusDraft)
+	if _, issues := Plan(draft, true, false); len(issues) != 1 || issues[0].Code != IssueNotApproved {
+		t.Fatalf("expected a not_approved issue, got %+v", issues)
+	}
+}
+
+func TestPlanWithoutCascadeNeedsPublishedChild(t *testing.T) {
+	course := node(models.ContentTypeCourse, 1, models.WorkflowStatusApproved,
+		node(models.ContentTypeLesson, 10, models.WorkflowStatusApproved))
+	if _, issues := Plan(course, true, false); len(issues) != 1 || issues[0].Code != IssueNoLessons {
+		t.Fatalf("expected a no_lessons issue for a course with only approved lessons, got %+v", issues)
+	}
+
+	exercise := node(models.ContentTypeExercise, 20, models.WorkflowStatusApproved,
+		node(models.ContentTypeExerciseQuestion, 200, models.WorkflowStatusApproved))
+	if _, issues := Plan(exercise, true, false); len(issues) != 1 || issues[0].Code != IssueNoQuestions {
+		t.Fatalf("expected a no_questions issue for an exercise with only approved questions, got %+v", issues)
+	}
+
+	course.Children = append(course.Children, node(models.ContentTypeLesson, 11, models.WorkflowStatusPublished))
+	if _, issues := Plan(course, true, false); len(issues) != 0 {
+		t.Fatalf("expected a course with a published lesson to be publishable, got %+v", issues)
+	}
+}
+
+func TestPlanCascadesToApprovedDescendants(t *testing.T) {
+	course := node(models.ContentTypeCourse, 1, models.WorkflowStatusApproved,
+		node(models.ContentTypeLesson, 10, models.WorkflowStatusApproved,
+			node(models.ContentTypeExercise, 20, models.WorkflowStatusApproved,
+				node(models.ContentTypeExerciseQuestion, 30, models.WorkflowStatusApproved),
+				node(models.ContentTypeExerciseQuestion, 31, models.WorkflowStatusDraft))),
+		node(models.ContentTypeLesson, 11, models.WorkflowStatusInReview,
+			node(models.ContentTypeExercise, 21, models.WorkflowStatusApproved)))
+
+	publish, issues := Plan(course, true, true)
+	if len(issues) != 0 {
+		t.Fatalf("expected no issues, got %+v", issues)
+	}
+	var ids []uint
+	for _, item := range publish {
+		ids = append(ids, item.ContentID)
+	}
+	if len(ids) != 4 || ids[0] != 1 || ids[1] != 10 || ids[2] != 20 || ids[3] != 30 {
+		t.Fatalf("expected course, lesson 10, exercise 20 and question 30, got %v", ids)
+	}
+}
+
+func TestPlanReportsBlockedDescendantsInCascade(t *testing.T) {
+	course := node(models.ContentTypeCourse, 1, models.WorkflowStatusPublished,
+		node(models.ContentTypeLesson, 10, models.WorkflowStatusPublished,
+			node(models.ContentTypeExercise, 20, models.WorkflowStatusApproved)))
+
+	publish, issues := Plan(course, true, true)
+	if len(issues) != 1 || issues[0].Code != IssueNoQuestions || issues[0].ContentID != 20 {
+		t.Fatalf("expected a no_questions issue for exercise 20, got %+v", issues)
+	}
+	if len(publish) != 1 {
+		t.Fatalf("expected only the exercise to be published, got %d items", len(publish))
+	}
+}
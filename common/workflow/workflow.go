@@ -0,0 +1,77 @@
+// Package workflow defines the editorial state machine that content moves through before
+// learners can see it: draft → in_review → approved → published → archived.
+package workflow
+
+import (
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/models"
+)
+
+// Workflow actions
+const (
+	ActionSubmit  = "submit"
+	ActionApprove = "approve"
+	ActionReject  = "reject"
+	ActionPublish = "publish"
+	ActionArchive = "archive"
+	ActionReopen  = "reopen"
+)
+
+// Rule describes when an action is allowed and the status it leads to
+type Rule struct {
+	From           []string
+	To             string
+	ReviewerOnly   bool
+	ReasonRequired bool
+}
+
+// rules lists every allowed action in the order they are offered
+var rules = []struct {
+	Action string
+	Rule
+}{
+	{ActionSubmit, Rule{From: []string{models.WorkflowStatusDraft}, To: models.WorkflowStatusInReview}},
+	{ActionApprove, Rule{From: []string{models.WorkflowStatusInReview}, To: models.WorkflowStatusApproved, ReviewerOnly: true}},
+	{ActionReject, Rule{From: []string{models.WorkflowStatusInReview, models.WorkflowStatusApproved}, To: models.WorkflowStatusDraft, ReviewerOnly: true, ReasonRequired: true}},
+	{ActionPublish, Rule{From: []string{models.WorkflowStatusApproved}, To: models.WorkflowStatusPublished, ReviewerOnly: true}},
+	{ActionArchive, Rule{From: []string{models.WorkflowStatusDraft, models.WorkflowStatusApproved, models.WorkflowStatusPublished}, To: models.WorkflowStatusArchived, ReviewerOnly: true}},
+	{ActionReopen, Rule{From: []string{models.WorkflowStatusArchived}, To: models.WorkflowStatusDraft}},
+}
+
+// Lookup returns the rule of an action
+func Lookup(action string) (Rule, error) {
+	for _, item := range rules {
+		if item.Action == action {
+			return item.Rule, nil
+		}
+	}
+	return Rule{}, errConstant.ErrInvalidWorkflowAction
+}
+
+// Next returns the status reached by applying the action to content in the given status
+func Next(status, action string) (string, error) {
+	rule, err := Lookup(action)
+	if err != nil {
+		return "", err
+	}
+	for _, from := range rule.From {
+		if from == status {
+			return rule.To, nil
+		}
+	}
+	return "", errConstant.ErrInvalidWorkflowTransition
+}
+
+// Actions lists the actions available from a status, leaving out reviewer-only actions for authors
+func Actions(status string, isReviewer bool) []string {
+	actions := make([]string, 0)
+	for _, item := range rules {
+		if item.ReviewerOnly && !isReviewer {
+			continue
+		}
+		if _, err := Next(status, item.Action); err == nil {
+			actions = append(actions, item.Action)
+		}
+	}
+	return actions
+}
@@ -0,0 +1,41 @@
+package workflow
+
+import (
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/models"
+	"reflect"
+	"testing"
+)
+
+func TestNextFollowsTheHappyPath(t *testing.T) {
+	status := models.WorkflowStatusDraft
+	for _, action := range []string{ActionSubmit, ActionApprove, ActionPublish, ActionArchive, ActionReopen} {
+		next, err := Next(status, action)
+		if err != nil {
+			t.Fatalf("%s from %s: %v", action, status, err)
+		}
+		status = next
+	}
+	if status != models.WorkflowStatusDraft {
+		t.Fatalf("expected to end in draft, got %s", status)
+	}
+}
+
+func TestNextRejectsSkippingReview(t *testing.T) {
+	if _, err := Next(models.WorkflowStatusDraft, ActionPublish); err != errConstant.ErrInvalidWorkflowTransition {
+		t.Fatalf("expected invalid transition, got %v", err)
+	}
+	if _, err := Next(models.WorkflowStatusDraft, "delete"); err != errConstant.ErrInvalidWorkflowAction {
+		t.Fatalf("expected invalid action, got %v", err)
+	}
+}
+
+func TestActionsHidesReviewerActionsFromAuthors(t *testing.T) {
+	if got := Actions(models.WorkflowStatusInReview, false); len(got) != 0 {
+		t.Fatalf("expected no author actions in review, got %v", got)
+	}
+	want := []string{ActionApprove, ActionReject}
+	if got := Actions(models.WorkflowStatusInReview, true); !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
@@ -11,14 +11,17 @@ import (
 var Config AppConfig
 
 type AppConfig struct {
-	Port                  int      `json:"port"`
-	AppName               string   `json:"appName"`
-	AppEnv                string   `json:"appEnv"`
-	Database              Database `json:"database"`
-	RateLimiterMaxRequest float64  `json:"rateLimiterMaxRequest"`
-	RateLimiterTimeSecond int      `json:"rateLimiterTimeSecond"`
-	JwtSecretKey          string   `json:"jwtSecretKey"`
-	JwtExpirationTime     int      `json:"jwtExpirationTime"`
+	Port                    int      `json:"port"`
+	AppName                 string   `json:"appName"`
+	AppEnv                  string   `json:"appEnv"`
+	Database                Database `json:"database"`
+	RateLimiterMaxRequest   float64  `json:"rateLimiterMaxRequest"`
+	RateLimiterTimeSecond   int      `json:"rateLimiterTimeSecond"`
+	JwtSecretKey            string   `json:"jwtSecretKey"`
+	JwtExpirationTime       int      `json:"jwtExpirationTime"`
+	Storage                 Storage  `json:"storage"`
+	SchedulerIntervalSecond int      `json:"schedulerIntervalSecond"`
+	TrashRetentionDays      int      `json:"trashRetentionDays"`
 }
 
 type Database struct {
@@ -33,6 +36,21 @@ type Database struct {
 	MaxIdleTime           int    `json:"maxIdleTime"`
 }
 
+type Storage struct {
+	Driver    string    `json:"driver"`
+	LocalPath string    `json:"localPath"`
+	S3        S3Storage `json:"s3"`
+}
+
+type S3Storage struct {
+	Endpoint        string `json:"endpoint"`
+	Region          string `json:"region"`
+	Bucket          string `json:"bucket"`
+	AccessKeyID     string `json:"accessKeyId"`
+	SecretAccessKey string `json:"secretAccessKey"`
+	UsePathStyle    bool   `json:"usePathStyle"`
+}
+
 func Init() {
 	err := util.BindFromJSON(&Config, "config.json", ".")
 	if err != nil {
@@ -0,0 +1,13 @@
+package constants
+
+// Answer strictness levels for free-text answers
+const (
+	// AnswerStrictnessStrict only ignores surrounding whitespace
+	AnswerStrictnessStrict = "strict"
+	// AnswerStrictnessNormal also ignores case, width, whitespace, punctuation and hiragana/katakana differences
+	AnswerStrictnessNormal = "normal"
+	// AnswerStrictnessLenient also accepts romaji input for kana answers
+	AnswerStrictnessLenient = "lenient"
+
+	DefaultAnswerStrictness = AnswerStrictnessNormal
+)
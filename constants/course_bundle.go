@@ -0,0 +1,15 @@
+package constants
+
+// CourseBundleFormatVersion is the version of the course bundle format written by exports.
+// Imports accept this version only.
+const CourseBundleFormatVersion = 1
+
+// Maximum sizes of an uploaded course bundle, inflated as well as compressed, and of its
+// manifest in bytes
+const (
+	MaxCourseBundleSize         = 200 << 20
+	MaxCourseBundleManifestSize = 10 << 20
+)
+
+// MaxCourseBundleEntries is the most files a course bundle archive may hold
+const MaxCourseBundleEntries = 2000
@@ -0,0 +1,19 @@
+package error
+
+import "errors"
+
+var (
+	ErrBookmarkNotFound       = errors.New("bookmark not found")
+	ErrBookmarkExists         = errors.New("this item is already bookmarked")
+	ErrBookmarkItemNotFound   = errors.New("item to bookmark not found")
+	ErrBookmarkFolderNotFound = errors.New("bookmark folder not found")
+	ErrBookmarkFolderExists   = errors.New("a bookmark folder with this name already exists")
+)
+
+var BookmarkErrors = []error{
+	ErrBookmarkNotFound,
+	ErrBookmarkExists,
+	ErrBookmarkItemNotFound,
+	ErrBookmarkFolderNotFound,
+	ErrBookmarkFolderExists,
+}
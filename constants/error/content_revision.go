@@ -0,0 +1,15 @@
+package error
+
+import "errors"
+
+var (
+	ErrContentRevisionNotFound    = errors.New("content revision not found")
+	ErrInvalidRevisionContentType = errors.New("content type must be course, lesson, exercise_question or vocabulary")
+	ErrContentRevisionConflict    = errors.New("content was changed at the same time, reload and try again")
+)
+
+var ContentRevisionErrors = []error{
+	ErrContentRevisionNotFound,
+	ErrInvalidRevisionContentType,
+	ErrContentRevisionConflict,
+}
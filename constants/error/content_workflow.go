@@ -0,0 +1,35 @@
+package error
+
+import "errors"
+
+var (
+	ErrContentNotFound           = errors.New("content not found")
+	ErrContentWorkflowNotFound   = errors.New("content workflow not found")
+	ErrInvalidContentType        = errors.New("content type must be course, lesson, exercise or exercise_question")
+	ErrInvalidWorkflowAction     = errors.New("workflow action must be submit, approve, reject, publish, archive or reopen")
+	ErrInvalidWorkflowTransition = errors.New("workflow action is not allowed in the current status")
+	ErrWorkflowReasonRequired    = errors.New("a reason is required to reject content")
+	ErrWorkflowReviewerOnly      = errors.New("only teachers and admins can perform this workflow action")
+	ErrWorkflowAuthorOnly        = errors.New("only teachers and admins can submit, reopen or comment on content")
+	ErrWorkflowNotAssignedToUser = errors.New("content is assigned to another reviewer")
+	ErrWorkflowSelfApproval      = errors.New("reviewers cannot approve content they submitted")
+	ErrInvalidWorkflowReviewer   = errors.New("assigned reviewer must be a teacher or admin")
+	ErrWorkflowConflict          = errors.New("content workflow was changed by someone else, reload and try again")
+	ErrContentNotReadyToPublish  = errors.New("content is not ready to publish, check its publish readiness")
+)
+
+var ContentWorkflowErrors = []error{
+	ErrContentNotFound,
+	ErrContentWorkflowNotFound,
+	ErrInvalidContentType,
+	ErrInvalidWorkflowAction,
+	ErrInvalidWorkflowTransition,
+	ErrWorkflowReasonRequired,
+	ErrWorkflowReviewerOnly,
+	ErrWorkflowAuthorOnly,
+	ErrWorkflowNotAssignedToUser,
+	ErrWorkflowSelfApproval,
+	ErrInvalidWorkflowReviewer,
+	ErrWorkflowConflict,
+	ErrContentNotReadyToPublish,
+}
@@ -10,6 +10,7 @@ var (
 	ErrInvalidCourseEstimatedHours = errors.New("estimated hours must be a positive number")
 	ErrCourseAlreadyPublished      = errors.New("course is already published")
 	ErrCourseNotPublished          = errors.New("course is not published")
+	ErrCourseCloneAuthorOnly       = errors.New("only teachers and admins can clone courses")
 )
 
 var CourseErrors = []error{
@@ -20,4 +21,5 @@ var CourseErrors = []error{
 	ErrInvalidCourseEstimatedHours,
 	ErrCourseAlreadyPublished,
 	ErrCourseNotPublished,
+	ErrCourseCloneAuthorOnly,
 }
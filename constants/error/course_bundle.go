@@ -0,0 +1,21 @@
+package error
+
+import "errors"
+
+var (
+	ErrCourseBundleFileRequired       = errors.New("bundle file is required")
+	ErrInvalidCourseBundle            = errors.New("file is not a valid course bundle")
+	ErrUnsupportedCourseBundleVersion = errors.New("unsupported course bundle format version")
+	ErrCourseBundleTooLarge           = errors.New("course bundle is too large")
+	ErrCourseBundleConflict           = errors.New("course bundle cannot be imported, see the reported conflicts")
+	ErrCourseBundleAuthorOnly         = errors.New("only teachers and admins can export or import course bundles")
+)
+
+var CourseBundleErrors = []error{
+	ErrCourseBundleFileRequired,
+	ErrInvalidCourseBundle,
+	ErrUnsupportedCourseBundleVersion,
+	ErrCourseBundleTooLarge,
+	ErrCourseBundleConflict,
+	ErrCourseBundleAuthorOnly,
+}
@@ -0,0 +1,15 @@
+package error
+
+import "errors"
+
+var (
+	ErrPrerequisiteCourseNotFound = errors.New("prerequisite course not found")
+	ErrSelfPrerequisite           = errors.New("a course cannot be its own prerequisite")
+	ErrPrerequisiteCycle          = errors.New("prerequisites cannot form a cycle")
+)
+
+var CoursePrerequisiteErrors = []error{
+	ErrPrerequisiteCourseNotFound,
+	ErrSelfPrerequisite,
+	ErrPrerequisiteCycle,
+}
@@ -0,0 +1,21 @@
+package error
+
+import "errors"
+
+var (
+	ErrDeckNotFound           = errors.New("deck not found")
+	ErrDeckCardNotFound       = errors.New("deck card not found")
+	ErrDeckVocabularyNotFound = errors.New("one or more vocabulary IDs to add to the deck do not exist")
+	ErrDeckAlreadySubscribed  = errors.New("already subscribed to this deck")
+	ErrDeckNotSubscribed      = errors.New("not subscribed to this deck")
+	ErrDeckCardStatusNotFound = errors.New("deck card is not in your review queue")
+)
+
+var DeckErrors = []error{
+	ErrDeckNotFound,
+	ErrDeckCardNotFound,
+	ErrDeckVocabularyNotFound,
+	ErrDeckAlreadySubscribed,
+	ErrDeckNotSubscribed,
+	ErrDeckCardStatusNotFound,
+}
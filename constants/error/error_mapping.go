@@ -14,6 +14,27 @@ func ErrMapping(err error) bool {
 	allErrors = append(allErrors, ExerciseErrors[:]...)
 	allErrors = append(allErrors, ExerciseQuestionErrors[:]...)
 	allErrors = append(allErrors, UserCourseProgressErrors[:]...)
+	allErrors = append(allErrors, ExampleSentenceErrors[:]...)
+	allErrors = append(allErrors, FuriganaErrors[:]...)
+	allErrors = append(allErrors, TranslationErrors[:]...)
+	allErrors = append(allErrors, ExamErrors[:]...)
+	allErrors = append(allErrors, PlacementTestErrors[:]...)
+	allErrors = append(allErrors, ExerciseAttemptErrors[:]...)
+	allErrors = append(allErrors, StorageErrors[:]...)
+	allErrors = append(allErrors, SpeakingSubmissionErrors[:]...)
+	allErrors = append(allErrors, NotificationErrors[:]...)
+	allErrors = append(allErrors, MediaErrors[:]...)
+	allErrors = append(allErrors, ContentWorkflowErrors[:]...)
+	allErrors = append(allErrors, PublishScheduleErrors[:]...)
+	allErrors = append(allErrors, ContentRevisionErrors[:]...)
+	allErrors = append(allErrors, TrashErrors[:]...)
+	allErrors = append(allErrors, CourseBundleErrors[:]...)
+	allErrors = append(allErrors, CoursePrerequisiteErrors[:]...)
+	allErrors = append(allErrors, LessonVocabularyErrors[:]...)
+	allErrors = append(allErrors, QuizErrors[:]...)
+	allErrors = append(allErrors, VocabularyNoteErrors[:]...)
+	allErrors = append(allErrors, DeckErrors[:]...)
+	allErrors = append(allErrors, BookmarkErrors[:]...)
 
 	for _, item := range allErrors {
 		if err.Error() == item.Error() {
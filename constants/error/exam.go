@@ -0,0 +1,31 @@
+package error
+
+import "errors"
+
+var (
+	ErrExamNotFound                = errors.New("exam not found")
+	ErrInvalidJlptLevelIDExam      = errors.New("invalid JLPT level ID for exam")
+	ErrExamScoringRuleNotFound     = errors.New("JLPT scoring rules are not defined for this level")
+	ErrInvalidExamSections         = errors.New("exam must have exactly one vocabulary, grammar, reading and listening section")
+	ErrExamQuestionPoolTooSmall    = errors.New("not enough published questions for this JLPT level to fill every exam section")
+	ErrExamAlreadyPublished        = errors.New("exam is already published")
+	ErrExamNotPublished            = errors.New("exam is not published")
+	ErrExamAttemptNotFound         = errors.New("exam attempt not found")
+	ErrExamAttemptExpired          = errors.New("exam time limit has passed and the attempt was submitted automatically")
+	ErrExamAttemptAlreadySubmitted = errors.New("exam attempt is already submitted")
+	ErrExamAnswerQuestionNotFound  = errors.New("question is not part of this exam attempt")
+)
+
+var ExamErrors = []error{
+	ErrExamNotFound,
+	ErrInvalidJlptLevelIDExam,
+	ErrExamScoringRuleNotFound,
+	ErrInvalidExamSections,
+	ErrExamQuestionPoolTooSmall,
+	ErrExamAlreadyPublished,
+	ErrExamNotPublished,
+	ErrExamAttemptNotFound,
+	ErrExamAttemptExpired,
+	ErrExamAttemptAlreadySubmitted,
+	ErrExamAnswerQuestionNotFound,
+}
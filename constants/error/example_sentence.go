@@ -0,0 +1,15 @@
+package error
+
+import "errors"
+
+var (
+	ErrExampleSentenceNotFound            = errors.New("example sentence not found")
+	ErrInvalidVocabularyIDExampleSentence = errors.New("invalid vocabulary ID for example sentence")
+	ErrInvalidExampleSentenceDifficulty   = errors.New("example sentence difficulty must be between 1 and 5")
+)
+
+var ExampleSentenceErrors = []error{
+	ErrExampleSentenceNotFound,
+	ErrInvalidVocabularyIDExampleSentence,
+	ErrInvalidExampleSentenceDifficulty,
+}
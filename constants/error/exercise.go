@@ -13,6 +13,7 @@ var (
 	ErrExerciseAlreadyPublished        = errors.New("exercise is already published")
 	ErrExerciseNotPublished            = errors.New("exercise is not published")
 	ErrDuplicateExerciseOrderIndex     = errors.New("an exercise with this order_index already exists for this lesson")
+	ErrInvalidExerciseOrder            = errors.New("ids must list every exercise of the lesson exactly once")
 )
 
 var ExerciseErrors = []error{
@@ -26,4 +27,5 @@ var ExerciseErrors = []error{
 	ErrExerciseAlreadyPublished,
 	ErrExerciseNotPublished,
 	ErrDuplicateExerciseOrderIndex,
+	ErrInvalidExerciseOrder,
 }
@@ -0,0 +1,17 @@
+package error
+
+import "errors"
+
+var (
+	ErrExerciseAttemptNotFound         = errors.New("exercise attempt not found")
+	ErrExerciseAttemptAlreadySubmitted = errors.New("exercise attempt is already submitted")
+	ErrExerciseAttemptQuestionNotFound = errors.New("question is not part of this exercise attempt")
+	ErrExerciseHasNoQuestions          = errors.New("exercise has no published questions")
+)
+
+var ExerciseAttemptErrors = []error{
+	ErrExerciseAttemptNotFound,
+	ErrExerciseAttemptAlreadySubmitted,
+	ErrExerciseAttemptQuestionNotFound,
+	ErrExerciseHasNoQuestions,
+}
@@ -10,6 +10,7 @@ var (
 	ErrInvalidQuestionPoints              = errors.New("points must be between 1 and 100")
 	ErrInvalidQuestionOrderIndex          = errors.New("order_index must be a non-negative integer")
 	ErrDuplicateQuestionOrderIndex        = errors.New("a question with this order_index already exists for this exercise")
+	ErrInvalidQuestionOrder               = errors.New("ids must list every question of the exercise exactly once")
 	ErrExerciseQuestionAlreadyPublished   = errors.New("exercise question is already published")
 	ErrExerciseQuestionNotPublished       = errors.New("exercise question is not published")
 	ErrInvalidQuestionType                = errors.New("question type must be one of: multiple_choice, fill_blank, matching, listening, speaking")
@@ -17,6 +18,16 @@ var (
 	ErrInvalidQuestionExplanation         = errors.New("explanation must not exceed 1000 characters")
 	ErrInvalidQuestionAudioURL            = errors.New("audio URL must be a valid URL and not exceed 500 characters")
 	ErrInvalidQuestionImageURL            = errors.New("image URL must be a valid URL and not exceed 500 characters")
+	ErrInvalidQuestionChoices             = errors.New("multiple choice and listening questions need 2 to 10 choices with unique keys")
+	ErrCorrectAnswerNotInChoices          = errors.New("correct answer must reference one of the choice keys")
+	ErrInvalidBlankAnswers                = errors.New("fill blank answer must list accepted answers for every blank in the template")
+	ErrInvalidMatchingItems               = errors.New("matching questions need 2 to 10 left items, at least as many right items, and unique keys")
+	ErrInvalidMatchingPairs               = errors.New("matching answer must pair every left item with a distinct right item")
+	ErrQuestionOptionsNotAllowed          = errors.New("options do not match the question type")
+	ErrInvalidQuestionAnswer              = errors.New("correct answer does not match the question type")
+	ErrInvalidAnswerStrictness            = errors.New("answer strictness must be one of: strict, normal, lenient")
+	ErrInvalidSubmittedAnswer             = errors.New("submitted answer does not match the question type")
+	ErrAnswerRequiresReview               = errors.New("speaking answers must be reviewed by a teacher")
 )
 
 var ExerciseQuestionErrors = []error{
@@ -27,6 +38,7 @@ var ExerciseQuestionErrors = []error{
 	ErrInvalidQuestionPoints,
 	ErrInvalidQuestionOrderIndex,
 	ErrDuplicateQuestionOrderIndex,
+	ErrInvalidQuestionOrder,
 	ErrExerciseQuestionAlreadyPublished,
 	ErrExerciseQuestionNotPublished,
 	ErrInvalidQuestionType,
@@ -34,4 +46,14 @@ var ExerciseQuestionErrors = []error{
 	ErrInvalidQuestionExplanation,
 	ErrInvalidQuestionAudioURL,
 	ErrInvalidQuestionImageURL,
+	ErrInvalidQuestionChoices,
+	ErrCorrectAnswerNotInChoices,
+	ErrInvalidBlankAnswers,
+	ErrInvalidMatchingItems,
+	ErrInvalidMatchingPairs,
+	ErrQuestionOptionsNotAllowed,
+	ErrInvalidQuestionAnswer,
+	ErrInvalidAnswerStrictness,
+	ErrInvalidSubmittedAnswer,
+	ErrAnswerRequiresReview,
 }
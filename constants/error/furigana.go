@@ -0,0 +1,11 @@
+package error
+
+import "errors"
+
+var (
+	ErrFuriganaReadingMismatch = errors.New("reading does not match the kana in the text")
+)
+
+var FuriganaErrors = []error{
+	ErrFuriganaReadingMismatch,
+}
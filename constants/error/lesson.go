@@ -11,6 +11,13 @@ var (
 	ErrLessonAlreadyPublished     = errors.New("lesson is already published")
 	ErrLessonNotPublished         = errors.New("lesson is not published")
 	ErrDuplicateOrderIndex        = errors.New("a lesson with this order_index already exists for this course")
+	ErrInvalidLessonOrder         = errors.New("ids must list every lesson of the course exactly once")
+	ErrInvalidLessonUnlockScore   = errors.New("unlock_min_score must be between 1 and 100 for the previous_score rule and 0 otherwise")
+	ErrInvalidLessonBlock         = errors.New("lesson block is missing the fields required by its type")
+	ErrLessonBlockVocabulary      = errors.New("vocabulary referenced by a lesson block does not exist")
+	ErrInvalidLessonBlockQuestion = errors.New("question of a lesson block does not match the schema of its question type")
+	ErrLessonBlockNotQuestion     = errors.New("lesson block not found or not a question")
+	ErrLessonLocked               = errors.New("lesson is locked")
 )
 
 var LessonErrors = []error{
@@ -22,4 +29,11 @@ var LessonErrors = []error{
 	ErrLessonAlreadyPublished,
 	ErrLessonNotPublished,
 	ErrDuplicateOrderIndex,
+	ErrInvalidLessonOrder,
+	ErrInvalidLessonUnlockScore,
+	ErrInvalidLessonBlock,
+	ErrLessonBlockVocabulary,
+	ErrInvalidLessonBlockQuestion,
+	ErrLessonBlockNotQuestion,
+	ErrLessonLocked,
 }
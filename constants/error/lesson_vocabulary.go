@@ -0,0 +1,13 @@
+package error
+
+import "errors"
+
+var (
+	ErrLessonVocabularyNotFound = errors.New("one or more vocabulary IDs do not exist")
+	ErrLessonNoVocabularies     = errors.New("lesson has no vocabulary to study")
+)
+
+var LessonVocabularyErrors = []error{
+	ErrLessonVocabularyNotFound,
+	ErrLessonNoVocabularies,
+}
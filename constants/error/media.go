@@ -0,0 +1,21 @@
+package error
+
+import "errors"
+
+var (
+	ErrMediaNotFound        = errors.New("media not found")
+	ErrMediaFileRequired    = errors.New("a media file is required")
+	ErrUnsupportedMediaType = errors.New("unsupported media type, expected mp3, ogg, webm, m4a, aac, wav, jpeg, png or gif")
+	ErrMediaTooLarge        = errors.New("media exceeds the maximum size of 20 MB for audio or 5 MB for images")
+	ErrInvalidImage         = errors.New("image file is corrupt, does not match its content type or is too large to process")
+	ErrMediaKindMismatch    = errors.New("referenced media is not of the expected kind")
+)
+
+var MediaErrors = []error{
+	ErrMediaNotFound,
+	ErrMediaFileRequired,
+	ErrUnsupportedMediaType,
+	ErrMediaTooLarge,
+	ErrInvalidImage,
+	ErrMediaKindMismatch,
+}
@@ -0,0 +1,11 @@
+package error
+
+import "errors"
+
+var (
+	ErrNotificationNotFound = errors.New("notification not found")
+)
+
+var NotificationErrors = []error{
+	ErrNotificationNotFound,
+}
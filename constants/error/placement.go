@@ -0,0 +1,17 @@
+package error
+
+import "errors"
+
+var (
+	ErrPlacementTestNotFound       = errors.New("placement test not found")
+	ErrPlacementTestCompleted      = errors.New("placement test is already completed")
+	ErrPlacementQuestionMismatch   = errors.New("answer does not match the current placement question")
+	ErrPlacementJlptLevelsNotFound = errors.New("no JLPT levels are available for a placement test")
+)
+
+var PlacementTestErrors = []error{
+	ErrPlacementTestNotFound,
+	ErrPlacementTestCompleted,
+	ErrPlacementQuestionMismatch,
+	ErrPlacementJlptLevelsNotFound,
+}
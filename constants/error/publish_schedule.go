@@ -0,0 +1,19 @@
+package error
+
+import "errors"
+
+var (
+	ErrPublishScheduleNotFound     = errors.New("publish schedule not found")
+	ErrPublishScheduleNotSupported = errors.New("publishing can only be scheduled for courses and lessons")
+	ErrPublishScheduleEmpty        = errors.New("publishAt or unpublishAt is required")
+	ErrPublishScheduleInPast       = errors.New("scheduled times must be in the future")
+	ErrInvalidPublishSchedule      = errors.New("unpublishAt must be after publishAt")
+)
+
+var PublishScheduleErrors = []error{
+	ErrPublishScheduleNotFound,
+	ErrPublishScheduleNotSupported,
+	ErrPublishScheduleEmpty,
+	ErrPublishScheduleInPast,
+	ErrInvalidPublishSchedule,
+}
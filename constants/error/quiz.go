@@ -0,0 +1,13 @@
+package error
+
+import "errors"
+
+var (
+	ErrQuizSourceRequired = errors.New("a category, JLPT level or lesson is required")
+	ErrQuizNoVocabulary   = errors.New("not enough vocabulary to generate questions")
+)
+
+var QuizErrors = []error{
+	ErrQuizSourceRequired,
+	ErrQuizNoVocabulary,
+}
@@ -0,0 +1,21 @@
+package error
+
+import "errors"
+
+var (
+	ErrSpeakingSubmissionNotFound = errors.New("speaking submission not found")
+	ErrNotSpeakingQuestion        = errors.New("recordings can only be submitted for published speaking questions")
+	ErrInvalidRecording           = errors.New("recording must be an audio file")
+	ErrRecordingTooLarge          = errors.New("recording exceeds the maximum size of 10 MB")
+	ErrSpeakingAlreadyReviewed    = errors.New("speaking submission is already reviewed")
+	ErrInvalidSpeakingReviewScore = errors.New("score must be between 0 and the question points")
+)
+
+var SpeakingSubmissionErrors = []error{
+	ErrSpeakingSubmissionNotFound,
+	ErrNotSpeakingQuestion,
+	ErrInvalidRecording,
+	ErrRecordingTooLarge,
+	ErrSpeakingAlreadyReviewed,
+	ErrInvalidSpeakingReviewScore,
+}
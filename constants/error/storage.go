@@ -0,0 +1,19 @@
+package error
+
+import "errors"
+
+var (
+	ErrUnsupportedStorageDriver = errors.New("unsupported storage driver")
+	ErrStorageNotConfigured     = errors.New("storage driver is missing required configuration")
+	ErrInvalidStorageKey        = errors.New("invalid storage key")
+	ErrStorageObjectNotFound    = errors.New("stored file not found")
+	ErrStorageRequestFailed     = errors.New("storage server rejected the request")
+)
+
+var StorageErrors = []error{
+	ErrUnsupportedStorageDriver,
+	ErrStorageNotConfigured,
+	ErrInvalidStorageKey,
+	ErrStorageObjectNotFound,
+	ErrStorageRequestFailed,
+}
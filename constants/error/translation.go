@@ -0,0 +1,19 @@
+package error
+
+import "errors"
+
+var (
+	ErrTranslationNotFound          = errors.New("translation not found")
+	ErrInvalidTranslationEntityType = errors.New("unsupported translation entity type or field")
+	ErrInvalidTranslationLocale     = errors.New("unsupported translation locale")
+	ErrTranslationEntityNotFound    = errors.New("translated entity not found")
+	ErrTranslationAdminOnly         = errors.New("only admins can manage translations")
+)
+
+var TranslationErrors = []error{
+	ErrTranslationNotFound,
+	ErrInvalidTranslationEntityType,
+	ErrInvalidTranslationLocale,
+	ErrTranslationEntityNotFound,
+	ErrTranslationAdminOnly,
+}
@@ -0,0 +1,17 @@
+package error
+
+import "errors"
+
+var (
+	ErrTrashItemNotFound    = errors.New("item not found in trash")
+	ErrTrashAdminOnly       = errors.New("only admins can manage the trash")
+	ErrTrashParentDeleted   = errors.New("the parent of this item is in the trash, restore it first")
+	ErrTrashRestoreConflict = errors.New("restoring would duplicate an existing title or order, change the existing item first")
+)
+
+var TrashErrors = []error{
+	ErrTrashItemNotFound,
+	ErrTrashAdminOnly,
+	ErrTrashParentDeleted,
+	ErrTrashRestoreConflict,
+}
@@ -0,0 +1,21 @@
+package error
+
+import "errors"
+
+var (
+	ErrVocabularyNoteNotFound  = errors.New("vocabulary note not found")
+	ErrVocabularyNoteExists    = errors.New("a note for this vocabulary already exists")
+	ErrVocabularyNoteEmpty     = errors.New("a note, mnemonic or image is required")
+	ErrVocabularyNoteNotShared = errors.New("only a note with a mnemonic can be shared")
+	ErrMnemonicNotFound        = errors.New("shared mnemonic not found")
+	ErrMnemonicOwnUpvote       = errors.New("you cannot upvote your own mnemonic")
+)
+
+var VocabularyNoteErrors = []error{
+	ErrVocabularyNoteNotFound,
+	ErrVocabularyNoteExists,
+	ErrVocabularyNoteEmpty,
+	ErrVocabularyNoteNotShared,
+	ErrMnemonicNotFound,
+	ErrMnemonicOwnUpvote,
+}
@@ -0,0 +1,25 @@
+package constants
+
+import "time"
+
+// JLPT exam sections. Exercise questions are assigned to a section to join its question pool.
+const (
+	ExamSectionVocabulary = "vocabulary"
+	ExamSectionGrammar    = "grammar"
+	ExamSectionReading    = "reading"
+	ExamSectionListening  = "listening"
+)
+
+// ExamSections lists every section a mock exam must contain, in exam order
+var ExamSections = []string{
+	ExamSectionVocabulary,
+	ExamSectionGrammar,
+	ExamSectionReading,
+	ExamSectionListening,
+}
+
+// ExamSubmitGracePeriod absorbs network latency when answers or submissions arrive right after the time limit
+const ExamSubmitGracePeriod = 30 * time.Second
+
+// PlacementRecommendedCourses is the number of published courses recommended after a placement test
+const PlacementRecommendedCourses = 5
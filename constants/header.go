@@ -3,5 +3,7 @@ package constants
 import "net/textproto"
 
 var (
-	Authorization = textproto.CanonicalMIMEHeaderKey("authorization")
+	Authorization   = textproto.CanonicalMIMEHeaderKey("authorization")
+	AcceptLanguage  = textproto.CanonicalMIMEHeaderKey("accept-language")
+	ContentLanguage = textproto.CanonicalMIMEHeaderKey("content-language")
 )
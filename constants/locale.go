@@ -0,0 +1,14 @@
+package constants
+
+const (
+	LocaleEnglish    = "en"
+	LocaleIndonesian = "id"
+
+	// DefaultLocale is the language of the untranslated content columns
+	DefaultLocale = LocaleEnglish
+)
+
+var SupportedLocales = []string{
+	LocaleEnglish,
+	LocaleIndonesian,
+}
@@ -0,0 +1,34 @@
+package constants
+
+// Maximum upload sizes of media files in bytes
+const (
+	MaxAudioMediaSize = 20 << 20
+	MaxImageMediaSize = 5 << 20
+)
+
+// MaxImagePixels is the largest accepted image area, guarding thumbnail generation against
+// images that are small on disk but huge once decoded
+const MaxImagePixels = 40_000_000
+
+// ThumbnailSize is the maximum width and height of generated image thumbnails
+const ThumbnailSize = 320
+
+// MediaType describes an accepted media content type
+type MediaType struct {
+	Kind      string
+	Extension string
+}
+
+// MediaTypes maps the accepted media content types to their kind and file extension
+var MediaTypes = map[string]MediaType{
+	"audio/mpeg":  {Kind: "audio", Extension: ".mp3"},
+	"audio/ogg":   {Kind: "audio", Extension: ".ogg"},
+	"audio/webm":  {Kind: "audio", Extension: ".webm"},
+	"audio/mp4":   {Kind: "audio", Extension: ".m4a"},
+	"audio/aac":   {Kind: "audio", Extension: ".aac"},
+	"audio/wav":   {Kind: "audio", Extension: ".wav"},
+	"audio/x-wav": {Kind: "audio", Extension: ".wav"},
+	"image/jpeg":  {Kind: "image", Extension: ".jpg"},
+	"image/png":   {Kind: "image", Extension: ".png"},
+	"image/gif":   {Kind: "image", Extension: ".gif"},
+}
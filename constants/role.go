@@ -1,6 +1,13 @@
 package constants
 
 const (
-	Admin = 1
-	User  = 2
+	Admin   = 1
+	User    = 2
+	Teacher = 3
+)
+
+// Role codes as carried by the login token
+const (
+	RoleAdmin   = "admin"
+	RoleTeacher = "teacher"
 )
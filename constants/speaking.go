@@ -0,0 +1,15 @@
+package constants
+
+// MaxRecordingSize is the largest accepted speaking recording in bytes (10 MB)
+const MaxRecordingSize = 10 << 20
+
+// RecordingExtensions maps the accepted recording content types to file extensions
+var RecordingExtensions = map[string]string{
+	"audio/webm":  ".webm",
+	"audio/ogg":   ".ogg",
+	"audio/mpeg":  ".mp3",
+	"audio/mp4":   ".m4a",
+	"audio/aac":   ".aac",
+	"audio/wav":   ".wav",
+	"audio/x-wav": ".wav",
+}
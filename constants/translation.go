@@ -0,0 +1,16 @@
+package constants
+
+const (
+	TranslationEntityVocabulary       = "vocabulary"
+	TranslationEntityCourse           = "course"
+	TranslationEntityLesson           = "lesson"
+	TranslationEntityExerciseQuestion = "exercise_question"
+)
+
+// TranslatableFields maps each translatable entity type to its translatable field
+var TranslatableFields = map[string]string{
+	TranslationEntityVocabulary:       "meaning",
+	TranslationEntityCourse:           "description",
+	TranslationEntityLesson:           "content",
+	TranslationEntityExerciseQuestion: "explanation",
+}
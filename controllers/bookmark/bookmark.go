@@ -0,0 +1,352 @@
+package controllers
+
+import (
+	errWrap "manabu-service/common/error"
+	"manabu-service/common/response"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type BookmarkController struct {
+	service services.IServiceRegistry
+}
+
+// IBookmarkController defines the contract for bookmark HTTP handlers.
+type IBookmarkController interface {
+	// Create handles POST requests to bookmark an item.
+	Create(*gin.Context)
+	// GetAll handles GET requests to list the user's bookmarks.
+	GetAll(*gin.Context)
+	// Move handles PUT requests to move a bookmark between folders.
+	Move(*gin.Context)
+	// Delete handles DELETE requests to remove the bookmark of an item.
+	Delete(*gin.Context)
+	// GetFolders handles GET requests to list the user's bookmark folders.
+	GetFolders(*gin.Context)
+	// CreateFolder handles POST requests to add a bookmark folder.
+	CreateFolder(*gin.Context)
+	// RenameFolder handles PUT requests to rename a bookmark folder.
+	RenameFolder(*gin.Context)
+	// DeleteFolder handles DELETE requests to remove a bookmark folder.
+	DeleteFolder(*gin.Context)
+}
+
+func NewBookmarkController(service services.IServiceRegistry) IBookmarkController {
+	return &BookmarkController{service: service}
+}
+
+// getStatusCode maps errors to appropriate HTTP status codes
+func (c *BookmarkController) getStatusCode(err error) int {
+	switch err {
+	case errConstant.ErrBookmarkNotFound, errConstant.ErrBookmarkItemNotFound, errConstant.ErrBookmarkFolderNotFound:
+		return http.StatusNotFound
+	case errConstant.ErrBookmarkExists, errConstant.ErrBookmarkFolderExists:
+		return http.StatusConflict
+	case errConstant.ErrInvalidID:
+		return http.StatusBadRequest
+	case errConstant.ErrUnauthorized:
+		return http.StatusUnauthorized
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// parseID parses the id path parameter, responding with 400 when it is invalid
+func (c *BookmarkController) parseID(ctx *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// bind binds and validates a JSON body or query string, responding with 400 or 422 when it is invalid
+func (c *BookmarkController) bind(ctx *gin.Context, request interface{}, query bool) bool {
+	var err error
+	if query {
+		err = ctx.ShouldBindQuery(request)
+	} else {
+		err = ctx.ShouldBindJSON(request)
+	}
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return false
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return false
+	}
+	return true
+}
+
+// respond writes the result of a service call
+func (c *BookmarkController) respond(ctx *gin.Context, code int, data interface{}, err error) {
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: code,
+		Data: data,
+		Gin:  ctx,
+	})
+}
+
+// respondMessage writes a success message
+func (c *BookmarkController) respondMessage(ctx *gin.Context, message string, err error) {
+	if err != nil {
+		c.respond(ctx, 0, nil, err)
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code:    http.StatusOK,
+		Message: &message,
+		Gin:     ctx,
+	})
+}
+
+// Create godoc
+// @Summary      Create Bookmark
+// @Description  Save a course, lesson, vocabulary or exercise question for later, optionally in one of the user's folders.
+// @Tags         Bookmarks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body dto.CreateBookmarkRequest true "Bookmark"
+// @Success      201 {object} dto.BookmarkSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Item or folder not found"
+// @Failure      409 {object} response.Response "Item already bookmarked"
+// @Failure      422 {object} response.Response
+// @Failure      500 {object} response.Response
+// @Router       /me/bookmarks [post]
+func (c *BookmarkController) Create(ctx *gin.Context) {
+	request := &dto.CreateBookmarkRequest{}
+	if !c.bind(ctx, request, false) {
+		return
+	}
+
+	bookmark, err := c.service.GetBookmark().Create(ctx.Request.Context(), request)
+	c.respond(ctx, http.StatusCreated, bookmark, err)
+}
+
+// GetAll godoc
+// @Summary      Get Bookmarks
+// @Description  Retrieve the authenticated user's bookmarks, newest first. Bookmarks of deleted items are marked unavailable.
+// @Tags         Bookmarks
+// @Produce      json
+// @Security     BearerAuth
+// @Param        page query int false "Page number" default(1) minimum(1)
+// @Param        limit query int false "Items per page" default(10) minimum(1) maximum(100)
+// @Param        itemType query string false "Item type" Enums(course, lesson, vocabulary, exercise_question)
+// @Param        folderId query int false "Folder ID"
+// @Success      200 {object} dto.BookmarkListSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      422 {object} response.Response
+// @Failure      500 {object} response.Response
+// @Router       /me/bookmarks [get]
+func (c *BookmarkController) GetAll(ctx *gin.Context) {
+	filter := &dto.BookmarkFilterRequest{}
+	if !c.bind(ctx, filter, true) {
+		return
+	}
+
+	bookmarks, err := c.service.GetBookmark().GetAll(ctx.Request.Context(), filter)
+	if err != nil {
+		c.respond(ctx, 0, nil, err)
+		return
+	}
+
+	// Return response with data array and pagination at same level
+	ctx.JSON(http.StatusOK, gin.H{
+		"message":    http.StatusText(http.StatusOK),
+		"pagination": bookmarks.Pagination,
+		"status":     "success",
+		"data":       bookmarks.Data,
+	})
+}
+
+// Move godoc
+// @Summary      Move Bookmark
+// @Description  Move one of the authenticated user's bookmarks into a folder, or out of its folder when folderId is empty.
+// @Tags         Bookmarks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Bookmark ID"
+// @Param        request body dto.MoveBookmarkRequest true "Folder"
+// @Success      200 {object} dto.BookmarkSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Bookmark or folder not found"
+// @Failure      422 {object} response.Response
+// @Failure      500 {object} response.Response
+// @Router       /me/bookmarks/{id} [put]
+func (c *BookmarkController) Move(ctx *gin.Context) {
+	id, ok := c.parseID(ctx)
+	if !ok {
+		return
+	}
+
+	request := &dto.MoveBookmarkRequest{}
+	if !c.bind(ctx, request, false) {
+		return
+	}
+
+	bookmark, err := c.service.GetBookmark().Move(ctx.Request.Context(), id, request)
+	c.respond(ctx, http.StatusOK, bookmark, err)
+}
+
+// Delete godoc
+// @Summary      Delete Bookmark
+// @Description  Remove the authenticated user's bookmark of an item.
+// @Tags         Bookmarks
+// @Produce      json
+// @Security     BearerAuth
+// @Param        itemType query string true "Item type" Enums(course, lesson, vocabulary, exercise_question)
+// @Param        itemId query int true "Item ID"
+// @Success      200 {object} response.Response
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Bookmark not found"
+// @Failure      422 {object} response.Response
+// @Failure      500 {object} response.Response
+// @Router       /me/bookmarks [delete]
+func (c *BookmarkController) Delete(ctx *gin.Context) {
+	request := &dto.BookmarkItemRequest{}
+	if !c.bind(ctx, request, true) {
+		return
+	}
+
+	err := c.service.GetBookmark().Delete(ctx.Request.Context(), request)
+	c.respondMessage(ctx, "Bookmark deleted successfully", err)
+}
+
+// GetFolders godoc
+// @Summary      Get Bookmark Folders
+// @Description  Retrieve the authenticated user's bookmark folders by name, with the number of bookmarks in each.
+// @Tags         Bookmarks
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} dto.BookmarkFolderListSwaggerResponse
+// @Failure      401 {object} response.Response
+// @Failure      500 {object} response.Response
+// @Router       /me/bookmark-folders [get]
+func (c *BookmarkController) GetFolders(ctx *gin.Context) {
+	folders, err := c.service.GetBookmark().GetFolders(ctx.Request.Context())
+	c.respond(ctx, http.StatusOK, folders, err)
+}
+
+// CreateFolder godoc
+// @Summary      Create Bookmark Folder
+// @Description  Add a bookmark folder. Folder names are unique per user.
+// @Tags         Bookmarks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body dto.BookmarkFolderRequest true "Folder"
+// @Success      201 {object} dto.BookmarkFolderSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      409 {object} response.Response "Folder name already used"
+// @Failure      422 {object} response.Response
+// @Failure      500 {object} response.Response
+// @Router       /me/bookmark-folders [post]
+func (c *BookmarkController) CreateFolder(ctx *gin.Context) {
+	request := &dto.BookmarkFolderRequest{}
+	if !c.bind(ctx, request, false) {
+		return
+	}
+
+	folder, err := c.service.GetBookmark().CreateFolder(ctx.Request.Context(), request)
+	c.respond(ctx, http.StatusCreated, folder, err)
+}
+
+// RenameFolder godoc
+// @Summary      Rename Bookmark Folder
+// @Description  Rename one of the authenticated user's bookmark folders.
+// @Tags         Bookmarks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Folder ID"
+// @Param        request body dto.BookmarkFolderRequest true "Folder"
+// @Success      200 {object} dto.BookmarkFolderSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Folder not found"
+// @Failure      409 {object} response.Response "Folder name already used"
+// @Failure      422 {object} response.Response
+// @Failure      500 {object} response.Response
+// @Router       /me/bookmark-folders/{id} [put]
+func (c *BookmarkController) RenameFolder(ctx *gin.Context) {
+	id, ok := c.parseID(ctx)
+	if !ok {
+		return
+	}
+
+	request := &dto.BookmarkFolderRequest{}
+	if !c.bind(ctx, request, false) {
+		return
+	}
+
+	folder, err := c.service.GetBookmark().RenameFolder(ctx.Request.Context(), id, request)
+	c.respond(ctx, http.StatusOK, folder, err)
+}
+
+// DeleteFolder godoc
+// @Summary      Delete Bookmark Folder
+// @Description  Delete one of the authenticated user's bookmark folders. Its bookmarks are kept without a folder.
+// @Tags         Bookmarks
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Folder ID"
+// @Success      200 {object} response.Response
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Folder not found"
+// @Failure      500 {object} response.Response
+// @Router       /me/bookmark-folders/{id} [delete]
+func (c *BookmarkController) DeleteFolder(ctx *gin.Context) {
+	id, ok := c.parseID(ctx)
+	if !ok {
+		return
+	}
+
+	err := c.service.GetBookmark().DeleteFolder(ctx.Request.Context(), id)
+	c.respondMessage(ctx, "Folder deleted successfully", err)
+}
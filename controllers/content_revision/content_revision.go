@@ -0,0 +1,293 @@
+package controllers
+
+import (
+	"context"
+	errWrap "manabu-service/common/error"
+	"manabu-service/common/response"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+	"manabu-service/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type ContentRevisionController struct {
+	service services.IServiceRegistry
+}
+
+// IContentRevisionController defines the contract for content revision history HTTP handlers.
+type IContentRevisionController interface {
+	// GetAll handles GET requests to list the revisions of a content item.
+	GetAll(*gin.Context)
+	// GetByVersion handles GET requests to retrieve a revision with its snapshot.
+	GetByVersion(*gin.Context)
+	// Diff handles GET requests to compare two revisions of a content item.
+	Diff(*gin.Context)
+	// Restore handles POST requests to restore a content item to a revision.
+	Restore(*gin.Context)
+}
+
+func NewContentRevisionController(service services.IServiceRegistry) IContentRevisionController {
+	return &ContentRevisionController{service: service}
+}
+
+// getStatusCode maps errors to appropriate HTTP status codes
+func (c *ContentRevisionController) getStatusCode(err error) int {
+	switch err {
+	case errConstant.ErrContentRevisionNotFound, errConstant.ErrCourseNotFound, errConstant.ErrLessonNotFound,
+		errConstant.ErrExerciseQuestionNotFound, errConstant.ErrVocabularyNotFound:
+		return http.StatusNotFound
+	case errConstant.ErrContentRevisionConflict:
+		return http.StatusConflict
+	case errConstant.ErrInvalidID, errConstant.ErrInvalidRevisionContentType:
+		return http.StatusBadRequest
+	case errConstant.ErrUnauthorized:
+		return http.StatusUnauthorized
+	default:
+		// A restored snapshot can fail the current validation rules of its content
+		if errConstant.ErrMapping(err) {
+			return http.StatusUnprocessableEntity
+		}
+		return http.StatusInternalServerError
+	}
+}
+
+// parseContent reads the content type and ID path parameters
+func (c *ContentRevisionController) parseContent(ctx *gin.Context) (string, uint, bool) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return "", 0, false
+	}
+
+	return ctx.Param("contentType"), uint(id), true
+}
+
+// parseVersion reads the version path parameter
+func (c *ContentRevisionController) parseVersion(ctx *gin.Context) (int, bool) {
+	version, err := strconv.Atoi(ctx.Param("version"))
+	if err != nil || version < 1 {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return 0, false
+	}
+
+	return version, true
+}
+
+// bindQuery binds and validates query parameters
+func (c *ContentRevisionController) bindQuery(ctx *gin.Context, request interface{}) bool {
+	if err := ctx.ShouldBindQuery(request); err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return false
+	}
+
+	validate := validator.New()
+	err := validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return false
+	}
+
+	return true
+}
+
+// respond writes the result or the error of a revision operation
+func (c *ContentRevisionController) respond(ctx *gin.Context, data interface{}, err error) {
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: data,
+		Gin:  ctx,
+	})
+}
+
+// GetAll godoc
+// @Summary      Get Content Revisions
+// @Description  List the revisions of a course, lesson, exercise question or vocabulary entry, newest first, with the fields each change modified
+// @Tags         Content Revisions
+// @Produce      json
+// @Security     BearerAuth
+// @Param        contentType path string true "Content type" Enums(course, lesson, exercise_question, vocabulary)
+// @Param        id path int true "Content ID"
+// @Param        page query int false "Page number" default(1) minimum(1)
+// @Param        limit query int false "Items per page" default(10) minimum(1) maximum(100)
+// @Success      200 {object} dto.ContentRevisionListSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      422 {object} response.Response
+// @Failure      500 {object} response.Response
+// @Router       /revisions/{contentType}/{id} [get]
+func (c *ContentRevisionController) GetAll(ctx *gin.Context) {
+	contentType, id, ok := c.parseContent(ctx)
+	if !ok {
+		return
+	}
+
+	filter := &dto.ContentRevisionFilterRequest{}
+	if !c.bindQuery(ctx, filter) {
+		return
+	}
+
+	revisions, err := c.service.GetContentRevision().GetAll(ctx.Request.Context(), contentType, id, filter)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	// Return response with data array and pagination at same level
+	ctx.JSON(http.StatusOK, gin.H{
+		"message":    http.StatusText(http.StatusOK),
+		"pagination": revisions.Pagination,
+		"status":     "success",
+		"data":       revisions.Data,
+	})
+}
+
+// GetByVersion godoc
+// @Summary      Get Content Revision
+// @Description  Retrieve a revision with the full snapshot of the content at that version
+// @Tags         Content Revisions
+// @Produce      json
+// @Security     BearerAuth
+// @Param        contentType path string true "Content type" Enums(course, lesson, exercise_question, vocabulary)
+// @Param        id path int true "Content ID"
+// @Param        version path int true "Revision version"
+// @Success      200 {object} dto.ContentRevisionSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Revision not found"
+// @Failure      500 {object} response.Response
+// @Router       /revisions/{contentType}/{id}/{version} [get]
+func (c *ContentRevisionController) GetByVersion(ctx *gin.Context) {
+	contentType, id, ok := c.parseContent(ctx)
+	if !ok {
+		return
+	}
+	version, ok := c.parseVersion(ctx)
+	if !ok {
+		return
+	}
+
+	contentRevision, err := c.service.GetContentRevision().GetByVersion(ctx.Request.Context(), contentType, id, version)
+	c.respond(ctx, contentRevision, err)
+}
+
+// Diff godoc
+// @Summary      Compare Content Revisions
+// @Description  Compare the snapshots of two revisions field by field
+// @Tags         Content Revisions
+// @Produce      json
+// @Security     BearerAuth
+// @Param        contentType path string true "Content type" Enums(course, lesson, exercise_question, vocabulary)
+// @Param        id path int true "Content ID"
+// @Param        from query int true "Version to compare from" example(1)
+// @Param        to query int true "Version to compare to" example(3)
+// @Success      200 {object} dto.ContentRevisionDiffSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Revision not found"
+// @Failure      422 {object} response.Response
+// @Failure      500 {object} response.Response
+// @Router       /revisions/{contentType}/{id}/diff [get]
+func (c *ContentRevisionController) Diff(ctx *gin.Context) {
+	contentType, id, ok := c.parseContent(ctx)
+	if !ok {
+		return
+	}
+
+	request := &dto.ContentRevisionDiffRequest{}
+	if !c.bindQuery(ctx, request) {
+		return
+	}
+
+	diff, err := c.service.GetContentRevision().Diff(ctx.Request.Context(), contentType, id, request)
+	c.respond(ctx, diff, err)
+}
+
+// Restore godoc
+// @Summary      Restore Content Revision
+// @Description  Update the content with the snapshot of a revision. The snapshot is validated like a regular update and the restore is recorded as a new revision.
+// @Tags         Content Revisions
+// @Produce      json
+// @Security     BearerAuth
+// @Param        contentType path string true "Content type" Enums(course, lesson, exercise_question, vocabulary)
+// @Param        id path int true "Content ID"
+// @Param        version path int true "Revision version to restore"
+// @Success      200 {object} response.Response "The restored content"
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Content or revision not found"
+// @Failure      409 {object} response.Response "Content changed at the same time"
+// @Failure      422 {object} response.Response "Snapshot no longer passes validation"
+// @Failure      500 {object} response.Response
+// @Router       /revisions/{contentType}/{id}/{version}/restore [post]
+func (c *ContentRevisionController) Restore(ctx *gin.Context) {
+	contentType, id, ok := c.parseContent(ctx)
+	if !ok {
+		return
+	}
+	version, ok := c.parseVersion(ctx)
+	if !ok {
+		return
+	}
+
+	// Each content type is restored by its own service so its validation rules apply
+	restorers := map[string]func(context.Context, uint, int) (interface{}, error){
+		models.ContentTypeCourse: func(ctx context.Context, id uint, version int) (interface{}, error) {
+			return c.service.GetCourse().RestoreRevision(ctx, id, version)
+		},
+		models.ContentTypeLesson: func(ctx context.Context, id uint, version int) (interface{}, error) {
+			return c.service.GetLesson().RestoreRevision(ctx, id, version)
+		},
+		models.ContentTypeExerciseQuestion: func(ctx context.Context, id uint, version int) (interface{}, error) {
+			return c.service.GetExerciseQuestion().RestoreRevision(ctx, id, version)
+		},
+		models.ContentTypeVocabulary: func(ctx context.Context, id uint, version int) (interface{}, error) {
+			return c.service.GetVocabulary().RestoreRevision(ctx, id, version)
+		},
+	}
+	restore, ok := restorers[contentType]
+	if !ok {
+		c.respond(ctx, nil, errConstant.ErrInvalidRevisionContentType)
+		return
+	}
+
+	content, err := restore(ctx.Request.Context(), id, version)
+	c.respond(ctx, content, err)
+}
@@ -0,0 +1,439 @@
+package controllers
+
+import (
+	errWrap "manabu-service/common/error"
+	"manabu-service/common/response"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type ContentWorkflowController struct {
+	service services.IServiceRegistry
+}
+
+// IContentWorkflowController defines the contract for content review and publishing workflow HTTP handlers.
+type IContentWorkflowController interface {
+	// GetByContent handles GET requests to retrieve the workflow of a content item.
+	GetByContent(*gin.Context)
+	// Transition handles POST requests to apply a workflow action to a content item.
+	Transition(*gin.Context)
+	// AssignReviewer handles PUT requests to assign the reviewer of a content item.
+	AssignReviewer(*gin.Context)
+	// AddComment handles POST requests to comment on the workflow of a content item.
+	AddComment(*gin.Context)
+	// GetReviewQueue handles GET requests to retrieve content waiting for review.
+	GetReviewQueue(*gin.Context)
+	// GetPublishSchedule handles GET requests to retrieve the publish schedule of a course or lesson.
+	GetPublishSchedule(*gin.Context)
+	// SchedulePublish handles PUT requests to schedule publishing of a course or lesson.
+	SchedulePublish(*gin.Context)
+	// CancelPublishSchedule handles DELETE requests to cancel the publish schedule of a course or lesson.
+	CancelPublishSchedule(*gin.Context)
+}
+
+func NewContentWorkflowController(service services.IServiceRegistry) IContentWorkflowController {
+	return &ContentWorkflowController{service: service}
+}
+
+// getStatusCode maps errors to appropriate HTTP status codes
+func (c *ContentWorkflowController) getStatusCode(err error) int {
+	switch err {
+	case errConstant.ErrContentNotFound, errConstant.ErrPublishScheduleNotFound:
+		return http.StatusNotFound
+	case errConstant.ErrInvalidWorkflowTransition, errConstant.ErrWorkflowConflict:
+		return http.StatusConflict
+	case errConstant.ErrInvalidWorkflowAction, errConstant.ErrWorkflowReasonRequired, errConstant.ErrContentNotReadyToPublish,
+		errConstant.ErrInvalidWorkflowReviewer, errConstant.ErrPublishScheduleEmpty, errConstant.ErrPublishScheduleInPast,
+		errConstant.ErrInvalidPublishSchedule:
+		return http.StatusUnprocessableEntity
+	case errConstant.ErrWorkflowReviewerOnly, errConstant.ErrWorkflowAuthorOnly, errConstant.ErrWorkflowNotAssignedToUser,
+		errConstant.ErrWorkflowSelfApproval:
+		return http.StatusForbidden
+	case errConstant.ErrInvalidID, errConstant.ErrInvalidContentType, errConstant.ErrPublishScheduleNotSupported:
+		return http.StatusBadRequest
+	case errConstant.ErrUnauthorized:
+		return http.StatusUnauthorized
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// parseContent reads the content type and ID path parameters
+func (c *ContentWorkflowController) parseContent(ctx *gin.Context) (string, uint, bool) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return "", 0, false
+	}
+
+	return ctx.Param("contentType"), uint(id), true
+}
+
+// bindRequest binds and validates a JSON request body
+func (c *ContentWorkflowController) bindRequest(ctx *gin.Context, request interface{}) bool {
+	err := ctx.ShouldBindJSON(request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return false
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return false
+	}
+
+	return true
+}
+
+// respond writes the workflow or the error of a workflow operation
+func (c *ContentWorkflowController) respond(ctx *gin.Context, contentWorkflow *dto.ContentWorkflowResponse, err error) {
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: contentWorkflow,
+		Gin:  ctx,
+	})
+}
+
+// GetByContent godoc
+// @Summary      Get Content Workflow
+// @Description  Retrieve the review status, transition history and comments of a course, lesson, exercise or exercise question, with the actions available to the current user
+// @Tags         Content Workflow
+// @Produce      json
+// @Security     BearerAuth
+// @Param        contentType path string true "Content type" Enums(course, lesson, exercise, exercise_question)
+// @Param        id path int true "Content ID"
+// @Success      200 {object} dto.ContentWorkflowSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Content not found"
+// @Failure      500 {object} response.Response
+// @Router       /workflows/{contentType}/{id} [get]
+func (c *ContentWorkflowController) GetByContent(ctx *gin.Context) {
+	contentType, id, ok := c.parseContent(ctx)
+	if !ok {
+		return
+	}
+
+	contentWorkflow, err := c.service.GetContentWorkflow().GetByContent(ctx.Request.Context(), contentType, id)
+	c.respond(ctx, contentWorkflow, err)
+}
+
+// Transition godoc
+// @Summary      Apply Workflow Action
+// @Description  Move content through draft → in_review → approved → published → archived. Authors (teachers and admins) submit and reopen; teachers and admins approve, reject (with a reason), publish and archive. Only published content is visible to learners. Publishing checks the course hierarchy: lessons, exercises and questions need a published parent, and courses and exercises need at least one approved or published lesson or question. With cascade, publishing also publishes every approved descendant.
+// @Tags         Content Workflow
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        contentType path string true "Content type" Enums(course, lesson, exercise, exercise_question)
+// @Param        id path int true "Content ID"
+// @Param        request body dto.WorkflowTransitionRequest true "Workflow action"
+// @Success      200 {object} dto.ContentWorkflowSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      403 {object} response.Response "Author only, reviewer only, assigned to another reviewer or self-approval"
+// @Failure      404 {object} response.Response "Content not found"
+// @Failure      409 {object} response.Response "Action not allowed in the current status"
+// @Failure      422 {object} response.Response "Reason required or content not ready to publish"
+// @Failure      500 {object} response.Response
+// @Router       /workflows/{contentType}/{id}/transitions [post]
+func (c *ContentWorkflowController) Transition(ctx *gin.Context) {
+	contentType, id, ok := c.parseContent(ctx)
+	if !ok {
+		return
+	}
+
+	request := &dto.WorkflowTransitionRequest{}
+	if !c.bindRequest(ctx, request) {
+		return
+	}
+
+	contentWorkflow, err := c.service.GetContentWorkflow().Transition(ctx.Request.Context(), contentType, id, request)
+	c.respond(ctx, contentWorkflow, err)
+}
+
+// AssignReviewer godoc
+// @Summary      Assign Workflow Reviewer
+// @Description  Assign the teacher or admin who reviews a content item. Once assigned, only that reviewer or an admin can approve or reject it.
+// @Tags         Content Workflow
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        contentType path string true "Content type" Enums(course, lesson, exercise, exercise_question)
+// @Param        id path int true "Content ID"
+// @Param        request body dto.AssignWorkflowReviewerRequest true "Reviewer"
+// @Success      200 {object} dto.ContentWorkflowSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      403 {object} response.Response "Reviewer only"
+// @Failure      404 {object} response.Response "Content not found"
+// @Failure      422 {object} response.Response "Assignee is not a teacher or admin"
+// @Failure      500 {object} response.Response
+// @Router       /workflows/{contentType}/{id}/reviewer [put]
+func (c *ContentWorkflowController) AssignReviewer(ctx *gin.Context) {
+	contentType, id, ok := c.parseContent(ctx)
+	if !ok {
+		return
+	}
+
+	request := &dto.AssignWorkflowReviewerRequest{}
+	if !c.bindRequest(ctx, request) {
+		return
+	}
+
+	contentWorkflow, err := c.service.GetContentWorkflow().AssignReviewer(ctx.Request.Context(), contentType, id, request)
+	c.respond(ctx, contentWorkflow, err)
+}
+
+// AddComment godoc
+// @Summary      Comment on Workflow
+// @Description  Add a review discussion comment to a content item (teachers and admins only)
+// @Tags         Content Workflow
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        contentType path string true "Content type" Enums(course, lesson, exercise, exercise_question)
+// @Param        id path int true "Content ID"
+// @Param        request body dto.WorkflowCommentRequest true "Comment"
+// @Success      200 {object} dto.ContentWorkflowSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      403 {object} response.Response "Author only"
+// @Failure      404 {object} response.Response "Content not found"
+// @Failure      422 {object} response.Response
+// @Failure      500 {object} response.Response
+// @Router       /workflows/{contentType}/{id}/comments [post]
+func (c *ContentWorkflowController) AddComment(ctx *gin.Context) {
+	contentType, id, ok := c.parseContent(ctx)
+	if !ok {
+		return
+	}
+
+	request := &dto.WorkflowCommentRequest{}
+	if !c.bindRequest(ctx, request) {
+		return
+	}
+
+	contentWorkflow, err := c.service.GetContentWorkflow().AddComment(ctx.Request.Context(), contentType, id, request)
+	c.respond(ctx, contentWorkflow, err)
+}
+
+// GetReviewQueue godoc
+// @Summary      Get Content Review Queue
+// @Description  Retrieve content waiting for review, longest waiting first. Only teachers and admins can access the queue.
+// @Tags         Content Workflow
+// @Produce      json
+// @Security     BearerAuth
+// @Param        page query int false "Page number" default(1) minimum(1)
+// @Param        limit query int false "Items per page" default(10) minimum(1) maximum(100)
+// @Param        contentType query string false "Filter by content type (course, lesson, exercise, exercise_question)" example("course")
+// @Param        status query string false "Filter by status, defaults to in_review" example("in_review")
+// @Param        assignedToMe query bool false "Only content assigned to the current reviewer" example(true)
+// @Success      200 {object} dto.ContentWorkflowListSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      403 {object} response.Response "Reviewer only"
+// @Failure      422 {object} response.Response
+// @Failure      500 {object} response.Response
+// @Router       /workflows/review-queue [get]
+func (c *ContentWorkflowController) GetReviewQueue(ctx *gin.Context) {
+	filter := &dto.ContentWorkflowFilterRequest{}
+
+	if err := ctx.ShouldBindQuery(filter); err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err := validate.Struct(filter)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	workflows, err := c.service.GetContentWorkflow().GetReviewQueue(ctx.Request.Context(), filter)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	// Return response with data array and pagination at same level
+	ctx.JSON(http.StatusOK, gin.H{
+		"message":    http.StatusText(http.StatusOK),
+		"pagination": workflows.Pagination,
+		"status":     "success",
+		"data":       workflows.Data,
+	})
+}
+
+// GetPublishSchedule godoc
+// @Summary      Get Publish Schedule
+// @Description  Retrieve the scheduled publish and unpublish times of a course or lesson with the outcome of each (teachers and admins only)
+// @Tags         Content Workflow
+// @Produce      json
+// @Security     BearerAuth
+// @Param        contentType path string true "Content type" Enums(course, lesson)
+// @Param        id path int true "Content ID"
+// @Success      200 {object} dto.PublishScheduleSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      403 {object} response.Response "Reviewer only"
+// @Failure      404 {object} response.Response "Content or schedule not found"
+// @Failure      500 {object} response.Response
+// @Router       /workflows/{contentType}/{id}/publish-schedule [get]
+func (c *ContentWorkflowController) GetPublishSchedule(ctx *gin.Context) {
+	contentType, id, ok := c.parseContent(ctx)
+	if !ok {
+		return
+	}
+
+	schedule, err := c.service.GetPublishSchedule().GetByContent(ctx.Request.Context(), contentType, id)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: schedule,
+		Gin:  ctx,
+	})
+}
+
+// SchedulePublish godoc
+// @Summary      Schedule Publishing
+// @Description  Schedule a course or lesson to be published and/or unpublished at a later time, replacing any previous schedule (teachers and admins only). At publishAt the content is published if it is approved and ready by then, together with its approved descendants when cascade is set; at unpublishAt published content is archived. Failures are recorded on the schedule and notified to the user who scheduled it.
+// @Tags         Content Workflow
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        contentType path string true "Content type" Enums(course, lesson)
+// @Param        id path int true "Content ID"
+// @Param        request body dto.PublishScheduleRequest true "Publish and unpublish times (RFC 3339)"
+// @Success      200 {object} dto.PublishScheduleSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      403 {object} response.Response "Reviewer only"
+// @Failure      404 {object} response.Response "Content not found"
+// @Failure      422 {object} response.Response "Missing, past or out-of-order times"
+// @Failure      500 {object} response.Response
+// @Router       /workflows/{contentType}/{id}/publish-schedule [put]
+func (c *ContentWorkflowController) SchedulePublish(ctx *gin.Context) {
+	contentType, id, ok := c.parseContent(ctx)
+	if !ok {
+		return
+	}
+
+	request := &dto.PublishScheduleRequest{}
+	if !c.bindRequest(ctx, request) {
+		return
+	}
+
+	schedule, err := c.service.GetPublishSchedule().Schedule(ctx.Request.Context(), contentType, id, request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: schedule,
+		Gin:  ctx,
+	})
+}
+
+// CancelPublishSchedule godoc
+// @Summary      Cancel Publish Schedule
+// @Description  Remove the publish schedule of a course or lesson (teachers and admins only)
+// @Tags         Content Workflow
+// @Produce      json
+// @Security     BearerAuth
+// @Param        contentType path string true "Content type" Enums(course, lesson)
+// @Param        id path int true "Content ID"
+// @Success      200 {object} response.Response
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      403 {object} response.Response "Reviewer only"
+// @Failure      404 {object} response.Response "Content or schedule not found"
+// @Failure      500 {object} response.Response
+// @Router       /workflows/{contentType}/{id}/publish-schedule [delete]
+func (c *ContentWorkflowController) CancelPublishSchedule(ctx *gin.Context) {
+	contentType, id, ok := c.parseContent(ctx)
+	if !ok {
+		return
+	}
+
+	err := c.service.GetPublishSchedule().Cancel(ctx.Request.Context(), contentType, id)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	successMessage := "Publish schedule cancelled successfully"
+	response.HttpResponse(response.ParamHTTPResp{
+		Code:    http.StatusOK,
+		Message: &successMessage,
+		Gin:     ctx,
+	})
+}
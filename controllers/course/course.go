@@ -2,6 +2,7 @@ package controllers
 
 import (
 	errWrap "manabu-service/common/error"
+	"manabu-service/common/locale"
 	"manabu-service/common/response"
 	errConstant "manabu-service/constants/error"
 	"manabu-service/domain/dto"
@@ -23,9 +24,9 @@ type ICourseController interface {
 	GetByID(*gin.Context)
 	Update(*gin.Context)
 	Delete(*gin.Context)
-	Publish(*gin.Context)
-	Unpublish(*gin.Context)
 	GetPublished(*gin.Context)
+	GetPublishReadiness(*gin.Context)
+	Clone(*gin.Context)
 }
 
 func NewCourseController(service services.IServiceRegistry) ICourseController {
@@ -35,16 +36,19 @@ func NewCourseController(service services.IServiceRegistry) ICourseController {
 // getStatusCode maps errors to appropriate HTTP status codes
 func (c *CourseController) getStatusCode(err error) int {
 	switch err {
-	case errConstant.ErrCourseNotFound:
+	case errConstant.ErrCourseNotFound, errConstant.ErrContentNotFound:
 		return http.StatusNotFound
 	case errConstant.ErrCourseDuplicate:
 		return http.StatusConflict
-	case errConstant.ErrInvalidJlptLevelIDCourse, errConstant.ErrInvalidCourseDifficulty, errConstant.ErrInvalidCourseEstimatedHours:
+	case errConstant.ErrInvalidJlptLevelIDCourse, errConstant.ErrInvalidCourseDifficulty, errConstant.ErrInvalidCourseEstimatedHours,
+		errConstant.ErrMediaNotFound, errConstant.ErrMediaKindMismatch:
 		return http.StatusUnprocessableEntity
-	case errConstant.ErrCourseAlreadyPublished, errConstant.ErrCourseNotPublished:
-		return http.StatusBadRequest
+	case errConstant.ErrCourseCloneAuthorOnly:
+		return http.StatusForbidden
 	case errConstant.ErrInvalidID:
 		return http.StatusBadRequest
+	case errConstant.ErrUnauthorized:
+		return http.StatusUnauthorized
 	default:
 		return http.StatusInternalServerError
 	}
@@ -93,7 +97,7 @@ func (c *CourseController) Create(ctx *gin.Context) {
 		return
 	}
 
-	course, err := c.service.GetCourse().Create(ctx, request)
+	course, err := c.service.GetCourse().Create(ctx.Request.Context(), request)
 	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: c.getStatusCode(err),
@@ -112,7 +116,7 @@ func (c *CourseController) Create(ctx *gin.Context) {
 
 // GetAll godoc
 // @Summary      Get all Courses
-// @Description  Retrieve courses with advanced filtering, search, sorting, and pagination
+// @Description  Retrieve courses with advanced filtering, search, sorting, and pagination. With a bearer token, each course includes whether the learner bookmarked it.
 // @Tags         Courses
 // @Produce      json
 // @Param        page query int false "Page number" default(1) minimum(1)
@@ -123,6 +127,7 @@ func (c *CourseController) Create(ctx *gin.Context) {
 // @Param        search query string false "Search in title or description" example("japanese")
 // @Param        sortBy query string false "Sort by field (title, difficulty, created_at)" default(created_at) example("title")
 // @Param        sortOrder query string false "Sort order (asc, desc)" default(desc) example("asc")
+// @Param        lang query string false "Response language (en, id); falls back to the Accept-Language header" example("id")
 // @Success      200 {object} dto.CourseListSwaggerResponse
 // @Failure      400 {object} response.Response
 // @Failure      422 {object} response.Response
@@ -165,6 +170,17 @@ func (c *CourseController) GetAll(ctx *gin.Context) {
 		return
 	}
 
+	// Localize translatable fields for the negotiated locale
+	err = c.service.GetTranslation().LocalizeCourses(ctx, locale.Negotiate(ctx), courses.Data)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
 	// Return response with data array and pagination at same level
 	ctx.JSON(http.StatusOK, gin.H{
 		"message":    http.StatusText(http.StatusOK),
@@ -176,10 +192,11 @@ func (c *CourseController) GetAll(ctx *gin.Context) {
 
 // GetByID godoc
 // @Summary      Get Course by ID
-// @Description  Retrieve a specific course entry by ID
+// @Description  Retrieve a specific course entry by ID. With a bearer token, it includes whether the learner bookmarked it.
 // @Tags         Courses
 // @Produce      json
 // @Param        id path int true "Course ID"
+// @Param        lang query string false "Response language (en, id); falls back to the Accept-Language header" example("id")
 // @Success      200 {object} dto.CourseSwaggerResponse
 // @Failure      400 {object} response.Response
 // @Failure      404 {object} response.Response "Course not found"
@@ -216,6 +233,19 @@ func (c *CourseController) GetByID(ctx *gin.Context) {
 		return
 	}
 
+	// Localize translatable fields for the negotiated locale
+	localized := []dto.CourseResponse{*course}
+	err = c.service.GetTranslation().LocalizeCourses(ctx, locale.Negotiate(ctx), localized)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+	course = &localized[0]
+
 	response.HttpResponse(response.ParamHTTPResp{
 		Code: http.StatusOK,
 		Data: course,
@@ -287,7 +317,7 @@ func (c *CourseController) Update(ctx *gin.Context) {
 		return
 	}
 
-	course, err := c.service.GetCourse().Update(ctx, request, uint(id))
+	course, err := c.service.GetCourse().Update(ctx.Request.Context(), request, uint(id))
 	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: c.getStatusCode(err),
@@ -306,7 +336,7 @@ func (c *CourseController) Update(ctx *gin.Context) {
 
 // Delete godoc
 // @Summary      Delete Course
-// @Description  Delete a course entry by ID (admin only)
+// @Description  Move a course and its lessons, exercises and questions to the trash by ID (admin only)
 // @Tags         Courses
 // @Produce      json
 // @Security     BearerAuth
@@ -356,41 +386,63 @@ func (c *CourseController) Delete(ctx *gin.Context) {
 	})
 }
 
-// Publish godoc
-// @Summary      Publish Course
-// @Description  Publish a course by ID (admin only) - sets is_published=true and published_at=now
+// GetPublished godoc
+// @Summary      Get Published Courses
+// @Description  Retrieve only published courses with filtering, search, sorting, and pagination. With a bearer token, each course includes whether the learner bookmarked it.
 // @Tags         Courses
 // @Produce      json
-// @Security     BearerAuth
-// @Param        id path int true "Course ID"
-// @Success      200 {object} dto.CourseSwaggerResponse
-// @Failure      400 {object} response.Response "Course is already published"
-// @Failure      401 {object} response.Response
-// @Failure      404 {object} response.Response "Course not found"
+// @Param        page query int false "Page number" default(1) minimum(1)
+// @Param        limit query int false "Items per page" default(10) minimum(1) maximum(100)
+// @Param        jlptLevelId query int false "Filter by JLPT Level ID" example(5)
+// @Param        difficulty query int false "Filter by difficulty (1-5)" minimum(1) maximum(5)
+// @Param        search query string false "Search in title or description" example("japanese")
+// @Param        sortBy query string false "Sort by field (title, difficulty, created_at)" default(created_at) example("title")
+// @Param        sortOrder query string false "Sort order (asc, desc)" default(desc) example("asc")
+// @Param        lang query string false "Response language (en, id); falls back to the Accept-Language header" example("id")
+// @Success      200 {object} dto.CourseListSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      422 {object} response.Response
 // @Failure      500 {object} response.Response
-// @Router       /courses/{id}/publish [post]
-func (c *CourseController) Publish(ctx *gin.Context) {
-	idParam := ctx.Param("id")
-	if idParam == "" {
+// @Router       /courses/published [get]
+func (c *CourseController) GetPublished(ctx *gin.Context) {
+	filter := &dto.CourseFilterRequest{}
+
+	if err := ctx.ShouldBindQuery(filter); err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: http.StatusBadRequest,
-			Err:  errConstant.ErrInvalidID,
+			Err:  err,
 			Gin:  ctx,
 		})
 		return
 	}
 
-	id, err := strconv.ParseUint(idParam, 10, 32)
+	validate := validator.New()
+	err := validate.Struct(filter)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	courses, err := c.service.GetCourse().GetPublished(ctx, filter)
 	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
-			Code: http.StatusBadRequest,
-			Err:  errConstant.ErrInvalidID,
+			Code: c.getStatusCode(err),
+			Err:  err,
 			Gin:  ctx,
 		})
 		return
 	}
 
-	course, err := c.service.GetCourse().Publish(ctx, uint(id))
+	// Localize translatable fields for the negotiated locale
+	err = c.service.GetTranslation().LocalizeCourses(ctx, locale.Negotiate(ctx), courses.Data)
 	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: c.getStatusCode(err),
@@ -400,29 +452,32 @@ func (c *CourseController) Publish(ctx *gin.Context) {
 		return
 	}
 
-	response.HttpResponse(response.ParamHTTPResp{
-		Code: http.StatusOK,
-		Data: course,
-		Gin:  ctx,
+	// Return response with data array and pagination at same level
+	ctx.JSON(http.StatusOK, gin.H{
+		"message":    http.StatusText(http.StatusOK),
+		"pagination": courses.Pagination,
+		"status":     "success",
+		"data":       courses.Data,
 	})
 }
 
-// Unpublish godoc
-// @Summary      Unpublish Course
-// @Description  Unpublish a course by ID (admin only) - sets is_published=false
+// GetPublishReadiness godoc
+// @Summary      Get Course Publish Readiness
+// @Description  Report whether a course can be published: the content publishing would publish and the issues blocking it. A course needs approval and at least one approved or published lesson. With cascade, approved lessons, exercises and questions are checked too; exercises need at least one approved or published question.
 // @Tags         Courses
 // @Produce      json
 // @Security     BearerAuth
 // @Param        id path int true "Course ID"
-// @Success      200 {object} dto.CourseSwaggerResponse
-// @Failure      400 {object} response.Response "Course is not published"
+// @Param        cascade query bool false "Include approved lessons, exercises and questions" example(true)
+// @Success      200 {object} dto.PublishReadinessSwaggerResponse
+// @Failure      400 {object} response.Response
 // @Failure      401 {object} response.Response
 // @Failure      404 {object} response.Response "Course not found"
 // @Failure      500 {object} response.Response
-// @Router       /courses/{id}/unpublish [post]
-func (c *CourseController) Unpublish(ctx *gin.Context) {
-	idParam := ctx.Param("id")
-	if idParam == "" {
+// @Router       /courses/{id}/publish-readiness [get]
+func (c *CourseController) GetPublishReadiness(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: http.StatusBadRequest,
 			Err:  errConstant.ErrInvalidID,
@@ -431,17 +486,17 @@ func (c *CourseController) Unpublish(ctx *gin.Context) {
 		return
 	}
 
-	id, err := strconv.ParseUint(idParam, 10, 32)
-	if err != nil {
+	request := &dto.PublishReadinessRequest{}
+	if err := ctx.ShouldBindQuery(request); err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: http.StatusBadRequest,
-			Err:  errConstant.ErrInvalidID,
+			Err:  err,
 			Gin:  ctx,
 		})
 		return
 	}
 
-	course, err := c.service.GetCourse().Unpublish(ctx, uint(id))
+	readiness, err := c.service.GetCourse().GetPublishReadiness(ctx, uint(id), request.Cascade)
 	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: c.getStatusCode(err),
@@ -453,32 +508,43 @@ func (c *CourseController) Unpublish(ctx *gin.Context) {
 
 	response.HttpResponse(response.ParamHTTPResp{
 		Code: http.StatusOK,
-		Data: course,
+		Data: readiness,
 		Gin:  ctx,
 	})
 }
 
-// GetPublished godoc
-// @Summary      Get Published Courses
-// @Description  Retrieve only published courses with filtering, search, sorting, and pagination
+// Clone godoc
+// @Summary      Clone Course
+// @Description  Copy a course with all its lessons, exercises, questions and lesson vocabulary under a new title and JLPT level. The copy is an unpublished draft; media references are copied unless includeMedia is false (teachers and admins only).
 // @Tags         Courses
+// @Accept       json
 // @Produce      json
-// @Param        page query int false "Page number" default(1) minimum(1)
-// @Param        limit query int false "Items per page" default(10) minimum(1) maximum(100)
-// @Param        jlptLevelId query int false "Filter by JLPT Level ID" example(5)
-// @Param        difficulty query int false "Filter by difficulty (1-5)" minimum(1) maximum(5)
-// @Param        search query string false "Search in title or description" example("japanese")
-// @Param        sortBy query string false "Sort by field (title, difficulty, created_at)" default(created_at) example("title")
-// @Param        sortOrder query string false "Sort order (asc, desc)" default(desc) example("asc")
-// @Success      200 {object} dto.CourseListSwaggerResponse
+// @Security     BearerAuth
+// @Param        id path int true "Course ID to copy"
+// @Param        request body dto.CloneCourseRequest true "Title and JLPT level of the copy"
+// @Success      201 {object} dto.CourseSwaggerResponse
 // @Failure      400 {object} response.Response
-// @Failure      422 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      403 {object} response.Response "Only teachers and admins can clone courses"
+// @Failure      404 {object} response.Response "Course not found"
+// @Failure      409 {object} response.Response "Course with this title already exists for the JLPT level"
+// @Failure      422 {object} response.Response "Invalid JLPT level ID"
 // @Failure      500 {object} response.Response
-// @Router       /courses/published [get]
-func (c *CourseController) GetPublished(ctx *gin.Context) {
-	filter := &dto.CourseFilterRequest{}
+// @Router       /courses/{id}/clone [post]
+func (c *CourseController) Clone(ctx *gin.Context) {
+	request := &dto.CloneCourseRequest{}
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
 
-	if err := ctx.ShouldBindQuery(filter); err != nil {
+	err = ctx.ShouldBindJSON(request)
+	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: http.StatusBadRequest,
 			Err:  err,
@@ -488,7 +554,7 @@ func (c *CourseController) GetPublished(ctx *gin.Context) {
 	}
 
 	validate := validator.New()
-	err := validate.Struct(filter)
+	err = validate.Struct(request)
 	if err != nil {
 		errMessage := http.StatusText(http.StatusUnprocessableEntity)
 		errResponse := errWrap.ErrValidationResponse(err)
@@ -502,7 +568,7 @@ func (c *CourseController) GetPublished(ctx *gin.Context) {
 		return
 	}
 
-	courses, err := c.service.GetCourse().GetPublished(ctx, filter)
+	course, err := c.service.GetCourse().Clone(ctx.Request.Context(), uint(id), request)
 	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: c.getStatusCode(err),
@@ -512,11 +578,9 @@ func (c *CourseController) GetPublished(ctx *gin.Context) {
 		return
 	}
 
-	// Return response with data array and pagination at same level
-	ctx.JSON(http.StatusOK, gin.H{
-		"message":    http.StatusText(http.StatusOK),
-		"pagination": courses.Pagination,
-		"status":     "success",
-		"data":       courses.Data,
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusCreated,
+		Data: course,
+		Gin:  ctx,
 	})
 }
@@ -0,0 +1,185 @@
+package controllers
+
+import (
+	"fmt"
+	"io"
+	"manabu-service/common/response"
+	"manabu-service/constants"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CourseBundleController struct {
+	service services.IServiceRegistry
+}
+
+// ICourseBundleController defines the contract for course bundle export and import HTTP handlers.
+type ICourseBundleController interface {
+	// Export handles GET requests to download the bundle of a course.
+	Export(*gin.Context)
+	// Import handles POST requests to create a course from an uploaded bundle.
+	Import(*gin.Context)
+}
+
+func NewCourseBundleController(service services.IServiceRegistry) ICourseBundleController {
+	return &CourseBundleController{service: service}
+}
+
+// getStatusCode maps errors to appropriate HTTP status codes
+func (c *CourseBundleController) getStatusCode(err error) int {
+	switch err {
+	case errConstant.ErrCourseNotFound, errConstant.ErrMediaNotFound, errConstant.ErrStorageObjectNotFound:
+		return http.StatusNotFound
+	case errConstant.ErrCourseBundleConflict, errConstant.ErrCourseDuplicate, errConstant.ErrVocabularyDuplicate:
+		return http.StatusConflict
+	case errConstant.ErrCourseBundleTooLarge, errConstant.ErrMediaTooLarge:
+		return http.StatusRequestEntityTooLarge
+	case errConstant.ErrInvalidCourseBundle, errConstant.ErrUnsupportedCourseBundleVersion, errConstant.ErrInvalidImage:
+		return http.StatusUnprocessableEntity
+	case errConstant.ErrUnsupportedMediaType:
+		return http.StatusUnsupportedMediaType
+	case errConstant.ErrInvalidID, errConstant.ErrCourseBundleFileRequired:
+		return http.StatusBadRequest
+	case errConstant.ErrUnauthorized:
+		return http.StatusUnauthorized
+	case errConstant.ErrCourseBundleAuthorOnly:
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// Export godoc
+// @Summary      Export Course Bundle
+// @Description  Download a course with its lessons, exercises, questions, lesson vocabulary and media as a zip bundle. The bundle holds a versioned course.json manifest, which references JLPT levels by code, and the media files under media/. Teachers and admins only.
+// @Tags         Courses
+// @Produce      application/zip
+// @Security     BearerAuth
+// @Param        id path int true "Course ID"
+// @Success      200 {file} file "Course bundle"
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      403 {object} response.Response "Only teachers and admins can export bundles"
+// @Failure      404 {object} response.Response "Course or media file not found"
+// @Failure      500 {object} response.Response
+// @Router       /courses/{id}/bundle [get]
+func (c *CourseBundleController) Export(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	content, fileName, err := c.service.GetCourseBundle().Export(ctx.Request.Context(), uint(id))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+	ctx.Data(http.StatusOK, "application/zip", content)
+}
+
+// Import godoc
+// @Summary      Import Course Bundle
+// @Description  Create an unpublished course from a zip bundle with a course.json (or course.yaml) manifest and its media files. JLPT levels are resolved by code and vocabulary categories by name; vocabulary that already exists is reused, and lesson vocabulary may reference bundled or existing words. Nothing is imported when the bundle has conflicts, which are reported in the response. Use dryRun to only validate the bundle. Teachers and admins only.
+// @Tags         Courses
+// @Accept       multipart/form-data
+// @Produce      json
+// @Security     BearerAuth
+// @Param        file formData file true "Course bundle (zip, max 200 MB)"
+// @Param        dryRun query bool false "Validate the bundle without importing it" default(false)
+// @Success      200 {object} dto.CourseBundleImportSwaggerResponse "Dry run report"
+// @Success      201 {object} dto.CourseBundleImportSwaggerResponse "Imported course"
+// @Failure      400 {object} response.Response "File missing"
+// @Failure      401 {object} response.Response
+// @Failure      403 {object} response.Response "Only teachers and admins can import bundles"
+// @Failure      409 {object} dto.CourseBundleImportSwaggerResponse "Bundle has conflicts"
+// @Failure      413 {object} response.Response "Bundle too large"
+// @Failure      422 {object} response.Response "Invalid bundle or unsupported format version"
+// @Failure      500 {object} response.Response
+// @Router       /courses/bundles [post]
+func (c *CourseBundleController) Import(ctx *gin.Context) {
+	request := &dto.CourseBundleImportRequest{}
+	if err := ctx.ShouldBindQuery(request); err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	file, err := ctx.FormFile("file")
+	if err != nil || file.Size == 0 {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrCourseBundleFileRequired,
+			Gin:  ctx,
+		})
+		return
+	}
+	if file.Size > constants.MaxCourseBundleSize {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusRequestEntityTooLarge,
+			Err:  errConstant.ErrCourseBundleTooLarge,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	body, err := file.Open()
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrCourseBundleFileRequired,
+			Gin:  ctx,
+		})
+		return
+	}
+	defer body.Close()
+	content, err := io.ReadAll(io.LimitReader(body, constants.MaxCourseBundleSize))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusInternalServerError,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	report, err := c.service.GetCourseBundle().Import(ctx.Request.Context(), content, request.DryRun)
+	if err != nil {
+		// The conflict report tells the caller what to fix
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Data: report,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	code := http.StatusCreated
+	if request.DryRun {
+		code = http.StatusOK
+	}
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: code,
+		Data: report,
+		Gin:  ctx,
+	})
+}
@@ -0,0 +1,156 @@
+package controllers
+
+import (
+	errWrap "manabu-service/common/error"
+	"manabu-service/common/response"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type CoursePrerequisiteController struct {
+	service services.IServiceRegistry
+}
+
+// ICoursePrerequisiteController defines the contract for course prerequisite HTTP handlers.
+type ICoursePrerequisiteController interface {
+	// GetByCourseID handles GET requests to list the prerequisites of a course.
+	GetByCourseID(*gin.Context)
+	// Set handles PUT requests to replace the prerequisites of a course.
+	Set(*gin.Context)
+}
+
+func NewCoursePrerequisiteController(service services.IServiceRegistry) ICoursePrerequisiteController {
+	return &CoursePrerequisiteController{service: service}
+}
+
+// getStatusCode maps errors to appropriate HTTP status codes
+func (c *CoursePrerequisiteController) getStatusCode(err error) int {
+	switch err {
+	case errConstant.ErrCourseNotFound:
+		return http.StatusNotFound
+	case errConstant.ErrPrerequisiteCycle:
+		return http.StatusConflict
+	case errConstant.ErrPrerequisiteCourseNotFound, errConstant.ErrSelfPrerequisite:
+		return http.StatusUnprocessableEntity
+	case errConstant.ErrInvalidID:
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// GetByCourseID godoc
+// @Summary      Get Course Prerequisites
+// @Description  Retrieve the courses a learner must complete before the lessons of a course unlock
+// @Tags         Courses
+// @Produce      json
+// @Param        id path int true "Course ID"
+// @Success      200 {object} dto.CoursePrerequisiteListSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      404 {object} response.Response "Course not found"
+// @Failure      500 {object} response.Response
+// @Router       /courses/{id}/prerequisites [get]
+func (c *CoursePrerequisiteController) GetByCourseID(ctx *gin.Context) {
+	courseID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	prerequisites, err := c.service.GetCoursePrerequisite().GetByCourseID(ctx.Request.Context(), uint(courseID))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: prerequisites,
+		Gin:  ctx,
+	})
+}
+
+// Set godoc
+// @Summary      Set Course Prerequisites
+// @Description  Replace the prerequisites of a course. Learners must complete every prerequisite course before its lessons unlock. An empty list removes all prerequisites.
+// @Tags         Courses
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Course ID"
+// @Param        request body dto.SetCoursePrerequisitesRequest true "Prerequisite course IDs"
+// @Success      200 {object} dto.CoursePrerequisiteListSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Course not found"
+// @Failure      409 {object} response.Response "Prerequisites would form a cycle"
+// @Failure      422 {object} response.Response "Validation error, unknown prerequisite or course listed as its own prerequisite"
+// @Failure      500 {object} response.Response
+// @Router       /courses/{id}/prerequisites [put]
+func (c *CoursePrerequisiteController) Set(ctx *gin.Context) {
+	request := &dto.SetCoursePrerequisitesRequest{}
+	courseID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	err = ctx.ShouldBindJSON(request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	prerequisites, err := c.service.GetCoursePrerequisite().Set(ctx.Request.Context(), uint(courseID), request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: prerequisites,
+		Gin:  ctx,
+	})
+}
@@ -0,0 +1,532 @@
+package controllers
+
+import (
+	errWrap "manabu-service/common/error"
+	"manabu-service/common/response"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type DeckController struct {
+	service services.IServiceRegistry
+}
+
+// IDeckController defines the contract for deck HTTP handlers.
+type IDeckController interface {
+	// Create handles POST requests to create a deck.
+	Create(*gin.Context)
+	// GetPublic handles GET requests to browse public decks.
+	GetPublic(*gin.Context)
+	// GetMine handles GET requests to list the user's decks.
+	GetMine(*gin.Context)
+	// GetSubscribed handles GET requests to list the decks the user is subscribed to.
+	GetSubscribed(*gin.Context)
+	// GetByID handles GET requests for a deck with its words and cards.
+	GetByID(*gin.Context)
+	// Update handles PUT requests to change one of the user's decks.
+	Update(*gin.Context)
+	// Delete handles DELETE requests to remove one of the user's decks.
+	Delete(*gin.Context)
+	// SetVocabularies handles PUT requests to replace the words of a deck.
+	SetVocabularies(*gin.Context)
+	// AddCard handles POST requests to add a custom card to a deck.
+	AddCard(*gin.Context)
+	// UpdateCard handles PUT requests to change a custom card.
+	UpdateCard(*gin.Context)
+	// DeleteCard handles DELETE requests to remove a custom card.
+	DeleteCard(*gin.Context)
+	// Subscribe handles POST requests to subscribe to a deck.
+	Subscribe(*gin.Context)
+	// Unsubscribe handles DELETE requests to unsubscribe from a deck.
+	Unsubscribe(*gin.Context)
+}
+
+func NewDeckController(service services.IServiceRegistry) IDeckController {
+	return &DeckController{service: service}
+}
+
+// getStatusCode maps errors to appropriate HTTP status codes
+func (c *DeckController) getStatusCode(err error) int {
+	switch err {
+	case errConstant.ErrDeckNotFound, errConstant.ErrDeckCardNotFound:
+		return http.StatusNotFound
+	case errConstant.ErrDeckAlreadySubscribed, errConstant.ErrDeckNotSubscribed:
+		return http.StatusConflict
+	case errConstant.ErrDeckVocabularyNotFound:
+		return http.StatusUnprocessableEntity
+	case errConstant.ErrInvalidID:
+		return http.StatusBadRequest
+	case errConstant.ErrUnauthorized:
+		return http.StatusUnauthorized
+	case errConstant.ErrForbidden:
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// parseID parses an ID path parameter, responding with 400 when it is invalid
+func (c *DeckController) parseID(ctx *gin.Context, param string) (uint, bool) {
+	id, err := strconv.ParseUint(ctx.Param(param), 10, 32)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// bind binds and validates a JSON body or query string, responding with 400 or 422 when it is invalid
+func (c *DeckController) bind(ctx *gin.Context, request interface{}, query bool) bool {
+	var err error
+	if query {
+		err = ctx.ShouldBindQuery(request)
+	} else {
+		err = ctx.ShouldBindJSON(request)
+	}
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return false
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return false
+	}
+	return true
+}
+
+// respond writes the result of a service call
+func (c *DeckController) respond(ctx *gin.Context, code int, data interface{}, err error) {
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: code,
+		Data: data,
+		Gin:  ctx,
+	})
+}
+
+// respondList writes a paginated list of decks
+func (c *DeckController) respondList(ctx *gin.Context, decks *dto.DeckListResponse, err error) {
+	if err != nil {
+		c.respond(ctx, 0, nil, err)
+		return
+	}
+
+	// Return response with data array and pagination at same level
+	ctx.JSON(http.StatusOK, gin.H{
+		"message":    http.StatusText(http.StatusOK),
+		"pagination": decks.Pagination,
+		"status":     "success",
+		"data":       decks.Data,
+	})
+}
+
+// respondMessage writes a success message
+func (c *DeckController) respondMessage(ctx *gin.Context, message string, err error) {
+	if err != nil {
+		c.respond(ctx, 0, nil, err)
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code:    http.StatusOK,
+		Message: &message,
+		Gin:     ctx,
+	})
+}
+
+// Create godoc
+// @Summary      Create Deck
+// @Description  Create a deck of words and custom cards. Decks are private unless visibility is unlisted (visible with the share code) or public (listed for everyone). The owner is subscribed to the deck.
+// @Tags         Decks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body dto.CreateDeckRequest true "Deck"
+// @Success      201 {object} dto.DeckSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      422 {object} response.Response
+// @Failure      500 {object} response.Response
+// @Router       /decks [post]
+func (c *DeckController) Create(ctx *gin.Context) {
+	request := &dto.CreateDeckRequest{}
+	if !c.bind(ctx, request, false) {
+		return
+	}
+
+	deck, err := c.service.GetDeck().Create(ctx.Request.Context(), request)
+	c.respond(ctx, http.StatusCreated, deck, err)
+}
+
+// GetPublic godoc
+// @Summary      Browse Decks
+// @Description  Browse public decks, most subscribed first by default. When authenticated, decks the user owns or is subscribed to are marked.
+// @Tags         Decks
+// @Produce      json
+// @Param        page query int false "Page number" default(1) minimum(1)
+// @Param        limit query int false "Items per page" default(10) minimum(1) maximum(100)
+// @Param        search query string false "Search in title and description"
+// @Param        sortBy query string false "Sort order" Enums(popular, newest, title)
+// @Success      200 {object} dto.DeckListSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      422 {object} response.Response
+// @Failure      500 {object} response.Response
+// @Router       /decks [get]
+func (c *DeckController) GetPublic(ctx *gin.Context) {
+	filter := &dto.DeckFilterRequest{}
+	if !c.bind(ctx, filter, true) {
+		return
+	}
+
+	decks, err := c.service.GetDeck().GetPublic(ctx.Request.Context(), filter)
+	c.respondList(ctx, decks, err)
+}
+
+// GetMine godoc
+// @Summary      Get My Decks
+// @Description  Retrieve the decks the authenticated user created, newest first.
+// @Tags         Decks
+// @Produce      json
+// @Security     BearerAuth
+// @Param        page query int false "Page number" default(1) minimum(1)
+// @Param        limit query int false "Items per page" default(10) minimum(1) maximum(100)
+// @Success      200 {object} dto.DeckListSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      422 {object} response.Response
+// @Failure      500 {object} response.Response
+// @Router       /me/decks [get]
+func (c *DeckController) GetMine(ctx *gin.Context) {
+	pagination := &dto.PaginationRequest{}
+	if !c.bind(ctx, pagination, true) {
+		return
+	}
+
+	decks, err := c.service.GetDeck().GetMine(ctx.Request.Context(), pagination)
+	c.respondList(ctx, decks, err)
+}
+
+// GetSubscribed godoc
+// @Summary      Get Subscribed Decks
+// @Description  Retrieve the decks the authenticated user is subscribed to, including their own.
+// @Tags         Decks
+// @Produce      json
+// @Security     BearerAuth
+// @Param        page query int false "Page number" default(1) minimum(1)
+// @Param        limit query int false "Items per page" default(10) minimum(1) maximum(100)
+// @Success      200 {object} dto.DeckListSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      422 {object} response.Response
+// @Failure      500 {object} response.Response
+// @Router       /me/deck-subscriptions [get]
+func (c *DeckController) GetSubscribed(ctx *gin.Context) {
+	pagination := &dto.PaginationRequest{}
+	if !c.bind(ctx, pagination, true) {
+		return
+	}
+
+	decks, err := c.service.GetDeck().GetSubscribed(ctx.Request.Context(), pagination)
+	c.respondList(ctx, decks, err)
+}
+
+// GetByID godoc
+// @Summary      Get Deck
+// @Description  Retrieve a deck with its words and custom cards. Unlisted decks need their share code; private decks are only visible to their owner.
+// @Tags         Decks
+// @Produce      json
+// @Param        id path int true "Deck ID"
+// @Param        shareCode query string false "Share code of an unlisted deck"
+// @Success      200 {object} dto.DeckDetailSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      404 {object} response.Response "Deck not found"
+// @Failure      422 {object} response.Response
+// @Failure      500 {object} response.Response
+// @Router       /decks/{id} [get]
+func (c *DeckController) GetByID(ctx *gin.Context) {
+	id, ok := c.parseID(ctx, "id")
+	if !ok {
+		return
+	}
+
+	access := &dto.DeckAccessRequest{}
+	if !c.bind(ctx, access, true) {
+		return
+	}
+
+	deck, err := c.service.GetDeck().GetByID(ctx.Request.Context(), id, access)
+	c.respond(ctx, http.StatusOK, deck, err)
+}
+
+// Update godoc
+// @Summary      Update Deck
+// @Description  Change the title, description and visibility of one of the authenticated user's decks.
+// @Tags         Decks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Deck ID"
+// @Param        request body dto.UpdateDeckRequest true "Deck"
+// @Success      200 {object} dto.DeckSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      403 {object} response.Response
+// @Failure      404 {object} response.Response "Deck not found"
+// @Failure      422 {object} response.Response
+// @Failure      500 {object} response.Response
+// @Router       /decks/{id} [put]
+func (c *DeckController) Update(ctx *gin.Context) {
+	id, ok := c.parseID(ctx, "id")
+	if !ok {
+		return
+	}
+
+	request := &dto.UpdateDeckRequest{}
+	if !c.bind(ctx, request, false) {
+		return
+	}
+
+	deck, err := c.service.GetDeck().Update(ctx.Request.Context(), id, request)
+	c.respond(ctx, http.StatusOK, deck, err)
+}
+
+// Delete godoc
+// @Summary      Delete Deck
+// @Description  Delete one of the authenticated user's decks with its cards and subscriptions. Words subscribers are learning stay in their review queue.
+// @Tags         Decks
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Deck ID"
+// @Success      200 {object} response.Response
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      403 {object} response.Response
+// @Failure      404 {object} response.Response "Deck not found"
+// @Failure      500 {object} response.Response
+// @Router       /decks/{id} [delete]
+func (c *DeckController) Delete(ctx *gin.Context) {
+	id, ok := c.parseID(ctx, "id")
+	if !ok {
+		return
+	}
+
+	err := c.service.GetDeck().Delete(ctx.Request.Context(), id)
+	c.respondMessage(ctx, "Deck deleted successfully", err)
+}
+
+// SetVocabularies godoc
+// @Summary      Set Deck Vocabularies
+// @Description  Replace the words of one of the authenticated user's decks, in the given order. New words are added to the review queue of every subscriber.
+// @Tags         Decks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Deck ID"
+// @Param        request body dto.SetDeckVocabulariesRequest true "Vocabulary IDs"
+// @Success      200 {object} dto.DeckDetailSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      403 {object} response.Response
+// @Failure      404 {object} response.Response "Deck not found"
+// @Failure      422 {object} response.Response "Validation error or unknown vocabulary"
+// @Failure      500 {object} response.Response
+// @Router       /decks/{id}/vocabularies [put]
+func (c *DeckController) SetVocabularies(ctx *gin.Context) {
+	id, ok := c.parseID(ctx, "id")
+	if !ok {
+		return
+	}
+
+	request := &dto.SetDeckVocabulariesRequest{}
+	if !c.bind(ctx, request, false) {
+		return
+	}
+
+	deck, err := c.service.GetDeck().SetVocabularies(ctx.Request.Context(), id, request)
+	c.respond(ctx, http.StatusOK, deck, err)
+}
+
+// AddCard godoc
+// @Summary      Add Deck Card
+// @Description  Add a custom card to one of the authenticated user's decks. The card is added to the review queue of every subscriber.
+// @Tags         Decks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Deck ID"
+// @Param        request body dto.DeckCardRequest true "Card"
+// @Success      201 {object} dto.DeckCardSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      403 {object} response.Response
+// @Failure      404 {object} response.Response "Deck not found"
+// @Failure      422 {object} response.Response
+// @Failure      500 {object} response.Response
+// @Router       /decks/{id}/cards [post]
+func (c *DeckController) AddCard(ctx *gin.Context) {
+	id, ok := c.parseID(ctx, "id")
+	if !ok {
+		return
+	}
+
+	request := &dto.DeckCardRequest{}
+	if !c.bind(ctx, request, false) {
+		return
+	}
+
+	card, err := c.service.GetDeck().AddCard(ctx.Request.Context(), id, request)
+	c.respond(ctx, http.StatusCreated, card, err)
+}
+
+// UpdateCard godoc
+// @Summary      Update Deck Card
+// @Description  Change a custom card of one of the authenticated user's decks. Fields left empty are cleared.
+// @Tags         Decks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Deck ID"
+// @Param        cardId path int true "Card ID"
+// @Param        request body dto.DeckCardRequest true "Card"
+// @Success      200 {object} dto.DeckCardSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      403 {object} response.Response
+// @Failure      404 {object} response.Response "Deck or card not found"
+// @Failure      422 {object} response.Response
+// @Failure      500 {object} response.Response
+// @Router       /decks/{id}/cards/{cardId} [put]
+func (c *DeckController) UpdateCard(ctx *gin.Context) {
+	id, ok := c.parseID(ctx, "id")
+	if !ok {
+		return
+	}
+	cardID, ok := c.parseID(ctx, "cardId")
+	if !ok {
+		return
+	}
+
+	request := &dto.DeckCardRequest{}
+	if !c.bind(ctx, request, false) {
+		return
+	}
+
+	card, err := c.service.GetDeck().UpdateCard(ctx.Request.Context(), id, cardID, request)
+	c.respond(ctx, http.StatusOK, card, err)
+}
+
+// DeleteCard godoc
+// @Summary      Delete Deck Card
+// @Description  Delete a custom card of one of the authenticated user's decks, with the progress of subscribers on it.
+// @Tags         Decks
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Deck ID"
+// @Param        cardId path int true "Card ID"
+// @Success      200 {object} response.Response
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      403 {object} response.Response
+// @Failure      404 {object} response.Response "Deck or card not found"
+// @Failure      500 {object} response.Response
+// @Router       /decks/{id}/cards/{cardId} [delete]
+func (c *DeckController) DeleteCard(ctx *gin.Context) {
+	id, ok := c.parseID(ctx, "id")
+	if !ok {
+		return
+	}
+	cardID, ok := c.parseID(ctx, "cardId")
+	if !ok {
+		return
+	}
+
+	err := c.service.GetDeck().DeleteCard(ctx.Request.Context(), id, cardID)
+	c.respondMessage(ctx, "Card deleted successfully", err)
+}
+
+// Subscribe godoc
+// @Summary      Subscribe to Deck
+// @Description  Subscribe to a deck. Its words join the user's vocabulary review queue and its custom cards join their card review queue. Unlisted decks need their share code.
+// @Tags         Decks
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Deck ID"
+// @Param        shareCode query string false "Share code of an unlisted deck"
+// @Success      200 {object} dto.DeckSubscriptionSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Deck not found"
+// @Failure      409 {object} response.Response "Already subscribed"
+// @Failure      422 {object} response.Response
+// @Failure      500 {object} response.Response
+// @Router       /decks/{id}/subscription [post]
+func (c *DeckController) Subscribe(ctx *gin.Context) {
+	id, ok := c.parseID(ctx, "id")
+	if !ok {
+		return
+	}
+
+	access := &dto.DeckAccessRequest{}
+	if !c.bind(ctx, access, true) {
+		return
+	}
+
+	subscription, err := c.service.GetDeck().Subscribe(ctx.Request.Context(), id, access)
+	c.respond(ctx, http.StatusOK, subscription, err)
+}
+
+// Unsubscribe godoc
+// @Summary      Unsubscribe from Deck
+// @Description  Unsubscribe from a deck. Its custom cards leave the user's review queue; words stay, with their progress.
+// @Tags         Decks
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Deck ID"
+// @Success      200 {object} response.Response
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      409 {object} response.Response "Not subscribed"
+// @Failure      500 {object} response.Response
+// @Router       /decks/{id}/subscription [delete]
+func (c *DeckController) Unsubscribe(ctx *gin.Context) {
+	id, ok := c.parseID(ctx, "id")
+	if !ok {
+		return
+	}
+
+	err := c.service.GetDeck().Unsubscribe(ctx.Request.Context(), id)
+	c.respondMessage(ctx, "Unsubscribed successfully", err)
+}
@@ -0,0 +1,168 @@
+package controllers
+
+import (
+	errWrap "manabu-service/common/error"
+	"manabu-service/common/response"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type DeckCardController struct {
+	service services.IServiceRegistry
+}
+
+// IDeckCardController defines the contract for custom card review HTTP handlers.
+type IDeckCardController interface {
+	// GetAll handles GET requests to list the user's progress on custom cards.
+	GetAll(*gin.Context)
+	// Review handles POST requests to review a custom card.
+	Review(*gin.Context)
+}
+
+func NewDeckCardController(service services.IServiceRegistry) IDeckCardController {
+	return &DeckCardController{service: service}
+}
+
+// getStatusCode maps errors to appropriate HTTP status codes
+func (c *DeckCardController) getStatusCode(err error) int {
+	switch err {
+	case errConstant.ErrDeckCardStatusNotFound:
+		return http.StatusNotFound
+	case errConstant.ErrInvalidID:
+		return http.StatusBadRequest
+	case errConstant.ErrUnauthorized:
+		return http.StatusUnauthorized
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// bind binds and validates a JSON body or query string, responding with 400 or 422 when it is invalid
+func (c *DeckCardController) bind(ctx *gin.Context, request interface{}, query bool) bool {
+	var err error
+	if query {
+		err = ctx.ShouldBindQuery(request)
+	} else {
+		err = ctx.ShouldBindJSON(request)
+	}
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return false
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return false
+	}
+	return true
+}
+
+// GetAll godoc
+// @Summary      Get Deck Card Reviews
+// @Description  Retrieve the authenticated user's progress on the custom cards of their subscribed decks, least recently reviewed first.
+// @Tags         Decks
+// @Produce      json
+// @Security     BearerAuth
+// @Param        page query int false "Page number" default(1) minimum(1)
+// @Param        limit query int false "Items per page" default(10) minimum(1) maximum(100)
+// @Param        deckId query int false "Deck ID"
+// @Param        status query string false "Learning status" Enums(learning, completed)
+// @Success      200 {object} dto.DeckCardStatusListSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      422 {object} response.Response
+// @Failure      500 {object} response.Response
+// @Router       /me/deck-cards [get]
+func (c *DeckCardController) GetAll(ctx *gin.Context) {
+	filter := &dto.DeckCardStatusListRequest{}
+	if !c.bind(ctx, filter, true) {
+		return
+	}
+
+	statuses, err := c.service.GetDeckCard().GetAll(ctx.Request.Context(), filter)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	// Return response with data array and pagination at same level
+	ctx.JSON(http.StatusOK, gin.H{
+		"message":    http.StatusText(http.StatusOK),
+		"pagination": statuses.Pagination,
+		"status":     "success",
+		"data":       statuses.Data,
+	})
+}
+
+// Review godoc
+// @Summary      Review Deck Card
+// @Description  Record a review of a custom card. A correct answer adds a repetition and the card is completed after 5; a wrong answer resets its progress.
+// @Tags         Decks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        cardId path int true "Card ID"
+// @Param        request body dto.ReviewUserVocabStatusRequest true "Review result"
+// @Success      200 {object} dto.DeckCardStatusSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Card not in review queue"
+// @Failure      422 {object} response.Response
+// @Failure      500 {object} response.Response
+// @Router       /me/deck-cards/{cardId}/review [post]
+func (c *DeckCardController) Review(ctx *gin.Context) {
+	cardID, err := strconv.ParseUint(ctx.Param("cardId"), 10, 32)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	request := &dto.ReviewUserVocabStatusRequest{}
+	if !c.bind(ctx, request, false) {
+		return
+	}
+
+	status, err := c.service.GetDeckCard().Review(ctx.Request.Context(), uint(cardID), request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: status,
+		Gin:  ctx,
+	})
+}
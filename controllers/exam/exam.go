@@ -0,0 +1,455 @@
+package controllers
+
+import (
+	errWrap "manabu-service/common/error"
+	"manabu-service/common/response"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type ExamController struct {
+	service services.IServiceRegistry
+}
+
+type IExamController interface {
+	Create(*gin.Context)
+	GetAll(*gin.Context)
+	GetByID(*gin.Context)
+	Update(*gin.Context)
+	Delete(*gin.Context)
+	Publish(*gin.Context)
+	Unpublish(*gin.Context)
+}
+
+func NewExamController(service services.IServiceRegistry) IExamController {
+	return &ExamController{service: service}
+}
+
+// getStatusCode maps errors to appropriate HTTP status codes
+func (c *ExamController) getStatusCode(err error) int {
+	switch err {
+	case errConstant.ErrExamNotFound:
+		return http.StatusNotFound
+	case errConstant.ErrInvalidJlptLevelIDExam, errConstant.ErrExamScoringRuleNotFound,
+		errConstant.ErrInvalidExamSections, errConstant.ErrExamQuestionPoolTooSmall:
+		return http.StatusUnprocessableEntity
+	case errConstant.ErrExamAlreadyPublished, errConstant.ErrExamNotPublished:
+		return http.StatusBadRequest
+	case errConstant.ErrInvalidID:
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// Create godoc
+// @Summary      Create Exam
+// @Description  Create a new JLPT mock exam with one section each for vocabulary, grammar, reading and listening (admin only)
+// @Tags         Exams
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body dto.CreateExamRequest true "Exam details"
+// @Success      201 {object} dto.ExamSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      422 {object} response.Response "Invalid JLPT level ID or sections"
+// @Failure      500 {object} response.Response
+// @Router       /exams [post]
+func (c *ExamController) Create(ctx *gin.Context) {
+	request := &dto.CreateExamRequest{}
+
+	err := ctx.ShouldBindJSON(request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	exam, err := c.service.GetExam().Create(ctx, request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusCreated,
+		Data: exam,
+		Gin:  ctx,
+	})
+}
+
+// GetAll godoc
+// @Summary      Get all Exams
+// @Description  Retrieve JLPT mock exams with filtering, search, sorting, and pagination
+// @Tags         Exams
+// @Produce      json
+// @Param        page query int false "Page number" default(1) minimum(1)
+// @Param        limit query int false "Items per page" default(10) minimum(1) maximum(100)
+// @Param        jlptLevelId query int false "Filter by JLPT Level ID" example(5)
+// @Param        isPublished query bool false "Filter by publication status" example(true)
+// @Param        search query string false "Search in title or description" example("mock")
+// @Param        sortBy query string false "Sort by field (title, created_at)" default(created_at) example("title")
+// @Param        sortOrder query string false "Sort order (asc, desc)" default(desc) example("asc")
+// @Success      200 {object} dto.ExamListSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      422 {object} response.Response
+// @Failure      500 {object} response.Response
+// @Router       /exams [get]
+func (c *ExamController) GetAll(ctx *gin.Context) {
+	filter := &dto.ExamFilterRequest{}
+
+	if err := ctx.ShouldBindQuery(filter); err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err := validate.Struct(filter)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	exams, err := c.service.GetExam().GetAll(ctx, filter)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	// Return response with data array and pagination at same level
+	ctx.JSON(http.StatusOK, gin.H{
+		"message":    http.StatusText(http.StatusOK),
+		"pagination": exams.Pagination,
+		"status":     "success",
+		"data":       exams.Data,
+	})
+}
+
+// GetByID godoc
+// @Summary      Get Exam by ID
+// @Description  Retrieve a specific JLPT mock exam with its sections by ID
+// @Tags         Exams
+// @Produce      json
+// @Param        id path int true "Exam ID"
+// @Success      200 {object} dto.ExamSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      404 {object} response.Response "Exam not found"
+// @Failure      500 {object} response.Response
+// @Router       /exams/{id} [get]
+func (c *ExamController) GetByID(ctx *gin.Context) {
+	idParam := ctx.Param("id")
+	if idParam == "" {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	exam, err := c.service.GetExam().GetByID(ctx, uint(id))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: exam,
+		Gin:  ctx,
+	})
+}
+
+// Update godoc
+// @Summary      Update Exam
+// @Description  Update an existing JLPT mock exam and replace its sections by ID (admin only)
+// @Tags         Exams
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Exam ID"
+// @Param        request body dto.UpdateExamRequest true "Updated exam details"
+// @Success      200 {object} dto.ExamSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Exam not found"
+// @Failure      422 {object} response.Response "Invalid JLPT level ID or sections, or question pool too small for a published exam"
+// @Failure      500 {object} response.Response
+// @Router       /exams/{id} [put]
+func (c *ExamController) Update(ctx *gin.Context) {
+	request := &dto.UpdateExamRequest{}
+	idParam := ctx.Param("id")
+	if idParam == "" {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	err = ctx.ShouldBindJSON(request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	exam, err := c.service.GetExam().Update(ctx, request, uint(id))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: exam,
+		Gin:  ctx,
+	})
+}
+
+// Delete godoc
+// @Summary      Delete Exam
+// @Description  Delete a JLPT mock exam by ID (admin only)
+// @Tags         Exams
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Exam ID"
+// @Success      200 {object} response.Response
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Exam not found"
+// @Failure      500 {object} response.Response
+// @Router       /exams/{id} [delete]
+func (c *ExamController) Delete(ctx *gin.Context) {
+	idParam := ctx.Param("id")
+	if idParam == "" {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	err = c.service.GetExam().Delete(ctx, uint(id))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	successMessage := "Exam deleted successfully"
+	response.HttpResponse(response.ParamHTTPResp{
+		Code:    http.StatusOK,
+		Message: &successMessage,
+		Gin:     ctx,
+	})
+}
+
+// Publish godoc
+// @Summary      Publish Exam
+// @Description  Publish a mock exam by ID (admin only). Every section must have enough published questions in its pool
+// @Tags         Exams
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Exam ID"
+// @Success      200 {object} dto.ExamSwaggerResponse
+// @Failure      400 {object} response.Response "Exam is already published"
+// @Failure      422 {object} response.Response "Question pool too small"
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Exam not found"
+// @Failure      500 {object} response.Response
+// @Router       /exams/{id}/publish [post]
+func (c *ExamController) Publish(ctx *gin.Context) {
+	idParam := ctx.Param("id")
+	if idParam == "" {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	exam, err := c.service.GetExam().Publish(ctx, uint(id))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: exam,
+		Gin:  ctx,
+	})
+}
+
+// Unpublish godoc
+// @Summary      Unpublish Exam
+// @Description  Unpublish a mock exam by ID (admin only) - sets is_published=false
+// @Tags         Exams
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Exam ID"
+// @Success      200 {object} dto.ExamSwaggerResponse
+// @Failure      400 {object} response.Response "Exam is not published"
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Exam not found"
+// @Failure      500 {object} response.Response
+// @Router       /exams/{id}/unpublish [post]
+func (c *ExamController) Unpublish(ctx *gin.Context) {
+	idParam := ctx.Param("id")
+	if idParam == "" {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	exam, err := c.service.GetExam().Unpublish(ctx, uint(id))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: exam,
+		Gin:  ctx,
+	})
+}
@@ -0,0 +1,348 @@
+package controllers
+
+import (
+	errWrap "manabu-service/common/error"
+	"manabu-service/common/locale"
+	"manabu-service/common/response"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+type ExamAttemptController struct {
+	service services.IServiceRegistry
+}
+
+// IExamAttemptController defines the contract for timed exam session HTTP handlers.
+type IExamAttemptController interface {
+	// Start handles POST requests to start (or resume) an attempt of an exam.
+	Start(*gin.Context)
+	// GetAll handles GET requests to retrieve the authenticated user's exam results.
+	GetAll(*gin.Context)
+	// GetByID handles GET requests to retrieve an attempt with its questions.
+	GetByID(*gin.Context)
+	// SaveAnswers handles PUT requests to autosave answers of an in-progress attempt.
+	SaveAnswers(*gin.Context)
+	// Submit handles POST requests to grade an in-progress attempt.
+	Submit(*gin.Context)
+}
+
+func NewExamAttemptController(service services.IServiceRegistry) IExamAttemptController {
+	return &ExamAttemptController{service: service}
+}
+
+// getStatusCode maps errors to appropriate HTTP status codes
+func (c *ExamAttemptController) getStatusCode(err error) int {
+	switch err {
+	case errConstant.ErrExamNotFound, errConstant.ErrExamAttemptNotFound:
+		return http.StatusNotFound
+	case errConstant.ErrExamAttemptExpired, errConstant.ErrExamAttemptAlreadySubmitted:
+		return http.StatusConflict
+	case errConstant.ErrExamQuestionPoolTooSmall, errConstant.ErrExamScoringRuleNotFound,
+		errConstant.ErrExamAnswerQuestionNotFound, errConstant.ErrInvalidSubmittedAnswer:
+		return http.StatusUnprocessableEntity
+	case errConstant.ErrInvalidID:
+		return http.StatusBadRequest
+	case errConstant.ErrUnauthorized:
+		return http.StatusUnauthorized
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// Start godoc
+// @Summary      Start Exam
+// @Description  Start a timed attempt of a published JLPT mock exam. Questions are drawn at random from the question pool of each section. An unfinished attempt of the same exam is resumed instead.
+// @Tags         Exam Attempts
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Exam ID"
+// @Success      201 {object} dto.ExamAttemptSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Exam not found"
+// @Failure      422 {object} response.Response "Question pool too small"
+// @Failure      500 {object} response.Response
+// @Router       /exams/{id}/start [post]
+func (c *ExamAttemptController) Start(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	attempt, err := c.service.GetExamAttempt().Start(ctx.Request.Context(), uint(id))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusCreated,
+		Data: attempt,
+		Gin:  ctx,
+	})
+}
+
+// GetAll godoc
+// @Summary      Get Exam Results
+// @Description  Retrieve the authenticated user's exam attempts, newest first. Attempts past their time limit are submitted automatically before listing.
+// @Tags         Exam Attempts
+// @Produce      json
+// @Security     BearerAuth
+// @Param        page query int false "Page number" default(1) minimum(1)
+// @Param        limit query int false "Items per page" default(10) minimum(1) maximum(100)
+// @Param        examId query int false "Filter by Exam ID" example(1)
+// @Param        status query string false "Filter by status (in_progress, submitted, timed_out)" example("submitted")
+// @Success      200 {object} dto.ExamAttemptListSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      422 {object} response.Response
+// @Failure      500 {object} response.Response
+// @Router       /exam-attempts [get]
+func (c *ExamAttemptController) GetAll(ctx *gin.Context) {
+	filter := &dto.ExamAttemptFilterRequest{}
+
+	if err := ctx.ShouldBindQuery(filter); err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err := validate.Struct(filter)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	attempts, err := c.service.GetExamAttempt().GetAll(ctx.Request.Context(), filter)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	// Return response with data array and pagination at same level
+	ctx.JSON(http.StatusOK, gin.H{
+		"message":    http.StatusText(http.StatusOK),
+		"pagination": attempts.Pagination,
+		"status":     "success",
+		"data":       attempts.Data,
+	})
+}
+
+// GetByID godoc
+// @Summary      Get Exam Attempt by ID
+// @Description  Retrieve an attempt with its questions and saved answers. Correct answers, explanations and scores are included once the attempt is finished.
+// @Tags         Exam Attempts
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Exam Attempt UUID" format(uuid)
+// @Success      200 {object} dto.ExamAttemptSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Exam attempt not found"
+// @Failure      500 {object} response.Response
+// @Router       /exam-attempts/{id} [get]
+func (c *ExamAttemptController) GetByID(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	attempt, err := c.service.GetExamAttempt().GetByID(ctx.Request.Context(), id)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	// Localize explanations for the negotiated locale
+	err = c.service.GetTranslation().LocalizeExamAttemptQuestions(ctx, locale.Negotiate(ctx), attempt.Questions)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: attempt,
+		Gin:  ctx,
+	})
+}
+
+// SaveAnswers godoc
+// @Summary      Save Exam Answers
+// @Description  Autosave answers of an in-progress attempt. Answers sent after the time limit are rejected and the attempt is submitted with the answers saved in time.
+// @Tags         Exam Attempts
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Exam Attempt UUID" format(uuid)
+// @Param        request body dto.SaveExamAnswersRequest true "Answers to save"
+// @Success      200 {object} dto.ExamAttemptSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Exam attempt not found"
+// @Failure      409 {object} response.Response "Attempt already submitted or time limit passed"
+// @Failure      422 {object} response.Response "Question is not part of this attempt"
+// @Failure      500 {object} response.Response
+// @Router       /exam-attempts/{id}/answers [put]
+func (c *ExamAttemptController) SaveAnswers(ctx *gin.Context) {
+	request := &dto.SaveExamAnswersRequest{}
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	err = ctx.ShouldBindJSON(request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	attempt, err := c.service.GetExamAttempt().SaveAnswers(ctx.Request.Context(), id, request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	// Localize explanations for the negotiated locale
+	err = c.service.GetTranslation().LocalizeExamAttemptQuestions(ctx, locale.Negotiate(ctx), attempt.Questions)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: attempt,
+		Gin:  ctx,
+	})
+}
+
+// Submit godoc
+// @Summary      Submit Exam
+// @Description  Grade an in-progress attempt and compute scaled scores per JLPT scoring section with sectional and overall pass marks. Late submissions are graded as timed out.
+// @Tags         Exam Attempts
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Exam Attempt UUID" format(uuid)
+// @Success      200 {object} dto.ExamAttemptSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Exam attempt not found"
+// @Failure      409 {object} response.Response "Attempt already submitted"
+// @Failure      500 {object} response.Response
+// @Router       /exam-attempts/{id}/submit [post]
+func (c *ExamAttemptController) Submit(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	attempt, err := c.service.GetExamAttempt().Submit(ctx.Request.Context(), id)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	// Localize explanations for the negotiated locale
+	err = c.service.GetTranslation().LocalizeExamAttemptQuestions(ctx, locale.Negotiate(ctx), attempt.Questions)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: attempt,
+		Gin:  ctx,
+	})
+}
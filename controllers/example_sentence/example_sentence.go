@@ -0,0 +1,397 @@
+package controllers
+
+import (
+	errWrap "manabu-service/common/error"
+	"manabu-service/common/response"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type ExampleSentenceController struct {
+	service services.IServiceRegistry
+}
+
+type IExampleSentenceController interface {
+	Create(*gin.Context)
+	GetAll(*gin.Context)
+	GetByID(*gin.Context)
+	GetByVocabularyID(*gin.Context)
+	Update(*gin.Context)
+	Delete(*gin.Context)
+}
+
+func NewExampleSentenceController(service services.IServiceRegistry) IExampleSentenceController {
+	return &ExampleSentenceController{service: service}
+}
+
+// getStatusCode maps errors to appropriate HTTP status codes
+func (c *ExampleSentenceController) getStatusCode(err error) int {
+	switch err {
+	case errConstant.ErrExampleSentenceNotFound, errConstant.ErrVocabularyNotFound:
+		return http.StatusNotFound
+	case errConstant.ErrInvalidVocabularyIDExampleSentence, errConstant.ErrInvalidExampleSentenceDifficulty:
+		return http.StatusUnprocessableEntity
+	case errConstant.ErrInvalidID:
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// Create godoc
+// @Summary      Create Example Sentence
+// @Description  Create a new example sentence and link it to vocabularies (admin only)
+// @Tags         Example Sentences
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body dto.CreateExampleSentenceRequest true "Example sentence details"
+// @Success      201 {object} dto.ExampleSentenceSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      422 {object} response.Response "Invalid vocabulary ID or difficulty"
+// @Failure      500 {object} response.Response
+// @Router       /example-sentences [post]
+func (c *ExampleSentenceController) Create(ctx *gin.Context) {
+	request := &dto.CreateExampleSentenceRequest{}
+
+	err := ctx.ShouldBindJSON(request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	sentence, err := c.service.GetExampleSentence().Create(ctx, request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusCreated,
+		Data: sentence,
+		Gin:  ctx,
+	})
+}
+
+// GetAll godoc
+// @Summary      Get all Example Sentences
+// @Description  Browse example sentences with filtering, search, sorting, and pagination
+// @Tags         Example Sentences
+// @Produce      json
+// @Param        page query int false "Page number" default(1) minimum(1)
+// @Param        limit query int false "Items per page" default(10) minimum(1) maximum(100)
+// @Param        vocabularyId query int false "Filter by linked Vocabulary ID" example(1)
+// @Param        difficulty query int false "Filter by difficulty (1-5)" minimum(1) maximum(5)
+// @Param        search query string false "Search in text, reading, or translation" example("犬")
+// @Param        sortBy query string false "Sort by field (difficulty, created_at)" default(created_at)
+// @Param        sortOrder query string false "Sort order (asc, desc)" default(desc)
+// @Success      200 {object} dto.ExampleSentenceListSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      422 {object} response.Response
+// @Failure      500 {object} response.Response
+// @Router       /example-sentences [get]
+func (c *ExampleSentenceController) GetAll(ctx *gin.Context) {
+	filter := &dto.ExampleSentenceFilterRequest{}
+
+	if err := ctx.ShouldBindQuery(filter); err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err := validate.Struct(filter)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	sentences, err := c.service.GetExampleSentence().GetAll(ctx, filter)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	// Return response with data array and pagination at same level
+	ctx.JSON(http.StatusOK, gin.H{
+		"message":    http.StatusText(http.StatusOK),
+		"pagination": sentences.Pagination,
+		"status":     "success",
+		"data":       sentences.Data,
+	})
+}
+
+// GetByID godoc
+// @Summary      Get Example Sentence by ID
+// @Description  Retrieve a specific example sentence by ID
+// @Tags         Example Sentences
+// @Produce      json
+// @Param        id path int true "Example Sentence ID"
+// @Success      200 {object} dto.ExampleSentenceSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      404 {object} response.Response "Example sentence not found"
+// @Failure      500 {object} response.Response
+// @Router       /example-sentences/{id} [get]
+func (c *ExampleSentenceController) GetByID(ctx *gin.Context) {
+	idParam := ctx.Param("id")
+	if idParam == "" {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	sentence, err := c.service.GetExampleSentence().GetByID(ctx, uint(id))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: sentence,
+		Gin:  ctx,
+	})
+}
+
+// GetByVocabularyID godoc
+// @Summary      Get Example Sentences by Vocabulary ID
+// @Description  Retrieve all example sentences linked to a vocabulary entry, easiest first
+// @Tags         Example Sentences
+// @Produce      json
+// @Param        id path int true "Vocabulary ID"
+// @Success      200 {object} response.Response{data=[]dto.ExampleSentenceResponse}
+// @Failure      400 {object} response.Response
+// @Failure      404 {object} response.Response "Vocabulary not found"
+// @Failure      500 {object} response.Response
+// @Router       /vocabularies/{id}/example-sentences [get]
+func (c *ExampleSentenceController) GetByVocabularyID(ctx *gin.Context) {
+	idParam := ctx.Param("id")
+	if idParam == "" {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	vocabularyID, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	sentences, err := c.service.GetExampleSentence().GetByVocabularyID(ctx, uint(vocabularyID))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: sentences,
+		Gin:  ctx,
+	})
+}
+
+// Update godoc
+// @Summary      Update Example Sentence
+// @Description  Update an existing example sentence and replace its vocabulary links (admin only)
+// @Tags         Example Sentences
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Example Sentence ID"
+// @Param        request body dto.UpdateExampleSentenceRequest true "Updated example sentence details"
+// @Success      200 {object} dto.ExampleSentenceSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Example sentence not found"
+// @Failure      422 {object} response.Response "Invalid vocabulary ID or difficulty"
+// @Failure      500 {object} response.Response
+// @Router       /example-sentences/{id} [put]
+func (c *ExampleSentenceController) Update(ctx *gin.Context) {
+	request := &dto.UpdateExampleSentenceRequest{}
+	idParam := ctx.Param("id")
+	if idParam == "" {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	err = ctx.ShouldBindJSON(request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	sentence, err := c.service.GetExampleSentence().Update(ctx, request, uint(id))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: sentence,
+		Gin:  ctx,
+	})
+}
+
+// Delete godoc
+// @Summary      Delete Example Sentence
+// @Description  Delete an example sentence and its vocabulary links by ID (admin only)
+// @Tags         Example Sentences
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Example Sentence ID"
+// @Success      200 {object} response.Response
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Example sentence not found"
+// @Failure      500 {object} response.Response
+// @Router       /example-sentences/{id} [delete]
+func (c *ExampleSentenceController) Delete(ctx *gin.Context) {
+	idParam := ctx.Param("id")
+	if idParam == "" {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	err = c.service.GetExampleSentence().Delete(ctx, uint(id))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	successMessage := "Example sentence deleted successfully"
+	response.HttpResponse(response.ParamHTTPResp{
+		Code:    http.StatusOK,
+		Message: &successMessage,
+		Gin:     ctx,
+	})
+}
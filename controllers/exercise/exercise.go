@@ -23,8 +23,8 @@ type IExerciseController interface {
 	GetByID(*gin.Context)
 	Update(*gin.Context)
 	Delete(*gin.Context)
-	UpdatePublishStatus(*gin.Context)
 	GetByLessonID(*gin.Context)
+	Reorder(*gin.Context)
 }
 
 func NewExerciseController(service services.IServiceRegistry) IExerciseController {
@@ -34,16 +34,16 @@ func NewExerciseController(service services.IServiceRegistry) IExerciseControlle
 // getStatusCode maps errors to appropriate HTTP status codes
 func (c *ExerciseController) getStatusCode(err error) int {
 	switch err {
-	case errConstant.ErrExerciseNotFound:
+	case errConstant.ErrExerciseNotFound, errConstant.ErrContentNotFound:
 		return http.StatusNotFound
 	case errConstant.ErrDuplicateExerciseOrderIndex:
 		return http.StatusConflict
-	case errConstant.ErrInvalidLessonIDExercise, errConstant.ErrInvalidExerciseTitle,
+	case errConstant.ErrInvalidLessonIDExercise, errConstant.ErrInvalidExerciseOrder, errConstant.ErrInvalidExerciseTitle,
 		errConstant.ErrInvalidExerciseType, errConstant.ErrInvalidExerciseOrderIndex,
 		errConstant.ErrInvalidExerciseDifficulty, errConstant.ErrInvalidExerciseEstimatedMinutes:
 		return http.StatusUnprocessableEntity
-	case errConstant.ErrExerciseAlreadyPublished, errConstant.ErrExerciseNotPublished:
-		return http.StatusBadRequest
+	case errConstant.ErrLessonLocked:
+		return http.StatusForbidden
 	case errConstant.ErrInvalidID:
 		return http.StatusBadRequest
 	default:
@@ -307,7 +307,7 @@ func (c *ExerciseController) Update(ctx *gin.Context) {
 
 // Delete godoc
 // @Summary      Delete Exercise
-// @Description  Delete an exercise entry by ID (admin only)
+// @Description  Move an exercise and its questions to the trash by ID (admin only)
 // @Tags         Exercises
 // @Produce      json
 // @Security     BearerAuth
@@ -357,26 +357,21 @@ func (c *ExerciseController) Delete(ctx *gin.Context) {
 	})
 }
 
-// UpdatePublishStatus godoc
-// @Summary      Publish/Unpublish Exercise
-// @Description  Update the publication status of an exercise by ID (admin only) - PATCH request with isPublished boolean
-// @Tags         Exercises
-// @Accept       json
+// GetByLessonID godoc
+// @Summary      Get Exercises by Lesson ID
+// @Description  Retrieve all exercises for a specific lesson, ordered by order_index. The exercises of a lesson that is locked for the learner are not returned.
+// @Tags         Lessons
 // @Produce      json
-// @Security     BearerAuth
-// @Param        id path int true "Exercise ID"
-// @Param        request body dto.PublishExerciseRequest true "Publish status (true to publish, false to unpublish)"
-// @Success      200 {object} dto.ExerciseSwaggerResponse
-// @Failure      400 {object} response.Response "Exercise is already in the requested state"
-// @Failure      401 {object} response.Response
-// @Failure      404 {object} response.Response "Exercise not found"
-// @Failure      422 {object} response.Response
+// @Param        id path int true "Lesson ID"
+// @Success      200 {object} response.Response{data=[]dto.ExerciseResponse}
+// @Failure      400 {object} response.Response
+// @Failure      403 {object} response.Response "Lesson is locked"
+// @Failure      422 {object} response.Response "Invalid lesson ID"
 // @Failure      500 {object} response.Response
-// @Router       /exercises/{id}/publish [patch]
-func (c *ExerciseController) UpdatePublishStatus(ctx *gin.Context) {
-	request := &dto.PublishExerciseRequest{}
-	idParam := ctx.Param("id")
-	if idParam == "" {
+// @Router       /lessons/{id}/exercises [get]
+func (c *ExerciseController) GetByLessonID(ctx *gin.Context) {
+	lessonIDParam := ctx.Param("id")
+	if lessonIDParam == "" {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: http.StatusBadRequest,
 			Err:  errConstant.ErrInvalidID,
@@ -385,7 +380,7 @@ func (c *ExerciseController) UpdatePublishStatus(ctx *gin.Context) {
 		return
 	}
 
-	id, err := strconv.ParseUint(idParam, 10, 32)
+	lessonID, err := strconv.ParseUint(lessonIDParam, 10, 32)
 	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: http.StatusBadRequest,
@@ -395,32 +390,7 @@ func (c *ExerciseController) UpdatePublishStatus(ctx *gin.Context) {
 		return
 	}
 
-	err = ctx.ShouldBindJSON(request)
-	if err != nil {
-		response.HttpResponse(response.ParamHTTPResp{
-			Code: http.StatusBadRequest,
-			Err:  err,
-			Gin:  ctx,
-		})
-		return
-	}
-
-	validate := validator.New()
-	err = validate.Struct(request)
-	if err != nil {
-		errMessage := http.StatusText(http.StatusUnprocessableEntity)
-		errResponse := errWrap.ErrValidationResponse(err)
-		response.HttpResponse(response.ParamHTTPResp{
-			Code:    http.StatusUnprocessableEntity,
-			Message: &errMessage,
-			Data:    errResponse,
-			Err:     err,
-			Gin:     ctx,
-		})
-		return
-	}
-
-	exercise, err := c.service.GetExercise().UpdatePublishStatus(ctx, uint(id), *request.IsPublished)
+	exercises, err := c.service.GetExercise().GetByLessonID(ctx, uint(lessonID))
 	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: c.getStatusCode(err),
@@ -432,25 +402,30 @@ func (c *ExerciseController) UpdatePublishStatus(ctx *gin.Context) {
 
 	response.HttpResponse(response.ParamHTTPResp{
 		Code: http.StatusOK,
-		Data: exercise,
+		Data: exercises,
 		Gin:  ctx,
 	})
 }
 
-// GetByLessonID godoc
-// @Summary      Get Exercises by Lesson ID
-// @Description  Retrieve all exercises for a specific lesson, ordered by order_index
-// @Tags         Lessons
+// Reorder godoc
+// @Summary      Reorder Exercises
+// @Description  Set the order of all exercises of a lesson at once. The IDs must list every exercise of the lesson exactly once; order_index follows their position, starting at 1.
+// @Tags         Exercises
+// @Accept       json
 // @Produce      json
+// @Security     BearerAuth
 // @Param        id path int true "Lesson ID"
+// @Param        request body dto.ReorderRequest true "Exercise IDs in their new order"
 // @Success      200 {object} response.Response{data=[]dto.ExerciseResponse}
 // @Failure      400 {object} response.Response
-// @Failure      422 {object} response.Response "Invalid lesson ID"
+// @Failure      401 {object} response.Response
+// @Failure      422 {object} response.Response "Invalid lesson ID or IDs do not match the exercises of the lesson"
 // @Failure      500 {object} response.Response
-// @Router       /lessons/{id}/exercises [get]
-func (c *ExerciseController) GetByLessonID(ctx *gin.Context) {
-	lessonIDParam := ctx.Param("id")
-	if lessonIDParam == "" {
+// @Router       /lessons/{id}/exercises/order [put]
+func (c *ExerciseController) Reorder(ctx *gin.Context) {
+	request := &dto.ReorderRequest{}
+	lessonID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: http.StatusBadRequest,
 			Err:  errConstant.ErrInvalidID,
@@ -459,17 +434,32 @@ func (c *ExerciseController) GetByLessonID(ctx *gin.Context) {
 		return
 	}
 
-	lessonID, err := strconv.ParseUint(lessonIDParam, 10, 32)
+	err = ctx.ShouldBindJSON(request)
 	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: http.StatusBadRequest,
-			Err:  errConstant.ErrInvalidID,
+			Err:  err,
 			Gin:  ctx,
 		})
 		return
 	}
 
-	exercises, err := c.service.GetExercise().GetByLessonID(ctx, uint(lessonID))
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	exercises, err := c.service.GetExercise().Reorder(ctx.Request.Context(), uint(lessonID), request)
 	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: c.getStatusCode(err),
@@ -0,0 +1,232 @@
+package controllers
+
+import (
+	errWrap "manabu-service/common/error"
+	"manabu-service/common/locale"
+	"manabu-service/common/response"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+type ExerciseAttemptController struct {
+	service services.IServiceRegistry
+}
+
+// IExerciseAttemptController defines the contract for exercise attempt HTTP handlers.
+type IExerciseAttemptController interface {
+	// Start handles POST requests to draw a new variant of an exercise.
+	Start(*gin.Context)
+	// GetByID handles GET requests to reproduce the variant of an attempt.
+	GetByID(*gin.Context)
+	// Submit handles POST requests to grade the answers of an attempt.
+	Submit(*gin.Context)
+}
+
+func NewExerciseAttemptController(service services.IServiceRegistry) IExerciseAttemptController {
+	return &ExerciseAttemptController{service: service}
+}
+
+// getStatusCode maps errors to appropriate HTTP status codes
+func (c *ExerciseAttemptController) getStatusCode(err error) int {
+	switch err {
+	case errConstant.ErrExerciseNotFound, errConstant.ErrExerciseAttemptNotFound:
+		return http.StatusNotFound
+	case errConstant.ErrExerciseAttemptAlreadySubmitted:
+		return http.StatusConflict
+	case errConstant.ErrExerciseHasNoQuestions, errConstant.ErrExerciseAttemptQuestionNotFound,
+		errConstant.ErrInvalidSubmittedAnswer, errConstant.ErrInvalidQuestionAnswer:
+		return http.StatusUnprocessableEntity
+	case errConstant.ErrLessonLocked:
+		return http.StatusForbidden
+	case errConstant.ErrInvalidID:
+		return http.StatusBadRequest
+	case errConstant.ErrUnauthorized:
+		return http.StatusUnauthorized
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// Start godoc
+// @Summary      Start Exercise Attempt
+// @Description  Draw a seeded variant of a published exercise of a lesson the learner has unlocked. The exercise settings decide how many questions are drawn from its pool and whether questions and options are shuffled.
+// @Tags         Exercise Attempts
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Exercise ID"
+// @Success      201 {object} dto.ExerciseAttemptSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      403 {object} response.Response "Lesson of the exercise is locked"
+// @Failure      404 {object} response.Response "Exercise not found"
+// @Failure      422 {object} response.Response "Exercise has no published questions"
+// @Failure      500 {object} response.Response
+// @Router       /exercises/{id}/attempts [post]
+func (c *ExerciseAttemptController) Start(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	attempt, err := c.service.GetExerciseAttempt().Start(ctx.Request.Context(), uint(id))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusCreated,
+		Data: attempt,
+		Gin:  ctx,
+	})
+}
+
+// GetByID godoc
+// @Summary      Get Exercise Attempt by ID
+// @Description  Reproduce the exact variant of an attempt from its seed, with the graded results once submitted.
+// @Tags         Exercise Attempts
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Exercise Attempt UUID" format(uuid)
+// @Success      200 {object} dto.ExerciseAttemptSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Exercise attempt not found"
+// @Failure      500 {object} response.Response
+// @Router       /exercise-attempts/{id} [get]
+func (c *ExerciseAttemptController) GetByID(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	attempt, err := c.service.GetExerciseAttempt().GetByID(ctx.Request.Context(), id)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	// Localize explanations for the negotiated locale
+	err = c.service.GetTranslation().LocalizeAnswerResults(ctx, locale.Negotiate(ctx), attempt.Results)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: attempt,
+		Gin:  ctx,
+	})
+}
+
+// Submit godoc
+// @Summary      Submit Exercise Attempt
+// @Description  Grade the answers to the questions of the attempt's variant. Unanswered questions count as incorrect; speaking questions are left for review.
+// @Tags         Exercise Attempts
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Exercise Attempt UUID" format(uuid)
+// @Param        request body dto.SubmitExerciseAttemptRequest true "Answers"
+// @Success      200 {object} dto.ExerciseAttemptSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Exercise attempt not found"
+// @Failure      409 {object} response.Response "Exercise attempt already submitted"
+// @Failure      422 {object} response.Response "Question is not part of this attempt"
+// @Failure      500 {object} response.Response
+// @Router       /exercise-attempts/{id}/submit [post]
+func (c *ExerciseAttemptController) Submit(ctx *gin.Context) {
+	request := &dto.SubmitExerciseAttemptRequest{}
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	err = ctx.ShouldBindJSON(request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	attempt, err := c.service.GetExerciseAttempt().Submit(ctx.Request.Context(), id, request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	// Localize explanations for the negotiated locale
+	err = c.service.GetTranslation().LocalizeAnswerResults(ctx, locale.Negotiate(ctx), attempt.Results)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: attempt,
+		Gin:  ctx,
+	})
+}
@@ -23,8 +23,9 @@ type IExerciseQuestionController interface {
 	GetByID(*gin.Context)
 	Update(*gin.Context)
 	Delete(*gin.Context)
-	UpdatePublishStatus(*gin.Context)
 	GetByExerciseID(*gin.Context)
+	Reorder(*gin.Context)
+	CheckAnswer(*gin.Context)
 }
 
 func NewExerciseQuestionController(service services.IServiceRegistry) IExerciseQuestionController {
@@ -34,18 +35,24 @@ func NewExerciseQuestionController(service services.IServiceRegistry) IExerciseQ
 // getStatusCode maps errors to appropriate HTTP status codes
 func (c *ExerciseQuestionController) getStatusCode(err error) int {
 	switch err {
-	case errConstant.ErrExerciseQuestionNotFound:
+	case errConstant.ErrExerciseQuestionNotFound, errConstant.ErrContentNotFound:
 		return http.StatusNotFound
 	case errConstant.ErrDuplicateQuestionOrderIndex:
 		return http.StatusConflict
-	case errConstant.ErrInvalidExerciseIDQuestion, errConstant.ErrInvalidQuestionText,
+	case errConstant.ErrInvalidExerciseIDQuestion, errConstant.ErrInvalidQuestionOrder, errConstant.ErrInvalidQuestionText,
 		errConstant.ErrInvalidCorrectAnswer, errConstant.ErrInvalidQuestionPoints,
 		errConstant.ErrInvalidQuestionOrderIndex, errConstant.ErrInvalidQuestionType,
 		errConstant.ErrInvalidQuestionOptions, errConstant.ErrInvalidQuestionExplanation,
-		errConstant.ErrInvalidQuestionAudioURL, errConstant.ErrInvalidQuestionImageURL:
+		errConstant.ErrInvalidQuestionAudioURL, errConstant.ErrInvalidQuestionImageURL,
+		errConstant.ErrInvalidQuestionChoices, errConstant.ErrCorrectAnswerNotInChoices,
+		errConstant.ErrInvalidBlankAnswers, errConstant.ErrInvalidMatchingItems,
+		errConstant.ErrInvalidMatchingPairs, errConstant.ErrQuestionOptionsNotAllowed,
+		errConstant.ErrInvalidQuestionAnswer, errConstant.ErrInvalidAnswerStrictness,
+		errConstant.ErrInvalidSubmittedAnswer, errConstant.ErrAnswerRequiresReview,
+		errConstant.ErrMediaNotFound, errConstant.ErrMediaKindMismatch:
 		return http.StatusUnprocessableEntity
-	case errConstant.ErrExerciseQuestionAlreadyPublished, errConstant.ErrExerciseQuestionNotPublished:
-		return http.StatusBadRequest
+	case errConstant.ErrLessonLocked:
+		return http.StatusForbidden
 	case errConstant.ErrInvalidID:
 		return http.StatusBadRequest
 	default:
@@ -65,7 +72,7 @@ func (c *ExerciseQuestionController) getStatusCode(err error) int {
 // @Failure      400 {object} response.Response
 // @Failure      401 {object} response.Response
 // @Failure      409 {object} response.Response "Question with this order_index already exists for this exercise"
-// @Failure      422 {object} response.Response "Invalid exercise ID, question type, points, or order_index"
+// @Failure      422 {object} response.Response "Invalid exercise ID, question type, points, order_index, or options/answer schema"
 // @Failure      500 {object} response.Response
 // @Router       /exercise-questions [post]
 func (c *ExerciseQuestionController) Create(ctx *gin.Context) {
@@ -96,7 +103,7 @@ func (c *ExerciseQuestionController) Create(ctx *gin.Context) {
 		return
 	}
 
-	question, err := c.service.GetExerciseQuestion().Create(ctx, request)
+	question, err := c.service.GetExerciseQuestion().Create(ctx.Request.Context(), request)
 	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: c.getStatusCode(err),
@@ -122,6 +129,7 @@ func (c *ExerciseQuestionController) Create(ctx *gin.Context) {
 // @Param        limit query int false "Items per page" default(10) minimum(1) maximum(100)
 // @Param        exerciseId query int false "Filter by Exercise ID" example(1)
 // @Param        questionType query string false "Filter by Question Type (multiple_choice, fill_blank, matching, listening, speaking)" example("multiple_choice")
+// @Param        jlptSection query string false "Filter by JLPT exam section (vocabulary, grammar, reading, listening)" example("vocabulary")
 // @Param        isPublished query bool false "Filter by publication status" example(true)
 // @Param        search query string false "Search in question text and explanation" example("hiragana")
 // @Param        sortBy query string false "Sort by field (order_index, question_text, created_at, points)" default(order_index) example("order_index")
@@ -242,7 +250,7 @@ func (c *ExerciseQuestionController) GetByID(ctx *gin.Context) {
 // @Failure      401 {object} response.Response
 // @Failure      404 {object} response.Response "Exercise question not found"
 // @Failure      409 {object} response.Response "Question with this order_index already exists for this exercise"
-// @Failure      422 {object} response.Response "Invalid exercise ID, question type, points, or order_index"
+// @Failure      422 {object} response.Response "Invalid exercise ID, question type, points, order_index, or options/answer schema"
 // @Failure      500 {object} response.Response
 // @Router       /exercise-questions/{id} [put]
 func (c *ExerciseQuestionController) Update(ctx *gin.Context) {
@@ -292,7 +300,7 @@ func (c *ExerciseQuestionController) Update(ctx *gin.Context) {
 		return
 	}
 
-	question, err := c.service.GetExerciseQuestion().Update(ctx, request, uint(id))
+	question, err := c.service.GetExerciseQuestion().Update(ctx.Request.Context(), request, uint(id))
 	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: c.getStatusCode(err),
@@ -311,7 +319,7 @@ func (c *ExerciseQuestionController) Update(ctx *gin.Context) {
 
 // Delete godoc
 // @Summary      Delete Exercise Question
-// @Description  Delete an exercise question by ID (admin only)
+// @Description  Move an exercise question to the trash by ID (admin only)
 // @Tags         Exercise Questions
 // @Produce      json
 // @Security     BearerAuth
@@ -361,26 +369,21 @@ func (c *ExerciseQuestionController) Delete(ctx *gin.Context) {
 	})
 }
 
-// UpdatePublishStatus godoc
-// @Summary      Publish/Unpublish Exercise Question
-// @Description  Update the publication status of an exercise question by ID (admin only) - PATCH request with isPublished boolean
-// @Tags         Exercise Questions
-// @Accept       json
+// GetByExerciseID godoc
+// @Summary      Get Questions by Exercise ID (Public)
+// @Description  Retrieve all questions for a specific exercise, ordered by order_index. Note: CorrectAnswer and Explanation are hidden for security. The questions of an exercise whose lesson is locked for the learner are not returned.
+// @Tags         Exercises
 // @Produce      json
-// @Security     BearerAuth
-// @Param        id path int true "Exercise Question ID"
-// @Param        request body dto.PublishExerciseQuestionRequest true "Publish status (true to publish, false to unpublish)"
-// @Success      200 {object} dto.ExerciseQuestionSwaggerResponse
-// @Failure      400 {object} response.Response "Question is already in the requested state"
-// @Failure      401 {object} response.Response
-// @Failure      404 {object} response.Response "Exercise question not found"
-// @Failure      422 {object} response.Response
+// @Param        id path int true "Exercise ID"
+// @Success      200 {object} response.Response{data=[]dto.ExerciseQuestionPublicResponse}
+// @Failure      400 {object} response.Response
+// @Failure      403 {object} response.Response "Lesson of the exercise is locked"
+// @Failure      422 {object} response.Response "Invalid exercise ID"
 // @Failure      500 {object} response.Response
-// @Router       /exercise-questions/{id}/publish [patch]
-func (c *ExerciseQuestionController) UpdatePublishStatus(ctx *gin.Context) {
-	request := &dto.PublishExerciseQuestionRequest{}
-	idParam := ctx.Param("id")
-	if idParam == "" {
+// @Router       /exercises/{id}/questions [get]
+func (c *ExerciseQuestionController) GetByExerciseID(ctx *gin.Context) {
+	exerciseIDParam := ctx.Param("id")
+	if exerciseIDParam == "" {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: http.StatusBadRequest,
 			Err:  errConstant.ErrInvalidID,
@@ -389,7 +392,54 @@ func (c *ExerciseQuestionController) UpdatePublishStatus(ctx *gin.Context) {
 		return
 	}
 
-	id, err := strconv.ParseUint(idParam, 10, 32)
+	exerciseID, err := strconv.ParseUint(exerciseIDParam, 10, 32)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	// Use public method to hide CorrectAnswer and Explanation
+	questions, err := c.service.GetExerciseQuestion().GetByExerciseIDPublic(ctx, uint(exerciseID))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: questions,
+		Gin:  ctx,
+	})
+}
+
+// CheckAnswer godoc
+// @Summary      Check Answer
+// @Description  Grade a submitted answer against a published question. Fill blank answers are normalised according to the question's answer strictness: strict compares exactly, normal ignores full-width/half-width, hiragana/katakana, case, spacing and punctuation differences, and lenient also accepts romaji. Only reports whether the answer is correct; the correct answer and explanation are not returned.
+// @Tags         Exercise Questions
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Exercise Question ID"
+// @Param        request body dto.SubmittedAnswer true "Submitted answer"
+// @Success      200 {object} dto.CheckAnswerSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      403 {object} response.Response "Lesson of the question is locked"
+// @Failure      404 {object} response.Response "Exercise question not found"
+// @Failure      422 {object} response.Response "Submitted answer does not match the question type, or speaking answers need review"
+// @Failure      500 {object} response.Response
+// @Router       /exercise-questions/{id}/check [post]
+func (c *ExerciseQuestionController) CheckAnswer(ctx *gin.Context) {
+	request := &dto.SubmittedAnswer{}
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
 	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: http.StatusBadRequest,
@@ -424,7 +474,7 @@ func (c *ExerciseQuestionController) UpdatePublishStatus(ctx *gin.Context) {
 		return
 	}
 
-	question, err := c.service.GetExerciseQuestion().UpdatePublishStatus(ctx, uint(id), *request.IsPublished)
+	result, err := c.service.GetExerciseQuestion().CheckAnswer(ctx, uint(id), request)
 	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: c.getStatusCode(err),
@@ -436,25 +486,30 @@ func (c *ExerciseQuestionController) UpdatePublishStatus(ctx *gin.Context) {
 
 	response.HttpResponse(response.ParamHTTPResp{
 		Code: http.StatusOK,
-		Data: question,
+		Data: result,
 		Gin:  ctx,
 	})
 }
 
-// GetByExerciseID godoc
-// @Summary      Get Questions by Exercise ID (Public)
-// @Description  Retrieve all questions for a specific exercise, ordered by order_index. Note: CorrectAnswer and Explanation are hidden for security.
-// @Tags         Exercises
+// Reorder godoc
+// @Summary      Reorder Exercise Questions
+// @Description  Set the order of all questions of an exercise at once. The IDs must list every question of the exercise exactly once; order_index follows their position, starting at 1.
+// @Tags         Exercise Questions
+// @Accept       json
 // @Produce      json
+// @Security     BearerAuth
 // @Param        id path int true "Exercise ID"
-// @Success      200 {object} response.Response{data=[]dto.ExerciseQuestionPublicResponse}
+// @Param        request body dto.ReorderRequest true "Question IDs in their new order"
+// @Success      200 {object} response.Response{data=[]dto.ExerciseQuestionResponse}
 // @Failure      400 {object} response.Response
-// @Failure      422 {object} response.Response "Invalid exercise ID"
+// @Failure      401 {object} response.Response
+// @Failure      422 {object} response.Response "Invalid exercise ID or IDs do not match the questions of the exercise"
 // @Failure      500 {object} response.Response
-// @Router       /exercises/{id}/questions [get]
-func (c *ExerciseQuestionController) GetByExerciseID(ctx *gin.Context) {
-	exerciseIDParam := ctx.Param("id")
-	if exerciseIDParam == "" {
+// @Router       /exercises/{id}/questions/order [put]
+func (c *ExerciseQuestionController) Reorder(ctx *gin.Context) {
+	request := &dto.ReorderRequest{}
+	exerciseID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: http.StatusBadRequest,
 			Err:  errConstant.ErrInvalidID,
@@ -463,18 +518,32 @@ func (c *ExerciseQuestionController) GetByExerciseID(ctx *gin.Context) {
 		return
 	}
 
-	exerciseID, err := strconv.ParseUint(exerciseIDParam, 10, 32)
+	err = ctx.ShouldBindJSON(request)
 	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: http.StatusBadRequest,
-			Err:  errConstant.ErrInvalidID,
+			Err:  err,
 			Gin:  ctx,
 		})
 		return
 	}
 
-	// Use public method to hide CorrectAnswer and Explanation
-	questions, err := c.service.GetExerciseQuestion().GetByExerciseIDPublic(ctx, uint(exerciseID))
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	questions, err := c.service.GetExerciseQuestion().Reorder(ctx.Request.Context(), uint(exerciseID), request)
 	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: c.getStatusCode(err),
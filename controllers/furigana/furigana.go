@@ -0,0 +1,92 @@
+package controllers
+
+import (
+	errWrap "manabu-service/common/error"
+	"manabu-service/common/response"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/services"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type FuriganaController struct {
+	service services.IServiceRegistry
+}
+
+type IFuriganaController interface {
+	Generate(*gin.Context)
+}
+
+func NewFuriganaController(service services.IServiceRegistry) IFuriganaController {
+	return &FuriganaController{service: service}
+}
+
+// getStatusCode maps errors to appropriate HTTP status codes
+func (c *FuriganaController) getStatusCode(err error) int {
+	switch err {
+	case errConstant.ErrFuriganaReadingMismatch:
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// Generate godoc
+// @Summary      Generate Furigana
+// @Description  Generate ruby segments and HTML <ruby> markup for Japanese text. When a reading is given it is aligned with the text, otherwise readings are resolved from the vocabulary dictionary
+// @Tags         Tools
+// @Accept       json
+// @Produce      json
+// @Param        request body dto.FuriganaRequest true "Text to annotate"
+// @Success      200 {object} dto.FuriganaSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      422 {object} response.Response "Reading does not match the text"
+// @Failure      500 {object} response.Response
+// @Router       /tools/furigana [post]
+func (c *FuriganaController) Generate(ctx *gin.Context) {
+	request := &dto.FuriganaRequest{}
+
+	err := ctx.ShouldBindJSON(request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	result, err := c.service.GetFurigana().Generate(ctx, request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  ctx,
+	})
+}
@@ -2,6 +2,7 @@ package controllers
 
 import (
 	errWrap "manabu-service/common/error"
+	"manabu-service/common/locale"
 	"manabu-service/common/response"
 	errConstant "manabu-service/constants/error"
 	"manabu-service/domain/dto"
@@ -23,9 +24,9 @@ type ILessonController interface {
 	GetByID(*gin.Context)
 	Update(*gin.Context)
 	Delete(*gin.Context)
-	Publish(*gin.Context)
-	Unpublish(*gin.Context)
 	GetByCourseID(*gin.Context)
+	Reorder(*gin.Context)
+	CheckBlockAnswer(*gin.Context)
 }
 
 func NewLessonController(service services.IServiceRegistry) ILessonController {
@@ -35,15 +36,18 @@ func NewLessonController(service services.IServiceRegistry) ILessonController {
 // getStatusCode maps errors to appropriate HTTP status codes
 func (c *LessonController) getStatusCode(err error) int {
 	switch err {
-	case errConstant.ErrLessonNotFound:
+	case errConstant.ErrLessonNotFound, errConstant.ErrContentNotFound, errConstant.ErrLessonBlockNotQuestion:
 		return http.StatusNotFound
 	case errConstant.ErrDuplicateOrderIndex:
 		return http.StatusConflict
-	case errConstant.ErrInvalidCourseIDLesson, errConstant.ErrInvalidLessonTitle,
-		errConstant.ErrInvalidLessonOrderIndex, errConstant.ErrInvalidLessonEstimatedTime:
+	case errConstant.ErrInvalidCourseIDLesson, errConstant.ErrInvalidLessonOrder, errConstant.ErrInvalidLessonTitle,
+		errConstant.ErrInvalidLessonOrderIndex, errConstant.ErrInvalidLessonEstimatedTime, errConstant.ErrInvalidLessonUnlockScore,
+		errConstant.ErrInvalidLessonBlock, errConstant.ErrLessonBlockVocabulary, errConstant.ErrInvalidLessonBlockQuestion,
+		errConstant.ErrMediaNotFound, errConstant.ErrMediaKindMismatch,
+		errConstant.ErrInvalidSubmittedAnswer, errConstant.ErrAnswerRequiresReview:
 		return http.StatusUnprocessableEntity
-	case errConstant.ErrLessonAlreadyPublished, errConstant.ErrLessonNotPublished:
-		return http.StatusBadRequest
+	case errConstant.ErrLessonLocked:
+		return http.StatusForbidden
 	case errConstant.ErrInvalidID:
 		return http.StatusBadRequest
 	default:
@@ -51,9 +55,15 @@ func (c *LessonController) getStatusCode(err error) int {
 	}
 }
 
+// wantsFurigana reports whether the caller requested ruby markup via ?furigana=true
+func (c *LessonController) wantsFurigana(ctx *gin.Context) bool {
+	furigana, err := strconv.ParseBool(ctx.Query("furigana"))
+	return err == nil && furigana
+}
+
 // Create godoc
 // @Summary      Create Lesson
-// @Description  Create a new lesson entry for a course (admin only)
+// @Description  Create a new lesson entry for a course (admin only). The lesson body is an ordered list of content blocks: markdown, audio, image, vocabulary card, grammar point or inline question. Markdown is sanitised and rendered to HTML in the response. Responses leave out the correct answers of inline questions; they are checked with POST /lessons/{id}/blocks/{index}/check.
 // @Tags         Lessons
 // @Accept       json
 // @Produce      json
@@ -63,7 +73,7 @@ func (c *LessonController) getStatusCode(err error) int {
 // @Failure      400 {object} response.Response
 // @Failure      401 {object} response.Response
 // @Failure      409 {object} response.Response "Lesson with this order_index already exists for this course"
-// @Failure      422 {object} response.Response "Invalid course ID or order_index"
+// @Failure      422 {object} response.Response "Invalid course ID, order_index or content block"
 // @Failure      500 {object} response.Response
 // @Router       /lessons [post]
 func (c *LessonController) Create(ctx *gin.Context) {
@@ -94,7 +104,7 @@ func (c *LessonController) Create(ctx *gin.Context) {
 		return
 	}
 
-	lesson, err := c.service.GetLesson().Create(ctx, request)
+	lesson, err := c.service.GetLesson().Create(ctx.Request.Context(), request)
 	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: c.getStatusCode(err),
@@ -113,7 +123,7 @@ func (c *LessonController) Create(ctx *gin.Context) {
 
 // GetAll godoc
 // @Summary      Get all Lessons
-// @Description  Retrieve lessons with advanced filtering, search, sorting, and pagination
+// @Description  Retrieve lessons with advanced filtering, search, sorting, and pagination. Each published lesson includes whether it is locked for the learner; locked lessons are returned without their content and blocks. With a bearer token, each lesson includes whether the learner bookmarked it.
 // @Tags         Lessons
 // @Produce      json
 // @Param        page query int false "Page number" default(1) minimum(1)
@@ -123,6 +133,8 @@ func (c *LessonController) Create(ctx *gin.Context) {
 // @Param        search query string false "Search in title" example("hiragana")
 // @Param        sortBy query string false "Sort by field (order_index, title, created_at)" default(order_index) example("order_index")
 // @Param        sortOrder query string false "Sort order (asc, desc)" default(asc) example("asc")
+// @Param        furigana query bool false "Include furigana (ruby) markup" default(false)
+// @Param        lang query string false "Response language (en, id); falls back to the Accept-Language header" example("id")
 // @Success      200 {object} dto.LessonListSwaggerResponse
 // @Failure      400 {object} response.Response
 // @Failure      422 {object} response.Response
@@ -165,6 +177,30 @@ func (c *LessonController) GetAll(ctx *gin.Context) {
 		return
 	}
 
+	// Localize translatable fields for the negotiated locale
+	err = c.service.GetTranslation().LocalizeLessons(ctx, locale.Negotiate(ctx), lessons.Data)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	// Attach ruby markup when requested via ?furigana=true
+	if c.wantsFurigana(ctx) {
+		err = c.service.GetFurigana().AnnotateLessons(ctx, lessons.Data)
+		if err != nil {
+			response.HttpResponse(response.ParamHTTPResp{
+				Code: c.getStatusCode(err),
+				Err:  err,
+				Gin:  ctx,
+			})
+			return
+		}
+	}
+
 	// Return response with data array and pagination at same level
 	ctx.JSON(http.StatusOK, gin.H{
 		"message":    http.StatusText(http.StatusOK),
@@ -176,10 +212,12 @@ func (c *LessonController) GetAll(ctx *gin.Context) {
 
 // GetByID godoc
 // @Summary      Get Lesson by ID
-// @Description  Retrieve a specific lesson entry by ID
+// @Description  Retrieve a specific lesson entry by ID. A published lesson includes whether it is locked for the learner; a locked lesson is returned without its content and blocks. With a bearer token, it includes whether the learner bookmarked it.
 // @Tags         Lessons
 // @Produce      json
 // @Param        id path int true "Lesson ID"
+// @Param        furigana query bool false "Include furigana (ruby) markup" default(false)
+// @Param        lang query string false "Response language (en, id); falls back to the Accept-Language header" example("id")
 // @Success      200 {object} dto.LessonSwaggerResponse
 // @Failure      400 {object} response.Response
 // @Failure      404 {object} response.Response "Lesson not found"
@@ -216,6 +254,34 @@ func (c *LessonController) GetByID(ctx *gin.Context) {
 		return
 	}
 
+	// Localize translatable fields for the negotiated locale
+	localized := []dto.LessonResponse{*lesson}
+	err = c.service.GetTranslation().LocalizeLessons(ctx, locale.Negotiate(ctx), localized)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+	lesson = &localized[0]
+
+	// Attach ruby markup when requested via ?furigana=true
+	if c.wantsFurigana(ctx) {
+		annotated := []dto.LessonResponse{*lesson}
+		err = c.service.GetFurigana().AnnotateLessons(ctx, annotated)
+		if err != nil {
+			response.HttpResponse(response.ParamHTTPResp{
+				Code: c.getStatusCode(err),
+				Err:  err,
+				Gin:  ctx,
+			})
+			return
+		}
+		lesson = &annotated[0]
+	}
+
 	response.HttpResponse(response.ParamHTTPResp{
 		Code: http.StatusOK,
 		Data: lesson,
@@ -225,7 +291,7 @@ func (c *LessonController) GetByID(ctx *gin.Context) {
 
 // Update godoc
 // @Summary      Update Lesson
-// @Description  Update an existing lesson entry by ID (admin only)
+// @Description  Update an existing lesson entry by ID (admin only). The content blocks of the lesson are replaced by the given blocks.
 // @Tags         Lessons
 // @Accept       json
 // @Produce      json
@@ -237,7 +303,7 @@ func (c *LessonController) GetByID(ctx *gin.Context) {
 // @Failure      401 {object} response.Response
 // @Failure      404 {object} response.Response "Lesson not found"
 // @Failure      409 {object} response.Response "Lesson with this order_index already exists for this course"
-// @Failure      422 {object} response.Response "Invalid course ID or order_index"
+// @Failure      422 {object} response.Response "Invalid course ID, order_index or content block"
 // @Failure      500 {object} response.Response
 // @Router       /lessons/{id} [put]
 func (c *LessonController) Update(ctx *gin.Context) {
@@ -287,7 +353,7 @@ func (c *LessonController) Update(ctx *gin.Context) {
 		return
 	}
 
-	lesson, err := c.service.GetLesson().Update(ctx, request, uint(id))
+	lesson, err := c.service.GetLesson().Update(ctx.Request.Context(), request, uint(id))
 	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: c.getStatusCode(err),
@@ -306,7 +372,7 @@ func (c *LessonController) Update(ctx *gin.Context) {
 
 // Delete godoc
 // @Summary      Delete Lesson
-// @Description  Delete a lesson entry by ID (admin only)
+// @Description  Move a lesson and its exercises and questions to the trash by ID (admin only)
 // @Tags         Lessons
 // @Produce      json
 // @Security     BearerAuth
@@ -356,22 +422,23 @@ func (c *LessonController) Delete(ctx *gin.Context) {
 	})
 }
 
-// Publish godoc
-// @Summary      Publish Lesson
-// @Description  Publish a lesson by ID (admin only) - sets is_published=true and published_at=now
+// GetByCourseID godoc
+// @Summary      Get Lessons by Course ID
+// @Description  Retrieve all lessons for a specific course, ordered by order_index. Each published lesson includes whether it is locked for the learner and why: incomplete prerequisite courses, an incomplete previous lesson or a score below the unlock threshold. Locked lessons are returned without their content and blocks. Learners without a valid bearer token are treated as having no progress; an invalid or expired token is ignored; teachers and admins see every lesson unlocked. With a bearer token, each lesson includes whether the learner bookmarked it.
 // @Tags         Lessons
 // @Produce      json
 // @Security     BearerAuth
-// @Param        id path int true "Lesson ID"
-// @Success      200 {object} dto.LessonSwaggerResponse
-// @Failure      400 {object} response.Response "Lesson is already published"
-// @Failure      401 {object} response.Response
-// @Failure      404 {object} response.Response "Lesson not found"
+// @Param        id path int true "Course ID"
+// @Param        furigana query bool false "Include furigana (ruby) markup" default(false)
+// @Param        lang query string false "Response language (en, id); falls back to the Accept-Language header" example("id")
+// @Success      200 {object} response.Response{data=[]dto.LessonResponse}
+// @Failure      400 {object} response.Response
+// @Failure      422 {object} response.Response "Invalid course ID"
 // @Failure      500 {object} response.Response
-// @Router       /lessons/{id}/publish [post]
-func (c *LessonController) Publish(ctx *gin.Context) {
-	idParam := ctx.Param("id")
-	if idParam == "" {
+// @Router       /courses/{id}/lessons [get]
+func (c *LessonController) GetByCourseID(ctx *gin.Context) {
+	courseIDParam := ctx.Param("id")
+	if courseIDParam == "" {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: http.StatusBadRequest,
 			Err:  errConstant.ErrInvalidID,
@@ -380,7 +447,7 @@ func (c *LessonController) Publish(ctx *gin.Context) {
 		return
 	}
 
-	id, err := strconv.ParseUint(idParam, 10, 32)
+	courseID, err := strconv.ParseUint(courseIDParam, 10, 32)
 	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: http.StatusBadRequest,
@@ -390,7 +457,18 @@ func (c *LessonController) Publish(ctx *gin.Context) {
 		return
 	}
 
-	lesson, err := c.service.GetLesson().Publish(ctx, uint(id))
+	lessons, err := c.service.GetLesson().GetByCourseID(ctx.Request.Context(), uint(courseID))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	// Localize translatable fields for the negotiated locale
+	err = c.service.GetTranslation().LocalizeLessons(ctx, locale.Negotiate(ctx), lessons)
 	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: c.getStatusCode(err),
@@ -400,29 +478,45 @@ func (c *LessonController) Publish(ctx *gin.Context) {
 		return
 	}
 
+	// Attach ruby markup when requested via ?furigana=true
+	if c.wantsFurigana(ctx) {
+		err = c.service.GetFurigana().AnnotateLessons(ctx, lessons)
+		if err != nil {
+			response.HttpResponse(response.ParamHTTPResp{
+				Code: c.getStatusCode(err),
+				Err:  err,
+				Gin:  ctx,
+			})
+			return
+		}
+	}
+
 	response.HttpResponse(response.ParamHTTPResp{
 		Code: http.StatusOK,
-		Data: lesson,
+		Data: lessons,
 		Gin:  ctx,
 	})
 }
 
-// Unpublish godoc
-// @Summary      Unpublish Lesson
-// @Description  Unpublish a lesson by ID (admin only) - sets is_published=false
+// Reorder godoc
+// @Summary      Reorder Lessons
+// @Description  Set the order of all lessons of a course at once. The IDs must list every lesson of the course exactly once; order_index follows their position, starting at 1.
 // @Tags         Lessons
+// @Accept       json
 // @Produce      json
 // @Security     BearerAuth
-// @Param        id path int true "Lesson ID"
-// @Success      200 {object} dto.LessonSwaggerResponse
-// @Failure      400 {object} response.Response "Lesson is not published"
+// @Param        id path int true "Course ID"
+// @Param        request body dto.ReorderRequest true "Lesson IDs in their new order"
+// @Success      200 {object} response.Response{data=[]dto.LessonResponse}
+// @Failure      400 {object} response.Response
 // @Failure      401 {object} response.Response
-// @Failure      404 {object} response.Response "Lesson not found"
+// @Failure      422 {object} response.Response "Invalid course ID or IDs do not match the lessons of the course"
 // @Failure      500 {object} response.Response
-// @Router       /lessons/{id}/unpublish [post]
-func (c *LessonController) Unpublish(ctx *gin.Context) {
-	idParam := ctx.Param("id")
-	if idParam == "" {
+// @Router       /courses/{id}/lessons/order [put]
+func (c *LessonController) Reorder(ctx *gin.Context) {
+	request := &dto.ReorderRequest{}
+	courseID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: http.StatusBadRequest,
 			Err:  errConstant.ErrInvalidID,
@@ -431,17 +525,32 @@ func (c *LessonController) Unpublish(ctx *gin.Context) {
 		return
 	}
 
-	id, err := strconv.ParseUint(idParam, 10, 32)
+	err = ctx.ShouldBindJSON(request)
 	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: http.StatusBadRequest,
-			Err:  errConstant.ErrInvalidID,
+			Err:  err,
 			Gin:  ctx,
 		})
 		return
 	}
 
-	lesson, err := c.service.GetLesson().Unpublish(ctx, uint(id))
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	lessons, err := c.service.GetLesson().Reorder(ctx.Request.Context(), uint(courseID), request)
 	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: c.getStatusCode(err),
@@ -453,25 +562,33 @@ func (c *LessonController) Unpublish(ctx *gin.Context) {
 
 	response.HttpResponse(response.ParamHTTPResp{
 		Code: http.StatusOK,
-		Data: lesson,
+		Data: lessons,
 		Gin:  ctx,
 	})
 }
 
-// GetByCourseID godoc
-// @Summary      Get Lessons by Course ID
-// @Description  Retrieve all lessons for a specific course, ordered by order_index
+// CheckBlockAnswer godoc
+// @Summary      Check Inline Question Answer
+// @Description  Grade a submitted answer against an inline question block of a published lesson. The block is addressed by its position in the lesson's blocks, starting at 0. Fill blank answers are graded with normal strictness. Returns the correct answer and explanation.
 // @Tags         Lessons
+// @Accept       json
 // @Produce      json
-// @Param        id path int true "Course ID"
-// @Success      200 {object} response.Response{data=[]dto.LessonResponse}
+// @Security     BearerAuth
+// @Param        id path int true "Lesson ID"
+// @Param        index path int true "Block position, starting at 0"
+// @Param        request body dto.SubmittedAnswer true "Submitted answer"
+// @Success      200 {object} dto.InlineCheckAnswerSwaggerResponse
 // @Failure      400 {object} response.Response
-// @Failure      422 {object} response.Response "Invalid course ID"
+// @Failure      401 {object} response.Response
+// @Failure      403 {object} response.Response "Lesson is locked"
+// @Failure      404 {object} response.Response "Lesson not found, or the block is not a question"
+// @Failure      422 {object} response.Response "Submitted answer does not match the question type"
 // @Failure      500 {object} response.Response
-// @Router       /courses/{id}/lessons [get]
-func (c *LessonController) GetByCourseID(ctx *gin.Context) {
-	courseIDParam := ctx.Param("id")
-	if courseIDParam == "" {
+// @Router       /lessons/{id}/blocks/{index}/check [post]
+func (c *LessonController) CheckBlockAnswer(ctx *gin.Context) {
+	request := &dto.SubmittedAnswer{}
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: http.StatusBadRequest,
 			Err:  errConstant.ErrInvalidID,
@@ -480,7 +597,7 @@ func (c *LessonController) GetByCourseID(ctx *gin.Context) {
 		return
 	}
 
-	courseID, err := strconv.ParseUint(courseIDParam, 10, 32)
+	index, err := strconv.Atoi(ctx.Param("index"))
 	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: http.StatusBadRequest,
@@ -490,7 +607,32 @@ func (c *LessonController) GetByCourseID(ctx *gin.Context) {
 		return
 	}
 
-	lessons, err := c.service.GetLesson().GetByCourseID(ctx, uint(courseID))
+	err = ctx.ShouldBindJSON(request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	result, err := c.service.GetLesson().CheckBlockAnswer(ctx, uint(id), index, request)
 	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: c.getStatusCode(err),
@@ -502,7 +644,7 @@ func (c *LessonController) GetByCourseID(ctx *gin.Context) {
 
 	response.HttpResponse(response.ParamHTTPResp{
 		Code: http.StatusOK,
-		Data: lessons,
+		Data: result,
 		Gin:  ctx,
 	})
 }
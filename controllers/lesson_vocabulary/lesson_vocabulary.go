@@ -0,0 +1,202 @@
+package controllers
+
+import (
+	errWrap "manabu-service/common/error"
+	"manabu-service/common/response"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type LessonVocabularyController struct {
+	service services.IServiceRegistry
+}
+
+// ILessonVocabularyController defines the contract for lesson vocabulary HTTP handlers.
+type ILessonVocabularyController interface {
+	// GetByLessonID handles GET requests to list the vocabulary of a lesson.
+	GetByLessonID(*gin.Context)
+	// Set handles PUT requests to replace the vocabulary of a lesson.
+	Set(*gin.Context)
+	// Study handles POST requests to add the vocabulary of a lesson to the learner's deck.
+	Study(*gin.Context)
+}
+
+func NewLessonVocabularyController(service services.IServiceRegistry) ILessonVocabularyController {
+	return &LessonVocabularyController{service: service}
+}
+
+// getStatusCode maps errors to appropriate HTTP status codes
+func (c *LessonVocabularyController) getStatusCode(err error) int {
+	switch err {
+	case errConstant.ErrLessonNotFound, errConstant.ErrLessonNoVocabularies:
+		return http.StatusNotFound
+	case errConstant.ErrLessonVocabularyNotFound:
+		return http.StatusUnprocessableEntity
+	case errConstant.ErrLessonLocked:
+		return http.StatusForbidden
+	case errConstant.ErrInvalidID:
+		return http.StatusBadRequest
+	case errConstant.ErrUnauthorized:
+		return http.StatusUnauthorized
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// GetByLessonID godoc
+// @Summary      Get Lesson Vocabulary
+// @Description  Retrieve the vocabulary taught by a lesson, in lesson order. Only teachers and admins can see the vocabulary of unpublished lessons, and the vocabulary of a lesson that is locked for the learner is not returned.
+// @Tags         Lessons
+// @Produce      json
+// @Param        id path int true "Lesson ID"
+// @Success      200 {object} dto.LessonVocabularyListSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      403 {object} response.Response "Lesson is locked"
+// @Failure      404 {object} response.Response "Lesson not found"
+// @Failure      500 {object} response.Response
+// @Router       /lessons/{id}/vocabularies [get]
+func (c *LessonVocabularyController) GetByLessonID(ctx *gin.Context) {
+	lessonID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	vocabularies, err := c.service.GetLessonVocabulary().GetByLessonID(ctx.Request.Context(), uint(lessonID))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: vocabularies,
+		Gin:  ctx,
+	})
+}
+
+// Set godoc
+// @Summary      Set Lesson Vocabulary
+// @Description  Replace the vocabulary taught by a lesson. Words are ordered by their position in the list. An empty list removes all words from the lesson.
+// @Tags         Lessons
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Lesson ID"
+// @Param        request body dto.SetLessonVocabulariesRequest true "Vocabulary IDs in lesson order"
+// @Success      200 {object} dto.LessonVocabularyListSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Lesson not found"
+// @Failure      422 {object} response.Response "Validation error or unknown vocabulary"
+// @Failure      500 {object} response.Response
+// @Router       /lessons/{id}/vocabularies [put]
+func (c *LessonVocabularyController) Set(ctx *gin.Context) {
+	request := &dto.SetLessonVocabulariesRequest{}
+	lessonID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	err = ctx.ShouldBindJSON(request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	vocabularies, err := c.service.GetLessonVocabulary().Set(ctx.Request.Context(), uint(lessonID), request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: vocabularies,
+		Gin:  ctx,
+	})
+}
+
+// Study godoc
+// @Summary      Study Lesson Vocabulary
+// @Description  Add every word of a lesson to the vocabulary deck of the logged in user. Words the user is already learning are skipped. Words are also added automatically when a lesson is completed. Learners can only study published lessons they have unlocked.
+// @Tags         Lessons
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Lesson ID"
+// @Success      200 {object} dto.StudyLessonVocabulariesSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      403 {object} response.Response "Lesson is locked"
+// @Failure      404 {object} response.Response "Lesson not found or lesson has no vocabulary"
+// @Failure      500 {object} response.Response
+// @Router       /lessons/{id}/vocabularies/study [post]
+func (c *LessonVocabularyController) Study(ctx *gin.Context) {
+	lessonID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	result, err := c.service.GetLessonVocabulary().Study(ctx.Request.Context(), uint(lessonID))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  ctx,
+	})
+}
@@ -0,0 +1,192 @@
+package controllers
+
+import (
+	"manabu-service/common/response"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/services"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type MediaController struct {
+	service services.IServiceRegistry
+}
+
+// IMediaController defines the contract for media upload and download HTTP handlers.
+type IMediaController interface {
+	// Upload handles POST requests to upload an audio or image file.
+	Upload(*gin.Context)
+	// GetByID handles GET requests to retrieve a media record.
+	GetByID(*gin.Context)
+	// GetFile handles GET requests to download the stored file of a media record.
+	GetFile(*gin.Context)
+	// GetThumbnail handles GET requests to download the thumbnail of an image.
+	GetThumbnail(*gin.Context)
+}
+
+func NewMediaController(service services.IServiceRegistry) IMediaController {
+	return &MediaController{service: service}
+}
+
+// getStatusCode maps errors to appropriate HTTP status codes
+func (c *MediaController) getStatusCode(err error) int {
+	switch err {
+	case errConstant.ErrMediaNotFound, errConstant.ErrStorageObjectNotFound:
+		return http.StatusNotFound
+	case errConstant.ErrMediaTooLarge:
+		return http.StatusRequestEntityTooLarge
+	case errConstant.ErrUnsupportedMediaType:
+		return http.StatusUnsupportedMediaType
+	case errConstant.ErrInvalidImage:
+		return http.StatusUnprocessableEntity
+	case errConstant.ErrInvalidID, errConstant.ErrMediaFileRequired:
+		return http.StatusBadRequest
+	case errConstant.ErrUnauthorized:
+		return http.StatusUnauthorized
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// serve streams a media file, which never changes once uploaded
+func (c *MediaController) serve(ctx *gin.Context, thumbnail bool) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	file, contentType, err := c.service.GetMedia().Open(ctx.Request.Context(), id, thumbnail)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+	defer file.Close()
+
+	ctx.DataFromReader(http.StatusOK, -1, contentType, file, map[string]string{
+		"Cache-Control": "public, max-age=31536000, immutable",
+	})
+}
+
+// Upload godoc
+// @Summary      Upload Media
+// @Description  Upload an audio (mp3, ogg, webm, m4a, aac, wav; max 20 MB) or image (jpeg, png, gif; max 5 MB) file. Images get a generated thumbnail. Reference the returned ID from vocabulary, questions and courses.
+// @Tags         Media
+// @Accept       multipart/form-data
+// @Produce      json
+// @Security     BearerAuth
+// @Param        file formData file true "Audio or image file"
+// @Success      201 {object} dto.MediaSwaggerResponse
+// @Failure      400 {object} response.Response "File missing"
+// @Failure      401 {object} response.Response
+// @Failure      413 {object} response.Response "File too large"
+// @Failure      415 {object} response.Response "Unsupported media type"
+// @Failure      422 {object} response.Response "Invalid image"
+// @Failure      500 {object} response.Response
+// @Router       /media [post]
+func (c *MediaController) Upload(ctx *gin.Context) {
+	file, err := ctx.FormFile("file")
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrMediaFileRequired,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	media, err := c.service.GetMedia().Upload(ctx.Request.Context(), file)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusCreated,
+		Data: media,
+		Gin:  ctx,
+	})
+}
+
+// GetByID godoc
+// @Summary      Get Media by ID
+// @Description  Retrieve a media record with the URLs of its file and thumbnail
+// @Tags         Media
+// @Produce      json
+// @Param        id path string true "Media UUID" format(uuid)
+// @Success      200 {object} dto.MediaSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      404 {object} response.Response "Media not found"
+// @Failure      500 {object} response.Response
+// @Router       /media/{id} [get]
+func (c *MediaController) GetByID(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	media, err := c.service.GetMedia().GetByID(ctx.Request.Context(), id)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: media,
+		Gin:  ctx,
+	})
+}
+
+// GetFile godoc
+// @Summary      Download Media File
+// @Description  Stream the uploaded file of a media record
+// @Tags         Media
+// @Produce      octet-stream
+// @Param        id path string true "Media UUID" format(uuid)
+// @Success      200 {file} binary
+// @Failure      400 {object} response.Response
+// @Failure      404 {object} response.Response "Media not found"
+// @Failure      500 {object} response.Response
+// @Router       /media/{id}/file [get]
+func (c *MediaController) GetFile(ctx *gin.Context) {
+	c.serve(ctx, false)
+}
+
+// GetThumbnail godoc
+// @Summary      Download Media Thumbnail
+// @Description  Stream the JPEG thumbnail generated for an image
+// @Tags         Media
+// @Produce      jpeg
+// @Param        id path string true "Media UUID" format(uuid)
+// @Success      200 {file} binary
+// @Failure      400 {object} response.Response
+// @Failure      404 {object} response.Response "Media or thumbnail not found"
+// @Failure      500 {object} response.Response
+// @Router       /media/{id}/thumbnail [get]
+func (c *MediaController) GetThumbnail(ctx *gin.Context) {
+	c.serve(ctx, true)
+}
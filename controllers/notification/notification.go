@@ -0,0 +1,175 @@
+package controllers
+
+import (
+	errWrap "manabu-service/common/error"
+	"manabu-service/common/response"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type NotificationController struct {
+	service services.IServiceRegistry
+}
+
+// INotificationController defines the contract for user notification HTTP handlers.
+type INotificationController interface {
+	// GetAll handles GET requests to retrieve the authenticated user's notifications.
+	GetAll(*gin.Context)
+	// MarkAsRead handles PATCH requests to mark a notification as read.
+	MarkAsRead(*gin.Context)
+	// MarkAllAsRead handles PATCH requests to mark every notification as read.
+	MarkAllAsRead(*gin.Context)
+}
+
+func NewNotificationController(service services.IServiceRegistry) INotificationController {
+	return &NotificationController{service: service}
+}
+
+// getStatusCode maps errors to appropriate HTTP status codes
+func (c *NotificationController) getStatusCode(err error) int {
+	switch err {
+	case errConstant.ErrNotificationNotFound:
+		return http.StatusNotFound
+	case errConstant.ErrInvalidID:
+		return http.StatusBadRequest
+	case errConstant.ErrUnauthorized:
+		return http.StatusUnauthorized
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// GetAll godoc
+// @Summary      Get Notifications
+// @Description  Retrieve the authenticated user's notifications, newest first.
+// @Tags         Notifications
+// @Produce      json
+// @Security     BearerAuth
+// @Param        page query int false "Page number" default(1) minimum(1)
+// @Param        limit query int false "Items per page" default(10) minimum(1) maximum(100)
+// @Param        unread query bool false "Only unread notifications" example(true)
+// @Success      200 {object} dto.NotificationListSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      422 {object} response.Response
+// @Failure      500 {object} response.Response
+// @Router       /notifications [get]
+func (c *NotificationController) GetAll(ctx *gin.Context) {
+	filter := &dto.NotificationFilterRequest{}
+
+	if err := ctx.ShouldBindQuery(filter); err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err := validate.Struct(filter)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	notifications, err := c.service.GetNotification().GetAll(ctx.Request.Context(), filter)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	// Return response with data array and pagination at same level
+	ctx.JSON(http.StatusOK, gin.H{
+		"message":    http.StatusText(http.StatusOK),
+		"pagination": notifications.Pagination,
+		"status":     "success",
+		"data":       notifications.Data,
+	})
+}
+
+// MarkAsRead godoc
+// @Summary      Mark Notification as Read
+// @Description  Mark one of the authenticated user's notifications as read.
+// @Tags         Notifications
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Notification ID"
+// @Success      200 {object} dto.NotificationSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Notification not found"
+// @Failure      500 {object} response.Response
+// @Router       /notifications/{id}/read [patch]
+func (c *NotificationController) MarkAsRead(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	notification, err := c.service.GetNotification().MarkAsRead(ctx.Request.Context(), uint(id))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: notification,
+		Gin:  ctx,
+	})
+}
+
+// MarkAllAsRead godoc
+// @Summary      Mark All Notifications as Read
+// @Description  Mark every unread notification of the authenticated user as read.
+// @Tags         Notifications
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      500 {object} response.Response
+// @Router       /notifications/read-all [patch]
+func (c *NotificationController) MarkAllAsRead(ctx *gin.Context) {
+	err := c.service.GetNotification().MarkAllAsRead(ctx.Request.Context())
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Gin:  ctx,
+	})
+}
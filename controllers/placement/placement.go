@@ -0,0 +1,192 @@
+package controllers
+
+import (
+	errWrap "manabu-service/common/error"
+	"manabu-service/common/response"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/services"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+type PlacementController struct {
+	service services.IServiceRegistry
+}
+
+// IPlacementController defines the contract for placement test HTTP handlers.
+type IPlacementController interface {
+	// Start handles POST requests to start (or resume) a placement test.
+	Start(*gin.Context)
+	// GetByID handles GET requests to retrieve a placement test and its current question or result.
+	GetByID(*gin.Context)
+	// SubmitAnswer handles POST requests to answer the current question of a placement test.
+	SubmitAnswer(*gin.Context)
+}
+
+func NewPlacementController(service services.IServiceRegistry) IPlacementController {
+	return &PlacementController{service: service}
+}
+
+// getStatusCode maps errors to appropriate HTTP status codes
+func (c *PlacementController) getStatusCode(err error) int {
+	switch err {
+	case errConstant.ErrPlacementTestNotFound:
+		return http.StatusNotFound
+	case errConstant.ErrPlacementTestCompleted:
+		return http.StatusConflict
+	case errConstant.ErrPlacementQuestionMismatch, errConstant.ErrPlacementJlptLevelsNotFound,
+		errConstant.ErrInvalidSubmittedAnswer, errConstant.ErrInvalidQuestionAnswer:
+		return http.StatusUnprocessableEntity
+	case errConstant.ErrInvalidID:
+		return http.StatusBadRequest
+	case errConstant.ErrUnauthorized:
+		return http.StatusUnauthorized
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// Start godoc
+// @Summary      Start Placement Test
+// @Description  Start an adaptive placement test serving questions from the easiest JLPT level upwards. An unfinished placement test is resumed instead.
+// @Tags         Placement Tests
+// @Produce      json
+// @Security     BearerAuth
+// @Success      201 {object} dto.PlacementTestSwaggerResponse
+// @Failure      401 {object} response.Response
+// @Failure      422 {object} response.Response "No JLPT levels available"
+// @Failure      500 {object} response.Response
+// @Router       /placement-tests [post]
+func (c *PlacementController) Start(ctx *gin.Context) {
+	test, err := c.service.GetPlacement().Start(ctx.Request.Context())
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusCreated,
+		Data: test,
+		Gin:  ctx,
+	})
+}
+
+// GetByID godoc
+// @Summary      Get Placement Test by ID
+// @Description  Retrieve a placement test with its current question, or its estimated JLPT level and recommended courses once completed.
+// @Tags         Placement Tests
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Placement Test UUID" format(uuid)
+// @Success      200 {object} dto.PlacementTestSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Placement test not found"
+// @Failure      500 {object} response.Response
+// @Router       /placement-tests/{id} [get]
+func (c *PlacementController) GetByID(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	test, err := c.service.GetPlacement().GetByID(ctx.Request.Context(), id)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: test,
+		Gin:  ctx,
+	})
+}
+
+// SubmitAnswer godoc
+// @Summary      Answer Placement Question
+// @Description  Grade the current question and serve the next one. Once the level is estimated, the test is completed, the level is stored on the user profile and published courses at that level are recommended.
+// @Tags         Placement Tests
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Placement Test UUID" format(uuid)
+// @Param        request body dto.SubmitPlacementAnswerRequest true "Answer to the current question"
+// @Success      200 {object} dto.PlacementTestSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Placement test not found"
+// @Failure      409 {object} response.Response "Placement test already completed"
+// @Failure      422 {object} response.Response "Answer does not match the current question"
+// @Failure      500 {object} response.Response
+// @Router       /placement-tests/{id}/answers [post]
+func (c *PlacementController) SubmitAnswer(ctx *gin.Context) {
+	request := &dto.SubmitPlacementAnswerRequest{}
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	err = ctx.ShouldBindJSON(request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	test, err := c.service.GetPlacement().SubmitAnswer(ctx.Request.Context(), id, request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: test,
+		Gin:  ctx,
+	})
+}
@@ -0,0 +1,179 @@
+package controllers
+
+import (
+	errWrap "manabu-service/common/error"
+	"manabu-service/common/response"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type QuizController struct {
+	service services.IServiceRegistry
+}
+
+// IQuizController defines the contract for vocabulary quiz HTTP handlers.
+type IQuizController interface {
+	// Generate handles GET requests for an ad-hoc vocabulary quiz.
+	Generate(*gin.Context)
+	// CreateQuestions handles POST requests to generate draft questions for an exercise.
+	CreateQuestions(*gin.Context)
+}
+
+func NewQuizController(service services.IServiceRegistry) IQuizController {
+	return &QuizController{service: service}
+}
+
+// getStatusCode maps errors to appropriate HTTP status codes
+func (c *QuizController) getStatusCode(err error) int {
+	switch err {
+	case errConstant.ErrLessonNotFound, errConstant.ErrExerciseNotFound:
+		return http.StatusNotFound
+	case errConstant.ErrQuizSourceRequired, errConstant.ErrQuizNoVocabulary:
+		return http.StatusUnprocessableEntity
+	case errConstant.ErrDuplicateQuestionOrderIndex:
+		return http.StatusConflict
+	case errConstant.ErrInvalidID:
+		return http.StatusBadRequest
+	case errConstant.ErrUnauthorized:
+		return http.StatusUnauthorized
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// Generate godoc
+// @Summary      Generate Vocabulary Quiz
+// @Description  Generate multiple-choice questions from the vocabulary of a category, JLPT level or lesson for instant practice. Nothing is stored. Wrong choices are taken from words of the same JLPT level and part of speech where possible. Question types are meaning (pick the word for a meaning), reading (pick the reading of a word) and listening (pick the word that is heard, only for words with audio).
+// @Tags         Vocabularies
+// @Produce      json
+// @Param        categoryId query int false "Category ID"
+// @Param        jlptLevelId query int false "JLPT level ID"
+// @Param        lessonId query int false "Lesson ID"
+// @Param        types query []string false "Question types (meaning, reading, listening)" collectionFormat(multi)
+// @Param        count query int false "Number of questions (default 10, max 50)"
+// @Param        choices query int false "Number of choices per question (default 4, 2 to 6)"
+// @Success      200 {object} dto.QuizQuestionListSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      404 {object} response.Response "Lesson not found"
+// @Failure      422 {object} response.Response "Validation error, no source given or not enough vocabulary"
+// @Failure      500 {object} response.Response
+// @Router       /vocabularies/quiz [get]
+func (c *QuizController) Generate(ctx *gin.Context) {
+	request := &dto.VocabularyQuizRequest{}
+	if err := ctx.ShouldBindQuery(request); err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err := validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	questions, err := c.service.GetQuiz().Generate(ctx.Request.Context(), request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: questions,
+		Gin:  ctx,
+	})
+}
+
+// CreateQuestions godoc
+// @Summary      Generate Exercise Questions
+// @Description  Generate multiple-choice questions from the vocabulary of a category, JLPT level or lesson and add them to an exercise as unpublished drafts, after its existing questions. Wrong choices are taken from words of the same JLPT level and part of speech where possible.
+// @Tags         Exercises
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Exercise ID"
+// @Param        request body dto.GenerateExerciseQuestionsRequest true "Vocabulary source and question settings"
+// @Success      201 {object} response.Response{data=[]dto.ExerciseQuestionResponse}
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Exercise or lesson not found"
+// @Failure      409 {object} response.Response "Question order index conflict"
+// @Failure      422 {object} response.Response "Validation error, no source given or not enough vocabulary"
+// @Failure      500 {object} response.Response
+// @Router       /exercises/{id}/questions/generate [post]
+func (c *QuizController) CreateQuestions(ctx *gin.Context) {
+	request := &dto.GenerateExerciseQuestionsRequest{}
+	exerciseID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	err = ctx.ShouldBindJSON(request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	questions, err := c.service.GetQuiz().CreateQuestions(ctx.Request.Context(), uint(exerciseID), request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusCreated,
+		Data: questions,
+		Gin:  ctx,
+	})
+}
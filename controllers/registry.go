@@ -1,17 +1,38 @@
 package controllers
 
 import (
+	bookmarkController "manabu-service/controllers/bookmark"
 	categoryController "manabu-service/controllers/category"
+	contentRevisionController "manabu-service/controllers/content_revision"
+	contentWorkflowController "manabu-service/controllers/content_workflow"
 	courseController "manabu-service/controllers/course"
+	courseBundleController "manabu-service/controllers/course_bundle"
+	coursePrerequisiteController "manabu-service/controllers/course_prerequisite"
+	deckController "manabu-service/controllers/deck"
+	deckCardController "manabu-service/controllers/deck_card"
+	examController "manabu-service/controllers/exam"
+	examAttemptController "manabu-service/controllers/exam_attempt"
+	exampleSentenceController "manabu-service/controllers/example_sentence"
 	exerciseController "manabu-service/controllers/exercise"
+	exerciseAttemptController "manabu-service/controllers/exercise_attempt"
 	exerciseQuestionController "manabu-service/controllers/exercise_question"
+	furiganaController "manabu-service/controllers/furigana"
 	jlptLevelController "manabu-service/controllers/jlpt_level"
 	lessonController "manabu-service/controllers/lesson"
+	lessonVocabularyController "manabu-service/controllers/lesson_vocabulary"
+	mediaController "manabu-service/controllers/media"
+	notificationController "manabu-service/controllers/notification"
+	placementController "manabu-service/controllers/placement"
+	quizController "manabu-service/controllers/quiz"
+	speakingSubmissionController "manabu-service/controllers/speaking_submission"
 	tagController "manabu-service/controllers/tag"
+	translationController "manabu-service/controllers/translation"
+	trashController "manabu-service/controllers/trash"
 	controllers "manabu-service/controllers/user"
 	userCourseProgressController "manabu-service/controllers/user_course_progress"
 	userVocabStatusController "manabu-service/controllers/user_vocabulary_status"
 	vocabularyController "manabu-service/controllers/vocabulary"
+	vocabularyNoteController "manabu-service/controllers/vocabulary_note"
 	"manabu-service/services"
 )
 
@@ -31,6 +52,27 @@ type IControllerRegistry interface {
 	GetExerciseController() exerciseController.IExerciseController
 	GetExerciseQuestionController() exerciseQuestionController.IExerciseQuestionController
 	GetUserCourseProgressController() userCourseProgressController.IUserCourseProgressController
+	GetExampleSentenceController() exampleSentenceController.IExampleSentenceController
+	GetFuriganaController() furiganaController.IFuriganaController
+	GetTranslationController() translationController.ITranslationController
+	GetExamController() examController.IExamController
+	GetExamAttemptController() examAttemptController.IExamAttemptController
+	GetPlacementController() placementController.IPlacementController
+	GetExerciseAttemptController() exerciseAttemptController.IExerciseAttemptController
+	GetSpeakingSubmissionController() speakingSubmissionController.ISpeakingSubmissionController
+	GetNotificationController() notificationController.INotificationController
+	GetMediaController() mediaController.IMediaController
+	GetContentWorkflowController() contentWorkflowController.IContentWorkflowController
+	GetContentRevisionController() contentRevisionController.IContentRevisionController
+	GetTrashController() trashController.ITrashController
+	GetCourseBundleController() courseBundleController.ICourseBundleController
+	GetCoursePrerequisiteController() coursePrerequisiteController.ICoursePrerequisiteController
+	GetLessonVocabularyController() lessonVocabularyController.ILessonVocabularyController
+	GetQuizController() quizController.IQuizController
+	GetVocabularyNoteController() vocabularyNoteController.IVocabularyNoteController
+	GetDeckController() deckController.IDeckController
+	GetDeckCardController() deckCardController.IDeckCardController
+	GetBookmarkController() bookmarkController.IBookmarkController
 }
 
 func NewControllerRegistry(service services.IServiceRegistry) IControllerRegistry {
@@ -80,3 +122,87 @@ func (u *Registry) GetExerciseQuestionController() exerciseQuestionController.IE
 func (u *Registry) GetUserCourseProgressController() userCourseProgressController.IUserCourseProgressController {
 	return userCourseProgressController.NewUserCourseProgressController(u.service)
 }
+
+func (u *Registry) GetExampleSentenceController() exampleSentenceController.IExampleSentenceController {
+	return exampleSentenceController.NewExampleSentenceController(u.service)
+}
+
+func (u *Registry) GetFuriganaController() furiganaController.IFuriganaController {
+	return furiganaController.NewFuriganaController(u.service)
+}
+
+func (u *Registry) GetTranslationController() translationController.ITranslationController {
+	return translationController.NewTranslationController(u.service)
+}
+
+func (u *Registry) GetExamController() examController.IExamController {
+	return examController.NewExamController(u.service)
+}
+
+func (u *Registry) GetExamAttemptController() examAttemptController.IExamAttemptController {
+	return examAttemptController.NewExamAttemptController(u.service)
+}
+
+func (u *Registry) GetPlacementController() placementController.IPlacementController {
+	return placementController.NewPlacementController(u.service)
+}
+
+func (u *Registry) GetExerciseAttemptController() exerciseAttemptController.IExerciseAttemptController {
+	return exerciseAttemptController.NewExerciseAttemptController(u.service)
+}
+
+func (u *Registry) GetSpeakingSubmissionController() speakingSubmissionController.ISpeakingSubmissionController {
+	return speakingSubmissionController.NewSpeakingSubmissionController(u.service)
+}
+
+func (u *Registry) GetNotificationController() notificationController.INotificationController {
+	return notificationController.NewNotificationController(u.service)
+}
+
+func (u *Registry) GetMediaController() mediaController.IMediaController {
+	return mediaController.NewMediaController(u.service)
+}
+
+func (u *Registry) GetContentWorkflowController() contentWorkflowController.IContentWorkflowController {
+	return contentWorkflowController.NewContentWorkflowController(u.service)
+}
+
+func (u *Registry) GetContentRevisionController() contentRevisionController.IContentRevisionController {
+	return contentRevisionController.NewContentRevisionController(u.service)
+}
+
+func (u *Registry) GetTrashController() trashController.ITrashController {
+	return trashController.NewTrashController(u.service)
+}
+
+func (u *Registry) GetCourseBundleController() courseBundleController.ICourseBundleController {
+	return courseBundleController.NewCourseBundleController(u.service)
+}
+
+func (u *Registry) GetCoursePrerequisiteController() coursePrerequisiteController.ICoursePrerequisiteController {
+	return coursePrerequisiteController.NewCoursePrerequisiteController(u.service)
+}
+
+func (u *Registry) GetLessonVocabularyController() lessonVocabularyController.ILessonVocabularyController {
+	return lessonVocabularyController.NewLessonVocabularyController(u.service)
+}
+
+func (u *Registry) GetQuizController() quizController.IQuizController {
+	return quizController.NewQuizController(u.service)
+}
+
+func (u *Registry) GetVocabularyNoteController() vocabularyNoteController.IVocabularyNoteController {
+	return vocabularyNoteController.NewVocabularyNoteController(u.service)
+}
+
+func (u *Registry) GetDeckController() deckController.IDeckController {
+	return deckController.NewDeckController(u.service)
+}
+
+func (u *Registry) GetDeckCardController() deckCardController.IDeckCardController {
+	return deckCardController.NewDeckCardController(u.service)
+}
+
+func (u *Registry) GetBookmarkController() bookmarkController.IBookmarkController {
+	return bookmarkController.NewBookmarkController(u.service)
+}
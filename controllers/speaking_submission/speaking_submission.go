@@ -0,0 +1,383 @@
+package controllers
+
+import (
+	errWrap "manabu-service/common/error"
+	"manabu-service/common/response"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+type SpeakingSubmissionController struct {
+	service services.IServiceRegistry
+}
+
+// ISpeakingSubmissionController defines the contract for speaking recording and review HTTP handlers.
+type ISpeakingSubmissionController interface {
+	// Submit handles POST requests to upload a recording answering a speaking question.
+	Submit(*gin.Context)
+	// GetAll handles GET requests to retrieve the authenticated learner's submissions.
+	GetAll(*gin.Context)
+	// GetByID handles GET requests to retrieve a single submission.
+	GetByID(*gin.Context)
+	// GetAudio handles GET requests to stream the recording of a submission.
+	GetAudio(*gin.Context)
+	// GetReviewQueue handles GET requests to retrieve submissions waiting for review.
+	GetReviewQueue(*gin.Context)
+	// Review handles POST requests to score a submission with feedback.
+	Review(*gin.Context)
+}
+
+func NewSpeakingSubmissionController(service services.IServiceRegistry) ISpeakingSubmissionController {
+	return &SpeakingSubmissionController{service: service}
+}
+
+// getStatusCode maps errors to appropriate HTTP status codes
+func (c *SpeakingSubmissionController) getStatusCode(err error) int {
+	switch err {
+	case errConstant.ErrSpeakingSubmissionNotFound, errConstant.ErrExerciseQuestionNotFound,
+		errConstant.ErrStorageObjectNotFound:
+		return http.StatusNotFound
+	case errConstant.ErrSpeakingAlreadyReviewed:
+		return http.StatusConflict
+	case errConstant.ErrNotSpeakingQuestion, errConstant.ErrInvalidRecording,
+		errConstant.ErrInvalidSpeakingReviewScore:
+		return http.StatusUnprocessableEntity
+	case errConstant.ErrRecordingTooLarge:
+		return http.StatusRequestEntityTooLarge
+	case errConstant.ErrInvalidID:
+		return http.StatusBadRequest
+	case errConstant.ErrUnauthorized:
+		return http.StatusUnauthorized
+	case errConstant.ErrForbidden:
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// bindFilter binds and validates the submission list query parameters
+func (c *SpeakingSubmissionController) bindFilter(ctx *gin.Context) (*dto.SpeakingSubmissionFilterRequest, bool) {
+	filter := &dto.SpeakingSubmissionFilterRequest{}
+
+	if err := ctx.ShouldBindQuery(filter); err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return nil, false
+	}
+
+	validate := validator.New()
+	err := validate.Struct(filter)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return nil, false
+	}
+
+	return filter, true
+}
+
+// Submit godoc
+// @Summary      Submit Speaking Recording
+// @Description  Upload an audio recording answering a published speaking question. The recording is queued for review by a teacher.
+// @Tags         Speaking Submissions
+// @Accept       multipart/form-data
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Exercise Question ID"
+// @Param        audio formData file true "Audio recording (webm, ogg, mp3, m4a, aac or wav, max 10 MB)"
+// @Success      201 {object} dto.SpeakingSubmissionSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Exercise question not found"
+// @Failure      413 {object} response.Response "Recording too large"
+// @Failure      422 {object} response.Response "Not a speaking question or unsupported audio format"
+// @Failure      500 {object} response.Response
+// @Router       /exercise-questions/{id}/recordings [post]
+func (c *SpeakingSubmissionController) Submit(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	file, err := ctx.FormFile("audio")
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidRecording,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	submission, err := c.service.GetSpeakingSubmission().Submit(ctx.Request.Context(), uint(id), file)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusCreated,
+		Data: submission,
+		Gin:  ctx,
+	})
+}
+
+// GetAll godoc
+// @Summary      Get My Speaking Submissions
+// @Description  Retrieve the authenticated learner's speaking submissions, newest first, with scores and feedback once reviewed.
+// @Tags         Speaking Submissions
+// @Produce      json
+// @Security     BearerAuth
+// @Param        page query int false "Page number" default(1) minimum(1)
+// @Param        limit query int false "Items per page" default(10) minimum(1) maximum(100)
+// @Param        questionId query int false "Filter by Exercise Question ID" example(1)
+// @Param        status query string false "Filter by status (pending, reviewed)" example("reviewed")
+// @Success      200 {object} dto.SpeakingSubmissionListSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      422 {object} response.Response
+// @Failure      500 {object} response.Response
+// @Router       /speaking-submissions [get]
+func (c *SpeakingSubmissionController) GetAll(ctx *gin.Context) {
+	filter, ok := c.bindFilter(ctx)
+	if !ok {
+		return
+	}
+
+	submissions, err := c.service.GetSpeakingSubmission().GetAll(ctx.Request.Context(), filter)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	// Return response with data array and pagination at same level
+	ctx.JSON(http.StatusOK, gin.H{
+		"message":    http.StatusText(http.StatusOK),
+		"pagination": submissions.Pagination,
+		"status":     "success",
+		"data":       submissions.Data,
+	})
+}
+
+// GetByID godoc
+// @Summary      Get Speaking Submission by ID
+// @Description  Retrieve a speaking submission. Learners can only access their own submissions; teachers and admins can access any submission.
+// @Tags         Speaking Submissions
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Speaking Submission UUID" format(uuid)
+// @Success      200 {object} dto.SpeakingSubmissionSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Speaking submission not found"
+// @Failure      500 {object} response.Response
+// @Router       /speaking-submissions/{id} [get]
+func (c *SpeakingSubmissionController) GetByID(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	submission, err := c.service.GetSpeakingSubmission().GetByID(ctx.Request.Context(), id)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: submission,
+		Gin:  ctx,
+	})
+}
+
+// GetAudio godoc
+// @Summary      Get Speaking Recording
+// @Description  Stream the audio recording of a speaking submission.
+// @Tags         Speaking Submissions
+// @Produce      octet-stream
+// @Security     BearerAuth
+// @Param        id path string true "Speaking Submission UUID" format(uuid)
+// @Success      200 {file} binary
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Speaking submission not found"
+// @Failure      500 {object} response.Response
+// @Router       /speaking-submissions/{id}/audio [get]
+func (c *SpeakingSubmissionController) GetAudio(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	audio, contentType, err := c.service.GetSpeakingSubmission().GetAudio(ctx.Request.Context(), id)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+	defer audio.Close()
+
+	ctx.DataFromReader(http.StatusOK, -1, contentType, audio, nil)
+}
+
+// GetReviewQueue godoc
+// @Summary      Get Speaking Review Queue
+// @Description  Retrieve speaking submissions waiting for review, oldest first. Only teachers and admins can access the queue.
+// @Tags         Speaking Submissions
+// @Produce      json
+// @Security     BearerAuth
+// @Param        page query int false "Page number" default(1) minimum(1)
+// @Param        limit query int false "Items per page" default(10) minimum(1) maximum(100)
+// @Param        questionId query int false "Filter by Exercise Question ID" example(1)
+// @Param        status query string false "Filter by status (pending, reviewed), defaults to pending" example("pending")
+// @Success      200 {object} dto.SpeakingSubmissionListSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      403 {object} response.Response "Not a teacher or admin"
+// @Failure      422 {object} response.Response
+// @Failure      500 {object} response.Response
+// @Router       /speaking-submissions/review-queue [get]
+func (c *SpeakingSubmissionController) GetReviewQueue(ctx *gin.Context) {
+	filter, ok := c.bindFilter(ctx)
+	if !ok {
+		return
+	}
+
+	submissions, err := c.service.GetSpeakingSubmission().GetReviewQueue(ctx.Request.Context(), filter)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	// Return response with data array and pagination at same level
+	ctx.JSON(http.StatusOK, gin.H{
+		"message":    http.StatusText(http.StatusOK),
+		"pagination": submissions.Pagination,
+		"status":     "success",
+		"data":       submissions.Data,
+	})
+}
+
+// Review godoc
+// @Summary      Review Speaking Submission
+// @Description  Score a pending speaking submission with feedback. The learner is notified of the graded result. Only teachers and admins can review.
+// @Tags         Speaking Submissions
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Speaking Submission UUID" format(uuid)
+// @Param        request body dto.ReviewSpeakingSubmissionRequest true "Score and feedback"
+// @Success      200 {object} dto.SpeakingSubmissionSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      403 {object} response.Response "Not a teacher or admin"
+// @Failure      404 {object} response.Response "Speaking submission not found"
+// @Failure      409 {object} response.Response "Submission already reviewed"
+// @Failure      422 {object} response.Response "Score exceeds question points"
+// @Failure      500 {object} response.Response
+// @Router       /speaking-submissions/{id}/review [post]
+func (c *SpeakingSubmissionController) Review(ctx *gin.Context) {
+	request := &dto.ReviewSpeakingSubmissionRequest{}
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	err = ctx.ShouldBindJSON(request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	submission, err := c.service.GetSpeakingSubmission().Review(ctx.Request.Context(), id, request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: submission,
+		Gin:  ctx,
+	})
+}
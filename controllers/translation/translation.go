@@ -0,0 +1,287 @@
+package controllers
+
+import (
+	errWrap "manabu-service/common/error"
+	"manabu-service/common/response"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type TranslationController struct {
+	service services.IServiceRegistry
+}
+
+type ITranslationController interface {
+	GetAll(*gin.Context)
+	GetMissing(*gin.Context)
+	Upsert(*gin.Context)
+	Delete(*gin.Context)
+}
+
+func NewTranslationController(service services.IServiceRegistry) ITranslationController {
+	return &TranslationController{service: service}
+}
+
+// getStatusCode maps errors to appropriate HTTP status codes
+func (c *TranslationController) getStatusCode(err error) int {
+	switch err {
+	case errConstant.ErrTranslationNotFound:
+		return http.StatusNotFound
+	case errConstant.ErrInvalidTranslationEntityType, errConstant.ErrInvalidTranslationLocale, errConstant.ErrTranslationEntityNotFound:
+		return http.StatusUnprocessableEntity
+	case errConstant.ErrTranslationAdminOnly:
+		return http.StatusForbidden
+	case errConstant.ErrInvalidID:
+		return http.StatusBadRequest
+	case errConstant.ErrUnauthorized:
+		return http.StatusUnauthorized
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// GetAll godoc
+// @Summary      Get all Translations
+// @Description  Retrieve stored translations with filtering and pagination (admin only)
+// @Tags         Translations
+// @Produce      json
+// @Security     BearerAuth
+// @Param        page query int false "Page number" default(1) minimum(1)
+// @Param        limit query int false "Items per page" default(10) minimum(1) maximum(100)
+// @Param        entityType query string false "Filter by entity type (vocabulary, course, lesson, exercise_question)" example("vocabulary")
+// @Param        entityId query int false "Filter by entity ID" example(1)
+// @Param        locale query string false "Filter by locale" example("id")
+// @Success      200 {object} dto.TranslationListSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      403 {object} response.Response "Admin only"
+// @Failure      422 {object} response.Response
+// @Failure      500 {object} response.Response
+// @Router       /translations [get]
+func (c *TranslationController) GetAll(ctx *gin.Context) {
+	filter := &dto.TranslationFilterRequest{}
+
+	if err := ctx.ShouldBindQuery(filter); err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err := validate.Struct(filter)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	translations, err := c.service.GetTranslation().GetAll(ctx, filter)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	// Return response with data array and pagination at same level
+	ctx.JSON(http.StatusOK, gin.H{
+		"message":    http.StatusText(http.StatusOK),
+		"pagination": translations.Pagination,
+		"status":     "success",
+		"data":       translations.Data,
+	})
+}
+
+// GetMissing godoc
+// @Summary      Get Missing Translations
+// @Description  List entities of a type whose translatable field has no translation for a locale (admin only)
+// @Tags         Translations
+// @Produce      json
+// @Security     BearerAuth
+// @Param        entityType query string true "Entity type (vocabulary, course, lesson, exercise_question)" example("vocabulary")
+// @Param        locale query string true "Target locale" example("id")
+// @Param        page query int false "Page number" default(1) minimum(1)
+// @Param        limit query int false "Items per page" default(10) minimum(1) maximum(100)
+// @Success      200 {object} dto.MissingTranslationListSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      403 {object} response.Response "Admin only"
+// @Failure      422 {object} response.Response "Unsupported entity type or locale"
+// @Failure      500 {object} response.Response
+// @Router       /translations/missing [get]
+func (c *TranslationController) GetMissing(ctx *gin.Context) {
+	filter := &dto.MissingTranslationFilterRequest{}
+
+	if err := ctx.ShouldBindQuery(filter); err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err := validate.Struct(filter)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	missing, err := c.service.GetTranslation().GetMissing(ctx, filter)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	// Return response with data array and pagination at same level
+	ctx.JSON(http.StatusOK, gin.H{
+		"message":    http.StatusText(http.StatusOK),
+		"pagination": missing.Pagination,
+		"status":     "success",
+		"data":       missing.Data,
+	})
+}
+
+// Upsert godoc
+// @Summary      Save Translation
+// @Description  Create or replace the translation of an entity field for a locale (admin only)
+// @Tags         Translations
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body dto.UpsertTranslationRequest true "Translation details"
+// @Success      200 {object} dto.TranslationSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      403 {object} response.Response "Admin only"
+// @Failure      422 {object} response.Response "Unsupported entity type, field or locale, or entity not found"
+// @Failure      500 {object} response.Response
+// @Router       /translations [put]
+func (c *TranslationController) Upsert(ctx *gin.Context) {
+	request := &dto.UpsertTranslationRequest{}
+
+	err := ctx.ShouldBindJSON(request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	translation, err := c.service.GetTranslation().Upsert(ctx, request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: translation,
+		Gin:  ctx,
+	})
+}
+
+// Delete godoc
+// @Summary      Delete Translation
+// @Description  Delete a translation by ID; the entity falls back to its default locale text (admin only)
+// @Tags         Translations
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Translation ID"
+// @Success      200 {object} response.Response
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      403 {object} response.Response "Admin only"
+// @Failure      404 {object} response.Response "Translation not found"
+// @Failure      500 {object} response.Response
+// @Router       /translations/{id} [delete]
+func (c *TranslationController) Delete(ctx *gin.Context) {
+	idParam := ctx.Param("id")
+	if idParam == "" {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	err = c.service.GetTranslation().Delete(ctx, uint(id))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	successMessage := "Translation deleted successfully"
+	response.HttpResponse(response.ParamHTTPResp{
+		Code:    http.StatusOK,
+		Message: &successMessage,
+		Gin:     ctx,
+	})
+}
@@ -0,0 +1,153 @@
+package controllers
+
+import (
+	errWrap "manabu-service/common/error"
+	"manabu-service/common/response"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type TrashController struct {
+	service services.IServiceRegistry
+}
+
+// ITrashController defines the contract for trash HTTP handlers.
+type ITrashController interface {
+	// GetAll handles GET requests to list trashed content.
+	GetAll(*gin.Context)
+	// Restore handles POST requests to take content out of the trash.
+	Restore(*gin.Context)
+}
+
+func NewTrashController(service services.IServiceRegistry) ITrashController {
+	return &TrashController{service: service}
+}
+
+// getStatusCode maps errors to appropriate HTTP status codes
+func (c *TrashController) getStatusCode(err error) int {
+	switch err {
+	case errConstant.ErrTrashItemNotFound:
+		return http.StatusNotFound
+	case errConstant.ErrTrashParentDeleted, errConstant.ErrTrashRestoreConflict:
+		return http.StatusConflict
+	case errConstant.ErrTrashAdminOnly:
+		return http.StatusForbidden
+	case errConstant.ErrInvalidID, errConstant.ErrInvalidContentType:
+		return http.StatusBadRequest
+	case errConstant.ErrUnauthorized:
+		return http.StatusUnauthorized
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// GetAll godoc
+// @Summary      List Trash
+// @Description  Retrieve deleted courses, lessons, exercises and questions, most recently deleted first. Items deleted together with their parent are restored with it and are not listed (admins only).
+// @Tags         Trash
+// @Produce      json
+// @Security     BearerAuth
+// @Param        contentType query string false "Filter by content type" Enums(course, lesson, exercise, exercise_question)
+// @Param        page query int false "Page number" default(1) minimum(1)
+// @Param        limit query int false "Items per page" default(10) minimum(1) maximum(100)
+// @Success      200 {object} dto.TrashListSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      403 {object} response.Response "Admin only"
+// @Failure      422 {object} response.Response "Validation error"
+// @Failure      500 {object} response.Response
+// @Router       /trash [get]
+func (c *TrashController) GetAll(ctx *gin.Context) {
+	filter := &dto.TrashFilterRequest{}
+
+	if err := ctx.ShouldBindQuery(filter); err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err := validate.Struct(filter)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	trash, err := c.service.GetTrash().GetAll(ctx.Request.Context(), filter)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	// Return response with data array and pagination at same level
+	ctx.JSON(http.StatusOK, gin.H{
+		"message":    http.StatusText(http.StatusOK),
+		"pagination": trash.Pagination,
+		"status":     "success",
+		"data":       trash.Data,
+	})
+}
+
+// Restore godoc
+// @Summary      Restore From Trash
+// @Description  Restore a deleted course, lesson, exercise or question together with the content deleted with it. The parent must be restored first (admins only).
+// @Tags         Trash
+// @Produce      json
+// @Security     BearerAuth
+// @Param        contentType path string true "Content type" Enums(course, lesson, exercise, exercise_question)
+// @Param        id path int true "Content ID"
+// @Success      200 {object} response.Response
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      403 {object} response.Response "Admin only"
+// @Failure      404 {object} response.Response "Item not found in trash"
+// @Failure      409 {object} response.Response "Parent still in trash or duplicate title/order"
+// @Failure      500 {object} response.Response
+// @Router       /trash/{contentType}/{id}/restore [post]
+func (c *TrashController) Restore(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	err = c.service.GetTrash().Restore(ctx.Request.Context(), ctx.Param("contentType"), uint(id))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Gin:  ctx,
+	})
+}
@@ -2,6 +2,7 @@ package controllers
 
 import (
 	errWrap "manabu-service/common/error"
+	"manabu-service/common/locale"
 	"manabu-service/common/response"
 	errConstant "manabu-service/constants/error"
 	"manabu-service/domain/dto"
@@ -36,7 +37,8 @@ func (c *VocabularyController) getStatusCode(err error) int {
 		return http.StatusNotFound
 	case errConstant.ErrVocabularyDuplicate:
 		return http.StatusConflict
-	case errConstant.ErrInvalidJlptLevelID, errConstant.ErrInvalidCategoryID, errConstant.ErrInvalidDifficulty, errConstant.ErrInvalidPartOfSpeech:
+	case errConstant.ErrInvalidJlptLevelID, errConstant.ErrInvalidCategoryID, errConstant.ErrInvalidDifficulty, errConstant.ErrInvalidPartOfSpeech,
+		errConstant.ErrMediaNotFound, errConstant.ErrMediaKindMismatch:
 		return http.StatusUnprocessableEntity
 	case errConstant.ErrInvalidID:
 		return http.StatusBadRequest
@@ -45,6 +47,12 @@ func (c *VocabularyController) getStatusCode(err error) int {
 	}
 }
 
+// wantsFurigana reports whether the caller requested ruby markup via ?furigana=true
+func (c *VocabularyController) wantsFurigana(ctx *gin.Context) bool {
+	furigana, err := strconv.ParseBool(ctx.Query("furigana"))
+	return err == nil && furigana
+}
+
 // Create godoc
 // @Summary      Create Vocabulary
 // @Description  Create a new vocabulary entry (admin only)
@@ -88,7 +96,7 @@ func (c *VocabularyController) Create(ctx *gin.Context) {
 		return
 	}
 
-	vocabulary, err := c.service.GetVocabulary().Create(ctx, request)
+	vocabulary, err := c.service.GetVocabulary().Create(ctx.Request.Context(), request)
 	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: c.getStatusCode(err),
@@ -107,7 +115,7 @@ func (c *VocabularyController) Create(ctx *gin.Context) {
 
 // GetAll godoc
 // @Summary      Get all Vocabularies
-// @Description  Retrieve vocabularies with advanced filtering, search, sorting, and pagination
+// @Description  Retrieve vocabularies with advanced filtering, search, sorting, and pagination. With a bearer token, each vocabulary includes whether the learner bookmarked it.
 // @Tags         Vocabularies
 // @Produce      json
 // @Param        page query int false "Page number" default(1) minimum(1)
@@ -119,6 +127,8 @@ func (c *VocabularyController) Create(ctx *gin.Context) {
 // @Param        search query string false "Search in word, reading, or meaning" example("dog")
 // @Param        sortBy query string false "Sort by field (word, difficulty, created_at)" default(created_at) example("word")
 // @Param        sortOrder query string false "Sort order (asc, desc)" default(desc) example("asc")
+// @Param        furigana query bool false "Include furigana (ruby) markup" default(false)
+// @Param        lang query string false "Response language (en, id); falls back to the Accept-Language header" example("id")
 // @Success      200 {object} dto.VocabularyListSwaggerResponse
 // @Failure      400 {object} response.Response
 // @Failure      422 {object} response.Response
@@ -161,6 +171,30 @@ func (c *VocabularyController) GetAll(ctx *gin.Context) {
 		return
 	}
 
+	// Localize translatable fields for the negotiated locale
+	err = c.service.GetTranslation().LocalizeVocabularies(ctx, locale.Negotiate(ctx), vocabularies.Data)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	// Attach ruby markup when requested via ?furigana=true
+	if c.wantsFurigana(ctx) {
+		err = c.service.GetFurigana().AnnotateVocabularies(ctx, vocabularies.Data)
+		if err != nil {
+			response.HttpResponse(response.ParamHTTPResp{
+				Code: c.getStatusCode(err),
+				Err:  err,
+				Gin:  ctx,
+			})
+			return
+		}
+	}
+
 	// Return response with data array and pagination at same level
 	ctx.JSON(http.StatusOK, gin.H{
 		"message":    http.StatusText(http.StatusOK),
@@ -172,10 +206,12 @@ func (c *VocabularyController) GetAll(ctx *gin.Context) {
 
 // GetByID godoc
 // @Summary      Get Vocabulary by ID
-// @Description  Retrieve a specific vocabulary entry by ID
+// @Description  Retrieve a specific vocabulary entry by ID. With a bearer token, it includes whether the learner bookmarked it.
 // @Tags         Vocabularies
 // @Produce      json
 // @Param        id path int true "Vocabulary ID"
+// @Param        furigana query bool false "Include furigana (ruby) markup" default(false)
+// @Param        lang query string false "Response language (en, id); falls back to the Accept-Language header" example("id")
 // @Success      200 {object} dto.VocabularySwaggerResponse
 // @Failure      400 {object} response.Response
 // @Failure      404 {object} response.Response "Vocabulary not found"
@@ -212,6 +248,34 @@ func (c *VocabularyController) GetByID(ctx *gin.Context) {
 		return
 	}
 
+	// Localize translatable fields for the negotiated locale
+	localized := []dto.VocabularyResponse{*vocabulary}
+	err = c.service.GetTranslation().LocalizeVocabularies(ctx, locale.Negotiate(ctx), localized)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+	vocabulary = &localized[0]
+
+	// Attach ruby markup when requested via ?furigana=true
+	if c.wantsFurigana(ctx) {
+		annotated := []dto.VocabularyResponse{*vocabulary}
+		err = c.service.GetFurigana().AnnotateVocabularies(ctx, annotated)
+		if err != nil {
+			response.HttpResponse(response.ParamHTTPResp{
+				Code: c.getStatusCode(err),
+				Err:  err,
+				Gin:  ctx,
+			})
+			return
+		}
+		vocabulary = &annotated[0]
+	}
+
 	response.HttpResponse(response.ParamHTTPResp{
 		Code: http.StatusOK,
 		Data: vocabulary,
@@ -283,7 +347,7 @@ func (c *VocabularyController) Update(ctx *gin.Context) {
 		return
 	}
 
-	vocabulary, err := c.service.GetVocabulary().Update(ctx, request, uint(id))
+	vocabulary, err := c.service.GetVocabulary().Update(ctx.Request.Context(), request, uint(id))
 	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code: c.getStatusCode(err),
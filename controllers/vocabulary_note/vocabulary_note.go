@@ -0,0 +1,364 @@
+package controllers
+
+import (
+	errWrap "manabu-service/common/error"
+	"manabu-service/common/response"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type VocabularyNoteController struct {
+	service services.IServiceRegistry
+}
+
+// IVocabularyNoteController defines the contract for vocabulary note HTTP handlers.
+type IVocabularyNoteController interface {
+	// Create handles POST requests to add a note to a word.
+	Create(*gin.Context)
+	// GetAll handles GET requests to list the user's notes.
+	GetAll(*gin.Context)
+	// GetByID handles GET requests for one of the user's notes.
+	GetByID(*gin.Context)
+	// Update handles PUT requests to change one of the user's notes.
+	Update(*gin.Context)
+	// Delete handles DELETE requests to remove one of the user's notes.
+	Delete(*gin.Context)
+	// GetShared handles GET requests to list the mnemonics shared for a word.
+	GetShared(*gin.Context)
+	// Upvote handles POST requests to upvote a shared mnemonic.
+	Upvote(*gin.Context)
+	// RemoveUpvote handles DELETE requests to withdraw an upvote.
+	RemoveUpvote(*gin.Context)
+}
+
+func NewVocabularyNoteController(service services.IServiceRegistry) IVocabularyNoteController {
+	return &VocabularyNoteController{service: service}
+}
+
+// getStatusCode maps errors to appropriate HTTP status codes
+func (c *VocabularyNoteController) getStatusCode(err error) int {
+	switch err {
+	case errConstant.ErrVocabularyNoteNotFound, errConstant.ErrMnemonicNotFound, errConstant.ErrVocabularyNotFound:
+		return http.StatusNotFound
+	case errConstant.ErrVocabularyNoteExists:
+		return http.StatusConflict
+	case errConstant.ErrVocabularyNoteEmpty, errConstant.ErrVocabularyNoteNotShared, errConstant.ErrMnemonicOwnUpvote,
+		errConstant.ErrMediaNotFound, errConstant.ErrMediaKindMismatch:
+		return http.StatusUnprocessableEntity
+	case errConstant.ErrInvalidID:
+		return http.StatusBadRequest
+	case errConstant.ErrUnauthorized:
+		return http.StatusUnauthorized
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// parseID parses the id path parameter, responding with 400 when it is invalid
+func (c *VocabularyNoteController) parseID(ctx *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrInvalidID,
+			Gin:  ctx,
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// bind binds and validates a JSON body or query string, responding with 400 or 422 when it is invalid
+func (c *VocabularyNoteController) bind(ctx *gin.Context, request interface{}, query bool) bool {
+	var err error
+	if query {
+		err = ctx.ShouldBindQuery(request)
+	} else {
+		err = ctx.ShouldBindJSON(request)
+	}
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return false
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := errWrap.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return false
+	}
+	return true
+}
+
+// respond writes the result of a service call
+func (c *VocabularyNoteController) respond(ctx *gin.Context, code int, data interface{}, err error) {
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code: c.getStatusCode(err),
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: code,
+		Data: data,
+		Gin:  ctx,
+	})
+}
+
+// Create godoc
+// @Summary      Create Vocabulary Note
+// @Description  Attach a personal note, mnemonic or image to a word. A user has one note per word. Set isShared to show the mnemonic to other learners.
+// @Tags         Notes
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body dto.CreateVocabularyNoteRequest true "Note"
+// @Success      201 {object} dto.VocabularyNoteSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Vocabulary not found"
+// @Failure      409 {object} response.Response "A note for this vocabulary already exists"
+// @Failure      422 {object} response.Response "Validation error, empty note or shared note without mnemonic"
+// @Failure      500 {object} response.Response
+// @Router       /me/notes [post]
+func (c *VocabularyNoteController) Create(ctx *gin.Context) {
+	request := &dto.CreateVocabularyNoteRequest{}
+	if !c.bind(ctx, request, false) {
+		return
+	}
+
+	note, err := c.service.GetVocabularyNote().Create(ctx.Request.Context(), request)
+	c.respond(ctx, http.StatusCreated, note, err)
+}
+
+// GetAll godoc
+// @Summary      Get Vocabulary Notes
+// @Description  Retrieve the authenticated user's notes, newest first.
+// @Tags         Notes
+// @Produce      json
+// @Security     BearerAuth
+// @Param        page query int false "Page number" default(1) minimum(1)
+// @Param        limit query int false "Items per page" default(10) minimum(1) maximum(100)
+// @Param        vocabularyId query int false "Vocabulary ID"
+// @Param        isShared query bool false "Only shared or only private notes"
+// @Success      200 {object} dto.VocabularyNoteListSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      422 {object} response.Response
+// @Failure      500 {object} response.Response
+// @Router       /me/notes [get]
+func (c *VocabularyNoteController) GetAll(ctx *gin.Context) {
+	filter := &dto.VocabularyNoteFilterRequest{}
+	if !c.bind(ctx, filter, true) {
+		return
+	}
+
+	notes, err := c.service.GetVocabularyNote().GetAll(ctx.Request.Context(), filter)
+	if err != nil {
+		c.respond(ctx, 0, nil, err)
+		return
+	}
+
+	// Return response with data array and pagination at same level
+	ctx.JSON(http.StatusOK, gin.H{
+		"message":    http.StatusText(http.StatusOK),
+		"pagination": notes.Pagination,
+		"status":     "success",
+		"data":       notes.Data,
+	})
+}
+
+// GetByID godoc
+// @Summary      Get Vocabulary Note
+// @Description  Retrieve one of the authenticated user's notes.
+// @Tags         Notes
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Note ID"
+// @Success      200 {object} dto.VocabularyNoteSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Note not found"
+// @Failure      500 {object} response.Response
+// @Router       /me/notes/{id} [get]
+func (c *VocabularyNoteController) GetByID(ctx *gin.Context) {
+	id, ok := c.parseID(ctx)
+	if !ok {
+		return
+	}
+
+	note, err := c.service.GetVocabularyNote().GetByID(ctx.Request.Context(), id)
+	c.respond(ctx, http.StatusOK, note, err)
+}
+
+// Update godoc
+// @Summary      Update Vocabulary Note
+// @Description  Replace the note, mnemonic and image of one of the authenticated user's notes. Fields left empty are cleared.
+// @Tags         Notes
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Note ID"
+// @Param        request body dto.UpdateVocabularyNoteRequest true "Note"
+// @Success      200 {object} dto.VocabularyNoteSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Note not found"
+// @Failure      422 {object} response.Response "Validation error, empty note or shared note without mnemonic"
+// @Failure      500 {object} response.Response
+// @Router       /me/notes/{id} [put]
+func (c *VocabularyNoteController) Update(ctx *gin.Context) {
+	id, ok := c.parseID(ctx)
+	if !ok {
+		return
+	}
+
+	request := &dto.UpdateVocabularyNoteRequest{}
+	if !c.bind(ctx, request, false) {
+		return
+	}
+
+	note, err := c.service.GetVocabularyNote().Update(ctx.Request.Context(), id, request)
+	c.respond(ctx, http.StatusOK, note, err)
+}
+
+// Delete godoc
+// @Summary      Delete Vocabulary Note
+// @Description  Delete one of the authenticated user's notes, with the upvotes of its mnemonic.
+// @Tags         Notes
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Note ID"
+// @Success      200 {object} response.Response
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Note not found"
+// @Failure      500 {object} response.Response
+// @Router       /me/notes/{id} [delete]
+func (c *VocabularyNoteController) Delete(ctx *gin.Context) {
+	id, ok := c.parseID(ctx)
+	if !ok {
+		return
+	}
+
+	err := c.service.GetVocabularyNote().Delete(ctx.Request.Context(), id)
+	if err != nil {
+		c.respond(ctx, 0, nil, err)
+		return
+	}
+
+	successMessage := "Note deleted successfully"
+	response.HttpResponse(response.ParamHTTPResp{
+		Code:    http.StatusOK,
+		Message: &successMessage,
+		Gin:     ctx,
+	})
+}
+
+// GetShared godoc
+// @Summary      Get Shared Mnemonics
+// @Description  Retrieve the mnemonics learners shared for a word, most upvoted first. When authenticated, the user's own upvotes and mnemonics are marked.
+// @Tags         Vocabularies
+// @Produce      json
+// @Param        id path int true "Vocabulary ID"
+// @Param        page query int false "Page number" default(1) minimum(1)
+// @Param        limit query int false "Items per page" default(10) minimum(1) maximum(100)
+// @Success      200 {object} dto.SharedMnemonicListSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      404 {object} response.Response "Vocabulary not found"
+// @Failure      422 {object} response.Response
+// @Failure      500 {object} response.Response
+// @Router       /vocabularies/{id}/mnemonics [get]
+func (c *VocabularyNoteController) GetShared(ctx *gin.Context) {
+	id, ok := c.parseID(ctx)
+	if !ok {
+		return
+	}
+
+	pagination := &dto.PaginationRequest{}
+	if !c.bind(ctx, pagination, true) {
+		return
+	}
+
+	mnemonics, err := c.service.GetVocabularyNote().GetShared(ctx.Request.Context(), id, pagination)
+	if err != nil {
+		c.respond(ctx, 0, nil, err)
+		return
+	}
+
+	// Return response with data array and pagination at same level
+	ctx.JSON(http.StatusOK, gin.H{
+		"message":    http.StatusText(http.StatusOK),
+		"pagination": mnemonics.Pagination,
+		"status":     "success",
+		"data":       mnemonics.Data,
+	})
+}
+
+// Upvote godoc
+// @Summary      Upvote Mnemonic
+// @Description  Upvote a mnemonic another learner shared. Upvoting a mnemonic twice has no effect.
+// @Tags         Notes
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Note ID"
+// @Success      200 {object} dto.SharedMnemonicSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Shared mnemonic not found"
+// @Failure      422 {object} response.Response "Own mnemonic"
+// @Failure      500 {object} response.Response
+// @Router       /mnemonics/{id}/upvote [post]
+func (c *VocabularyNoteController) Upvote(ctx *gin.Context) {
+	id, ok := c.parseID(ctx)
+	if !ok {
+		return
+	}
+
+	mnemonic, err := c.service.GetVocabularyNote().Upvote(ctx.Request.Context(), id)
+	c.respond(ctx, http.StatusOK, mnemonic, err)
+}
+
+// RemoveUpvote godoc
+// @Summary      Remove Mnemonic Upvote
+// @Description  Withdraw the authenticated user's upvote of a shared mnemonic.
+// @Tags         Notes
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int true "Note ID"
+// @Success      200 {object} dto.SharedMnemonicSwaggerResponse
+// @Failure      400 {object} response.Response
+// @Failure      401 {object} response.Response
+// @Failure      404 {object} response.Response "Shared mnemonic not found"
+// @Failure      500 {object} response.Response
+// @Router       /mnemonics/{id}/upvote [delete]
+func (c *VocabularyNoteController) RemoveUpvote(ctx *gin.Context) {
+	id, ok := c.parseID(ctx)
+	if !ok {
+		return
+	}
+
+	mnemonic, err := c.service.GetVocabularyNote().RemoveUpvote(ctx.Request.Context(), id)
+	c.respond(ctx, http.StatusOK, mnemonic, err)
+}
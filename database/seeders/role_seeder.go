@@ -17,6 +17,10 @@ func RunRoleSeeder(db *gorm.DB) {
 			Code: "USER",
 			Name: "User",
 		},
+		{
+			Code: "TEACHER",
+			Name: "Teacher",
+		},
 	}
 
 	for _, role := range roles {
@@ -0,0 +1,77 @@
+package dto
+
+type CreateBookmarkRequest struct {
+	ItemType string `json:"itemType" validate:"required,oneof=course lesson vocabulary exercise_question" example:"vocabulary"`
+	ItemID   uint   `json:"itemId" validate:"required,min=1" example:"1"`
+	FolderID *uint  `json:"folderId" validate:"omitempty,min=1" example:"1"`
+}
+
+// BookmarkItemRequest identifies the bookmarked item to remove
+type BookmarkItemRequest struct {
+	ItemType string `form:"itemType" validate:"required,oneof=course lesson vocabulary exercise_question" example:"vocabulary"`
+	ItemID   uint   `form:"itemId" validate:"required,min=1" example:"1"`
+}
+
+// MoveBookmarkRequest moves a bookmark into a folder, or out of any folder when FolderID is empty
+type MoveBookmarkRequest struct {
+	FolderID *uint `json:"folderId" validate:"omitempty,min=1" example:"1"`
+}
+
+type BookmarkFilterRequest struct {
+	ItemType string `form:"itemType" validate:"omitempty,oneof=course lesson vocabulary exercise_question" example:"course"`
+	FolderID uint   `form:"folderId" validate:"omitempty,min=1" example:"1"`
+	PaginationRequest
+}
+
+type BookmarkResponse struct {
+	ID          uint    `json:"id" example:"1"`
+	ItemType    string  `json:"itemType" example:"vocabulary"`
+	ItemID      uint    `json:"itemId" example:"1"`
+	Title       string  `json:"title" example:"犬"`
+	IsAvailable bool    `json:"isAvailable" example:"true"`
+	FolderID    *uint   `json:"folderId,omitempty" example:"1"`
+	FolderName  *string `json:"folderName,omitempty" example:"Animals"`
+	CreatedAt   string  `json:"createdAt" example:"2024-01-16T09:00:00Z"`
+}
+
+type BookmarkListResponse struct {
+	Data       []BookmarkResponse `json:"data"`
+	Pagination PaginationResponse `json:"pagination"`
+}
+
+type BookmarkFolderRequest struct {
+	Name string `json:"name" validate:"required,min=1,max=100" example:"Animals"`
+}
+
+type BookmarkFolderResponse struct {
+	ID            uint   `json:"id" example:"1"`
+	Name          string `json:"name" example:"Animals"`
+	BookmarkCount int64  `json:"bookmarkCount" example:"12"`
+	CreatedAt     string `json:"createdAt" example:"2024-01-16T09:00:00Z"`
+}
+
+// Swagger response wrappers
+type BookmarkSwaggerResponse struct {
+	Message string           `json:"message" example:"OK"`
+	Status  string           `json:"status" example:"success"`
+	Data    BookmarkResponse `json:"data"`
+}
+
+type BookmarkListSwaggerResponse struct {
+	Message    string             `json:"message" example:"OK"`
+	Pagination PaginationResponse `json:"pagination"`
+	Status     string             `json:"status" example:"success"`
+	Data       []BookmarkResponse `json:"data"`
+}
+
+type BookmarkFolderSwaggerResponse struct {
+	Message string                 `json:"message" example:"OK"`
+	Status  string                 `json:"status" example:"success"`
+	Data    BookmarkFolderResponse `json:"data"`
+}
+
+type BookmarkFolderListSwaggerResponse struct {
+	Message string                   `json:"message" example:"OK"`
+	Status  string                   `json:"status" example:"success"`
+	Data    []BookmarkFolderResponse `json:"data"`
+}
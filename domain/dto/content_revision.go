@@ -0,0 +1,62 @@
+package dto
+
+import "encoding/json"
+
+type ContentRevisionFilterRequest struct {
+	PaginationRequest
+}
+
+type ContentRevisionDiffRequest struct {
+	From int `form:"from" validate:"required,min=1" example:"1"`
+	To   int `form:"to" validate:"required,min=1" example:"3"`
+}
+
+type RevisionChange struct {
+	From interface{} `json:"from"`
+	To   interface{} `json:"to"`
+}
+
+type ContentRevisionResponse struct {
+	ContentType  string                    `json:"contentType" example:"course"`
+	ContentID    uint                      `json:"contentId" example:"1"`
+	Version      int                       `json:"version" example:"3"`
+	Action       string                    `json:"action" example:"update"`
+	RestoredFrom *int                      `json:"restoredFrom,omitempty" example:"1"`
+	AuthorID     *string                   `json:"authorId,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Changes      map[string]RevisionChange `json:"changes"`
+	Snapshot     json.RawMessage           `json:"snapshot,omitempty" swaggertype:"object"`
+	CreatedAt    string                    `json:"createdAt" example:"2024-01-15T10:30:00Z"`
+}
+
+type ContentRevisionListResponse struct {
+	Data       []ContentRevisionResponse `json:"data"`
+	Pagination PaginationResponse        `json:"pagination"`
+}
+
+type ContentRevisionDiffResponse struct {
+	ContentType string                    `json:"contentType" example:"course"`
+	ContentID   uint                      `json:"contentId" example:"1"`
+	FromVersion int                       `json:"fromVersion" example:"1"`
+	ToVersion   int                       `json:"toVersion" example:"3"`
+	Changes     map[string]RevisionChange `json:"changes"`
+}
+
+// Swagger response wrappers
+type ContentRevisionSwaggerResponse struct {
+	Message string                  `json:"message" example:"OK"`
+	Status  string                  `json:"status" example:"success"`
+	Data    ContentRevisionResponse `json:"data"`
+}
+
+type ContentRevisionListSwaggerResponse struct {
+	Message    string                    `json:"message" example:"Content revisions retrieved successfully"`
+	Pagination PaginationResponse        `json:"pagination"`
+	Status     string                    `json:"status" example:"success"`
+	Data       []ContentRevisionResponse `json:"data"`
+}
+
+type ContentRevisionDiffSwaggerResponse struct {
+	Message string                      `json:"message" example:"OK"`
+	Status  string                      `json:"status" example:"success"`
+	Data    ContentRevisionDiffResponse `json:"data"`
+}
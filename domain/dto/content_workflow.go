@@ -0,0 +1,106 @@
+package dto
+
+type WorkflowTransitionRequest struct {
+	Action  string `json:"action" validate:"required,oneof=submit approve reject publish archive reopen" example:"submit"`
+	Reason  string `json:"reason" validate:"omitempty,max=2000" example:"Example sentences in lesson 3 need furigana"`
+	Cascade bool   `json:"cascade" example:"false"`
+}
+
+type AssignWorkflowReviewerRequest struct {
+	ReviewerID string `json:"reviewerId" validate:"required,uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
+}
+
+type WorkflowCommentRequest struct {
+	Body string `json:"body" validate:"required,min=1,max=2000" example:"Please double-check the reading of 日本語"`
+}
+
+type WorkflowTransitionResponse struct {
+	ID         uint   `json:"id" example:"1"`
+	Action     string `json:"action" example:"submit"`
+	FromStatus string `json:"fromStatus" example:"draft"`
+	ToStatus   string `json:"toStatus" example:"in_review"`
+	ActorID    string `json:"actorId" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Reason     string `json:"reason,omitempty" example:"Example sentences in lesson 3 need furigana"`
+	CreatedAt  string `json:"createdAt" example:"2024-01-15T10:30:00Z"`
+}
+
+type WorkflowCommentResponse struct {
+	ID        uint   `json:"id" example:"1"`
+	AuthorID  string `json:"authorId" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Body      string `json:"body" example:"Please double-check the reading of 日本語"`
+	CreatedAt string `json:"createdAt" example:"2024-01-15T10:30:00Z"`
+}
+
+type ContentWorkflowResponse struct {
+	ContentType     string                       `json:"contentType" example:"course"`
+	ContentID       uint                         `json:"contentId" example:"1"`
+	Status          string                       `json:"status" example:"in_review"`
+	ReviewerID      *string                      `json:"reviewerId,omitempty" example:"550e8400-e29b-41d4-a716-446655440001"`
+	SubmittedBy     *string                      `json:"submittedBy,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	SubmittedAt     *string                      `json:"submittedAt,omitempty" example:"2024-01-15T10:30:00Z"`
+	RejectionReason string                       `json:"rejectionReason,omitempty" example:"Example sentences in lesson 3 need furigana"`
+	AllowedActions  []string                     `json:"allowedActions" example:"approve,reject"`
+	Transitions     []WorkflowTransitionResponse `json:"transitions,omitempty"`
+	Comments        []WorkflowCommentResponse    `json:"comments,omitempty"`
+	UpdatedAt       *string                      `json:"updatedAt,omitempty" example:"2024-01-15T10:30:00Z"`
+}
+
+type ContentWorkflowListResponse struct {
+	Data       []ContentWorkflowResponse `json:"data"`
+	Pagination PaginationResponse        `json:"pagination"`
+}
+
+type ContentWorkflowFilterRequest struct {
+	ContentType  string `form:"contentType" validate:"omitempty,oneof=course lesson exercise exercise_question" example:"course"`
+	Status       string `form:"status" validate:"omitempty,oneof=draft in_review approved published archived" example:"in_review"`
+	AssignedToMe bool   `form:"assignedToMe" example:"true"`
+	PaginationRequest
+}
+
+type PublishReadinessRequest struct {
+	Cascade bool `form:"cascade" example:"true"`
+}
+
+type PublishReadinessItem struct {
+	ContentType string `json:"contentType" example:"lesson"`
+	ContentID   uint   `json:"contentId" example:"3"`
+	Title       string `json:"title" example:"Greetings"`
+}
+
+type PublishReadinessIssue struct {
+	ContentType string `json:"contentType" example:"exercise"`
+	ContentID   uint   `json:"contentId" example:"7"`
+	Title       string `json:"title" example:"Greetings Quiz"`
+	Code        string `json:"code" example:"no_questions"`
+	Message     string `json:"message" example:"exercise needs at least one approved or published exercise_question"`
+}
+
+type PublishReadinessResponse struct {
+	ContentType string                  `json:"contentType" example:"course"`
+	ContentID   uint                    `json:"contentId" example:"1"`
+	Status      string                  `json:"status" example:"approved"`
+	Cascade     bool                    `json:"cascade" example:"true"`
+	Ready       bool                    `json:"ready" example:"false"`
+	WillPublish []PublishReadinessItem  `json:"willPublish"`
+	Issues      []PublishReadinessIssue `json:"issues"`
+}
+
+// Swagger response wrappers
+type ContentWorkflowSwaggerResponse struct {
+	Message string                  `json:"message" example:"OK"`
+	Status  string                  `json:"status" example:"success"`
+	Data    ContentWorkflowResponse `json:"data"`
+}
+
+type ContentWorkflowListSwaggerResponse struct {
+	Message    string                    `json:"message" example:"Content workflows retrieved successfully"`
+	Pagination PaginationResponse        `json:"pagination"`
+	Status     string                    `json:"status" example:"success"`
+	Data       []ContentWorkflowResponse `json:"data"`
+}
+
+type PublishReadinessSwaggerResponse struct {
+	Message string                   `json:"message" example:"OK"`
+	Status  string                   `json:"status" example:"success"`
+	Data    PublishReadinessResponse `json:"data"`
+}
@@ -1,34 +1,46 @@
 package dto
 
+import "github.com/google/uuid"
+
 type CreateCourseRequest struct {
-	Title          string `json:"title" validate:"required,min=3,max=200" example:"Introduction to Japanese"`
-	Description    string `json:"description" validate:"required,min=10" example:"A comprehensive course for beginners learning Japanese language"`
-	JlptLevelID    uint   `json:"jlptLevelId" validate:"required,min=1" example:"5"`
-	ThumbnailURL   string `json:"thumbnailUrl" validate:"omitempty,url,max=255" example:"https://example.com/images/course-thumbnail.jpg"`
-	Difficulty     int    `json:"difficulty" validate:"omitempty,min=1,max=5" example:"1"`
-	EstimatedHours int    `json:"estimatedHours" validate:"omitempty,min=1" example:"40"`
+	Title            string     `json:"title" validate:"required,min=3,max=200" example:"Introduction to Japanese"`
+	Description      string     `json:"description" validate:"required,min=10" example:"A comprehensive course for beginners learning Japanese language"`
+	JlptLevelID      uint       `json:"jlptLevelId" validate:"required,min=1" example:"5"`
+	ThumbnailURL     string     `json:"thumbnailUrl" validate:"omitempty,url,max=255" example:"https://example.com/images/course-thumbnail.jpg"`
+	ThumbnailMediaID *uuid.UUID `json:"thumbnailMediaId" swaggertype:"string" format:"uuid" example:"550e8400-e29b-41d4-a716-446655440001"`
+	Difficulty       int        `json:"difficulty" validate:"omitempty,min=1,max=5" example:"1"`
+	EstimatedHours   int        `json:"estimatedHours" validate:"omitempty,min=1" example:"40"`
 }
 
 type UpdateCourseRequest struct {
-	Title          string `json:"title" validate:"required,min=3,max=200" example:"Introduction to Japanese"`
-	Description    string `json:"description" validate:"required,min=10" example:"A comprehensive course for beginners learning Japanese language"`
-	JlptLevelID    uint   `json:"jlptLevelId" validate:"required,min=1" example:"5"`
-	ThumbnailURL   string `json:"thumbnailUrl" validate:"omitempty,url,max=255" example:"https://example.com/images/course-thumbnail.jpg"`
-	Difficulty     int    `json:"difficulty" validate:"omitempty,min=1,max=5" example:"1"`
-	EstimatedHours int    `json:"estimatedHours" validate:"omitempty,min=1" example:"40"`
+	Title            string     `json:"title" validate:"required,min=3,max=200" example:"Introduction to Japanese"`
+	Description      string     `json:"description" validate:"required,min=10" example:"A comprehensive course for beginners learning Japanese language"`
+	JlptLevelID      uint       `json:"jlptLevelId" validate:"required,min=1" example:"5"`
+	ThumbnailURL     string     `json:"thumbnailUrl" validate:"omitempty,url,max=255" example:"https://example.com/images/course-thumbnail.jpg"`
+	ThumbnailMediaID *uuid.UUID `json:"thumbnailMediaId" swaggertype:"string" format:"uuid" example:"550e8400-e29b-41d4-a716-446655440001"`
+	Difficulty       int        `json:"difficulty" validate:"omitempty,min=1,max=5" example:"1"`
+	EstimatedHours   int        `json:"estimatedHours" validate:"omitempty,min=1" example:"40"`
+}
+
+type CloneCourseRequest struct {
+	Title        string `json:"title" validate:"required,min=3,max=200" example:"Japanese for N4"`
+	JlptLevelID  uint   `json:"jlptLevelId" validate:"required,min=1" example:"4"`
+	IncludeMedia *bool  `json:"includeMedia" example:"true"`
 }
 
 type CourseResponse struct {
-	ID             uint               `json:"id" example:"1"`
-	Title          string             `json:"title" example:"Introduction to Japanese"`
-	Description    string             `json:"description" example:"A comprehensive course for beginners learning Japanese language"`
-	JlptLevelID    uint               `json:"jlptLevelId" example:"5"`
-	ThumbnailURL   string             `json:"thumbnailUrl" example:"https://example.com/images/course-thumbnail.jpg"`
-	Difficulty     int                `json:"difficulty" example:"1"`
-	EstimatedHours int                `json:"estimatedHours" example:"40"`
-	IsPublished    bool               `json:"isPublished" example:"true"`
-	PublishedAt    *string            `json:"publishedAt,omitempty" example:"2024-01-15T10:30:00Z"`
-	JlptLevel      *JlptLevelResponse `json:"jlptLevel,omitempty"`
+	ID               uint               `json:"id" example:"1"`
+	Title            string             `json:"title" example:"Introduction to Japanese"`
+	Description      string             `json:"description" example:"A comprehensive course for beginners learning Japanese language"`
+	JlptLevelID      uint               `json:"jlptLevelId" example:"5"`
+	ThumbnailURL     string             `json:"thumbnailUrl" example:"https://example.com/images/course-thumbnail.jpg"`
+	ThumbnailMediaID *string            `json:"thumbnailMediaId,omitempty" example:"550e8400-e29b-41d4-a716-446655440001"`
+	Difficulty       int                `json:"difficulty" example:"1"`
+	EstimatedHours   int                `json:"estimatedHours" example:"40"`
+	IsPublished      bool               `json:"isPublished" example:"true"`
+	PublishedAt      *string            `json:"publishedAt,omitempty" example:"2024-01-15T10:30:00Z"`
+	JlptLevel        *JlptLevelResponse `json:"jlptLevel,omitempty"`
+	IsBookmarked     *bool              `json:"isBookmarked,omitempty" example:"true"`
 }
 
 type CourseListResponse struct {
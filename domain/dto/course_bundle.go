@@ -0,0 +1,143 @@
+package dto
+
+// CourseBundle is the manifest of a course bundle, stored as course.json in a zip archive
+// next to the media files it references. Media are referenced by their path in the archive,
+// JLPT levels by code and categories by name within a JLPT level, so a bundle can be
+// imported into any installation.
+type CourseBundle struct {
+	FormatVersion int                `json:"formatVersion" validate:"required" example:"1"`
+	ExportedAt    string             `json:"exportedAt,omitempty" example:"2024-01-15T10:30:00Z"`
+	Course        BundleCourse       `json:"course"`
+	Vocabularies  []BundleVocabulary `json:"vocabularies" validate:"omitempty,dive"`
+	Media         []BundleMedia      `json:"media" validate:"omitempty,dive"`
+}
+
+// BundleMedia describes a media file of the archive
+type BundleMedia struct {
+	Path        string `json:"path" validate:"required,max=255" example:"media/550e8400-e29b-41d4-a716-446655440001.png"`
+	Kind        string `json:"kind" validate:"required,oneof=audio image" example:"image"`
+	FileName    string `json:"fileName" validate:"omitempty,max=255" example:"cover.png"`
+	ContentType string `json:"contentType" validate:"required,max=100" example:"image/png"`
+}
+
+type BundleCourse struct {
+	Title          string         `json:"title" validate:"required,min=3,max=200" example:"Introduction to Japanese"`
+	Description    string         `json:"description" validate:"required,min=10" example:"A comprehensive course for beginners learning Japanese language"`
+	JlptLevel      string         `json:"jlptLevel" validate:"required,max=10" example:"N5"`
+	ThumbnailURL   string         `json:"thumbnailUrl,omitempty" validate:"omitempty,url,max=255" example:"https://example.com/images/course-thumbnail.jpg"`
+	Thumbnail      string         `json:"thumbnail,omitempty" validate:"omitempty,max=255" example:"media/550e8400-e29b-41d4-a716-446655440001.png"`
+	Difficulty     int            `json:"difficulty" validate:"omitempty,min=1,max=5" example:"1"`
+	EstimatedHours int            `json:"estimatedHours" validate:"omitempty,min=0" example:"40"`
+	Lessons        []BundleLesson `json:"lessons" validate:"omitempty,dive"`
+}
+
+type BundleLesson struct {
+	Title            string           `json:"title" validate:"required,min=3,max=255" example:"Introduction to Hiragana"`
+	Content          string           `json:"content" example:"Learn the basics of Hiragana characters..."`
+	Blocks           []BundleBlock    `json:"blocks,omitempty" validate:"omitempty,max=100,dive"`
+	OrderIndex       int              `json:"orderIndex" validate:"min=0" example:"1"`
+	EstimatedMinutes int              `json:"estimatedMinutes" validate:"omitempty,min=0" example:"30"`
+	UnlockRule       string           `json:"unlockRule,omitempty" validate:"omitempty,oneof=none previous_lesson previous_score" example:"previous_score"`
+	UnlockMinScore   int              `json:"unlockMinScore,omitempty" validate:"omitempty,min=0,max=100" example:"80"`
+	Exercises        []BundleExercise `json:"exercises" validate:"omitempty,dive"`
+	Vocabularies     []BundleWordRef  `json:"vocabularies,omitempty" validate:"omitempty,max=200,dive"`
+}
+
+// BundleBlock is a content block of a lesson. Media files are referenced by their path in the
+// bundle and vocabulary by word and JLPT level.
+type BundleBlock struct {
+	Type       string               `json:"type" validate:"required,oneof=markdown audio image vocabulary grammar question" example:"audio"`
+	Markdown   string               `json:"markdown,omitempty" validate:"omitempty,max=20000" example:"Hiragana has **46** basic characters."`
+	URL        string               `json:"url,omitempty" validate:"omitempty,url,max=500" example:"https://example.com/audio/a.mp3"`
+	Media      string               `json:"media,omitempty" validate:"omitempty,max=255" example:"media/550e8400-e29b-41d4-a716-446655440000.mp3"`
+	Caption    string               `json:"caption,omitempty" validate:"omitempty,max=255" example:"Pronunciation of あ"`
+	AltText    string               `json:"altText,omitempty" validate:"omitempty,max=255" example:"Stroke order of あ"`
+	Vocabulary *BundleWordRef       `json:"vocabulary,omitempty"`
+	Grammar    *GrammarBlock        `json:"grammar,omitempty"`
+	Question   *InlineQuestionBlock `json:"question,omitempty"`
+}
+
+// BundleWordRef references a vocabulary word taught by a lesson, either one of the vocabularies
+// of the bundle or a word that already exists
+type BundleWordRef struct {
+	Word      string `json:"word" validate:"required,min=1,max=255" example:"犬"`
+	JlptLevel string `json:"jlptLevel" validate:"required,max=10" example:"N5"`
+}
+
+type BundleExercise struct {
+	Title            string           `json:"title" validate:"required,min=3,max=200" example:"Fill in the Hiragana"`
+	Description      string           `json:"description" validate:"omitempty,max=1000" example:"Complete the sentences by filling in the correct Hiragana character"`
+	ExerciseType     string           `json:"exerciseType" validate:"required,oneof=multiple_choice fill_blank matching listening speaking" example:"fill_blank"`
+	OrderIndex       int              `json:"orderIndex" validate:"min=0" example:"1"`
+	DifficultyLevel  int              `json:"difficultyLevel" validate:"omitempty,min=1,max=5" example:"2"`
+	EstimatedMinutes int              `json:"estimatedMinutes" validate:"omitempty,min=0" example:"10"`
+	QuestionPoolSize int              `json:"questionPoolSize" validate:"omitempty,min=0,max=100" example:"5"`
+	ShuffleQuestions bool             `json:"shuffleQuestions" example:"true"`
+	ShuffleOptions   bool             `json:"shuffleOptions" example:"true"`
+	Questions        []BundleQuestion `json:"questions" validate:"omitempty,dive"`
+}
+
+type BundleQuestion struct {
+	QuestionText     string           `json:"questionText" validate:"required,min=3,max=1000" example:"What is the correct Hiragana for 'a'?"`
+	QuestionType     string           `json:"questionType" validate:"required,oneof=multiple_choice fill_blank matching listening speaking" example:"multiple_choice"`
+	Options          *QuestionOptions `json:"options,omitempty" validate:"omitempty"`
+	CorrectAnswer    *QuestionAnswer  `json:"correctAnswer" validate:"required"`
+	AnswerStrictness string           `json:"answerStrictness,omitempty" validate:"omitempty,oneof=strict normal lenient" example:"normal"`
+	JlptSection      string           `json:"jlptSection,omitempty" validate:"omitempty,oneof=vocabulary grammar reading listening" example:"vocabulary"`
+	Explanation      string           `json:"explanation,omitempty" validate:"omitempty,max=1000" example:"The Hiragana character for 'a' is あ"`
+	AudioURL         string           `json:"audioUrl,omitempty" validate:"omitempty,url,max=500" example:"https://example.com/audio/question1.mp3"`
+	ImageURL         string           `json:"imageUrl,omitempty" validate:"omitempty,url,max=500" example:"https://example.com/images/question1.jpg"`
+	Audio            string           `json:"audio,omitempty" validate:"omitempty,max=255" example:"media/550e8400-e29b-41d4-a716-446655440000.mp3"`
+	Image            string           `json:"image,omitempty" validate:"omitempty,max=255" example:"media/550e8400-e29b-41d4-a716-446655440001.png"`
+	OrderIndex       int              `json:"orderIndex" validate:"min=0" example:"1"`
+	Points           int              `json:"points" validate:"required,min=1,max=100" example:"10"`
+}
+
+type BundleVocabulary struct {
+	Word                   string `json:"word" validate:"required,min=1,max=255" example:"犬"`
+	Reading                string `json:"reading,omitempty" validate:"omitempty,max=255" example:"いぬ"`
+	Meaning                string `json:"meaning" validate:"required,min=1,max=500" example:"dog"`
+	PartOfSpeech           string `json:"partOfSpeech,omitempty" validate:"omitempty,max=50" example:"noun"`
+	JlptLevel              string `json:"jlptLevel" validate:"required,max=10" example:"N5"`
+	Category               string `json:"category" validate:"required,max=100" example:"Animals"`
+	ExampleSentence        string `json:"exampleSentence,omitempty" example:"犬が好きです"`
+	ExampleSentenceReading string `json:"exampleSentenceReading,omitempty" example:"いぬがすきです"`
+	ExampleSentenceMeaning string `json:"exampleSentenceMeaning,omitempty" example:"I like dogs"`
+	AudioURL               string `json:"audioUrl,omitempty" validate:"omitempty,url,max=255" example:"https://example.com/audio/inu.mp3"`
+	ImageURL               string `json:"imageUrl,omitempty" validate:"omitempty,url,max=255" example:"https://example.com/images/dog.jpg"`
+	Audio                  string `json:"audio,omitempty" validate:"omitempty,max=255" example:"media/550e8400-e29b-41d4-a716-446655440002.mp3"`
+	Image                  string `json:"image,omitempty" validate:"omitempty,max=255" example:"media/550e8400-e29b-41d4-a716-446655440003.png"`
+	Difficulty             int    `json:"difficulty" validate:"omitempty,min=1,max=5" example:"1"`
+}
+
+type CourseBundleImportRequest struct {
+	DryRun bool `form:"dryRun" example:"true"`
+}
+
+// CourseBundleConflict is a problem that prevents a bundle from being imported.
+// Path points into the manifest, for example course.lessons[0].exercises[1].
+type CourseBundleConflict struct {
+	Type    string `json:"type" example:"jlpt_level"`
+	Path    string `json:"path" example:"course.jlptLevel"`
+	Message string `json:"message" example:"JLPT level N6 does not exist"`
+}
+
+type CourseBundleImportResponse struct {
+	DryRun             bool                   `json:"dryRun" example:"false"`
+	Imported           bool                   `json:"imported" example:"true"`
+	Course             *CourseResponse        `json:"course,omitempty"`
+	Lessons            int                    `json:"lessons" example:"12"`
+	Exercises          int                    `json:"exercises" example:"30"`
+	Questions          int                    `json:"questions" example:"240"`
+	Vocabularies       int                    `json:"vocabularies" example:"80"`
+	ReusedVocabularies int                    `json:"reusedVocabularies" example:"20"`
+	Media              int                    `json:"media" example:"15"`
+	Conflicts          []CourseBundleConflict `json:"conflicts"`
+}
+
+// Swagger response wrappers
+type CourseBundleImportSwaggerResponse struct {
+	Message string                     `json:"message" example:"OK"`
+	Status  string                     `json:"status" example:"success"`
+	Data    CourseBundleImportResponse `json:"data"`
+}
@@ -0,0 +1,19 @@
+package dto
+
+type SetCoursePrerequisitesRequest struct {
+	CourseIDs []uint `json:"courseIds" validate:"required,max=20,unique,dive,min=1" example:"1,2"`
+}
+
+type CoursePrerequisiteResponse struct {
+	CourseID    uint   `json:"courseId" example:"1"`
+	Title       string `json:"title" example:"Introduction to Japanese"`
+	JlptLevelID uint   `json:"jlptLevelId" example:"1"`
+	IsPublished bool   `json:"isPublished" example:"true"`
+}
+
+// Swagger response wrappers
+type CoursePrerequisiteListSwaggerResponse struct {
+	Message string                       `json:"message" example:"OK"`
+	Status  string                       `json:"status" example:"success"`
+	Data    []CoursePrerequisiteResponse `json:"data"`
+}
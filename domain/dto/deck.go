@@ -0,0 +1,163 @@
+package dto
+
+import "time"
+
+type CreateDeckRequest struct {
+	Title       string `json:"title" validate:"required,min=1,max=200" example:"Kitchen words"`
+	Description string `json:"description" validate:"omitempty,max=2000" example:"Everything I need to cook from Japanese recipes"`
+	Visibility  string `json:"visibility" validate:"omitempty,oneof=private unlisted public" example:"private"`
+}
+
+type UpdateDeckRequest struct {
+	Title       string `json:"title" validate:"required,min=1,max=200" example:"Kitchen words"`
+	Description string `json:"description" validate:"omitempty,max=2000" example:"Everything I need to cook from Japanese recipes"`
+	Visibility  string `json:"visibility" validate:"required,oneof=private unlisted public" example:"public"`
+}
+
+// DeckAccessRequest carries the share code that gives access to an unlisted deck
+type DeckAccessRequest struct {
+	ShareCode string `form:"shareCode" validate:"omitempty,uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
+}
+
+type DeckFilterRequest struct {
+	Search string `form:"search" validate:"omitempty,max=100" example:"kitchen"`
+	SortBy string `form:"sortBy" validate:"omitempty,oneof=popular newest title" example:"popular"`
+	PaginationRequest
+}
+
+type SetDeckVocabulariesRequest struct {
+	VocabularyIDs []uint `json:"vocabularyIds" validate:"required,max=1000,unique,dive,min=1" example:"1,2,3"`
+}
+
+type DeckCardRequest struct {
+	Front   string `json:"front" validate:"required,min=1,max=255" example:"包丁"`
+	Reading string `json:"reading" validate:"omitempty,max=255" example:"ほうちょう"`
+	Back    string `json:"back" validate:"required,min=1,max=500" example:"kitchen knife"`
+	Note    string `json:"note" validate:"omitempty,max=2000" example:"Written on every knife in the shop"`
+}
+
+type DeckResponse struct {
+	ID              uint    `json:"id" example:"1"`
+	Title           string  `json:"title" example:"Kitchen words"`
+	Description     string  `json:"description" example:"Everything I need to cook from Japanese recipes"`
+	Visibility      string  `json:"visibility" example:"public"`
+	ShareCode       *string `json:"shareCode,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Owner           string  `json:"owner" example:"Hana"`
+	IsOwner         bool    `json:"isOwner" example:"false"`
+	IsSubscribed    bool    `json:"isSubscribed" example:"true"`
+	SubscriberCount int     `json:"subscriberCount" example:"12"`
+	VocabularyCount int64   `json:"vocabularyCount" example:"30"`
+	CardCount       int64   `json:"cardCount" example:"8"`
+	CreatedAt       string  `json:"createdAt" example:"2024-01-16T09:00:00Z"`
+	UpdatedAt       string  `json:"updatedAt" example:"2024-01-16T09:00:00Z"`
+}
+
+// DeckDetailResponse is a deck with its words and custom cards
+type DeckDetailResponse struct {
+	DeckResponse
+	Vocabularies []DeckVocabularyResponse `json:"vocabularies"`
+	Cards        []DeckCardResponse       `json:"cards"`
+}
+
+type DeckVocabularyResponse struct {
+	VocabularyID uint   `json:"vocabularyId" example:"1"`
+	OrderIndex   int    `json:"orderIndex" example:"1"`
+	Word         string `json:"word" example:"鍋"`
+	Reading      string `json:"reading" example:"なべ"`
+	Meaning      string `json:"meaning" example:"pot"`
+	PartOfSpeech string `json:"partOfSpeech" example:"noun"`
+	JlptLevelID  uint   `json:"jlptLevelId" example:"4"`
+	AudioURL     string `json:"audioUrl" example:"https://example.com/audio/nabe.mp3"`
+	ImageURL     string `json:"imageUrl" example:"https://example.com/images/nabe.jpg"`
+}
+
+type DeckCardResponse struct {
+	ID         uint   `json:"id" example:"1"`
+	DeckID     uint   `json:"deckId" example:"1"`
+	Front      string `json:"front" example:"包丁"`
+	Reading    string `json:"reading" example:"ほうちょう"`
+	Back       string `json:"back" example:"kitchen knife"`
+	Note       string `json:"note" example:"Written on every knife in the shop"`
+	OrderIndex int    `json:"orderIndex" example:"1"`
+}
+
+type DeckListResponse struct {
+	Data       []DeckResponse     `json:"data"`
+	Pagination PaginationResponse `json:"pagination"`
+}
+
+// DeckSubscriptionResponse reports how many words and cards subscribing added to the review queue
+type DeckSubscriptionResponse struct {
+	DeckID          uint `json:"deckId" example:"1"`
+	AddedWords      int  `json:"addedWords" example:"28"`
+	AlreadyLearning int  `json:"alreadyLearning" example:"2"`
+	AddedCards      int  `json:"addedCards" example:"8"`
+}
+
+// DeckCardStatusResponse is the learning progress of the user on a custom card
+type DeckCardStatusResponse struct {
+	ID             uint              `json:"id" example:"1"`
+	CardID         uint              `json:"cardId" example:"1"`
+	Card           *DeckCardResponse `json:"card,omitempty"`
+	Status         string            `json:"status" example:"learning"`
+	Repetitions    int               `json:"repetitions" example:"0"`
+	LastReviewedAt *time.Time        `json:"lastReviewedAt,omitempty" example:"2024-01-08T10:00:00Z"`
+	CreatedAt      time.Time         `json:"createdAt" example:"2024-01-08T10:00:00Z"`
+	UpdatedAt      time.Time         `json:"updatedAt" example:"2024-01-08T10:00:00Z"`
+}
+
+type DeckCardStatusListRequest struct {
+	DeckID uint   `form:"deckId" validate:"omitempty,min=1" example:"1"`
+	Status string `form:"status" validate:"omitempty,oneof=learning completed" example:"learning"`
+	PaginationRequest
+}
+
+type DeckCardStatusListResponse struct {
+	Data       []DeckCardStatusResponse `json:"data"`
+	Pagination PaginationResponse       `json:"pagination"`
+}
+
+// Swagger response wrappers
+type DeckSwaggerResponse struct {
+	Message string       `json:"message" example:"OK"`
+	Status  string       `json:"status" example:"success"`
+	Data    DeckResponse `json:"data"`
+}
+
+type DeckDetailSwaggerResponse struct {
+	Message string             `json:"message" example:"OK"`
+	Status  string             `json:"status" example:"success"`
+	Data    DeckDetailResponse `json:"data"`
+}
+
+type DeckListSwaggerResponse struct {
+	Message    string             `json:"message" example:"OK"`
+	Pagination PaginationResponse `json:"pagination"`
+	Status     string             `json:"status" example:"success"`
+	Data       []DeckResponse     `json:"data"`
+}
+
+type DeckCardSwaggerResponse struct {
+	Message string           `json:"message" example:"OK"`
+	Status  string           `json:"status" example:"success"`
+	Data    DeckCardResponse `json:"data"`
+}
+
+type DeckSubscriptionSwaggerResponse struct {
+	Message string                   `json:"message" example:"OK"`
+	Status  string                   `json:"status" example:"success"`
+	Data    DeckSubscriptionResponse `json:"data"`
+}
+
+type DeckCardStatusSwaggerResponse struct {
+	Message string                 `json:"message" example:"OK"`
+	Status  string                 `json:"status" example:"success"`
+	Data    DeckCardStatusResponse `json:"data"`
+}
+
+type DeckCardStatusListSwaggerResponse struct {
+	Message    string                   `json:"message" example:"OK"`
+	Pagination PaginationResponse       `json:"pagination"`
+	Status     string                   `json:"status" example:"success"`
+	Data       []DeckCardStatusResponse `json:"data"`
+}
@@ -0,0 +1,147 @@
+package dto
+
+type ExamSectionRequest struct {
+	SectionType   string `json:"sectionType" validate:"required,oneof=vocabulary grammar reading listening" example:"vocabulary"`
+	QuestionCount int    `json:"questionCount" validate:"required,min=1,max=100" example:"20"`
+}
+
+type CreateExamRequest struct {
+	Title           string               `json:"title" validate:"required,min=3,max=200" example:"JLPT N5 Mock Exam 1"`
+	Description     string               `json:"description" validate:"omitempty,max=2000" example:"Full-length N5 mock test"`
+	JlptLevelID     uint                 `json:"jlptLevelId" validate:"required,min=1" example:"5"`
+	DurationMinutes int                  `json:"durationMinutes" validate:"required,min=1,max=300" example:"105"`
+	Sections        []ExamSectionRequest `json:"sections" validate:"required,len=4,dive"`
+}
+
+type UpdateExamRequest struct {
+	Title           string               `json:"title" validate:"required,min=3,max=200" example:"JLPT N5 Mock Exam 1"`
+	Description     string               `json:"description" validate:"omitempty,max=2000" example:"Full-length N5 mock test"`
+	JlptLevelID     uint                 `json:"jlptLevelId" validate:"required,min=1" example:"5"`
+	DurationMinutes int                  `json:"durationMinutes" validate:"required,min=1,max=300" example:"105"`
+	Sections        []ExamSectionRequest `json:"sections" validate:"required,len=4,dive"`
+}
+
+type ExamSectionResponse struct {
+	ID            uint   `json:"id" example:"1"`
+	SectionType   string `json:"sectionType" example:"vocabulary"`
+	QuestionCount int    `json:"questionCount" example:"20"`
+	OrderIndex    int    `json:"orderIndex" example:"0"`
+}
+
+type ExamResponse struct {
+	ID              uint                  `json:"id" example:"1"`
+	Title           string                `json:"title" example:"JLPT N5 Mock Exam 1"`
+	Description     string                `json:"description,omitempty" example:"Full-length N5 mock test"`
+	JlptLevelID     uint                  `json:"jlptLevelId" example:"5"`
+	DurationMinutes int                   `json:"durationMinutes" example:"105"`
+	IsPublished     bool                  `json:"isPublished" example:"true"`
+	PublishedAt     *string               `json:"publishedAt,omitempty" example:"2024-01-15T10:30:00Z"`
+	Sections        []ExamSectionResponse `json:"sections"`
+	JlptLevel       *JlptLevelResponse    `json:"jlptLevel,omitempty"`
+}
+
+type ExamListResponse struct {
+	Data       []ExamResponse     `json:"data"`
+	Pagination PaginationResponse `json:"pagination"`
+}
+
+type ExamFilterRequest struct {
+	JlptLevelID uint   `form:"jlptLevelId" validate:"omitempty,min=1" example:"5"`
+	IsPublished *bool  `form:"isPublished" validate:"omitempty" example:"true"`
+	Search      string `form:"search" validate:"omitempty,max=100" example:"mock"`
+	SortBy      string `form:"sortBy" validate:"omitempty,oneof=title created_at" example:"title"`
+	SortOrder   string `form:"sortOrder" validate:"omitempty,oneof=asc desc" example:"asc"`
+	PaginationRequest
+}
+
+// ExamAnswerRequest is the autosaved answer to one question of an attempt
+type ExamAnswerRequest struct {
+	QuestionID uint             `json:"questionId" validate:"required,min=1" example:"1"`
+	Answer     *SubmittedAnswer `json:"answer" validate:"required"`
+}
+
+type SaveExamAnswersRequest struct {
+	Answers []ExamAnswerRequest `json:"answers" validate:"required,min=1,max=200,dive"`
+}
+
+type ExamScoreResponse struct {
+	Name      string `json:"name" example:"language_knowledge"`
+	Score     int    `json:"score" example:"45"`
+	MaxScore  int    `json:"maxScore" example:"60"`
+	PassScore int    `json:"passScore" example:"19"`
+	Passed    bool   `json:"passed" example:"true"`
+}
+
+// ExamAttemptQuestionResponse is a question of an attempt. CorrectAnswer, Explanation
+// and IsCorrect are only revealed once the attempt is finished.
+type ExamAttemptQuestionResponse struct {
+	QuestionID    uint             `json:"questionId" example:"1"`
+	SectionType   string           `json:"sectionType" example:"vocabulary"`
+	OrderIndex    int              `json:"orderIndex" example:"0"`
+	QuestionText  string           `json:"questionText" example:"What is the reading of 学生?"`
+	QuestionType  string           `json:"questionType" example:"multiple_choice"`
+	Options       *QuestionOptions `json:"options,omitempty"`
+	AudioURL      string           `json:"audioUrl,omitempty" example:"https://example.com/audio/question1.mp3"`
+	ImageURL      string           `json:"imageUrl,omitempty" example:"https://example.com/images/question1.jpg"`
+	Points        int              `json:"points" example:"10"`
+	Answer        *SubmittedAnswer `json:"answer,omitempty"`
+	IsCorrect     *bool            `json:"isCorrect,omitempty" example:"true"`
+	PointsEarned  int              `json:"pointsEarned" example:"10"`
+	CorrectAnswer *QuestionAnswer  `json:"correctAnswer,omitempty"`
+	Explanation   string           `json:"explanation,omitempty" example:"学生 is read がくせい"`
+}
+
+type ExamAttemptResponse struct {
+	ID               string                        `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ExamID           uint                          `json:"examId" example:"1"`
+	Status           string                        `json:"status" example:"in_progress"`
+	StartedAt        string                        `json:"startedAt" example:"2024-01-15T10:30:00Z"`
+	ExpiresAt        string                        `json:"expiresAt" example:"2024-01-15T12:15:00Z"`
+	SubmittedAt      *string                       `json:"submittedAt,omitempty" example:"2024-01-15T12:00:00Z"`
+	RemainingSeconds int                           `json:"remainingSeconds" example:"5400"`
+	TotalScore       *int                          `json:"totalScore,omitempty" example:"115"`
+	MaxScore         int                           `json:"maxScore" example:"180"`
+	PassScore        int                           `json:"passScore" example:"80"`
+	Passed           *bool                         `json:"passed,omitempty" example:"true"`
+	Scores           []ExamScoreResponse           `json:"scores,omitempty"`
+	Exam             *ExamResponse                 `json:"exam,omitempty"`
+	Questions        []ExamAttemptQuestionResponse `json:"questions,omitempty"`
+}
+
+type ExamAttemptListResponse struct {
+	Data       []ExamAttemptResponse `json:"data"`
+	Pagination PaginationResponse    `json:"pagination"`
+}
+
+type ExamAttemptFilterRequest struct {
+	ExamID uint   `form:"examId" validate:"omitempty,min=1" example:"1"`
+	Status string `form:"status" validate:"omitempty,oneof=in_progress submitted timed_out" example:"submitted"`
+	PaginationRequest
+}
+
+// Swagger response wrappers
+type ExamSwaggerResponse struct {
+	Message string       `json:"message" example:"Exam created successfully"`
+	Status  string       `json:"status" example:"success"`
+	Data    ExamResponse `json:"data"`
+}
+
+type ExamListSwaggerResponse struct {
+	Message    string             `json:"message" example:"Exams retrieved successfully"`
+	Pagination PaginationResponse `json:"pagination"`
+	Status     string             `json:"status" example:"success"`
+	Data       []ExamResponse     `json:"data"`
+}
+
+type ExamAttemptSwaggerResponse struct {
+	Message string              `json:"message" example:"OK"`
+	Status  string              `json:"status" example:"success"`
+	Data    ExamAttemptResponse `json:"data"`
+}
+
+type ExamAttemptListSwaggerResponse struct {
+	Message    string                `json:"message" example:"Exam attempts retrieved successfully"`
+	Pagination PaginationResponse    `json:"pagination"`
+	Status     string                `json:"status" example:"success"`
+	Data       []ExamAttemptResponse `json:"data"`
+}
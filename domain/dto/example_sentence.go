@@ -0,0 +1,61 @@
+package dto
+
+type CreateExampleSentenceRequest struct {
+	Text          string `json:"text" validate:"required,min=1" example:"犬が好きです"`
+	Reading       string `json:"reading" validate:"omitempty" example:"いぬがすきです"`
+	Translation   string `json:"translation" validate:"required,min=1" example:"I like dogs"`
+	AudioURL      string `json:"audioUrl" validate:"omitempty,url,max=255" example:"https://example.com/audio/inu-sentence.mp3"`
+	Source        string `json:"source" validate:"omitempty,max=255" example:"Tatoeba #12345"`
+	Difficulty    int    `json:"difficulty" validate:"omitempty,min=1,max=5" example:"1"`
+	VocabularyIDs []uint `json:"vocabularyIds" validate:"omitempty,dive,min=1" example:"1,2"`
+}
+
+type UpdateExampleSentenceRequest struct {
+	Text          string `json:"text" validate:"required,min=1" example:"犬が好きです"`
+	Reading       string `json:"reading" validate:"omitempty" example:"いぬがすきです"`
+	Translation   string `json:"translation" validate:"required,min=1" example:"I like dogs"`
+	AudioURL      string `json:"audioUrl" validate:"omitempty,url,max=255" example:"https://example.com/audio/inu-sentence.mp3"`
+	Source        string `json:"source" validate:"omitempty,max=255" example:"Tatoeba #12345"`
+	Difficulty    int    `json:"difficulty" validate:"omitempty,min=1,max=5" example:"1"`
+	VocabularyIDs []uint `json:"vocabularyIds" validate:"omitempty,dive,min=1" example:"1,2"`
+}
+
+type ExampleSentenceResponse struct {
+	ID            uint              `json:"id" example:"1"`
+	Text          string            `json:"text" example:"犬が好きです"`
+	Reading       string            `json:"reading" example:"いぬがすきです"`
+	Translation   string            `json:"translation" example:"I like dogs"`
+	AudioURL      string            `json:"audioUrl,omitempty" example:"https://example.com/audio/inu-sentence.mp3"`
+	Source        string            `json:"source,omitempty" example:"Tatoeba #12345"`
+	Difficulty    int               `json:"difficulty" example:"1"`
+	VocabularyIDs []uint            `json:"vocabularyIds,omitempty" example:"1,2"`
+	Furigana      *FuriganaResponse `json:"furigana,omitempty"`
+}
+
+type ExampleSentenceListResponse struct {
+	Data       []ExampleSentenceResponse `json:"data"`
+	Pagination PaginationResponse        `json:"pagination"`
+}
+
+type ExampleSentenceFilterRequest struct {
+	VocabularyID uint   `form:"vocabularyId" validate:"omitempty,min=1" example:"1"`
+	Difficulty   int    `form:"difficulty" validate:"omitempty,min=1,max=5" example:"1"`
+	Search       string `form:"search" validate:"omitempty,max=100" example:"犬"`
+	SortBy       string `form:"sortBy" validate:"omitempty,oneof=difficulty created_at" example:"created_at"`
+	SortOrder    string `form:"sortOrder" validate:"omitempty,oneof=asc desc" example:"desc"`
+	PaginationRequest
+}
+
+// Swagger response wrappers (without token field)
+type ExampleSentenceSwaggerResponse struct {
+	Message string                  `json:"message" example:"Example sentence created successfully"`
+	Status  string                  `json:"status" example:"success"`
+	Data    ExampleSentenceResponse `json:"data"`
+}
+
+type ExampleSentenceListSwaggerResponse struct {
+	Message    string                    `json:"message" example:"Example sentences retrieved successfully"`
+	Pagination PaginationResponse        `json:"pagination"`
+	Status     string                    `json:"status" example:"success"`
+	Data       []ExampleSentenceResponse `json:"data"`
+}
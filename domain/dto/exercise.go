@@ -8,6 +8,9 @@ type CreateExerciseRequest struct {
 	OrderIndex       int    `json:"orderIndex" validate:"required,min=0" example:"1"`
 	DifficultyLevel  int    `json:"difficultyLevel" validate:"omitempty,min=1,max=5" example:"2"`
 	EstimatedMinutes int    `json:"estimatedMinutes" validate:"omitempty,min=1,max=60" example:"10"`
+	QuestionPoolSize int    `json:"questionPoolSize" validate:"omitempty,min=0,max=100" example:"5"`
+	ShuffleQuestions bool   `json:"shuffleQuestions" example:"true"`
+	ShuffleOptions   bool   `json:"shuffleOptions" example:"true"`
 }
 
 type UpdateExerciseRequest struct {
@@ -18,6 +21,9 @@ type UpdateExerciseRequest struct {
 	OrderIndex       int    `json:"orderIndex" validate:"required,min=0" example:"1"`
 	DifficultyLevel  int    `json:"difficultyLevel" validate:"omitempty,min=1,max=5" example:"2"`
 	EstimatedMinutes int    `json:"estimatedMinutes" validate:"omitempty,min=1,max=60" example:"10"`
+	QuestionPoolSize int    `json:"questionPoolSize" validate:"omitempty,min=0,max=100" example:"5"`
+	ShuffleQuestions bool   `json:"shuffleQuestions" example:"true"`
+	ShuffleOptions   bool   `json:"shuffleOptions" example:"true"`
 }
 
 type ExerciseResponse struct {
@@ -29,6 +35,9 @@ type ExerciseResponse struct {
 	OrderIndex       int             `json:"orderIndex" example:"1"`
 	DifficultyLevel  int             `json:"difficultyLevel" example:"2"`
 	EstimatedMinutes int             `json:"estimatedMinutes" example:"10"`
+	QuestionPoolSize int             `json:"questionPoolSize" example:"5"`
+	ShuffleQuestions bool            `json:"shuffleQuestions" example:"true"`
+	ShuffleOptions   bool            `json:"shuffleOptions" example:"true"`
 	IsPublished      bool            `json:"isPublished" example:"true"`
 	PublishedAt      *string         `json:"publishedAt,omitempty" example:"2024-01-15T10:30:00Z"`
 	Lesson           *LessonResponse `json:"lesson,omitempty"`
@@ -49,10 +58,6 @@ type ExerciseFilterRequest struct {
 	PaginationRequest
 }
 
-type PublishExerciseRequest struct {
-	IsPublished *bool `json:"isPublished" validate:"required" example:"true"`
-}
-
 // Swagger response wrappers (without token field)
 type ExerciseSwaggerResponse struct {
 	Message string           `json:"message" example:"Exercise created successfully"`
@@ -0,0 +1,33 @@
+package dto
+
+type ExerciseAttemptAnswerRequest struct {
+	QuestionID uint             `json:"questionId" validate:"required,min=1" example:"1"`
+	Answer     *SubmittedAnswer `json:"answer" validate:"required"`
+}
+
+type SubmitExerciseAttemptRequest struct {
+	Answers []ExerciseAttemptAnswerRequest `json:"answers" validate:"required,min=1,max=100,dive"`
+}
+
+// ExerciseAttemptResponse is the variant of an exercise served to a learner:
+// the drawn questions in attempt order with their options shuffled by the seed.
+// Results are included once the attempt is submitted.
+type ExerciseAttemptResponse struct {
+	ID          string                           `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ExerciseID  uint                             `json:"exerciseId" example:"1"`
+	Seed        int64                            `json:"seed" example:"8674665223082153551"`
+	Status      string                           `json:"status" example:"in_progress"`
+	StartedAt   string                           `json:"startedAt" example:"2024-01-15T10:30:00Z"`
+	SubmittedAt *string                          `json:"submittedAt,omitempty" example:"2024-01-15T10:40:00Z"`
+	Score       *int                             `json:"score,omitempty" example:"40"`
+	MaxScore    int                              `json:"maxScore" example:"50"`
+	Questions   []ExerciseQuestionPublicResponse `json:"questions"`
+	Results     []CheckAnswerResponse            `json:"results,omitempty"`
+}
+
+// Swagger response wrappers
+type ExerciseAttemptSwaggerResponse struct {
+	Message string                  `json:"message" example:"OK"`
+	Status  string                  `json:"status" example:"success"`
+	Data    ExerciseAttemptResponse `json:"data"`
+}
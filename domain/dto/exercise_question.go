@@ -1,46 +1,114 @@
 package dto
 
+import "github.com/google/uuid"
+
+// QuestionChoice is a keyed option shown to the learner.
+type QuestionChoice struct {
+	Key  string `json:"key" validate:"required,max=20" example:"a"`
+	Text string `json:"text" validate:"required,max=500" example:"あ"`
+}
+
+// QuestionOptions holds the options of a question. Which fields apply depends on the question type:
+//   - multiple_choice, listening: Choices
+//   - fill_blank: Template (optional), where every ___ marks a blank; defaults to the question text
+//   - matching: Left and Right items to be paired
+//   - speaking: no options
+type QuestionOptions struct {
+	Choices  []QuestionChoice `json:"choices,omitempty" validate:"omitempty,max=10,dive"`
+	Template string           `json:"template,omitempty" validate:"omitempty,max=1000" example:"わたしは___です"`
+	Left     []QuestionChoice `json:"left,omitempty" validate:"omitempty,max=10,dive"`
+	Right    []QuestionChoice `json:"right,omitempty" validate:"omitempty,max=10,dive"`
+}
+
+// MatchingPair links a left item key to a right item key.
+type MatchingPair struct {
+	Left  string `json:"left" validate:"required,max=20" example:"1"`
+	Right string `json:"right" validate:"required,max=20" example:"a"`
+}
+
+// QuestionAnswer holds the correct answer of a question. Which fields apply depends on the question type:
+//   - multiple_choice, listening: Key of the correct choice
+//   - fill_blank: Blanks, the accepted answers for each blank in order
+//   - matching: Pairs covering every left item
+//   - speaking: Text, the model answer
+type QuestionAnswer struct {
+	Key    string         `json:"key,omitempty" validate:"omitempty,max=20" example:"a"`
+	Blanks [][]string     `json:"blanks,omitempty" validate:"omitempty,max=10,dive,min=1,max=10,dive,required,max=500"`
+	Pairs  []MatchingPair `json:"pairs,omitempty" validate:"omitempty,max=10,dive"`
+	Text   string         `json:"text,omitempty" validate:"omitempty,max=500" example:"こんにちは"`
+}
+
+// SubmittedAnswer is a learner's answer to a question. Which fields apply depends on the question type:
+//   - multiple_choice, listening: Key of the chosen option
+//   - fill_blank: Blanks, one answer per blank in order
+//   - matching: Pairs
+type SubmittedAnswer struct {
+	Key    string         `json:"key,omitempty" validate:"omitempty,max=20" example:"a"`
+	Blanks []string       `json:"blanks,omitempty" validate:"omitempty,max=10,dive,max=500" example:"がくせい"`
+	Pairs  []MatchingPair `json:"pairs,omitempty" validate:"omitempty,max=10,dive"`
+}
+
+type CheckAnswerResponse struct {
+	QuestionID    uint            `json:"questionId" example:"1"`
+	IsCorrect     bool            `json:"isCorrect" example:"true"`
+	BlankResults  []bool          `json:"blankResults,omitempty"`
+	CorrectAnswer *QuestionAnswer `json:"correctAnswer,omitempty"`
+	Explanation   string          `json:"explanation,omitempty" example:"The Hiragana character for 'a' is あ"`
+}
+
 type CreateExerciseQuestionRequest struct {
-	ExerciseID    uint   `json:"exerciseId" validate:"required,min=1" example:"1"`
-	QuestionText  string `json:"questionText" validate:"required,min=3,max=1000" example:"What is the correct Hiragana for 'a'?"`
-	QuestionType  string `json:"questionType" validate:"required,oneof=multiple_choice fill_blank matching listening speaking" example:"multiple_choice"`
-	Options       string `json:"options" validate:"omitempty,max=2000" example:"{\"a\":\"あ\",\"b\":\"い\",\"c\":\"う\",\"d\":\"え\"}"`
-	CorrectAnswer string `json:"correctAnswer" validate:"required,min=1,max=500" example:"あ"`
-	Explanation   string `json:"explanation" validate:"omitempty,max=1000" example:"The Hiragana character for 'a' is あ"`
-	AudioURL      string `json:"audioUrl" validate:"omitempty,url,max=500" example:"https://example.com/audio/question1.mp3"`
-	ImageURL      string `json:"imageUrl" validate:"omitempty,url,max=500" example:"https://example.com/images/question1.jpg"`
-	OrderIndex    int    `json:"orderIndex" validate:"required,min=0" example:"1"`
-	Points        int    `json:"points" validate:"required,min=1,max=100" example:"10"`
+	ExerciseID       uint             `json:"exerciseId" validate:"required,min=1" example:"1"`
+	QuestionText     string           `json:"questionText" validate:"required,min=3,max=1000" example:"What is the correct Hiragana for 'a'?"`
+	QuestionType     string           `json:"questionType" validate:"required,oneof=multiple_choice fill_blank matching listening speaking" example:"multiple_choice"`
+	Options          *QuestionOptions `json:"options" validate:"omitempty"`
+	CorrectAnswer    *QuestionAnswer  `json:"correctAnswer" validate:"required"`
+	AnswerStrictness string           `json:"answerStrictness" validate:"omitempty,oneof=strict normal lenient" example:"normal"`
+	JlptSection      string           `json:"jlptSection" validate:"omitempty,oneof=vocabulary grammar reading listening" example:"vocabulary"`
+	Explanation      string           `json:"explanation" validate:"omitempty,max=1000" example:"The Hiragana character for 'a' is あ"`
+	AudioURL         string           `json:"audioUrl" validate:"omitempty,url,max=500" example:"https://example.com/audio/question1.mp3"`
+	ImageURL         string           `json:"imageUrl" validate:"omitempty,url,max=500" example:"https://example.com/images/question1.jpg"`
+	AudioMediaID     *uuid.UUID       `json:"audioMediaId" swaggertype:"string" format:"uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ImageMediaID     *uuid.UUID       `json:"imageMediaId" swaggertype:"string" format:"uuid" example:"550e8400-e29b-41d4-a716-446655440001"`
+	OrderIndex       int              `json:"orderIndex" validate:"required,min=0" example:"1"`
+	Points           int              `json:"points" validate:"required,min=1,max=100" example:"10"`
 }
 
 type UpdateExerciseQuestionRequest struct {
-	ExerciseID    uint   `json:"exerciseId" validate:"required,min=1" example:"1"`
-	QuestionText  string `json:"questionText" validate:"required,min=3,max=1000" example:"What is the correct Hiragana for 'a'?"`
-	QuestionType  string `json:"questionType" validate:"required,oneof=multiple_choice fill_blank matching listening speaking" example:"multiple_choice"`
-	Options       string `json:"options" validate:"omitempty,max=2000" example:"{\"a\":\"あ\",\"b\":\"い\",\"c\":\"う\",\"d\":\"え\"}"`
-	CorrectAnswer string `json:"correctAnswer" validate:"required,min=1,max=500" example:"あ"`
-	Explanation   string `json:"explanation" validate:"omitempty,max=1000" example:"The Hiragana character for 'a' is あ"`
-	AudioURL      string `json:"audioUrl" validate:"omitempty,url,max=500" example:"https://example.com/audio/question1.mp3"`
-	ImageURL      string `json:"imageUrl" validate:"omitempty,url,max=500" example:"https://example.com/images/question1.jpg"`
-	OrderIndex    int    `json:"orderIndex" validate:"required,min=0" example:"1"`
-	Points        int    `json:"points" validate:"required,min=1,max=100" example:"10"`
+	ExerciseID       uint             `json:"exerciseId" validate:"required,min=1" example:"1"`
+	QuestionText     string           `json:"questionText" validate:"required,min=3,max=1000" example:"What is the correct Hiragana for 'a'?"`
+	QuestionType     string           `json:"questionType" validate:"required,oneof=multiple_choice fill_blank matching listening speaking" example:"multiple_choice"`
+	Options          *QuestionOptions `json:"options" validate:"omitempty"`
+	CorrectAnswer    *QuestionAnswer  `json:"correctAnswer" validate:"required"`
+	AnswerStrictness string           `json:"answerStrictness" validate:"omitempty,oneof=strict normal lenient" example:"normal"`
+	JlptSection      string           `json:"jlptSection" validate:"omitempty,oneof=vocabulary grammar reading listening" example:"vocabulary"`
+	Explanation      string           `json:"explanation" validate:"omitempty,max=1000" example:"The Hiragana character for 'a' is あ"`
+	AudioURL         string           `json:"audioUrl" validate:"omitempty,url,max=500" example:"https://example.com/audio/question1.mp3"`
+	ImageURL         string           `json:"imageUrl" validate:"omitempty,url,max=500" example:"https://example.com/images/question1.jpg"`
+	AudioMediaID     *uuid.UUID       `json:"audioMediaId" swaggertype:"string" format:"uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ImageMediaID     *uuid.UUID       `json:"imageMediaId" swaggertype:"string" format:"uuid" example:"550e8400-e29b-41d4-a716-446655440001"`
+	OrderIndex       int              `json:"orderIndex" validate:"required,min=0" example:"1"`
+	Points           int              `json:"points" validate:"required,min=1,max=100" example:"10"`
 }
 
 type ExerciseQuestionResponse struct {
-	ID            uint              `json:"id" example:"1"`
-	ExerciseID    uint              `json:"exerciseId" example:"1"`
-	QuestionText  string            `json:"questionText" example:"What is the correct Hiragana for 'a'?"`
-	QuestionType  string            `json:"questionType" example:"multiple_choice"`
-	Options       string            `json:"options,omitempty" example:"{\"a\":\"あ\",\"b\":\"い\",\"c\":\"う\",\"d\":\"え\"}"`
-	CorrectAnswer string            `json:"correctAnswer" example:"あ"`
-	Explanation   string            `json:"explanation,omitempty" example:"The Hiragana character for 'a' is あ"`
-	AudioURL      string            `json:"audioUrl,omitempty" example:"https://example.com/audio/question1.mp3"`
-	ImageURL      string            `json:"imageUrl,omitempty" example:"https://example.com/images/question1.jpg"`
-	OrderIndex    int               `json:"orderIndex" example:"1"`
-	Points        int               `json:"points" example:"10"`
-	IsPublished   bool              `json:"isPublished" example:"true"`
-	PublishedAt   *string           `json:"publishedAt,omitempty" example:"2024-01-15T10:30:00Z"`
-	Exercise      *ExerciseResponse `json:"exercise,omitempty"`
+	ID               uint              `json:"id" example:"1"`
+	ExerciseID       uint              `json:"exerciseId" example:"1"`
+	QuestionText     string            `json:"questionText" example:"What is the correct Hiragana for 'a'?"`
+	QuestionType     string            `json:"questionType" example:"multiple_choice"`
+	Options          *QuestionOptions  `json:"options,omitempty"`
+	CorrectAnswer    *QuestionAnswer   `json:"correctAnswer"`
+	AnswerStrictness string            `json:"answerStrictness" example:"normal"`
+	JlptSection      string            `json:"jlptSection,omitempty" example:"vocabulary"`
+	Explanation      string            `json:"explanation,omitempty" example:"The Hiragana character for 'a' is あ"`
+	AudioURL         string            `json:"audioUrl,omitempty" example:"https://example.com/audio/question1.mp3"`
+	ImageURL         string            `json:"imageUrl,omitempty" example:"https://example.com/images/question1.jpg"`
+	AudioMediaID     *string           `json:"audioMediaId,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ImageMediaID     *string           `json:"imageMediaId,omitempty" example:"550e8400-e29b-41d4-a716-446655440001"`
+	OrderIndex       int               `json:"orderIndex" example:"1"`
+	Points           int               `json:"points" example:"10"`
+	IsPublished      bool              `json:"isPublished" example:"true"`
+	PublishedAt      *string           `json:"publishedAt,omitempty" example:"2024-01-15T10:30:00Z"`
+	Exercise         *ExerciseResponse `json:"exercise,omitempty"`
 }
 
 // ExerciseQuestionPublicResponse is used for public endpoints to hide sensitive fields like CorrectAnswer
@@ -49,7 +117,7 @@ type ExerciseQuestionPublicResponse struct {
 	ExerciseID   uint              `json:"exerciseId" example:"1"`
 	QuestionText string            `json:"questionText" example:"What is the correct Hiragana for 'a'?"`
 	QuestionType string            `json:"questionType" example:"multiple_choice"`
-	Options      string            `json:"options,omitempty" example:"{\"a\":\"あ\",\"b\":\"い\",\"c\":\"う\",\"d\":\"え\"}"`
+	Options      *QuestionOptions  `json:"options,omitempty"`
 	AudioURL     string            `json:"audioUrl,omitempty" example:"https://example.com/audio/question1.mp3"`
 	ImageURL     string            `json:"imageUrl,omitempty" example:"https://example.com/images/question1.jpg"`
 	OrderIndex   int               `json:"orderIndex" example:"1"`
@@ -72,6 +140,7 @@ type ExerciseQuestionPublicListResponse struct {
 type ExerciseQuestionFilterRequest struct {
 	ExerciseID   uint   `form:"exerciseId" validate:"omitempty,min=1" example:"1"`
 	QuestionType string `form:"questionType" validate:"omitempty,oneof=multiple_choice fill_blank matching listening speaking" example:"multiple_choice"`
+	JlptSection  string `form:"jlptSection" validate:"omitempty,oneof=vocabulary grammar reading listening" example:"vocabulary"`
 	IsPublished  *bool  `form:"isPublished" validate:"omitempty" example:"true"`
 	Search       string `form:"search" validate:"omitempty,max=100" example:"hiragana"`
 	SortBy       string `form:"sortBy" validate:"omitempty,oneof=order_index question_text created_at points" example:"order_index"`
@@ -79,10 +148,6 @@ type ExerciseQuestionFilterRequest struct {
 	PaginationRequest
 }
 
-type PublishExerciseQuestionRequest struct {
-	IsPublished *bool `json:"isPublished" validate:"required" example:"true"`
-}
-
 // Swagger response wrappers
 type ExerciseQuestionSwaggerResponse struct {
 	Message string                   `json:"message" example:"Exercise question created successfully"`
@@ -90,6 +155,12 @@ type ExerciseQuestionSwaggerResponse struct {
 	Data    ExerciseQuestionResponse `json:"data"`
 }
 
+type CheckAnswerSwaggerResponse struct {
+	Message string              `json:"message" example:"OK"`
+	Status  string              `json:"status" example:"success"`
+	Data    CheckAnswerResponse `json:"data"`
+}
+
 type ExerciseQuestionListSwaggerResponse struct {
 	Message    string                     `json:"message" example:"Exercise questions retrieved successfully"`
 	Pagination PaginationResponse         `json:"pagination"`
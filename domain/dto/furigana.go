@@ -0,0 +1,23 @@
+package dto
+
+type FuriganaRequest struct {
+	Text    string `json:"text" validate:"required,min=1,max=10000" example:"日本語を勉強します"`
+	Reading string `json:"reading" validate:"omitempty,max=20000" example:"にほんごをべんきょうします"`
+}
+
+type FuriganaSegment struct {
+	Text    string `json:"text" example:"日本語"`
+	Reading string `json:"reading,omitempty" example:"にほんご"`
+}
+
+type FuriganaResponse struct {
+	Segments []FuriganaSegment `json:"segments"`
+	HTML     string            `json:"html" example:"<ruby>日本語<rt>にほんご</rt></ruby>を<ruby>勉強<rt>べんきょう</rt></ruby>します"`
+}
+
+// Swagger response wrappers (without token field)
+type FuriganaSwaggerResponse struct {
+	Message string           `json:"message" example:"OK"`
+	Status  string           `json:"status" example:"success"`
+	Data    FuriganaResponse `json:"data"`
+}
@@ -1,31 +1,133 @@
 package dto
 
+import "github.com/google/uuid"
+
 type CreateLessonRequest struct {
-	CourseID         uint   `json:"courseId" validate:"required,min=1" example:"1"`
-	Title            string `json:"title" validate:"required,min=3,max=255" example:"Introduction to Hiragana"`
-	Content          string `json:"content" validate:"omitempty" example:"Learn the basics of Hiragana characters..."`
-	OrderIndex       int    `json:"orderIndex" validate:"required,min=0" example:"1"`
-	EstimatedMinutes int    `json:"estimatedMinutes" validate:"omitempty,min=0" example:"30"`
+	CourseID         uint          `json:"courseId" validate:"required,min=1" example:"1"`
+	Title            string        `json:"title" validate:"required,min=3,max=255" example:"Introduction to Hiragana"`
+	Content          string        `json:"content" validate:"omitempty" example:"Learn the basics of Hiragana characters..."`
+	Blocks           []LessonBlock `json:"blocks" validate:"omitempty,max=100,dive"`
+	OrderIndex       int           `json:"orderIndex" validate:"required,min=0" example:"1"`
+	EstimatedMinutes int           `json:"estimatedMinutes" validate:"omitempty,min=0" example:"30"`
+	UnlockRule       string        `json:"unlockRule" validate:"omitempty,oneof=none previous_lesson previous_score" example:"previous_score"`
+	UnlockMinScore   int           `json:"unlockMinScore" validate:"omitempty,min=0,max=100" example:"80"`
 }
 
 type UpdateLessonRequest struct {
-	CourseID         uint   `json:"courseId" validate:"required,min=1" example:"1"`
-	Title            string `json:"title" validate:"required,min=3,max=255" example:"Introduction to Hiragana"`
-	Content          string `json:"content" validate:"omitempty" example:"Learn the basics of Hiragana characters..."`
-	OrderIndex       int    `json:"orderIndex" validate:"required,min=0" example:"1"`
-	EstimatedMinutes int    `json:"estimatedMinutes" validate:"omitempty,min=0" example:"30"`
+	CourseID         uint          `json:"courseId" validate:"required,min=1" example:"1"`
+	Title            string        `json:"title" validate:"required,min=3,max=255" example:"Introduction to Hiragana"`
+	Content          string        `json:"content" validate:"omitempty" example:"Learn the basics of Hiragana characters..."`
+	Blocks           []LessonBlock `json:"blocks" validate:"omitempty,max=100,dive"`
+	OrderIndex       int           `json:"orderIndex" validate:"required,min=0" example:"1"`
+	EstimatedMinutes int           `json:"estimatedMinutes" validate:"omitempty,min=0" example:"30"`
+	UnlockRule       string        `json:"unlockRule" validate:"omitempty,oneof=none previous_lesson previous_score" example:"previous_score"`
+	UnlockMinScore   int           `json:"unlockMinScore" validate:"omitempty,min=0,max=100" example:"80"`
 }
 
 type LessonResponse struct {
-	ID               uint            `json:"id" example:"1"`
-	CourseID         uint            `json:"courseId" example:"1"`
-	Title            string          `json:"title" example:"Introduction to Hiragana"`
-	Content          string          `json:"content" example:"Learn the basics of Hiragana characters..."`
-	OrderIndex       int             `json:"orderIndex" example:"1"`
-	EstimatedMinutes int             `json:"estimatedMinutes" example:"30"`
-	IsPublished      bool            `json:"isPublished" example:"true"`
-	PublishedAt      *string         `json:"publishedAt,omitempty" example:"2024-01-15T10:30:00Z"`
-	Course           *CourseResponse `json:"course,omitempty"`
+	ID               uint                  `json:"id" example:"1"`
+	CourseID         uint                  `json:"courseId" example:"1"`
+	Title            string                `json:"title" example:"Introduction to Hiragana"`
+	Content          string                `json:"content" example:"Learn the basics of Hiragana characters..."`
+	Blocks           []LessonBlockResponse `json:"blocks"`
+	OrderIndex       int                   `json:"orderIndex" example:"1"`
+	EstimatedMinutes int                   `json:"estimatedMinutes" example:"30"`
+	UnlockRule       string                `json:"unlockRule" example:"previous_score"`
+	UnlockMinScore   int                   `json:"unlockMinScore" example:"80"`
+	IsPublished      bool                  `json:"isPublished" example:"true"`
+	PublishedAt      *string               `json:"publishedAt,omitempty" example:"2024-01-15T10:30:00Z"`
+	Course           *CourseResponse       `json:"course,omitempty"`
+	ContentFurigana  *FuriganaResponse     `json:"contentFurigana,omitempty"`
+	Access           *LessonAccessResponse `json:"access,omitempty"`
+	IsBookmarked     *bool                 `json:"isBookmarked,omitempty" example:"true"`
+}
+
+// LessonAccessResponse is the lock state of a lesson for the authenticated learner
+type LessonAccessResponse struct {
+	Locked  bool   `json:"locked" example:"true"`
+	Reason  string `json:"reason" example:"previous_score"`
+	Message string `json:"message,omitempty" example:"Score at least 80% on the exercises of the previous lesson to unlock this lesson"`
+}
+
+// LessonBlock is a content block of a lesson. The type selects the fields that apply:
+//   - markdown: Markdown
+//   - audio, image: MediaID or URL, with an optional Caption (and AltText for images)
+//   - vocabulary: VocabularyID, shown as a vocabulary card
+//   - grammar: Grammar, a grammar point explained inline
+//   - question: Question, a practice question answered inline
+type LessonBlock struct {
+	Type         string               `json:"type" validate:"required,oneof=markdown audio image vocabulary grammar question" example:"markdown"`
+	Markdown     string               `json:"markdown,omitempty" validate:"omitempty,max=20000" example:"Hiragana has **46** basic characters."`
+	MediaID      *uuid.UUID           `json:"mediaId,omitempty" swaggertype:"string" format:"uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
+	URL          string               `json:"url,omitempty" validate:"omitempty,url,max=500" example:"https://example.com/audio/a.mp3"`
+	Caption      string               `json:"caption,omitempty" validate:"omitempty,max=255" example:"Pronunciation of あ"`
+	AltText      string               `json:"altText,omitempty" validate:"omitempty,max=255" example:"Stroke order of あ"`
+	VocabularyID uint                 `json:"vocabularyId,omitempty" validate:"omitempty,min=1" example:"1"`
+	Grammar      *GrammarBlock        `json:"grammar,omitempty"`
+	Question     *InlineQuestionBlock `json:"question,omitempty"`
+}
+
+// GrammarBlock is a grammar point explained in a lesson. Explanation is markdown.
+type GrammarBlock struct {
+	Pattern        string `json:"pattern" validate:"required,max=255" example:"〜てください"`
+	Meaning        string `json:"meaning" validate:"required,max=500" example:"Please do ~"`
+	Explanation    string `json:"explanation,omitempty" validate:"omitempty,max=5000" example:"Attach to the te-form of a verb to make a polite request."`
+	Example        string `json:"example,omitempty" validate:"omitempty,max=500" example:"ゆっくり話してください"`
+	ExampleMeaning string `json:"exampleMeaning,omitempty" validate:"omitempty,max=500" example:"Please speak slowly"`
+}
+
+// InlineQuestionBlock is a practice question answered within a lesson. Options and the
+// correct answer follow the schema of exercise questions of the same type.
+type InlineQuestionBlock struct {
+	QuestionText  string           `json:"questionText" validate:"required,min=3,max=1000" example:"Which character is read 'a'?"`
+	QuestionType  string           `json:"questionType" validate:"required,oneof=multiple_choice fill_blank matching" example:"multiple_choice"`
+	Options       *QuestionOptions `json:"options,omitempty"`
+	CorrectAnswer *QuestionAnswer  `json:"correctAnswer" validate:"required"`
+	Explanation   string           `json:"explanation,omitempty" validate:"omitempty,max=1000" example:"あ is read 'a'"`
+}
+
+// InlineQuestionPublicBlock is an inline practice question shown to learners. The correct
+// answer and explanation are only revealed once an answer is checked.
+type InlineQuestionPublicBlock struct {
+	QuestionText string           `json:"questionText" example:"Which character is read 'a'?"`
+	QuestionType string           `json:"questionType" example:"multiple_choice"`
+	Options      *QuestionOptions `json:"options,omitempty"`
+}
+
+// InlineCheckAnswerResponse is the result of checking an answer to an inline question of a lesson
+type InlineCheckAnswerResponse struct {
+	LessonID      uint            `json:"lessonId" example:"1"`
+	BlockIndex    int             `json:"blockIndex" example:"3"`
+	IsCorrect     bool            `json:"isCorrect" example:"true"`
+	BlankResults  []bool          `json:"blankResults,omitempty"`
+	CorrectAnswer *QuestionAnswer `json:"correctAnswer"`
+	Explanation   string          `json:"explanation,omitempty" example:"あ is read 'a'"`
+}
+
+// LessonBlockResponse is a content block of a lesson rendered for display. HTML holds the
+// sanitised HTML of markdown blocks and of grammar explanations.
+type LessonBlockResponse struct {
+	Type         string                     `json:"type" example:"markdown"`
+	Markdown     string                     `json:"markdown,omitempty" example:"Hiragana has **46** basic characters."`
+	HTML         string                     `json:"html,omitempty" example:"<p>Hiragana has <strong>46</strong> basic characters.</p>"`
+	MediaID      *string                    `json:"mediaId,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	URL          string                     `json:"url,omitempty" example:"https://example.com/audio/a.mp3"`
+	Caption      string                     `json:"caption,omitempty" example:"Pronunciation of あ"`
+	AltText      string                     `json:"altText,omitempty" example:"Stroke order of あ"`
+	VocabularyID uint                       `json:"vocabularyId,omitempty" example:"1"`
+	Vocabulary   *VocabularyCard            `json:"vocabulary,omitempty"`
+	Grammar      *GrammarBlock              `json:"grammar,omitempty"`
+	Question     *InlineQuestionPublicBlock `json:"question,omitempty"`
+}
+
+// VocabularyCard is the vocabulary word shown by a vocabulary block
+type VocabularyCard struct {
+	Word         string `json:"word" example:"犬"`
+	Reading      string `json:"reading" example:"いぬ"`
+	Meaning      string `json:"meaning" example:"dog"`
+	PartOfSpeech string `json:"partOfSpeech" example:"noun"`
+	AudioURL     string `json:"audioUrl,omitempty" example:"https://example.com/audio/inu.mp3"`
+	ImageURL     string `json:"imageUrl,omitempty" example:"https://example.com/images/dog.jpg"`
 }
 
 type LessonListResponse struct {
@@ -55,3 +157,9 @@ type LessonListSwaggerResponse struct {
 	Status     string             `json:"status" example:"success"`
 	Data       []LessonResponse   `json:"data"`
 }
+
+type InlineCheckAnswerSwaggerResponse struct {
+	Message string                    `json:"message" example:"OK"`
+	Status  string                    `json:"status" example:"success"`
+	Data    InlineCheckAnswerResponse `json:"data"`
+}
@@ -0,0 +1,37 @@
+package dto
+
+type SetLessonVocabulariesRequest struct {
+	VocabularyIDs []uint `json:"vocabularyIds" validate:"required,max=200,unique,dive,min=1" example:"1,2"`
+}
+
+type LessonVocabularyResponse struct {
+	VocabularyID uint   `json:"vocabularyId" example:"1"`
+	OrderIndex   int    `json:"orderIndex" example:"1"`
+	Word         string `json:"word" example:"犬"`
+	Reading      string `json:"reading" example:"いぬ"`
+	Meaning      string `json:"meaning" example:"dog"`
+	PartOfSpeech string `json:"partOfSpeech" example:"noun"`
+	JlptLevelID  uint   `json:"jlptLevelId" example:"5"`
+	AudioURL     string `json:"audioUrl" example:"https://example.com/audio/inu.mp3"`
+	ImageURL     string `json:"imageUrl" example:"https://example.com/images/dog.jpg"`
+}
+
+// StudyLessonVocabulariesResponse reports how many words of a lesson were added to the learner's deck
+type StudyLessonVocabulariesResponse struct {
+	LessonID        uint `json:"lessonId" example:"1"`
+	Added           int  `json:"added" example:"12"`
+	AlreadyLearning int  `json:"alreadyLearning" example:"3"`
+}
+
+// Swagger response wrappers
+type LessonVocabularyListSwaggerResponse struct {
+	Message string                     `json:"message" example:"OK"`
+	Status  string                     `json:"status" example:"success"`
+	Data    []LessonVocabularyResponse `json:"data"`
+}
+
+type StudyLessonVocabulariesSwaggerResponse struct {
+	Message string                          `json:"message" example:"OK"`
+	Status  string                          `json:"status" example:"success"`
+	Data    StudyLessonVocabulariesResponse `json:"data"`
+}
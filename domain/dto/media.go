@@ -0,0 +1,21 @@
+package dto
+
+type MediaResponse struct {
+	ID           string `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Kind         string `json:"kind" example:"image"`
+	FileName     string `json:"fileName,omitempty" example:"dog.png"`
+	ContentType  string `json:"contentType" example:"image/png"`
+	SizeBytes    int64  `json:"sizeBytes" example:"204800"`
+	URL          string `json:"url" example:"/api/v1/media/550e8400-e29b-41d4-a716-446655440000/file"`
+	ThumbnailURL string `json:"thumbnailUrl,omitempty" example:"/api/v1/media/550e8400-e29b-41d4-a716-446655440000/thumbnail"`
+	Width        int    `json:"width,omitempty" example:"1024"`
+	Height       int    `json:"height,omitempty" example:"768"`
+	CreatedAt    string `json:"createdAt" example:"2024-01-15T10:30:00Z"`
+}
+
+// Swagger response wrappers
+type MediaSwaggerResponse struct {
+	Message string        `json:"message" example:"OK"`
+	Status  string        `json:"status" example:"success"`
+	Data    MediaResponse `json:"data"`
+}
@@ -0,0 +1,36 @@
+package dto
+
+type NotificationResponse struct {
+	ID        uint                   `json:"id" example:"1"`
+	Type      string                 `json:"type" example:"speaking_reviewed"`
+	Title     string                 `json:"title" example:"Your speaking answer was reviewed"`
+	Message   string                 `json:"message" example:"You scored 8/10. Good pitch accent, watch the long vowel in がっこう"`
+	Data      map[string]interface{} `json:"data,omitempty"`
+	IsRead    bool                   `json:"isRead" example:"false"`
+	ReadAt    *string                `json:"readAt,omitempty" example:"2024-01-16T10:00:00Z"`
+	CreatedAt string                 `json:"createdAt" example:"2024-01-16T09:00:00Z"`
+}
+
+type NotificationListResponse struct {
+	Data       []NotificationResponse `json:"data"`
+	Pagination PaginationResponse     `json:"pagination"`
+}
+
+type NotificationFilterRequest struct {
+	Unread *bool `form:"unread" validate:"omitempty" example:"true"`
+	PaginationRequest
+}
+
+// Swagger response wrappers
+type NotificationSwaggerResponse struct {
+	Message string               `json:"message" example:"OK"`
+	Status  string               `json:"status" example:"success"`
+	Data    NotificationResponse `json:"data"`
+}
+
+type NotificationListSwaggerResponse struct {
+	Message    string                 `json:"message" example:"Notifications retrieved successfully"`
+	Pagination PaginationResponse     `json:"pagination"`
+	Status     string                 `json:"status" example:"success"`
+	Data       []NotificationResponse `json:"data"`
+}
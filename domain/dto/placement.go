@@ -0,0 +1,38 @@
+package dto
+
+// SubmitPlacementAnswerRequest is the answer to the current question of a placement test
+type SubmitPlacementAnswerRequest struct {
+	QuestionID uint             `json:"questionId" validate:"required,min=1" example:"1"`
+	Answer     *SubmittedAnswer `json:"answer" validate:"required"`
+}
+
+// PlacementQuestionResponse is the question currently served by a placement test
+type PlacementQuestionResponse struct {
+	QuestionID   uint             `json:"questionId" example:"1"`
+	JlptLevelID  uint             `json:"jlptLevelId" example:"5"`
+	QuestionText string           `json:"questionText" example:"What is the reading of 学生?"`
+	QuestionType string           `json:"questionType" example:"multiple_choice"`
+	Options      *QuestionOptions `json:"options,omitempty"`
+	AudioURL     string           `json:"audioUrl,omitempty" example:"https://example.com/audio/question1.mp3"`
+	ImageURL     string           `json:"imageUrl,omitempty" example:"https://example.com/images/question1.jpg"`
+}
+
+type PlacementTestResponse struct {
+	ID                 string                     `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Status             string                     `json:"status" example:"in_progress"`
+	StartedAt          string                     `json:"startedAt" example:"2024-01-15T10:30:00Z"`
+	CompletedAt        *string                    `json:"completedAt,omitempty" example:"2024-01-15T10:45:00Z"`
+	QuestionsAnswered  int                        `json:"questionsAnswered" example:"7"`
+	CorrectAnswers     int                        `json:"correctAnswers" example:"5"`
+	LastAnswerCorrect  *bool                      `json:"lastAnswerCorrect,omitempty" example:"true"`
+	CurrentQuestion    *PlacementQuestionResponse `json:"currentQuestion,omitempty"`
+	EstimatedJlptLevel *JlptLevelResponse         `json:"estimatedJlptLevel,omitempty"`
+	RecommendedCourses []CourseResponse           `json:"recommendedCourses,omitempty"`
+}
+
+// Swagger response wrappers
+type PlacementTestSwaggerResponse struct {
+	Message string                `json:"message" example:"OK"`
+	Status  string                `json:"status" example:"success"`
+	Data    PlacementTestResponse `json:"data"`
+}
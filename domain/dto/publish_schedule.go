@@ -0,0 +1,29 @@
+package dto
+
+import "time"
+
+type PublishScheduleRequest struct {
+	PublishAt   *time.Time `json:"publishAt" swaggertype:"string" example:"2024-02-01T09:00:00+07:00"`
+	UnpublishAt *time.Time `json:"unpublishAt" swaggertype:"string" example:"2024-03-01T09:00:00+07:00"`
+	Cascade     bool       `json:"cascade" example:"true"`
+}
+
+type PublishScheduleResponse struct {
+	ContentType     string  `json:"contentType" example:"course"`
+	ContentID       uint    `json:"contentId" example:"1"`
+	PublishAt       *string `json:"publishAt,omitempty" example:"2024-02-01T09:00:00+07:00"`
+	PublishStatus   string  `json:"publishStatus,omitempty" example:"pending"`
+	Cascade         bool    `json:"cascade" example:"true"`
+	UnpublishAt     *string `json:"unpublishAt,omitempty" example:"2024-03-01T09:00:00+07:00"`
+	UnpublishStatus string  `json:"unpublishStatus,omitempty" example:"pending"`
+	LastError       string  `json:"lastError,omitempty" example:"invalid workflow transition"`
+	ScheduledBy     string  `json:"scheduledBy" example:"550e8400-e29b-41d4-a716-446655440000"`
+	UpdatedAt       *string `json:"updatedAt,omitempty" example:"2024-01-15T10:30:00Z"`
+}
+
+// Swagger response wrappers
+type PublishScheduleSwaggerResponse struct {
+	Message string                  `json:"message" example:"OK"`
+	Status  string                  `json:"status" example:"success"`
+	Data    PublishScheduleResponse `json:"data"`
+}
@@ -0,0 +1,42 @@
+package dto
+
+// VocabularyQuizRequest selects the vocabulary an ad-hoc quiz is generated from.
+// At least one of CategoryID, JlptLevelID or LessonID is required.
+type VocabularyQuizRequest struct {
+	CategoryID  uint     `form:"categoryId" validate:"omitempty,min=1" example:"1"`
+	JlptLevelID uint     `form:"jlptLevelId" validate:"omitempty,min=1" example:"5"`
+	LessonID    uint     `form:"lessonId" validate:"omitempty,min=1" example:"1"`
+	Types       []string `form:"types" validate:"omitempty,max=3,unique,dive,oneof=meaning reading listening" example:"meaning"`
+	Count       int      `form:"count" validate:"omitempty,min=1,max=50" example:"10"`
+	Choices     int      `form:"choices" validate:"omitempty,min=2,max=6" example:"4"`
+}
+
+// GenerateExerciseQuestionsRequest selects the vocabulary draft exercise questions are generated from.
+// At least one of CategoryID, JlptLevelID or LessonID is required.
+type GenerateExerciseQuestionsRequest struct {
+	CategoryID  uint     `json:"categoryId" validate:"omitempty,min=1" example:"1"`
+	JlptLevelID uint     `json:"jlptLevelId" validate:"omitempty,min=1" example:"5"`
+	LessonID    uint     `json:"lessonId" validate:"omitempty,min=1" example:"1"`
+	Types       []string `json:"types" validate:"omitempty,max=3,unique,dive,oneof=meaning reading listening" example:"meaning,reading"`
+	Count       int      `json:"count" validate:"omitempty,min=1,max=50" example:"10"`
+	Choices     int      `json:"choices" validate:"omitempty,min=2,max=6" example:"4"`
+	Points      int      `json:"points" validate:"omitempty,min=1,max=100" example:"10"`
+}
+
+// QuizQuestionResponse is a generated question about a vocabulary word
+type QuizQuestionResponse struct {
+	VocabularyID  uint             `json:"vocabularyId" example:"1"`
+	Kind          string           `json:"kind" example:"meaning"`
+	QuestionType  string           `json:"questionType" example:"multiple_choice"`
+	QuestionText  string           `json:"questionText" example:"Which word means: dog?"`
+	AudioURL      string           `json:"audioUrl,omitempty" example:"https://example.com/audio/inu.mp3"`
+	Options       *QuestionOptions `json:"options"`
+	CorrectAnswer *QuestionAnswer  `json:"correctAnswer"`
+}
+
+// Swagger response wrappers
+type QuizQuestionListSwaggerResponse struct {
+	Message string                 `json:"message" example:"OK"`
+	Status  string                 `json:"status" example:"success"`
+	Data    []QuizQuestionResponse `json:"data"`
+}
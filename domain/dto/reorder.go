@@ -0,0 +1,7 @@
+package dto
+
+// ReorderRequest lists every child of a parent (lessons of a course, exercises of a lesson
+// or questions of an exercise) in their new order
+type ReorderRequest struct {
+	IDs []uint `json:"ids" validate:"required,min=1,unique" example:"3,1,2"`
+}
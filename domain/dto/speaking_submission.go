@@ -0,0 +1,47 @@
+package dto
+
+type ReviewSpeakingSubmissionRequest struct {
+	Score    *int   `json:"score" validate:"required,min=0" example:"8"`
+	Feedback string `json:"feedback" validate:"omitempty,max=2000" example:"Good pitch accent, watch the long vowel in がっこう"`
+}
+
+type SpeakingSubmissionResponse struct {
+	ID           string  `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	QuestionID   uint    `json:"questionId" example:"1"`
+	QuestionText string  `json:"questionText,omitempty" example:"Read the sentence aloud: わたしはがくせいです"`
+	UserID       string  `json:"userId" example:"550e8400-e29b-41d4-a716-446655440001"`
+	Status       string  `json:"status" example:"pending"`
+	AudioURL     string  `json:"audioUrl" example:"/api/v1/speaking-submissions/550e8400-e29b-41d4-a716-446655440000/audio"`
+	ContentType  string  `json:"contentType" example:"audio/webm"`
+	SizeBytes    int64   `json:"sizeBytes" example:"48213"`
+	Score        *int    `json:"score,omitempty" example:"8"`
+	MaxScore     int     `json:"maxScore" example:"10"`
+	Feedback     string  `json:"feedback,omitempty" example:"Good pitch accent, watch the long vowel in がっこう"`
+	ReviewedAt   *string `json:"reviewedAt,omitempty" example:"2024-01-16T09:00:00Z"`
+	CreatedAt    string  `json:"createdAt" example:"2024-01-15T10:30:00Z"`
+}
+
+type SpeakingSubmissionListResponse struct {
+	Data       []SpeakingSubmissionResponse `json:"data"`
+	Pagination PaginationResponse           `json:"pagination"`
+}
+
+type SpeakingSubmissionFilterRequest struct {
+	QuestionID uint   `form:"questionId" validate:"omitempty,min=1" example:"1"`
+	Status     string `form:"status" validate:"omitempty,oneof=pending reviewed" example:"pending"`
+	PaginationRequest
+}
+
+// Swagger response wrappers
+type SpeakingSubmissionSwaggerResponse struct {
+	Message string                     `json:"message" example:"OK"`
+	Status  string                     `json:"status" example:"success"`
+	Data    SpeakingSubmissionResponse `json:"data"`
+}
+
+type SpeakingSubmissionListSwaggerResponse struct {
+	Message    string                       `json:"message" example:"Speaking submissions retrieved successfully"`
+	Pagination PaginationResponse           `json:"pagination"`
+	Status     string                       `json:"status" example:"success"`
+	Data       []SpeakingSubmissionResponse `json:"data"`
+}
@@ -0,0 +1,72 @@
+package dto
+
+type UpsertTranslationRequest struct {
+	EntityType string `json:"entityType" validate:"required,oneof=vocabulary course lesson exercise_question" example:"vocabulary"`
+	EntityID   uint   `json:"entityId" validate:"required,min=1" example:"1"`
+	Field      string `json:"field" validate:"required,max=50" example:"meaning"`
+	Locale     string `json:"locale" validate:"required,min=2,max=10" example:"id"`
+	Value      string `json:"value" validate:"required,min=1" example:"anjing"`
+}
+
+type TranslationResponse struct {
+	ID         uint    `json:"id" example:"1"`
+	EntityType string  `json:"entityType" example:"vocabulary"`
+	EntityID   uint    `json:"entityId" example:"1"`
+	Field      string  `json:"field" example:"meaning"`
+	Locale     string  `json:"locale" example:"id"`
+	Value      string  `json:"value" example:"anjing"`
+	UpdatedAt  *string `json:"updatedAt,omitempty" example:"2024-01-15T10:30:00Z"`
+}
+
+type TranslationListResponse struct {
+	Data       []TranslationResponse `json:"data"`
+	Pagination PaginationResponse    `json:"pagination"`
+}
+
+type TranslationFilterRequest struct {
+	EntityType string `form:"entityType" validate:"omitempty,oneof=vocabulary course lesson exercise_question" example:"vocabulary"`
+	EntityID   uint   `form:"entityId" validate:"omitempty,min=1" example:"1"`
+	Locale     string `form:"locale" validate:"omitempty,min=2,max=10" example:"id"`
+	PaginationRequest
+}
+
+// MissingTranslationResponse describes an entity field that has no translation for a locale
+type MissingTranslationResponse struct {
+	EntityType string `json:"entityType" example:"vocabulary"`
+	EntityID   uint   `json:"entityId" example:"1"`
+	Field      string `json:"field" example:"meaning"`
+	Locale     string `json:"locale" example:"id"`
+	SourceText string `json:"sourceText" example:"dog"`
+}
+
+type MissingTranslationListResponse struct {
+	Data       []MissingTranslationResponse `json:"data"`
+	Pagination PaginationResponse           `json:"pagination"`
+}
+
+type MissingTranslationFilterRequest struct {
+	EntityType string `form:"entityType" validate:"required,oneof=vocabulary course lesson exercise_question" example:"vocabulary"`
+	Locale     string `form:"locale" validate:"required,min=2,max=10" example:"id"`
+	PaginationRequest
+}
+
+// Swagger response wrappers (without token field)
+type TranslationSwaggerResponse struct {
+	Message string              `json:"message" example:"Translation saved successfully"`
+	Status  string              `json:"status" example:"success"`
+	Data    TranslationResponse `json:"data"`
+}
+
+type TranslationListSwaggerResponse struct {
+	Message    string                `json:"message" example:"Translations retrieved successfully"`
+	Pagination PaginationResponse    `json:"pagination"`
+	Status     string                `json:"status" example:"success"`
+	Data       []TranslationResponse `json:"data"`
+}
+
+type MissingTranslationListSwaggerResponse struct {
+	Message    string                       `json:"message" example:"Missing translations retrieved successfully"`
+	Pagination PaginationResponse           `json:"pagination"`
+	Status     string                       `json:"status" example:"success"`
+	Data       []MissingTranslationResponse `json:"data"`
+}
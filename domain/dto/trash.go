@@ -0,0 +1,28 @@
+package dto
+
+type TrashFilterRequest struct {
+	ContentType string `form:"contentType" validate:"omitempty,oneof=course lesson exercise exercise_question" example:"course"`
+	PaginationRequest
+}
+
+type TrashItemResponse struct {
+	ContentType string `json:"contentType" example:"lesson"`
+	ContentID   uint   `json:"contentId" example:"3"`
+	Title       string `json:"title" example:"Greetings"`
+	ParentID    *uint  `json:"parentId,omitempty" example:"1"`
+	DeletedAt   string `json:"deletedAt" example:"2024-01-15T10:30:00Z"`
+	PurgeAt     string `json:"purgeAt" example:"2024-02-14T10:30:00Z"`
+}
+
+type TrashListResponse struct {
+	Data       []TrashItemResponse `json:"data"`
+	Pagination PaginationResponse  `json:"pagination"`
+}
+
+// Swagger response wrappers
+type TrashListSwaggerResponse struct {
+	Message    string              `json:"message" example:"Trash retrieved successfully"`
+	Pagination PaginationResponse  `json:"pagination"`
+	Status     string              `json:"status" example:"success"`
+	Data       []TrashItemResponse `json:"data"`
+}
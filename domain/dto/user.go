@@ -8,12 +8,13 @@ type LoginRequest struct {
 }
 
 type UserResponse struct {
-	ID       uint      `json:"-"` // Internal use only, not exposed in JSON
-	UUID     uuid.UUID `json:"uuid"`
-	Name     string    `json:"name"`
-	Username string    `json:"username"`
-	Email    string    `json:"email"`
-	Role     string    `json:"role,omitempty"`
+	ID          uint      `json:"-"` // Internal use only, not exposed in JSON
+	UUID        uuid.UUID `json:"uuid"`
+	Name        string    `json:"name"`
+	Username    string    `json:"username"`
+	Email       string    `json:"email"`
+	Role        string    `json:"role,omitempty"`
+	JlptLevelID *uint     `json:"jlptLevelId,omitempty"`
 }
 
 type LoginResponse struct {
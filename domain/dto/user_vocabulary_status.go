@@ -9,15 +9,16 @@ type CreateUserVocabStatusRequest struct {
 
 // UserVocabStatusResponse represents the response for user vocabulary status
 type UserVocabStatusResponse struct {
-	ID             uint                `json:"id" example:"1"`
-	UserID         string              `json:"userId" example:"1"`
-	VocabularyID   uint                `json:"vocabularyId" example:"1"`
-	Vocabulary     *VocabularyResponse `json:"vocabulary,omitempty"`
-	Status         string              `json:"status" example:"learning"`
-	Repetitions    int                 `json:"repetitions" example:"0"`
-	LastReviewedAt *time.Time          `json:"lastReviewedAt,omitempty" example:"2024-01-08T10:00:00Z"`
-	CreatedAt      time.Time           `json:"createdAt" example:"2024-01-08T10:00:00Z"`
-	UpdatedAt      time.Time           `json:"updatedAt" example:"2024-01-08T10:00:00Z"`
+	ID             uint                    `json:"id" example:"1"`
+	UserID         string                  `json:"userId" example:"1"`
+	VocabularyID   uint                    `json:"vocabularyId" example:"1"`
+	Vocabulary     *VocabularyResponse     `json:"vocabulary,omitempty"`
+	Note           *VocabularyNoteResponse `json:"note,omitempty"`
+	Status         string                  `json:"status" example:"learning"`
+	Repetitions    int                     `json:"repetitions" example:"0"`
+	LastReviewedAt *time.Time              `json:"lastReviewedAt,omitempty" example:"2024-01-08T10:00:00Z"`
+	CreatedAt      time.Time               `json:"createdAt" example:"2024-01-08T10:00:00Z"`
+	UpdatedAt      time.Time               `json:"updatedAt" example:"2024-01-08T10:00:00Z"`
 }
 
 // UserVocabStatusListRequest represents the request for listing user vocabulary statuses
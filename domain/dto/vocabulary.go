@@ -1,51 +1,62 @@
 package dto
 
+import "github.com/google/uuid"
+
 type CreateVocabularyRequest struct {
-	Word                   string `json:"word" validate:"required,min=1,max=255" example:"犬"`
-	Reading                string `json:"reading" validate:"omitempty,max=255" example:"いぬ"`
-	Meaning                string `json:"meaning" validate:"required,min=1,max=500" example:"dog"`
-	PartOfSpeech           string `json:"partOfSpeech" validate:"omitempty,max=50" example:"noun"`
-	JlptLevelID            uint   `json:"jlptLevelId" validate:"required,min=1" example:"5"`
-	CategoryID             uint   `json:"categoryId" validate:"required,min=1" example:"1"`
-	ExampleSentence        string `json:"exampleSentence" validate:"omitempty" example:"犬が好きです"`
-	ExampleSentenceReading string `json:"exampleSentenceReading" validate:"omitempty" example:"いぬがすきです"`
-	ExampleSentenceMeaning string `json:"exampleSentenceMeaning" validate:"omitempty" example:"I like dogs"`
-	AudioURL               string `json:"audioUrl" validate:"omitempty,url,max=255" example:"https://example.com/audio/inu.mp3"`
-	ImageURL               string `json:"imageUrl" validate:"omitempty,url,max=255" example:"https://example.com/images/dog.jpg"`
-	Difficulty             int    `json:"difficulty" validate:"omitempty,min=1,max=5" example:"1"`
+	Word                   string     `json:"word" validate:"required,min=1,max=255" example:"犬"`
+	Reading                string     `json:"reading" validate:"omitempty,max=255" example:"いぬ"`
+	Meaning                string     `json:"meaning" validate:"required,min=1,max=500" example:"dog"`
+	PartOfSpeech           string     `json:"partOfSpeech" validate:"omitempty,max=50" example:"noun"`
+	JlptLevelID            uint       `json:"jlptLevelId" validate:"required,min=1" example:"5"`
+	CategoryID             uint       `json:"categoryId" validate:"required,min=1" example:"1"`
+	ExampleSentence        string     `json:"exampleSentence" validate:"omitempty" example:"犬が好きです"`
+	ExampleSentenceReading string     `json:"exampleSentenceReading" validate:"omitempty" example:"いぬがすきです"`
+	ExampleSentenceMeaning string     `json:"exampleSentenceMeaning" validate:"omitempty" example:"I like dogs"`
+	AudioURL               string     `json:"audioUrl" validate:"omitempty,url,max=255" example:"https://example.com/audio/inu.mp3"`
+	ImageURL               string     `json:"imageUrl" validate:"omitempty,url,max=255" example:"https://example.com/images/dog.jpg"`
+	AudioMediaID           *uuid.UUID `json:"audioMediaId" swaggertype:"string" format:"uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ImageMediaID           *uuid.UUID `json:"imageMediaId" swaggertype:"string" format:"uuid" example:"550e8400-e29b-41d4-a716-446655440001"`
+	Difficulty             int        `json:"difficulty" validate:"omitempty,min=1,max=5" example:"1"`
 }
 
 type UpdateVocabularyRequest struct {
-	Word                   string `json:"word" validate:"required,min=1,max=255" example:"犬"`
-	Reading                string `json:"reading" validate:"omitempty,max=255" example:"いぬ"`
-	Meaning                string `json:"meaning" validate:"required,min=1,max=500" example:"dog"`
-	PartOfSpeech           string `json:"partOfSpeech" validate:"omitempty,max=50" example:"noun"`
-	JlptLevelID            uint   `json:"jlptLevelId" validate:"required,min=1" example:"5"`
-	CategoryID             uint   `json:"categoryId" validate:"required,min=1" example:"1"`
-	ExampleSentence        string `json:"exampleSentence" validate:"omitempty" example:"犬が好きです"`
-	ExampleSentenceReading string `json:"exampleSentenceReading" validate:"omitempty" example:"いぬがすきです"`
-	ExampleSentenceMeaning string `json:"exampleSentenceMeaning" validate:"omitempty" example:"I like dogs"`
-	AudioURL               string `json:"audioUrl" validate:"omitempty,url,max=255" example:"https://example.com/audio/inu.mp3"`
-	ImageURL               string `json:"imageUrl" validate:"omitempty,url,max=255" example:"https://example.com/images/dog.jpg"`
-	Difficulty             int    `json:"difficulty" validate:"omitempty,min=1,max=5" example:"1"`
+	Word                   string     `json:"word" validate:"required,min=1,max=255" example:"犬"`
+	Reading                string     `json:"reading" validate:"omitempty,max=255" example:"いぬ"`
+	Meaning                string     `json:"meaning" validate:"required,min=1,max=500" example:"dog"`
+	PartOfSpeech           string     `json:"partOfSpeech" validate:"omitempty,max=50" example:"noun"`
+	JlptLevelID            uint       `json:"jlptLevelId" validate:"required,min=1" example:"5"`
+	CategoryID             uint       `json:"categoryId" validate:"required,min=1" example:"1"`
+	ExampleSentence        string     `json:"exampleSentence" validate:"omitempty" example:"犬が好きです"`
+	ExampleSentenceReading string     `json:"exampleSentenceReading" validate:"omitempty" example:"いぬがすきです"`
+	ExampleSentenceMeaning string     `json:"exampleSentenceMeaning" validate:"omitempty" example:"I like dogs"`
+	AudioURL               string     `json:"audioUrl" validate:"omitempty,url,max=255" example:"https://example.com/audio/inu.mp3"`
+	ImageURL               string     `json:"imageUrl" validate:"omitempty,url,max=255" example:"https://example.com/images/dog.jpg"`
+	AudioMediaID           *uuid.UUID `json:"audioMediaId" swaggertype:"string" format:"uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ImageMediaID           *uuid.UUID `json:"imageMediaId" swaggertype:"string" format:"uuid" example:"550e8400-e29b-41d4-a716-446655440001"`
+	Difficulty             int        `json:"difficulty" validate:"omitempty,min=1,max=5" example:"1"`
 }
 
 type VocabularyResponse struct {
-	ID                     uint               `json:"id" example:"1"`
-	Word                   string             `json:"word" example:"犬"`
-	Reading                string             `json:"reading" example:"いぬ"`
-	Meaning                string             `json:"meaning" example:"dog"`
-	PartOfSpeech           string             `json:"partOfSpeech" example:"noun"`
-	JlptLevelID            uint               `json:"jlptLevelId" example:"5"`
-	CategoryID             uint               `json:"categoryId" example:"1"`
-	ExampleSentence        string             `json:"exampleSentence" example:"犬が好きです"`
-	ExampleSentenceReading string             `json:"exampleSentenceReading" example:"いぬがすきです"`
-	ExampleSentenceMeaning string             `json:"exampleSentenceMeaning" example:"I like dogs"`
-	AudioURL               string             `json:"audioUrl" example:"https://example.com/audio/inu.mp3"`
-	ImageURL               string             `json:"imageUrl" example:"https://example.com/images/dog.jpg"`
-	Difficulty             int                `json:"difficulty" example:"1"`
-	JlptLevel              *JlptLevelResponse `json:"jlptLevel,omitempty"`
-	Category               *CategoryResponse  `json:"category,omitempty"`
+	ID                     uint                      `json:"id" example:"1"`
+	Word                   string                    `json:"word" example:"犬"`
+	Reading                string                    `json:"reading" example:"いぬ"`
+	Meaning                string                    `json:"meaning" example:"dog"`
+	PartOfSpeech           string                    `json:"partOfSpeech" example:"noun"`
+	JlptLevelID            uint                      `json:"jlptLevelId" example:"5"`
+	CategoryID             uint                      `json:"categoryId" example:"1"`
+	ExampleSentence        string                    `json:"exampleSentence" example:"犬が好きです"`
+	ExampleSentenceReading string                    `json:"exampleSentenceReading" example:"いぬがすきです"`
+	ExampleSentenceMeaning string                    `json:"exampleSentenceMeaning" example:"I like dogs"`
+	AudioURL               string                    `json:"audioUrl" example:"https://example.com/audio/inu.mp3"`
+	ImageURL               string                    `json:"imageUrl" example:"https://example.com/images/dog.jpg"`
+	AudioMediaID           *string                   `json:"audioMediaId,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ImageMediaID           *string                   `json:"imageMediaId,omitempty" example:"550e8400-e29b-41d4-a716-446655440001"`
+	Difficulty             int                       `json:"difficulty" example:"1"`
+	JlptLevel              *JlptLevelResponse        `json:"jlptLevel,omitempty"`
+	Category               *CategoryResponse         `json:"category,omitempty"`
+	ExampleSentences       []ExampleSentenceResponse `json:"exampleSentences,omitempty"`
+	Furigana               *FuriganaResponse         `json:"furigana,omitempty"`
+	IsBookmarked           *bool                     `json:"isBookmarked,omitempty" example:"true"`
 }
 
 type VocabularyListResponse struct {
@@ -0,0 +1,89 @@
+package dto
+
+import "github.com/google/uuid"
+
+type CreateVocabularyNoteRequest struct {
+	VocabularyID uint       `json:"vocabularyId" validate:"required,min=1" example:"1"`
+	Note         string     `json:"note" validate:"omitempty,max=2000" example:"Used for pets, not wild dogs"`
+	Mnemonic     string     `json:"mnemonic" validate:"omitempty,max=1000" example:"The dog says いぬ when it wants to go in"`
+	ImageURL     string     `json:"imageUrl" validate:"omitempty,url,max=500" example:"https://example.com/images/my-dog.jpg"`
+	ImageMediaID *uuid.UUID `json:"imageMediaId" swaggertype:"string" format:"uuid" example:"550e8400-e29b-41d4-a716-446655440001"`
+	IsShared     bool       `json:"isShared" example:"false"`
+}
+
+type UpdateVocabularyNoteRequest struct {
+	Note         string     `json:"note" validate:"omitempty,max=2000" example:"Used for pets, not wild dogs"`
+	Mnemonic     string     `json:"mnemonic" validate:"omitempty,max=1000" example:"The dog says いぬ when it wants to go in"`
+	ImageURL     string     `json:"imageUrl" validate:"omitempty,url,max=500" example:"https://example.com/images/my-dog.jpg"`
+	ImageMediaID *uuid.UUID `json:"imageMediaId" swaggertype:"string" format:"uuid" example:"550e8400-e29b-41d4-a716-446655440001"`
+	IsShared     bool       `json:"isShared" example:"true"`
+}
+
+type VocabularyNoteResponse struct {
+	ID           uint    `json:"id" example:"1"`
+	VocabularyID uint    `json:"vocabularyId" example:"1"`
+	Note         string  `json:"note" example:"Used for pets, not wild dogs"`
+	Mnemonic     string  `json:"mnemonic" example:"The dog says いぬ when it wants to go in"`
+	ImageURL     string  `json:"imageUrl" example:"https://example.com/images/my-dog.jpg"`
+	ImageMediaID *string `json:"imageMediaId,omitempty" example:"550e8400-e29b-41d4-a716-446655440001"`
+	IsShared     bool    `json:"isShared" example:"true"`
+	Upvotes      int     `json:"upvotes" example:"4"`
+	CreatedAt    string  `json:"createdAt" example:"2024-01-16T09:00:00Z"`
+	UpdatedAt    string  `json:"updatedAt" example:"2024-01-16T09:00:00Z"`
+}
+
+type VocabularyNoteListResponse struct {
+	Data       []VocabularyNoteResponse `json:"data"`
+	Pagination PaginationResponse       `json:"pagination"`
+}
+
+type VocabularyNoteFilterRequest struct {
+	VocabularyID uint  `form:"vocabularyId" validate:"omitempty,min=1" example:"1"`
+	IsShared     *bool `form:"isShared" validate:"omitempty" example:"true"`
+	PaginationRequest
+}
+
+// SharedMnemonicResponse is a mnemonic another learner shared for a word
+type SharedMnemonicResponse struct {
+	ID           uint   `json:"id" example:"1"`
+	VocabularyID uint   `json:"vocabularyId" example:"1"`
+	Mnemonic     string `json:"mnemonic" example:"The dog says いぬ when it wants to go in"`
+	ImageURL     string `json:"imageUrl,omitempty" example:"https://example.com/images/my-dog.jpg"`
+	Author       string `json:"author" example:"Hana"`
+	Upvotes      int    `json:"upvotes" example:"4"`
+	IsUpvoted    bool   `json:"isUpvoted" example:"false"`
+	IsOwn        bool   `json:"isOwn" example:"false"`
+	CreatedAt    string `json:"createdAt" example:"2024-01-16T09:00:00Z"`
+}
+
+type SharedMnemonicListResponse struct {
+	Data       []SharedMnemonicResponse `json:"data"`
+	Pagination PaginationResponse       `json:"pagination"`
+}
+
+// Swagger response wrappers
+type VocabularyNoteSwaggerResponse struct {
+	Message string                 `json:"message" example:"OK"`
+	Status  string                 `json:"status" example:"success"`
+	Data    VocabularyNoteResponse `json:"data"`
+}
+
+type VocabularyNoteListSwaggerResponse struct {
+	Message    string                   `json:"message" example:"OK"`
+	Pagination PaginationResponse       `json:"pagination"`
+	Status     string                   `json:"status" example:"success"`
+	Data       []VocabularyNoteResponse `json:"data"`
+}
+
+type SharedMnemonicSwaggerResponse struct {
+	Message string                 `json:"message" example:"OK"`
+	Status  string                 `json:"status" example:"success"`
+	Data    SharedMnemonicResponse `json:"data"`
+}
+
+type SharedMnemonicListSwaggerResponse struct {
+	Message    string                   `json:"message" example:"OK"`
+	Pagination PaginationResponse       `json:"pagination"`
+	Status     string                   `json:"status" example:"success"`
+	Data       []SharedMnemonicResponse `json:"data"`
+}
@@ -0,0 +1,55 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BookmarkSource describes where the content of a bookmarkable type is stored
+type BookmarkSource struct {
+	Table       string
+	TitleColumn string
+	SoftDeleted bool
+}
+
+// BookmarkSources maps each content type that can be bookmarked to where its content is stored
+var BookmarkSources = map[string]BookmarkSource{
+	ContentTypeCourse:           {"courses", "title", true},
+	ContentTypeLesson:           {"lessons", "title", true},
+	ContentTypeVocabulary:       {"vocabularies", "word", false},
+	ContentTypeExerciseQuestion: {"exercise_questions", "question_text", true},
+}
+
+// BookmarkFolder groups the bookmarks of a user
+type BookmarkFolder struct {
+	ID        uint      `gorm:"primaryKey;autoIncrement"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_bookmark_folder_name"`
+	Name      string    `gorm:"type:varchar(100);not null;uniqueIndex:idx_bookmark_folder_name"`
+	User      User      `gorm:"foreignKey:UserID;references:UUID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	CreatedAt *time.Time
+	UpdatedAt *time.Time
+}
+
+// TableName specifies the table name for the BookmarkFolder model
+func (BookmarkFolder) TableName() string {
+	return "bookmark_folders"
+}
+
+// Bookmark saves a course, lesson, vocabulary or exercise question for later. A bookmark
+// outlives its content, which then shows as unavailable.
+type Bookmark struct {
+	ID        uint            `gorm:"primaryKey;autoIncrement"`
+	UserID    uuid.UUID       `gorm:"type:uuid;not null;uniqueIndex:idx_bookmark_user_item"`
+	ItemType  string          `gorm:"type:varchar(30);not null;uniqueIndex:idx_bookmark_user_item;index:idx_bookmark_item;check:item_type IN ('course', 'lesson', 'vocabulary', 'exercise_question')"`
+	ItemID    uint            `gorm:"not null;uniqueIndex:idx_bookmark_user_item;index:idx_bookmark_item"`
+	FolderID  *uint           `gorm:"index"`
+	User      User            `gorm:"foreignKey:UserID;references:UUID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Folder    *BookmarkFolder `gorm:"foreignKey:FolderID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+	CreatedAt *time.Time
+}
+
+// TableName specifies the table name for the Bookmark model
+func (Bookmark) TableName() string {
+	return "bookmarks"
+}
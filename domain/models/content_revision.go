@@ -0,0 +1,45 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ContentTypeVocabulary identifies vocabulary entries, which keep revisions but have no workflow
+const ContentTypeVocabulary = "vocabulary"
+
+// Revision actions
+const (
+	RevisionActionCreate  = "create"
+	RevisionActionUpdate  = "update"
+	RevisionActionRestore = "restore"
+)
+
+// RevisionContentTypes lists the content types that keep a revision history
+var RevisionContentTypes = map[string]bool{
+	ContentTypeCourse:           true,
+	ContentTypeLesson:           true,
+	ContentTypeExerciseQuestion: true,
+	ContentTypeVocabulary:       true,
+}
+
+// ContentRevision is an immutable snapshot of the editable fields of a content item, taken
+// after each change. Diff holds the fields that changed since the previous version.
+type ContentRevision struct {
+	ID           uint       `gorm:"primaryKey;autoIncrement"`
+	ContentType  string     `gorm:"type:varchar(30);not null;uniqueIndex:idx_content_revision_version"`
+	ContentID    uint       `gorm:"not null;uniqueIndex:idx_content_revision_version"`
+	Version      int        `gorm:"type:int;not null;uniqueIndex:idx_content_revision_version"`
+	Action       string     `gorm:"type:varchar(20);not null;check:action IN ('create', 'update', 'restore')"`
+	Snapshot     string     `gorm:"type:jsonb;not null"`
+	Diff         string     `gorm:"type:jsonb;not null"`
+	RestoredFrom *int       `gorm:"type:int"`
+	AuthorID     *uuid.UUID `gorm:"type:uuid;index"`
+	CreatedAt    *time.Time
+}
+
+// TableName specifies the table name for the ContentRevision model
+func (ContentRevision) TableName() string {
+	return "content_revisions"
+}
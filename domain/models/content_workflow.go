@@ -0,0 +1,103 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Workflow status constants for content
+const (
+	WorkflowStatusDraft     = "draft"
+	WorkflowStatusInReview  = "in_review"
+	WorkflowStatusApproved  = "approved"
+	WorkflowStatusPublished = "published"
+	WorkflowStatusArchived  = "archived"
+)
+
+// Content type constants for content that goes through the workflow
+const (
+	ContentTypeCourse           = "course"
+	ContentTypeLesson           = "lesson"
+	ContentTypeExercise         = "exercise"
+	ContentTypeExerciseQuestion = "exercise_question"
+)
+
+// ContentTypeTables maps each workflow content type to the table holding the content
+var ContentTypeTables = map[string]string{
+	ContentTypeCourse:           "courses",
+	ContentTypeLesson:           "lessons",
+	ContentTypeExercise:         "exercises",
+	ContentTypeExerciseQuestion: "exercise_questions",
+}
+
+// ContentLevel describes where a content type sits in the course hierarchy
+type ContentLevel struct {
+	TitleColumn  string
+	ParentType   string
+	ParentColumn string
+	ChildType    string
+}
+
+// ContentHierarchy maps each workflow content type to its level in the course hierarchy
+var ContentHierarchy = map[string]ContentLevel{
+	ContentTypeCourse:           {"title", "", "", ContentTypeLesson},
+	ContentTypeLesson:           {"title", ContentTypeCourse, "course_id", ContentTypeExercise},
+	ContentTypeExercise:         {"title", ContentTypeLesson, "lesson_id", ContentTypeExerciseQuestion},
+	ContentTypeExerciseQuestion: {"question_text", ContentTypeExercise, "exercise_id", ""},
+}
+
+// ContentWorkflow tracks the editorial status of a course, lesson, exercise or question.
+// Content without a workflow is a draft, or published if it was published before the
+// workflow existed. The IsPublished flag of the content follows the published status.
+type ContentWorkflow struct {
+	ID              uint                        `gorm:"primaryKey;autoIncrement"`
+	ContentType     string                      `gorm:"type:varchar(30);not null;uniqueIndex:idx_content_workflow_content"`
+	ContentID       uint                        `gorm:"not null;uniqueIndex:idx_content_workflow_content"`
+	Status          string                      `gorm:"type:varchar(20);not null;default:'draft';index;check:status IN ('draft', 'in_review', 'approved', 'published', 'archived')"`
+	ReviewerID      *uuid.UUID                  `gorm:"type:uuid;index"`
+	SubmittedBy     *uuid.UUID                  `gorm:"type:uuid"`
+	SubmittedAt     *time.Time                  `gorm:"type:timestamp"`
+	RejectionReason string                      `gorm:"type:text"`
+	Transitions     []ContentWorkflowTransition `gorm:"foreignKey:WorkflowID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Comments        []ContentWorkflowComment    `gorm:"foreignKey:WorkflowID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	CreatedAt       *time.Time
+	UpdatedAt       *time.Time
+}
+
+// TableName specifies the table name for the ContentWorkflow model
+func (ContentWorkflow) TableName() string {
+	return "content_workflows"
+}
+
+// ContentWorkflowTransition records one status change of a content workflow
+type ContentWorkflowTransition struct {
+	ID         uint      `gorm:"primaryKey;autoIncrement"`
+	WorkflowID uint      `gorm:"not null;index"`
+	Action     string    `gorm:"type:varchar(20);not null"`
+	FromStatus string    `gorm:"type:varchar(20);not null"`
+	ToStatus   string    `gorm:"type:varchar(20);not null"`
+	ActorID    uuid.UUID `gorm:"type:uuid;not null"`
+	Reason     string    `gorm:"type:text"`
+	CreatedAt  *time.Time
+}
+
+// TableName specifies the table name for the ContentWorkflowTransition model
+func (ContentWorkflowTransition) TableName() string {
+	return "content_workflow_transitions"
+}
+
+// ContentWorkflowComment is a discussion comment between authors and reviewers
+type ContentWorkflowComment struct {
+	ID         uint      `gorm:"primaryKey;autoIncrement"`
+	WorkflowID uint      `gorm:"not null;index"`
+	AuthorID   uuid.UUID `gorm:"type:uuid;not null"`
+	Body       string    `gorm:"type:text;not null"`
+	CreatedAt  *time.Time
+	UpdatedAt  *time.Time
+}
+
+// TableName specifies the table name for the ContentWorkflowComment model
+func (ContentWorkflowComment) TableName() string {
+	return "content_workflow_comments"
+}
@@ -1,20 +1,28 @@
 package models
 
-import "time"
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
 
 type Course struct {
-	ID             uint       `gorm:"primaryKey;autoIncrement"`
-	Title          string     `gorm:"type:varchar(200);not null;uniqueIndex:idx_course_title_jlpt"`
-	Description    string     `gorm:"type:text;not null"`
-	JlptLevelID    uint       `gorm:"not null;uniqueIndex:idx_course_title_jlpt;index"`
-	ThumbnailURL   string     `gorm:"type:varchar(255)"`
-	Difficulty     int        `gorm:"type:int;default:1;check:difficulty >= 1 AND difficulty <= 5"`
-	EstimatedHours int        `gorm:"type:int"`
-	IsPublished    bool       `gorm:"type:boolean;default:false"`
-	PublishedAt    *time.Time `gorm:"type:timestamp"`
-	JlptLevel      JlptLevel  `gorm:"foreignKey:JlptLevelID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
-	CreatedAt      *time.Time
-	UpdatedAt      *time.Time
+	ID               uint       `gorm:"primaryKey;autoIncrement"`
+	Title            string     `gorm:"type:varchar(200);not null;uniqueIndex:idx_course_title_jlpt,where:deleted_at IS NULL"`
+	Description      string     `gorm:"type:text;not null"`
+	JlptLevelID      uint       `gorm:"not null;uniqueIndex:idx_course_title_jlpt,where:deleted_at IS NULL;index"`
+	ThumbnailURL     string     `gorm:"type:varchar(255)"`
+	ThumbnailMediaID *uuid.UUID `gorm:"type:uuid;index"`
+	Difficulty       int        `gorm:"type:int;default:1;check:difficulty >= 1 AND difficulty <= 5"`
+	EstimatedHours   int        `gorm:"type:int"`
+	IsPublished      bool       `gorm:"type:boolean;default:false"`
+	PublishedAt      *time.Time `gorm:"type:timestamp"`
+	JlptLevel        JlptLevel  `gorm:"foreignKey:JlptLevelID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+	ThumbnailMedia   *Media     `gorm:"foreignKey:ThumbnailMediaID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+	CreatedAt        *time.Time
+	UpdatedAt        *time.Time
+	DeletedAt        gorm.DeletedAt `gorm:"index"`
 }
 
 // TableName specifies the table name for the Course model
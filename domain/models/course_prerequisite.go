@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// CoursePrerequisite requires learners to complete PrerequisiteCourseID before the lessons
+// of CourseID unlock.
+type CoursePrerequisite struct {
+	ID                   uint   `gorm:"primaryKey;autoIncrement"`
+	CourseID             uint   `gorm:"not null;uniqueIndex:idx_course_prerequisite;index;check:course_id <> prerequisite_course_id"`
+	PrerequisiteCourseID uint   `gorm:"not null;uniqueIndex:idx_course_prerequisite;index"`
+	Course               Course `gorm:"foreignKey:CourseID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	PrerequisiteCourse   Course `gorm:"foreignKey:PrerequisiteCourseID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	CreatedAt            *time.Time
+}
+
+// TableName specifies the table name for the CoursePrerequisite model
+func (CoursePrerequisite) TableName() string {
+	return "course_prerequisites"
+}
@@ -0,0 +1,104 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Deck visibilities
+const (
+	// DeckVisibilityPrivate decks are only visible to their owner
+	DeckVisibilityPrivate = "private"
+	// DeckVisibilityUnlisted decks are visible to anyone with their share code but are not listed
+	DeckVisibilityUnlisted = "unlisted"
+	// DeckVisibilityPublic decks are listed and visible to everyone
+	DeckVisibilityPublic = "public"
+)
+
+// Deck is a learner-made collection of existing vocabulary and custom cards.
+// Subscribing to a deck adds its words and cards to the subscriber's review queue.
+type Deck struct {
+	ID              uint      `gorm:"primaryKey;autoIncrement"`
+	UserID          uuid.UUID `gorm:"type:uuid;not null;index"`
+	Title           string    `gorm:"type:varchar(200);not null"`
+	Description     string    `gorm:"type:text"`
+	Visibility      string    `gorm:"type:varchar(20);not null;default:'private';index;check:visibility IN ('private', 'unlisted', 'public')"`
+	ShareCode       uuid.UUID `gorm:"type:uuid;not null;uniqueIndex"`
+	SubscriberCount int       `gorm:"not null;default:0"`
+	User            User      `gorm:"foreignKey:UserID;references:UUID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	CreatedAt       *time.Time
+	UpdatedAt       *time.Time
+}
+
+// TableName specifies the table name for the Deck model
+func (Deck) TableName() string {
+	return "decks"
+}
+
+// DeckVocabulary links an existing vocabulary word to a deck
+type DeckVocabulary struct {
+	ID           uint       `gorm:"primaryKey;autoIncrement"`
+	DeckID       uint       `gorm:"not null;uniqueIndex:idx_deck_vocabulary"`
+	VocabularyID uint       `gorm:"not null;uniqueIndex:idx_deck_vocabulary;index"`
+	OrderIndex   int        `gorm:"type:int;not null;default:0"`
+	Deck         Deck       `gorm:"foreignKey:DeckID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Vocabulary   Vocabulary `gorm:"foreignKey:VocabularyID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	CreatedAt    *time.Time
+}
+
+// TableName specifies the table name for the DeckVocabulary model
+func (DeckVocabulary) TableName() string {
+	return "deck_vocabularies"
+}
+
+// DeckCard is a custom card written by the owner of a deck
+type DeckCard struct {
+	ID         uint   `gorm:"primaryKey;autoIncrement"`
+	DeckID     uint   `gorm:"not null;index"`
+	Front      string `gorm:"type:varchar(255);not null"`
+	Reading    string `gorm:"type:varchar(255)"`
+	Back       string `gorm:"type:varchar(500);not null"`
+	Note       string `gorm:"type:text"`
+	OrderIndex int    `gorm:"type:int;not null;default:0"`
+	Deck       Deck   `gorm:"foreignKey:DeckID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	CreatedAt  *time.Time
+	UpdatedAt  *time.Time
+}
+
+// TableName specifies the table name for the DeckCard model
+func (DeckCard) TableName() string {
+	return "deck_cards"
+}
+
+// DeckSubscription records that a user studies a deck
+type DeckSubscription struct {
+	ID        uint      `gorm:"primaryKey;autoIncrement"`
+	DeckID    uint      `gorm:"not null;uniqueIndex:idx_deck_subscription"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_deck_subscription;index"`
+	Deck      Deck      `gorm:"foreignKey:DeckID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	CreatedAt *time.Time
+}
+
+// TableName specifies the table name for the DeckSubscription model
+func (DeckSubscription) TableName() string {
+	return "deck_subscriptions"
+}
+
+// UserDeckCardStatus is the learning progress of a user on a custom card, tracked like UserVocabularyStatus
+type UserDeckCardStatus struct {
+	ID             uint       `gorm:"primaryKey;autoIncrement"`
+	UserID         uuid.UUID  `gorm:"type:uuid;not null;index;uniqueIndex:idx_user_deck_card"`
+	CardID         uint       `gorm:"not null;index;uniqueIndex:idx_user_deck_card"`
+	Status         string     `gorm:"type:varchar(20);not null;default:'learning';check:status IN ('learning', 'completed')"`
+	Repetitions    int        `gorm:"type:int;not null;default:0"`
+	LastReviewedAt *time.Time `gorm:"null"`
+	Card           DeckCard   `gorm:"foreignKey:CardID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	CreatedAt      *time.Time
+	UpdatedAt      *time.Time
+}
+
+// TableName specifies the table name for the UserDeckCardStatus model
+func (UserDeckCardStatus) TableName() string {
+	return "user_deck_card_status"
+}
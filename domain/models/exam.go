@@ -0,0 +1,40 @@
+package models
+
+import "time"
+
+// Exam is a timed JLPT mock exam. Questions are drawn per attempt from the
+// published exercise questions of the exam's JLPT level, section by section.
+type Exam struct {
+	ID              uint          `gorm:"primaryKey;autoIncrement"`
+	Title           string        `gorm:"type:varchar(200);not null"`
+	Description     string        `gorm:"type:text"`
+	JlptLevelID     uint          `gorm:"not null;index"`
+	DurationMinutes int           `gorm:"type:int;not null"`
+	IsPublished     bool          `gorm:"type:boolean;default:false;index"`
+	PublishedAt     *time.Time    `gorm:"type:timestamp"`
+	JlptLevel       JlptLevel     `gorm:"foreignKey:JlptLevelID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+	Sections        []ExamSection `gorm:"foreignKey:ExamID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	CreatedAt       *time.Time
+	UpdatedAt       *time.Time
+}
+
+// TableName specifies the table name for the Exam model
+func (Exam) TableName() string {
+	return "exams"
+}
+
+// ExamSection defines how many questions of a JLPT section an exam draws.
+type ExamSection struct {
+	ID            uint   `gorm:"primaryKey;autoIncrement"`
+	ExamID        uint   `gorm:"not null;uniqueIndex:idx_exam_section_type;index"`
+	SectionType   string `gorm:"type:varchar(20);not null;uniqueIndex:idx_exam_section_type"`
+	QuestionCount int    `gorm:"type:int;not null"`
+	OrderIndex    int    `gorm:"type:int;not null;default:0"`
+	CreatedAt     *time.Time
+	UpdatedAt     *time.Time
+}
+
+// TableName specifies the table name for the ExamSection model
+func (ExamSection) TableName() string {
+	return "exam_sections"
+}
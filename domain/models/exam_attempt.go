@@ -0,0 +1,60 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Status constants for exam attempts
+const (
+	ExamAttemptStatusInProgress = "in_progress"
+	ExamAttemptStatusSubmitted  = "submitted"
+	ExamAttemptStatusTimedOut   = "timed_out"
+)
+
+// ExamAttempt is a learner's timed session of an exam. Scores holds the scaled
+// JLPT scores per scoring section as JSON once the attempt is finished.
+type ExamAttempt struct {
+	ID          uuid.UUID           `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	ExamID      uint                `gorm:"not null;index"`
+	UserID      uuid.UUID           `gorm:"type:uuid;not null;index"`
+	Status      string              `gorm:"type:varchar(20);not null;default:'in_progress';index;check:status IN ('in_progress', 'submitted', 'timed_out')"`
+	StartedAt   time.Time           `gorm:"type:timestamp;not null"`
+	ExpiresAt   time.Time           `gorm:"type:timestamp;not null;index"`
+	SubmittedAt *time.Time          `gorm:"type:timestamp"`
+	TotalScore  *int                `gorm:"type:int"`
+	MaxScore    int                 `gorm:"type:int;not null;default:0"`
+	PassScore   int                 `gorm:"type:int;not null;default:0"`
+	Passed      *bool               `gorm:"type:boolean"`
+	Scores      *string             `gorm:"type:jsonb"`
+	Exam        Exam                `gorm:"foreignKey:ExamID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Answers     []ExamAttemptAnswer `gorm:"foreignKey:AttemptID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	CreatedAt   *time.Time
+	UpdatedAt   *time.Time
+}
+
+// TableName specifies the table name for the ExamAttempt model
+func (ExamAttempt) TableName() string {
+	return "exam_attempts"
+}
+
+// ExamAttemptAnswer is a question drawn for an attempt and the learner's autosaved answer.
+type ExamAttemptAnswer struct {
+	ID           uint             `gorm:"primaryKey;autoIncrement"`
+	AttemptID    uuid.UUID        `gorm:"type:uuid;not null;uniqueIndex:idx_exam_attempt_question"`
+	QuestionID   uint             `gorm:"not null;uniqueIndex:idx_exam_attempt_question;index"`
+	SectionType  string           `gorm:"type:varchar(20);not null"`
+	OrderIndex   int              `gorm:"type:int;not null"`
+	Answer       *string          `gorm:"type:jsonb"`
+	IsCorrect    *bool            `gorm:"type:boolean"`
+	PointsEarned int              `gorm:"type:int;not null;default:0"`
+	Question     ExerciseQuestion `gorm:"foreignKey:QuestionID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	CreatedAt    *time.Time
+	UpdatedAt    *time.Time
+}
+
+// TableName specifies the table name for the ExamAttemptAnswer model
+func (ExamAttemptAnswer) TableName() string {
+	return "exam_attempt_answers"
+}
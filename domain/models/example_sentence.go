@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// ExampleSentence is a reusable Japanese example sentence that can be linked to many vocabulary entries
+type ExampleSentence struct {
+	ID           uint         `gorm:"primaryKey;autoIncrement"`
+	Text         string       `gorm:"type:text;not null"`
+	Reading      string       `gorm:"type:text"`
+	Translation  string       `gorm:"type:text;not null"`
+	AudioURL     string       `gorm:"type:varchar(255)"`
+	Source       string       `gorm:"type:varchar(255)"`
+	Difficulty   int          `gorm:"type:int;default:1;check:difficulty >= 1 AND difficulty <= 5;index"`
+	Vocabularies []Vocabulary `gorm:"many2many:vocabulary_example_sentences;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	CreatedAt    *time.Time
+	UpdatedAt    *time.Time
+}
+
+// TableName specifies the table name for the ExampleSentence model
+func (ExampleSentence) TableName() string {
+	return "example_sentences"
+}
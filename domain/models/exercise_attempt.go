@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Status constants for exercise attempts
+const (
+	ExerciseAttemptStatusInProgress = "in_progress"
+	ExerciseAttemptStatusSubmitted  = "submitted"
+)
+
+// ExerciseAttempt is a learner's variant of an exercise. The seed reproduces the
+// question draw and option order; QuestionIDs pins the drawn questions (JSON array)
+// so later changes to the pool do not alter the variant. Results holds the graded
+// answers as JSON once submitted.
+type ExerciseAttempt struct {
+	ID          uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	ExerciseID  uint       `gorm:"not null;index"`
+	UserID      uuid.UUID  `gorm:"type:uuid;not null;index"`
+	Seed        int64      `gorm:"type:bigint;not null"`
+	QuestionIDs string     `gorm:"type:jsonb;not null"`
+	Status      string     `gorm:"type:varchar(20);not null;default:'in_progress';index;check:status IN ('in_progress', 'submitted')"`
+	Score       *int       `gorm:"type:int"`
+	MaxScore    int        `gorm:"type:int;not null;default:0"`
+	Results     *string    `gorm:"type:jsonb"`
+	StartedAt   time.Time  `gorm:"type:timestamp;not null"`
+	SubmittedAt *time.Time `gorm:"type:timestamp"`
+	Exercise    Exercise   `gorm:"foreignKey:ExerciseID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	CreatedAt   *time.Time
+	UpdatedAt   *time.Time
+}
+
+// TableName specifies the table name for the ExerciseAttempt model
+func (ExerciseAttempt) TableName() string {
+	return "exercise_attempts"
+}
@@ -1,24 +1,36 @@
 package models
 
-import "time"
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
 
 type ExerciseQuestion struct {
-	ID            uint       `gorm:"primaryKey;autoIncrement"`
-	ExerciseID    uint       `gorm:"not null;uniqueIndex:idx_question_exercise_order;index"`
-	QuestionText  string     `gorm:"type:text;not null"`
-	QuestionType  string     `gorm:"type:varchar(50);not null;index"`
-	Options       string     `gorm:"type:text"`
-	CorrectAnswer string     `gorm:"type:text;not null"`
-	Explanation   string     `gorm:"type:text"`
-	AudioURL      string     `gorm:"type:varchar(500)"`
-	ImageURL      string     `gorm:"type:varchar(500)"`
-	OrderIndex    int        `gorm:"type:int;not null;default:0;uniqueIndex:idx_question_exercise_order"`
-	Points        int        `gorm:"type:int;not null;default:10"`
-	IsPublished   bool       `gorm:"type:boolean;default:false;index"`
-	PublishedAt   *time.Time `gorm:"type:timestamp"`
-	Exercise      Exercise   `gorm:"foreignKey:ExerciseID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
-	CreatedAt     *time.Time
-	UpdatedAt     *time.Time
+	ID               uint       `gorm:"primaryKey;autoIncrement"`
+	ExerciseID       uint       `gorm:"not null;uniqueIndex:idx_question_exercise_order,where:deleted_at IS NULL;index"`
+	QuestionText     string     `gorm:"type:text;not null"`
+	QuestionType     string     `gorm:"type:varchar(50);not null;index"`
+	Options          *string    `gorm:"type:jsonb"`
+	CorrectAnswer    string     `gorm:"type:jsonb;not null"`
+	AnswerStrictness string     `gorm:"type:varchar(20);not null;default:'normal'"`
+	JlptSection      string     `gorm:"type:varchar(20);index"`
+	Explanation      string     `gorm:"type:text"`
+	AudioURL         string     `gorm:"type:varchar(500)"`
+	ImageURL         string     `gorm:"type:varchar(500)"`
+	AudioMediaID     *uuid.UUID `gorm:"type:uuid;index"`
+	ImageMediaID     *uuid.UUID `gorm:"type:uuid;index"`
+	OrderIndex       int        `gorm:"type:int;not null;default:0;uniqueIndex:idx_question_exercise_order,where:deleted_at IS NULL"`
+	Points           int        `gorm:"type:int;not null;default:10"`
+	IsPublished      bool       `gorm:"type:boolean;default:false;index"`
+	PublishedAt      *time.Time `gorm:"type:timestamp"`
+	Exercise         Exercise   `gorm:"foreignKey:ExerciseID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	AudioMedia       *Media     `gorm:"foreignKey:AudioMediaID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+	ImageMedia       *Media     `gorm:"foreignKey:ImageMediaID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+	CreatedAt        *time.Time
+	UpdatedAt        *time.Time
+	DeletedAt        gorm.DeletedAt `gorm:"index"`
 }
 
 // TableName specifies the table name for the ExerciseQuestion model
@@ -1,19 +1,48 @@
 package models
 
-import "time"
+import (
+	"time"
 
+	"gorm.io/gorm"
+)
+
+// Unlock rules of a lesson. A lesson is open, unlocks once the previous lesson is completed,
+// or unlocks once the learner scores at least UnlockMinScore percent on the exercises of the
+// previous lesson.
+const (
+	LessonUnlockRuleNone           = "none"
+	LessonUnlockRulePreviousLesson = "previous_lesson"
+	LessonUnlockRulePreviousScore  = "previous_score"
+)
+
+// Types of the content blocks of a lesson
+const (
+	LessonBlockMarkdown   = "markdown"
+	LessonBlockAudio      = "audio"
+	LessonBlockImage      = "image"
+	LessonBlockVocabulary = "vocabulary"
+	LessonBlockGrammar    = "grammar"
+	LessonBlockQuestion   = "question"
+)
+
+// Lesson is a lesson of a course. Blocks holds the ordered content blocks of the lesson as a
+// JSON array; Content is the plain text body of lessons written before blocks existed.
 type Lesson struct {
 	ID               uint       `gorm:"primaryKey;autoIncrement"`
-	CourseID         uint       `gorm:"not null;uniqueIndex:idx_lesson_course_order;index"`
+	CourseID         uint       `gorm:"not null;uniqueIndex:idx_lesson_course_order,where:deleted_at IS NULL;index"`
 	Title            string     `gorm:"type:varchar(255);not null"`
 	Content          string     `gorm:"type:text"`
-	OrderIndex       int        `gorm:"type:int;not null;default:0;uniqueIndex:idx_lesson_course_order"`
+	Blocks           *string    `gorm:"type:jsonb"`
+	OrderIndex       int        `gorm:"type:int;not null;default:0;uniqueIndex:idx_lesson_course_order,where:deleted_at IS NULL"`
 	EstimatedMinutes int        `gorm:"type:int;default:0"`
+	UnlockRule       string     `gorm:"type:varchar(20);not null;default:'none';check:unlock_rule IN ('none', 'previous_lesson', 'previous_score')"`
+	UnlockMinScore   int        `gorm:"type:int;not null;default:0;check:unlock_min_score >= 0 AND unlock_min_score <= 100"`
 	IsPublished      bool       `gorm:"type:boolean;default:false;index"`
 	PublishedAt      *time.Time `gorm:"type:timestamp"`
 	Course           Course     `gorm:"foreignKey:CourseID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 	CreatedAt        *time.Time
 	UpdatedAt        *time.Time
+	DeletedAt        gorm.DeletedAt `gorm:"index"`
 }
 
 // TableName specifies the table name for the Lesson model
@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// LessonVocabulary attaches a vocabulary word to a lesson. OrderIndex is the position of the
+// word in the lesson's word list.
+type LessonVocabulary struct {
+	ID           uint       `gorm:"primaryKey;autoIncrement"`
+	LessonID     uint       `gorm:"not null;uniqueIndex:idx_lesson_vocabulary;index"`
+	VocabularyID uint       `gorm:"not null;uniqueIndex:idx_lesson_vocabulary;index"`
+	OrderIndex   int        `gorm:"type:int;not null;default:0"`
+	Lesson       Lesson     `gorm:"foreignKey:LessonID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Vocabulary   Vocabulary `gorm:"foreignKey:VocabularyID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	CreatedAt    *time.Time
+}
+
+// TableName specifies the table name for the LessonVocabulary model
+func (LessonVocabulary) TableName() string {
+	return "lesson_vocabularies"
+}
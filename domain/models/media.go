@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Kind constants for media
+const (
+	MediaKindAudio = "audio"
+	MediaKindImage = "image"
+)
+
+// Media is an uploaded audio or image file kept in file storage under StorageKey.
+// Images also get a JPEG thumbnail stored under ThumbnailKey. Content such as vocabulary,
+// questions and courses reference media by ID.
+type Media struct {
+	ID           uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Kind         string     `gorm:"type:varchar(20);not null;index;check:kind IN ('audio', 'image')"`
+	FileName     string     `gorm:"type:varchar(255)"`
+	ContentType  string     `gorm:"type:varchar(100);not null"`
+	SizeBytes    int64      `gorm:"type:bigint;not null"`
+	StorageKey   string     `gorm:"type:varchar(255);not null"`
+	ThumbnailKey string     `gorm:"type:varchar(255)"`
+	Width        int        `gorm:"type:int"`
+	Height       int        `gorm:"type:int"`
+	UploadedBy   *uuid.UUID `gorm:"type:uuid;index"`
+	CreatedAt    *time.Time
+	UpdatedAt    *time.Time
+}
+
+// TableName specifies the table name for the Media model
+func (Media) TableName() string {
+	return "media"
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Notification types
+const (
+	NotificationTypeSpeakingReviewed      = "speaking_reviewed"
+	NotificationTypePublishScheduleFailed = "publish_schedule_failed"
+)
+
+// Notification is a message to a user, e.g. a graded speaking submission.
+// Data holds type-specific JSON such as the related submission ID.
+type Notification struct {
+	ID        uint       `gorm:"primaryKey;autoIncrement"`
+	UserID    uuid.UUID  `gorm:"type:uuid;not null;index"`
+	Type      string     `gorm:"type:varchar(50);not null"`
+	Title     string     `gorm:"type:varchar(200);not null"`
+	Message   string     `gorm:"type:varchar(1000);not null"`
+	Data      *string    `gorm:"type:jsonb"`
+	ReadAt    *time.Time `gorm:"type:timestamp;index"`
+	CreatedAt *time.Time
+	UpdatedAt *time.Time
+}
+
+// TableName specifies the table name for the Notification model
+func (Notification) TableName() string {
+	return "notifications"
+}
@@ -0,0 +1,54 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Status constants for placement tests
+const (
+	PlacementTestStatusInProgress = "in_progress"
+	PlacementTestStatusCompleted  = "completed"
+)
+
+// PlacementTest is an adaptive test estimating the JLPT level of a learner.
+// Questions are served one at a time; the latest unanswered one is the current question.
+type PlacementTest struct {
+	ID                   uuid.UUID             `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	UserID               uuid.UUID             `gorm:"type:uuid;not null;index"`
+	Status               string                `gorm:"type:varchar(20);not null;default:'in_progress';index;check:status IN ('in_progress', 'completed')"`
+	EstimatedJlptLevelID *uint                 `gorm:"index"`
+	StartedAt            time.Time             `gorm:"type:timestamp;not null"`
+	CompletedAt          *time.Time            `gorm:"type:timestamp"`
+	EstimatedJlptLevel   *JlptLevel            `gorm:"foreignKey:EstimatedJlptLevelID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+	Answers              []PlacementTestAnswer `gorm:"foreignKey:PlacementTestID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	CreatedAt            *time.Time
+	UpdatedAt            *time.Time
+}
+
+// TableName specifies the table name for the PlacementTest model
+func (PlacementTest) TableName() string {
+	return "placement_tests"
+}
+
+// PlacementTestAnswer is a question served during a placement test and the learner's graded answer.
+type PlacementTestAnswer struct {
+	ID              uint             `gorm:"primaryKey;autoIncrement"`
+	PlacementTestID uuid.UUID        `gorm:"type:uuid;not null;uniqueIndex:idx_placement_test_question"`
+	QuestionID      uint             `gorm:"not null;uniqueIndex:idx_placement_test_question;index"`
+	JlptLevelID     uint             `gorm:"not null"`
+	LevelOrder      int              `gorm:"type:int;not null"`
+	OrderIndex      int              `gorm:"type:int;not null"`
+	Answer          *string          `gorm:"type:jsonb"`
+	IsCorrect       *bool            `gorm:"type:boolean"`
+	AnsweredAt      *time.Time       `gorm:"type:timestamp"`
+	Question        ExerciseQuestion `gorm:"foreignKey:QuestionID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	CreatedAt       *time.Time
+	UpdatedAt       *time.Time
+}
+
+// TableName specifies the table name for the PlacementTestAnswer model
+func (PlacementTestAnswer) TableName() string {
+	return "placement_test_answers"
+}
@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Status constants for the publish and unpublish parts of a schedule.
+// An empty status means that part is not scheduled.
+const (
+	PublishScheduleStatusPending = "pending"
+	PublishScheduleStatusDone    = "done"
+	PublishScheduleStatusFailed  = "failed"
+)
+
+// PublishSchedule holds the planned publish and unpublish times of a course or lesson.
+// The scheduler applies them as workflow transitions on behalf of ScheduledBy.
+type PublishSchedule struct {
+	ID              uint       `gorm:"primaryKey;autoIncrement"`
+	ContentType     string     `gorm:"type:varchar(30);not null;uniqueIndex:idx_publish_schedule_content"`
+	ContentID       uint       `gorm:"not null;uniqueIndex:idx_publish_schedule_content"`
+	PublishAt       *time.Time `gorm:"type:timestamp;index"`
+	PublishStatus   string     `gorm:"type:varchar(20);not null;default:'';check:publish_status IN ('', 'pending', 'done', 'failed')"`
+	Cascade         bool       `gorm:"type:boolean;not null;default:false"`
+	UnpublishAt     *time.Time `gorm:"type:timestamp;index"`
+	UnpublishStatus string     `gorm:"type:varchar(20);not null;default:'';check:unpublish_status IN ('', 'pending', 'done', 'failed')"`
+	LastError       string     `gorm:"type:text"`
+	ScheduledBy     uuid.UUID  `gorm:"type:uuid;not null"`
+	CreatedAt       *time.Time
+	UpdatedAt       *time.Time
+}
+
+// TableName specifies the table name for the PublishSchedule model
+func (PublishSchedule) TableName() string {
+	return "publish_schedules"
+}
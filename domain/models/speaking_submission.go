@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Status constants for speaking submissions
+const (
+	SpeakingSubmissionStatusPending  = "pending"
+	SpeakingSubmissionStatusReviewed = "reviewed"
+)
+
+// SpeakingSubmission is a learner's audio recording answering a speaking question.
+// The recording is kept in file storage under AudioKey until a teacher scores it.
+type SpeakingSubmission struct {
+	ID          uuid.UUID        `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	QuestionID  uint             `gorm:"not null;index"`
+	UserID      uuid.UUID        `gorm:"type:uuid;not null;index"`
+	AudioKey    string           `gorm:"type:varchar(255);not null"`
+	ContentType string           `gorm:"type:varchar(100);not null"`
+	SizeBytes   int64            `gorm:"type:bigint;not null"`
+	Status      string           `gorm:"type:varchar(20);not null;default:'pending';index;check:status IN ('pending', 'reviewed')"`
+	Score       *int             `gorm:"type:int"`
+	Feedback    string           `gorm:"type:text"`
+	ReviewerID  *uuid.UUID       `gorm:"type:uuid"`
+	ReviewedAt  *time.Time       `gorm:"type:timestamp"`
+	Question    ExerciseQuestion `gorm:"foreignKey:QuestionID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	CreatedAt   *time.Time
+	UpdatedAt   *time.Time
+}
+
+// TableName specifies the table name for the SpeakingSubmission model
+func (SpeakingSubmission) TableName() string {
+	return "speaking_submissions"
+}
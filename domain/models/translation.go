@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// Translation stores the localized text of a single field of a content entity.
+// The base column on the entity itself holds the text in the default locale.
+type Translation struct {
+	ID         uint   `gorm:"primaryKey;autoIncrement"`
+	EntityType string `gorm:"type:varchar(50);not null;uniqueIndex:idx_translation_entity_field_locale;index:idx_translation_entity"`
+	EntityID   uint   `gorm:"not null;uniqueIndex:idx_translation_entity_field_locale;index:idx_translation_entity"`
+	Field      string `gorm:"type:varchar(50);not null;uniqueIndex:idx_translation_entity_field_locale"`
+	Locale     string `gorm:"type:varchar(10);not null;uniqueIndex:idx_translation_entity_field_locale;index"`
+	Value      string `gorm:"type:text;not null"`
+	CreatedAt  *time.Time
+	UpdatedAt  *time.Time
+}
+
+// TableName specifies the table name for the Translation model
+func (Translation) TableName() string {
+	return "translations"
+}
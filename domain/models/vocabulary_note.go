@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// VocabularyNote is a learner's own note, mnemonic and image for a vocabulary word.
+// A user has at most one note per word. When IsShared is set the mnemonic is shown to
+// other learners, who can upvote it.
+type VocabularyNote struct {
+	ID           uint       `gorm:"primaryKey;autoIncrement"`
+	UserID       uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_vocabulary_note_user"`
+	VocabularyID uint       `gorm:"not null;uniqueIndex:idx_vocabulary_note_user;index"`
+	Note         string     `gorm:"type:text"`
+	Mnemonic     string     `gorm:"type:text"`
+	ImageURL     string     `gorm:"type:varchar(500)"`
+	ImageMediaID *uuid.UUID `gorm:"type:uuid;index"`
+	IsShared     bool       `gorm:"not null;default:false;index"`
+	Upvotes      int        `gorm:"not null;default:0"`
+	User         User       `gorm:"foreignKey:UserID;references:UUID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Vocabulary   Vocabulary `gorm:"foreignKey:VocabularyID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	ImageMedia   *Media     `gorm:"foreignKey:ImageMediaID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+	CreatedAt    *time.Time
+	UpdatedAt    *time.Time
+}
+
+// TableName specifies the table name for the VocabularyNote model
+func (VocabularyNote) TableName() string {
+	return "vocabulary_notes"
+}
+
+// VocabularyNoteUpvote records that a user upvoted a shared mnemonic
+type VocabularyNoteUpvote struct {
+	ID        uint           `gorm:"primaryKey;autoIncrement"`
+	NoteID    uint           `gorm:"not null;uniqueIndex:idx_vocabulary_note_upvote"`
+	UserID    uuid.UUID      `gorm:"type:uuid;not null;uniqueIndex:idx_vocabulary_note_upvote"`
+	Note      VocabularyNote `gorm:"foreignKey:NoteID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	CreatedAt *time.Time
+}
+
+// TableName specifies the table name for the VocabularyNoteUpvote model
+func (VocabularyNoteUpvote) TableName() string {
+	return "vocabulary_note_upvotes"
+}
@@ -112,3 +112,18 @@ func Authenticate() gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// LenientAuthenticate authenticates the request when it carries a valid bearer token and
+// otherwise serves it anonymously, for public reads whose personalization is optional.
+// A malformed or expired token is ignored instead of rejected.
+func LenientAuthenticate() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := c.GetHeader(constants.Authorization)
+		if token != "" {
+			// The user is only set on the request when the token is valid
+			_ = validateBearerToken(c, token)
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,35 @@
+-- Migration: Move inline vocabulary example sentences into the example sentence library
+-- Description: Copies vocabularies.example_sentence / example_sentence_reading / example_sentence_meaning
+--              into example_sentences and links them through vocabulary_example_sentences.
+--              Identical sentence texts are stored once and shared between vocabularies.
+-- Prerequisite: Run the service once so GORM AutoMigrate creates example_sentences
+--               and vocabulary_example_sentences.
+-- Created: 2026-10-18
+
+BEGIN;
+
+-- Create one library entry per distinct inline sentence that is not in the library yet
+INSERT INTO example_sentences (text, reading, translation, difficulty, created_at, updated_at)
+SELECT DISTINCT ON (v.example_sentence)
+    v.example_sentence,
+    v.example_sentence_reading,
+    COALESCE(v.example_sentence_meaning, ''),
+    v.difficulty,
+    NOW(),
+    NOW()
+FROM vocabularies v
+WHERE COALESCE(v.example_sentence, '') <> ''
+  AND NOT EXISTS (
+      SELECT 1 FROM example_sentences es WHERE es.text = v.example_sentence
+  )
+ORDER BY v.example_sentence, v.id;
+
+-- Link every vocabulary to the library entry holding its inline sentence
+INSERT INTO vocabulary_example_sentences (vocabulary_id, example_sentence_id)
+SELECT v.id, es.id
+FROM vocabularies v
+JOIN example_sentences es ON es.text = v.example_sentence
+WHERE COALESCE(v.example_sentence, '') <> ''
+ON CONFLICT DO NOTHING;
+
+COMMIT;
@@ -0,0 +1,91 @@
+-- Migration: Convert exercise question options and answers to typed JSONB schemas
+-- Description: exercise_questions.options and correct_answer used to be free-form text.
+--              They become JSONB documents following the schema of each question type:
+--                multiple_choice / listening: options {"choices":[{"key","text"}]}, answer {"key"}
+--                fill_blank:                  answer {"blanks":[["accepted", ...]]}
+--                matching:                    options {"left":[...],"right":[...]}, answer {"pairs":[{"left","right"}]}
+--                speaking:                    answer {"text"}
+--              Legacy option objects such as {"a":"あ","b":"い"} become keyed choices, and a legacy
+--              answer matching a choice key or text becomes {"key": ...}.
+--              Values that cannot be converted are kept as JSON strings; list them with the
+--              query at the bottom and fix them through the API.
+-- Prerequisite: Run BEFORE deploying the version that declares these columns as jsonb,
+--               otherwise GORM AutoMigrate fails to cast the existing text values.
+-- Created: 2026-10-18
+
+BEGIN;
+
+-- Parse text as JSONB, returning NULL instead of failing on malformed input
+CREATE OR REPLACE FUNCTION pg_temp.try_jsonb(value TEXT) RETURNS JSONB AS $$
+BEGIN
+    RETURN value::jsonb;
+EXCEPTION WHEN others THEN
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql IMMUTABLE;
+
+-- 1. Options: legacy {"key":"text"} objects become keyed choices
+UPDATE exercise_questions q
+SET options = (
+    SELECT jsonb_build_object('choices', jsonb_agg(jsonb_build_object('key', e.key, 'text', e.value) ORDER BY e.key))::text
+    FROM jsonb_each_text(pg_temp.try_jsonb(q.options)) e
+)
+WHERE q.question_type IN ('multiple_choice', 'listening')
+  AND jsonb_typeof(pg_temp.try_jsonb(q.options)) = 'object'
+  AND pg_temp.try_jsonb(q.options)->'choices' IS NULL;
+
+-- Legacy ["あ","い"] arrays become choices keyed a, b, c, ...
+UPDATE exercise_questions q
+SET options = (
+    SELECT jsonb_build_object('choices', jsonb_agg(jsonb_build_object('key', chr(96 + e.idx::int), 'text', e.value) ORDER BY e.idx))::text
+    FROM jsonb_array_elements_text(pg_temp.try_jsonb(q.options)) WITH ORDINALITY AS e(value, idx)
+)
+WHERE q.question_type IN ('multiple_choice', 'listening')
+  AND jsonb_typeof(pg_temp.try_jsonb(q.options)) = 'array';
+
+-- Empty options are stored as NULL; remaining non-JSON text is kept as a JSON string
+UPDATE exercise_questions SET options = NULL WHERE COALESCE(TRIM(options), '') = '';
+UPDATE exercise_questions SET options = to_jsonb(options)::text
+WHERE options IS NOT NULL AND pg_temp.try_jsonb(options) IS NULL;
+
+-- 2. Correct answers (skip values that are already JSON objects)
+-- multiple_choice / listening: reference the choice whose key or text equals the legacy answer
+UPDATE exercise_questions q
+SET correct_answer = jsonb_build_object('key', COALESCE(
+    (SELECT c->>'key'
+     FROM jsonb_array_elements(pg_temp.try_jsonb(q.options)->'choices') c
+     WHERE c->>'key' = q.correct_answer OR c->>'text' = q.correct_answer
+     ORDER BY (c->>'key' = q.correct_answer) DESC
+     LIMIT 1),
+    q.correct_answer))::text
+WHERE q.question_type IN ('multiple_choice', 'listening')
+  AND jsonb_typeof(pg_temp.try_jsonb(q.correct_answer)) IS DISTINCT FROM 'object';
+
+-- fill_blank: a single blank accepting the legacy answer
+UPDATE exercise_questions
+SET correct_answer = jsonb_build_object('blanks', jsonb_build_array(jsonb_build_array(correct_answer)))::text
+WHERE question_type = 'fill_blank'
+  AND jsonb_typeof(pg_temp.try_jsonb(correct_answer)) IS DISTINCT FROM 'object';
+
+-- speaking: the legacy answer becomes the model answer
+UPDATE exercise_questions
+SET correct_answer = jsonb_build_object('text', correct_answer)::text
+WHERE question_type = 'speaking'
+  AND jsonb_typeof(pg_temp.try_jsonb(correct_answer)) IS DISTINCT FROM 'object';
+
+-- Anything else that is not JSON is kept as a JSON string for manual review
+UPDATE exercise_questions SET correct_answer = to_jsonb(correct_answer)::text
+WHERE pg_temp.try_jsonb(correct_answer) IS NULL;
+
+-- 3. Switch the columns to JSONB
+ALTER TABLE exercise_questions
+    ALTER COLUMN options TYPE JSONB USING options::jsonb,
+    ALTER COLUMN correct_answer TYPE JSONB USING correct_answer::jsonb;
+
+COMMIT;
+
+-- Questions that still need manual review:
+-- SELECT id, question_type, options, correct_answer
+-- FROM exercise_questions
+-- WHERE jsonb_typeof(correct_answer) <> 'object'
+--    OR (options IS NOT NULL AND jsonb_typeof(options) <> 'object');
@@ -0,0 +1,36 @@
+-- Migration: Soft delete curriculum content
+-- Description: Courses, lessons, exercises and exercise questions get a deleted_at column and
+--              deleting them moves them to the trash instead of removing the rows.
+--              The unique title and order indexes become partial so trashed content no longer
+--              blocks new content with the same title or position.
+-- Prerequisite: None. Safe to run before or after deploying; GORM AutoMigrate does not
+--               replace the existing full unique indexes, so this migration is required.
+-- Created: 2026-10-18
+
+BEGIN;
+
+-- 1. Deletion time columns
+ALTER TABLE courses ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+ALTER TABLE lessons ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+ALTER TABLE exercises ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+ALTER TABLE exercise_questions ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_courses_deleted_at ON courses (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_lessons_deleted_at ON lessons (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_exercises_deleted_at ON exercises (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_exercise_questions_deleted_at ON exercise_questions (deleted_at);
+
+-- 2. Unique indexes only cover content outside the trash
+DROP INDEX IF EXISTS idx_course_title_jlpt;
+CREATE UNIQUE INDEX idx_course_title_jlpt ON courses (title, jlpt_level_id) WHERE deleted_at IS NULL;
+
+DROP INDEX IF EXISTS idx_lesson_course_order;
+CREATE UNIQUE INDEX idx_lesson_course_order ON lessons (course_id, order_index) WHERE deleted_at IS NULL;
+
+DROP INDEX IF EXISTS idx_exercise_lesson_order;
+CREATE UNIQUE INDEX idx_exercise_lesson_order ON exercises (lesson_id, order_index) WHERE deleted_at IS NULL;
+
+DROP INDEX IF EXISTS idx_question_exercise_order;
+CREATE UNIQUE INDEX idx_question_exercise_order ON exercise_questions (exercise_id, order_index) WHERE deleted_at IS NULL;
+
+COMMIT;
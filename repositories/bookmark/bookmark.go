@@ -0,0 +1,315 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	errWrap "manabu-service/common/error"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type BookmarkRepository struct {
+	db *gorm.DB
+}
+
+// IBookmarkRepository defines the contract for bookmark and bookmark folder data access operations.
+type IBookmarkRepository interface {
+	// Create inserts a new bookmark. It fails with ErrBookmarkExists if the user already bookmarked the item.
+	Create(context.Context, *models.Bookmark) error
+
+	// GetByID retrieves a single bookmark by its ID with its folder.
+	GetByID(context.Context, uint) (*models.Bookmark, error)
+
+	// GetAll retrieves a user's bookmarks with their folders, with optional filtering and pagination, newest first.
+	GetAll(context.Context, string, *dto.BookmarkFilterRequest) ([]models.Bookmark, int64, error)
+
+	// GetBookmarkedIDs returns which of the given items of a content type a user has bookmarked.
+	GetBookmarkedIDs(context.Context, string, string, []uint) ([]uint, error)
+
+	// Move writes the folder of a bookmark.
+	Move(context.Context, *models.Bookmark) error
+
+	// DeleteByItem removes a user's bookmark of an item.
+	DeleteByItem(context.Context, string, string, uint) error
+
+	// ItemExists reports whether an item of a bookmarkable content type exists.
+	ItemExists(context.Context, string, uint) (bool, error)
+
+	// GetItemTitles returns the titles of the given items of a content type, keyed by item ID.
+	// Items that no longer exist are left out.
+	GetItemTitles(context.Context, string, []uint) (map[uint]string, error)
+
+	// CreateFolder inserts a new folder. It fails with ErrBookmarkFolderExists if the user has a folder with the same name.
+	CreateFolder(context.Context, *models.BookmarkFolder) error
+
+	// GetFolderByID retrieves a single folder by its ID.
+	GetFolderByID(context.Context, uint) (*models.BookmarkFolder, error)
+
+	// GetFolders retrieves a user's folders by name.
+	GetFolders(context.Context, string) ([]models.BookmarkFolder, error)
+
+	// CountByFolderIDs returns the number of bookmarks in each of the given folders.
+	CountByFolderIDs(context.Context, []uint) (map[uint]int64, error)
+
+	// RenameFolder writes the name of a folder. It fails with ErrBookmarkFolderExists if the user has a folder with the same name.
+	RenameFolder(context.Context, *models.BookmarkFolder) error
+
+	// DeleteFolder removes a folder by ID. Its bookmarks are kept without a folder.
+	DeleteFolder(context.Context, uint) error
+}
+
+func NewBookmarkRepository(db *gorm.DB) IBookmarkRepository {
+	return &BookmarkRepository{db: db}
+}
+
+// isDuplicate reports whether an error is a unique constraint violation on the given index
+func isDuplicate(err error, index string) bool {
+	return strings.Contains(err.Error(), index) ||
+		strings.Contains(err.Error(), "duplicate key") ||
+		strings.Contains(err.Error(), "UNIQUE constraint")
+}
+
+func (r *BookmarkRepository) Create(ctx context.Context, bookmark *models.Bookmark) error {
+	err := r.db.WithContext(ctx).Omit(clause.Associations).Create(bookmark).Error
+	if err != nil {
+		if isDuplicate(err, "idx_bookmark_user_item") {
+			return errConstant.ErrBookmarkExists
+		}
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+func (r *BookmarkRepository) GetByID(ctx context.Context, id uint) (*models.Bookmark, error) {
+	var bookmark models.Bookmark
+	err := r.db.WithContext(ctx).
+		Preload("Folder").
+		Where("id = ?", id).
+		First(&bookmark).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrBookmarkNotFound
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &bookmark, nil
+}
+
+func (r *BookmarkRepository) GetAll(ctx context.Context, userID string, filter *dto.BookmarkFilterRequest) ([]models.Bookmark, int64, error) {
+	var bookmarks []models.Bookmark
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&models.Bookmark{}).Where("user_id = ?::uuid", userID)
+
+	// Apply filters
+	if filter != nil {
+		if filter.ItemType != "" {
+			query = query.Where("item_type = ?", filter.ItemType)
+		}
+		if filter.FolderID > 0 {
+			query = query.Where("folder_id = ?", filter.FolderID)
+		}
+	}
+
+	// Count total records with filters applied
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	query = query.Preload("Folder").Order("created_at DESC, id DESC")
+
+	// Apply pagination
+	if filter != nil && filter.Limit > 0 {
+		page := filter.Page
+		if page < 1 {
+			page = 1
+		}
+		query = query.Limit(filter.Limit).Offset((page - 1) * filter.Limit)
+	}
+
+	err := query.Find(&bookmarks).Error
+	if err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return bookmarks, total, nil
+}
+
+func (r *BookmarkRepository) GetBookmarkedIDs(ctx context.Context, userID string, itemType string, itemIDs []uint) ([]uint, error) {
+	ids := make([]uint, 0)
+	if len(itemIDs) == 0 {
+		return ids, nil
+	}
+
+	err := r.db.WithContext(ctx).
+		Model(&models.Bookmark{}).
+		Where("user_id = ?::uuid AND item_type = ? AND item_id IN ?", userID, itemType, itemIDs).
+		Pluck("item_id", &ids).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return ids, nil
+}
+
+func (r *BookmarkRepository) Move(ctx context.Context, bookmark *models.Bookmark) error {
+	err := r.db.WithContext(ctx).
+		Model(bookmark).
+		UpdateColumn("folder_id", bookmark.FolderID).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+func (r *BookmarkRepository) DeleteByItem(ctx context.Context, userID string, itemType string, itemID uint) error {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ?::uuid AND item_type = ? AND item_id = ?", userID, itemType, itemID).
+		Delete(&models.Bookmark{})
+	if result.Error != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	if result.RowsAffected == 0 {
+		return errConstant.ErrBookmarkNotFound
+	}
+	return nil
+}
+
+// itemQuery starts a query on the live items of a bookmarkable content type
+func (r *BookmarkRepository) itemQuery(ctx context.Context, itemType string) *gorm.DB {
+	source := models.BookmarkSources[itemType]
+	query := r.db.WithContext(ctx).Table(source.Table)
+	if source.SoftDeleted {
+		query = query.Where("deleted_at IS NULL")
+	}
+	return query
+}
+
+func (r *BookmarkRepository) ItemExists(ctx context.Context, itemType string, itemID uint) (bool, error) {
+	if _, ok := models.BookmarkSources[itemType]; !ok {
+		return false, nil
+	}
+
+	var count int64
+	err := r.itemQuery(ctx, itemType).Where("id = ?", itemID).Count(&count).Error
+	if err != nil {
+		return false, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return count > 0, nil
+}
+
+func (r *BookmarkRepository) GetItemTitles(ctx context.Context, itemType string, itemIDs []uint) (map[uint]string, error) {
+	titles := make(map[uint]string)
+	source, ok := models.BookmarkSources[itemType]
+	if !ok || len(itemIDs) == 0 {
+		return titles, nil
+	}
+
+	var rows []struct {
+		ID    uint
+		Title string
+	}
+	err := r.itemQuery(ctx, itemType).
+		Select("id, "+source.TitleColumn+" AS title").
+		Where("id IN ?", itemIDs).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	for _, row := range rows {
+		titles[row.ID] = row.Title
+	}
+	return titles, nil
+}
+
+func (r *BookmarkRepository) CreateFolder(ctx context.Context, folder *models.BookmarkFolder) error {
+	err := r.db.WithContext(ctx).Omit(clause.Associations).Create(folder).Error
+	if err != nil {
+		if isDuplicate(err, "idx_bookmark_folder_name") {
+			return errConstant.ErrBookmarkFolderExists
+		}
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+func (r *BookmarkRepository) GetFolderByID(ctx context.Context, id uint) (*models.BookmarkFolder, error) {
+	var folder models.BookmarkFolder
+	err := r.db.WithContext(ctx).
+		Where("id = ?", id).
+		First(&folder).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrBookmarkFolderNotFound
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &folder, nil
+}
+
+func (r *BookmarkRepository) GetFolders(ctx context.Context, userID string) ([]models.BookmarkFolder, error) {
+	var folders []models.BookmarkFolder
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?::uuid", userID).
+		Order("name ASC, id ASC").
+		Find(&folders).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return folders, nil
+}
+
+func (r *BookmarkRepository) CountByFolderIDs(ctx context.Context, folderIDs []uint) (map[uint]int64, error) {
+	counts := make(map[uint]int64)
+	if len(folderIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		FolderID uint
+		Count    int64
+	}
+	err := r.db.WithContext(ctx).
+		Model(&models.Bookmark{}).
+		Select("folder_id, COUNT(*) AS count").
+		Where("folder_id IN ?", folderIDs).
+		Group("folder_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	for _, row := range rows {
+		counts[row.FolderID] = row.Count
+	}
+	return counts, nil
+}
+
+func (r *BookmarkRepository) RenameFolder(ctx context.Context, folder *models.BookmarkFolder) error {
+	err := r.db.WithContext(ctx).
+		Model(folder).
+		Select("name", "updated_at").
+		Updates(folder).Error
+	if err != nil {
+		if isDuplicate(err, "idx_bookmark_folder_name") {
+			return errConstant.ErrBookmarkFolderExists
+		}
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+func (r *BookmarkRepository) DeleteFolder(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&models.BookmarkFolder{})
+	if result.Error != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	if result.RowsAffected == 0 {
+		return errConstant.ErrBookmarkFolderNotFound
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	errWrap "manabu-service/common/error"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+type ContentRevisionRepository struct {
+	db *gorm.DB
+}
+
+// IContentRevisionRepository defines the contract for content revision data access operations.
+// Revisions are never updated or deleted.
+type IContentRevisionRepository interface {
+	// Create inserts a revision. It fails with ErrContentRevisionConflict if the version is already taken.
+	Create(context.Context, *models.ContentRevision) error
+
+	// GetLatest retrieves the newest revision of a content item.
+	GetLatest(context.Context, string, uint) (*models.ContentRevision, error)
+
+	// GetByVersion retrieves a revision of a content item by version.
+	GetByVersion(context.Context, string, uint, int) (*models.ContentRevision, error)
+
+	// GetAll retrieves the revisions of a content item without snapshots, newest first.
+	GetAll(context.Context, string, uint, *dto.ContentRevisionFilterRequest) ([]models.ContentRevision, int64, error)
+}
+
+func NewContentRevisionRepository(db *gorm.DB) IContentRevisionRepository {
+	return &ContentRevisionRepository{db: db}
+}
+
+func (r *ContentRevisionRepository) Create(ctx context.Context, revision *models.ContentRevision) error {
+	err := r.db.WithContext(ctx).Create(revision).Error
+	if err != nil {
+		// Another change took the version first
+		if errors.Is(err, gorm.ErrDuplicatedKey) || strings.Contains(err.Error(), "idx_content_revision_version") {
+			return errConstant.ErrContentRevisionConflict
+		}
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+func (r *ContentRevisionRepository) GetLatest(ctx context.Context, contentType string, contentID uint) (*models.ContentRevision, error) {
+	var revision models.ContentRevision
+	err := r.db.WithContext(ctx).
+		Where("content_type = ? AND content_id = ?", contentType, contentID).
+		Order("version DESC").
+		First(&revision).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrContentRevisionNotFound
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &revision, nil
+}
+
+func (r *ContentRevisionRepository) GetByVersion(ctx context.Context, contentType string, contentID uint, version int) (*models.ContentRevision, error) {
+	var revision models.ContentRevision
+	err := r.db.WithContext(ctx).
+		Where("content_type = ? AND content_id = ? AND version = ?", contentType, contentID, version).
+		First(&revision).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrContentRevisionNotFound
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &revision, nil
+}
+
+func (r *ContentRevisionRepository) GetAll(ctx context.Context, contentType string, contentID uint, filter *dto.ContentRevisionFilterRequest) ([]models.ContentRevision, int64, error) {
+	var revisions []models.ContentRevision
+	var total int64
+
+	query := r.db.WithContext(ctx).
+		Model(&models.ContentRevision{}).
+		Where("content_type = ? AND content_id = ?", contentType, contentID)
+
+	// Count total records
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	// Apply pagination, leaving out the snapshots
+	offset := (filter.Page - 1) * filter.Limit
+	err := query.
+		Omit("snapshot").
+		Order("version DESC").
+		Offset(offset).
+		Limit(filter.Limit).
+		Find(&revisions).Error
+	if err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return revisions, total, nil
+}
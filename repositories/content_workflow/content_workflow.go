@@ -0,0 +1,404 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	errWrap "manabu-service/common/error"
+	"manabu-service/common/workflow"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// contentRow is the part of a content row needed to build the hierarchy
+type contentRow struct {
+	ID          uint
+	Title       string
+	IsPublished bool
+	ParentID    uint
+}
+
+type ContentWorkflowRepository struct {
+	db *gorm.DB
+}
+
+// IContentWorkflowRepository defines the contract for content workflow data access operations.
+type IContentWorkflowRepository interface {
+	// IsContentPublished reports whether the content is published, or returns ErrContentNotFound.
+	IsContentPublished(context.Context, string, uint) (bool, error)
+
+	// GetByContent retrieves the workflow of a content item with its transitions and comments.
+	GetByContent(context.Context, string, uint) (*models.ContentWorkflow, error)
+
+	// GetOrCreate retrieves the workflow of a content item, creating it with the given status if missing.
+	GetOrCreate(context.Context, string, uint, string) (*models.ContentWorkflow, error)
+
+	// ApplyTransition moves a workflow out of the given status, records the transition and
+	// keeps the published flag of the content in sync. It fails with ErrWorkflowConflict if
+	// the workflow is no longer in that status.
+	ApplyTransition(context.Context, *models.ContentWorkflow, string, *models.ContentWorkflowTransition) error
+
+	// AssignReviewer sets the reviewer responsible for a workflow.
+	AssignReviewer(context.Context, uint, uuid.UUID) error
+
+	// AddComment adds a discussion comment to a workflow.
+	AddComment(context.Context, *models.ContentWorkflowComment) error
+
+	// GetContentTree retrieves a content item with all its descendants and their workflow
+	// statuses, and reports whether its parent is published (always true for courses).
+	GetContentTree(context.Context, string, uint) (*workflow.Node, bool, error)
+
+	// Publish moves approved content to published in a single transaction, recording a
+	// transition by the actor for each item. It fails with ErrWorkflowConflict if any item
+	// is no longer approved.
+	Publish(context.Context, []*workflow.Node, uuid.UUID, string) error
+
+	// GetAll retrieves workflows with filtering and pagination, longest waiting first.
+	// A non-empty reviewer ID limits the result to workflows assigned to that reviewer.
+	GetAll(context.Context, *dto.ContentWorkflowFilterRequest, string) ([]models.ContentWorkflow, int64, error)
+}
+
+func NewContentWorkflowRepository(db *gorm.DB) IContentWorkflowRepository {
+	return &ContentWorkflowRepository{db: db}
+}
+
+func (r *ContentWorkflowRepository) IsContentPublished(ctx context.Context, contentType string, contentID uint) (bool, error) {
+	table, ok := models.ContentTypeTables[contentType]
+	if !ok {
+		return false, errConstant.ErrInvalidContentType
+	}
+
+	var isPublished []bool
+	err := r.db.WithContext(ctx).
+		Table(table).
+		Where("id = ? AND deleted_at IS NULL", contentID).
+		Pluck("is_published", &isPublished).Error
+	if err != nil {
+		return false, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	if len(isPublished) == 0 {
+		return false, errConstant.ErrContentNotFound
+	}
+
+	return isPublished[0], nil
+}
+
+func (r *ContentWorkflowRepository) GetByContent(ctx context.Context, contentType string, contentID uint) (*models.ContentWorkflow, error) {
+	var contentWorkflow models.ContentWorkflow
+	err := r.db.WithContext(ctx).
+		Preload("Transitions", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at ASC, id ASC")
+		}).
+		Preload("Comments", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at ASC, id ASC")
+		}).
+		Where("content_type = ? AND content_id = ?", contentType, contentID).
+		First(&contentWorkflow).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrContentWorkflowNotFound
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return &contentWorkflow, nil
+}
+
+func (r *ContentWorkflowRepository) GetOrCreate(ctx context.Context, contentType string, contentID uint, status string) (*models.ContentWorkflow, error) {
+	// Concurrent requests may both try to create the workflow, so ignore the conflict
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&models.ContentWorkflow{
+			ContentType: contentType,
+			ContentID:   contentID,
+			Status:      status,
+		}).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return r.GetByContent(ctx, contentType, contentID)
+}
+
+func (r *ContentWorkflowRepository) ApplyTransition(ctx context.Context, contentWorkflow *models.ContentWorkflow, fromStatus string, transition *models.ContentWorkflowTransition) error {
+	table, ok := models.ContentTypeTables[contentWorkflow.ContentType]
+	if !ok {
+		return errConstant.ErrInvalidContentType
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Only move the workflow if nobody changed its status in the meantime
+		result := tx.Model(&models.ContentWorkflow{}).
+			Where("id = ? AND status = ?", contentWorkflow.ID, fromStatus).
+			Updates(map[string]interface{}{
+				"status":           contentWorkflow.Status,
+				"submitted_by":     contentWorkflow.SubmittedBy,
+				"submitted_at":     contentWorkflow.SubmittedAt,
+				"rejection_reason": contentWorkflow.RejectionReason,
+			})
+		if result.Error != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		if result.RowsAffected == 0 {
+			return errConstant.ErrWorkflowConflict
+		}
+
+		transition.WorkflowID = contentWorkflow.ID
+		if err := tx.Create(transition).Error; err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+
+		// Keep the published flag learners see in sync with the workflow
+		var updates map[string]interface{}
+		if contentWorkflow.Status == models.WorkflowStatusPublished {
+			updates = map[string]interface{}{"is_published": true, "published_at": time.Now()}
+		} else if fromStatus == models.WorkflowStatusPublished {
+			updates = map[string]interface{}{"is_published": false, "published_at": nil}
+		}
+		if updates != nil {
+			err := tx.Table(table).Where("id = ?", contentWorkflow.ContentID).Updates(updates).Error
+			if err != nil {
+				return errWrap.WrapError(errConstant.ErrSQLError)
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *ContentWorkflowRepository) AssignReviewer(ctx context.Context, id uint, reviewerID uuid.UUID) error {
+	err := r.db.WithContext(ctx).
+		Model(&models.ContentWorkflow{}).
+		Where("id = ?", id).
+		Update("reviewer_id", reviewerID).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return nil
+}
+
+func (r *ContentWorkflowRepository) AddComment(ctx context.Context, comment *models.ContentWorkflowComment) error {
+	err := r.db.WithContext(ctx).Create(comment).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return nil
+}
+
+func (r *ContentWorkflowRepository) GetAll(ctx context.Context, filter *dto.ContentWorkflowFilterRequest, reviewerID string) ([]models.ContentWorkflow, int64, error) {
+	var workflows []models.ContentWorkflow
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&models.ContentWorkflow{})
+
+	// Apply filters
+	if filter.ContentType != "" {
+		query = query.Where("content_type = ?", filter.ContentType)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if reviewerID != "" {
+		query = query.Where("reviewer_id = ?::uuid", reviewerID)
+	}
+
+	// Count total records
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	// Apply pagination
+	offset := (filter.Page - 1) * filter.Limit
+	err := query.
+		Order("updated_at ASC, id ASC").
+		Offset(offset).
+		Limit(filter.Limit).
+		Find(&workflows).Error
+	if err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return workflows, total, nil
+}
+
+// loadRows loads the hierarchy rows of a content type by ID or by parent ID
+func (r *ContentWorkflowRepository) loadRows(ctx context.Context, contentType string, column string, ids []uint) ([]contentRow, error) {
+	hierarchy := models.ContentHierarchy[contentType]
+	parentColumn := "0"
+	if hierarchy.ParentColumn != "" {
+		parentColumn = hierarchy.ParentColumn
+	}
+
+	query := r.db.WithContext(ctx).
+		Table(models.ContentTypeTables[contentType]).
+		Select(hierarchy.TitleColumn+" AS title, id, is_published, "+parentColumn+" AS parent_id").
+		Where(column+" IN ? AND deleted_at IS NULL", ids)
+	if contentType != models.ContentTypeCourse {
+		query = query.Order("order_index ASC")
+	}
+
+	var rows []contentRow
+	if err := query.Order("id ASC").Scan(&rows).Error; err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return rows, nil
+}
+
+// loadStatuses returns the workflow status of content of one type, keyed by content ID.
+// Content without a workflow is published or a draft depending on its published flag.
+func (r *ContentWorkflowRepository) loadStatuses(ctx context.Context, contentType string, rows []contentRow) (map[uint]string, error) {
+	statuses := make(map[uint]string, len(rows))
+	ids := make([]uint, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.ID)
+		if row.IsPublished {
+			statuses[row.ID] = models.WorkflowStatusPublished
+		} else {
+			statuses[row.ID] = models.WorkflowStatusDraft
+		}
+	}
+	if len(ids) == 0 {
+		return statuses, nil
+	}
+
+	var workflows []models.ContentWorkflow
+	err := r.db.WithContext(ctx).
+		Select("content_id, status").
+		Where("content_type = ? AND content_id IN ?", contentType, ids).
+		Find(&workflows).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	for _, item := range workflows {
+		statuses[item.ContentID] = item.Status
+	}
+
+	return statuses, nil
+}
+
+func (r *ContentWorkflowRepository) GetContentTree(ctx context.Context, contentType string, contentID uint) (*workflow.Node, bool, error) {
+	hierarchy, ok := models.ContentHierarchy[contentType]
+	if !ok {
+		return nil, false, errConstant.ErrInvalidContentType
+	}
+
+	rows, err := r.loadRows(ctx, contentType, "id", []uint{contentID})
+	if err != nil {
+		return nil, false, err
+	}
+	if len(rows) == 0 {
+		return nil, false, errConstant.ErrContentNotFound
+	}
+	statuses, err := r.loadStatuses(ctx, contentType, rows)
+	if err != nil {
+		return nil, false, err
+	}
+	root := &workflow.Node{
+		ContentType: contentType,
+		ContentID:   rows[0].ID,
+		Title:       rows[0].Title,
+		Status:      statuses[rows[0].ID],
+	}
+
+	parentPublished := true
+	if hierarchy.ParentType != "" {
+		parentRows, err := r.loadRows(ctx, hierarchy.ParentType, "id", []uint{rows[0].ParentID})
+		if err != nil {
+			return nil, false, err
+		}
+		parentStatuses, err := r.loadStatuses(ctx, hierarchy.ParentType, parentRows)
+		if err != nil {
+			return nil, false, err
+		}
+		parentPublished = len(parentRows) > 0 && parentStatuses[rows[0].ParentID] == models.WorkflowStatusPublished
+	}
+
+	// Load the descendants one level at a time
+	level := map[uint]*workflow.Node{root.ContentID: root}
+	for childType := hierarchy.ChildType; childType != "" && len(level) > 0; childType = models.ContentHierarchy[childType].ChildType {
+		parentIDs := make([]uint, 0, len(level))
+		for id := range level {
+			parentIDs = append(parentIDs, id)
+		}
+
+		childRows, err := r.loadRows(ctx, childType, models.ContentHierarchy[childType].ParentColumn, parentIDs)
+		if err != nil {
+			return nil, false, err
+		}
+		childStatuses, err := r.loadStatuses(ctx, childType, childRows)
+		if err != nil {
+			return nil, false, err
+		}
+
+		nextLevel := make(map[uint]*workflow.Node, len(childRows))
+		for _, row := range childRows {
+			child := &workflow.Node{
+				ContentType: childType,
+				ContentID:   row.ID,
+				Title:       row.Title,
+				Status:      childStatuses[row.ID],
+			}
+			level[row.ParentID].Children = append(level[row.ParentID].Children, child)
+			nextLevel[row.ID] = child
+		}
+		level = nextLevel
+	}
+
+	return root, parentPublished, nil
+}
+
+func (r *ContentWorkflowRepository) Publish(ctx context.Context, nodes []*workflow.Node, actorID uuid.UUID, reason string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		for _, node := range nodes {
+			var contentWorkflow models.ContentWorkflow
+			err := tx.Where("content_type = ? AND content_id = ?", node.ContentType, node.ContentID).
+				First(&contentWorkflow).Error
+			if err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return errConstant.ErrWorkflowConflict
+				}
+				return errWrap.WrapError(errConstant.ErrSQLError)
+			}
+
+			// Only publish content that is still approved
+			result := tx.Model(&models.ContentWorkflow{}).
+				Where("id = ? AND status = ?", contentWorkflow.ID, models.WorkflowStatusApproved).
+				Update("status", models.WorkflowStatusPublished)
+			if result.Error != nil {
+				return errWrap.WrapError(errConstant.ErrSQLError)
+			}
+			if result.RowsAffected == 0 {
+				return errConstant.ErrWorkflowConflict
+			}
+
+			err = tx.Create(&models.ContentWorkflowTransition{
+				WorkflowID: contentWorkflow.ID,
+				Action:     workflow.ActionPublish,
+				FromStatus: models.WorkflowStatusApproved,
+				ToStatus:   models.WorkflowStatusPublished,
+				ActorID:    actorID,
+				Reason:     reason,
+			}).Error
+			if err != nil {
+				return errWrap.WrapError(errConstant.ErrSQLError)
+			}
+
+			err = tx.Table(models.ContentTypeTables[node.ContentType]).
+				Where("id = ?", node.ContentID).
+				Updates(map[string]interface{}{"is_published": true, "published_at": now}).Error
+			if err != nil {
+				return errWrap.WrapError(errConstant.ErrSQLError)
+			}
+		}
+
+		return nil
+	})
+}
@@ -8,9 +8,9 @@ import (
 	"manabu-service/domain/dto"
 	"manabu-service/domain/models"
 	"strings"
-	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type CourseRepository struct {
@@ -36,17 +36,13 @@ type ICourseRepository interface {
 	// Update modifies an existing course entry by ID.
 	Update(context.Context, *dto.UpdateCourseRequest, uint) (*models.Course, error)
 
-	// Delete removes a course entry by ID.
-	Delete(context.Context, uint) error
-
-	// Publish sets a course as published with the current timestamp.
-	Publish(context.Context, uint) (*models.Course, error)
-
-	// Unpublish sets a course as unpublished.
-	Unpublish(context.Context, uint) (*models.Course, error)
-
 	// GetPublished retrieves only published courses with optional filtering and pagination.
 	GetPublished(context.Context, *dto.CourseFilterRequest) ([]models.Course, int64, error)
+
+	// Clone copies a course with its lessons, exercises, questions and lesson vocabulary in a
+	// single transaction, under a new title and JLPT level. The copies are unpublished drafts; media references
+	// are cleared unless includeMedia is set. Trashed content is not copied.
+	Clone(context.Context, uint, *dto.CloneCourseRequest, bool) (*models.Course, error)
 }
 
 func NewCourseRepository(db *gorm.DB) ICourseRepository {
@@ -61,13 +57,14 @@ func (r *CourseRepository) Create(ctx context.Context, req *dto.CreateCourseRequ
 	}
 
 	course := models.Course{
-		Title:          req.Title,
-		Description:    req.Description,
-		JlptLevelID:    req.JlptLevelID,
-		ThumbnailURL:   req.ThumbnailURL,
-		Difficulty:     difficulty,
-		EstimatedHours: req.EstimatedHours,
-		IsPublished:    false,
+		Title:            req.Title,
+		Description:      req.Description,
+		JlptLevelID:      req.JlptLevelID,
+		ThumbnailURL:     req.ThumbnailURL,
+		ThumbnailMediaID: req.ThumbnailMediaID,
+		Difficulty:       difficulty,
+		EstimatedHours:   req.EstimatedHours,
+		IsPublished:      false,
 	}
 
 	err := r.db.WithContext(ctx).Create(&course).Error
@@ -207,12 +204,13 @@ func (r *CourseRepository) Update(ctx context.Context, req *dto.UpdateCourseRequ
 	}
 
 	course := models.Course{
-		Title:          req.Title,
-		Description:    req.Description,
-		JlptLevelID:    req.JlptLevelID,
-		ThumbnailURL:   req.ThumbnailURL,
-		Difficulty:     difficulty,
-		EstimatedHours: req.EstimatedHours,
+		Title:            req.Title,
+		Description:      req.Description,
+		JlptLevelID:      req.JlptLevelID,
+		ThumbnailURL:     req.ThumbnailURL,
+		ThumbnailMediaID: req.ThumbnailMediaID,
+		Difficulty:       difficulty,
+		EstimatedHours:   req.EstimatedHours,
 	}
 
 	result := r.db.WithContext(ctx).
@@ -240,86 +238,6 @@ func (r *CourseRepository) Update(ctx context.Context, req *dto.UpdateCourseRequ
 	return &course, nil
 }
 
-func (r *CourseRepository) Delete(ctx context.Context, id uint) error {
-	result := r.db.WithContext(ctx).
-		Where("id = ?", id).
-		Delete(&models.Course{})
-
-	if result.Error != nil {
-		return errWrap.WrapError(errConstant.ErrSQLError)
-	}
-
-	// Check if any rows were affected
-	if result.RowsAffected == 0 {
-		return errConstant.ErrCourseNotFound
-	}
-
-	return nil
-}
-
-func (r *CourseRepository) Publish(ctx context.Context, id uint) (*models.Course, error) {
-	now := time.Now()
-	result := r.db.WithContext(ctx).
-		Model(&models.Course{}).
-		Where("id = ?", id).
-		Updates(map[string]interface{}{
-			"is_published": true,
-			"published_at": now,
-		})
-
-	if result.Error != nil {
-		return nil, errWrap.WrapError(errConstant.ErrSQLError)
-	}
-
-	// Check if any rows were affected
-	if result.RowsAffected == 0 {
-		return nil, errConstant.ErrCourseNotFound
-	}
-
-	// Fetch the updated record with preloaded relationships
-	var course models.Course
-	err := r.db.WithContext(ctx).
-		Preload("JlptLevel").
-		Where("id = ?", id).
-		First(&course).Error
-	if err != nil {
-		return nil, errWrap.WrapError(errConstant.ErrSQLError)
-	}
-
-	return &course, nil
-}
-
-func (r *CourseRepository) Unpublish(ctx context.Context, id uint) (*models.Course, error) {
-	result := r.db.WithContext(ctx).
-		Model(&models.Course{}).
-		Where("id = ?", id).
-		Updates(map[string]interface{}{
-			"is_published": false,
-			"published_at": nil,
-		})
-
-	if result.Error != nil {
-		return nil, errWrap.WrapError(errConstant.ErrSQLError)
-	}
-
-	// Check if any rows were affected
-	if result.RowsAffected == 0 {
-		return nil, errConstant.ErrCourseNotFound
-	}
-
-	// Fetch the updated record with preloaded relationships
-	var course models.Course
-	err := r.db.WithContext(ctx).
-		Preload("JlptLevel").
-		Where("id = ?", id).
-		First(&course).Error
-	if err != nil {
-		return nil, errWrap.WrapError(errConstant.ErrSQLError)
-	}
-
-	return &course, nil
-}
-
 func (r *CourseRepository) GetPublished(ctx context.Context, filter *dto.CourseFilterRequest) ([]models.Course, int64, error) {
 	var courses []models.Course
 	var total int64
@@ -400,3 +318,146 @@ func (r *CourseRepository) GetPublished(ctx context.Context, filter *dto.CourseF
 
 	return courses, total, nil
 }
+
+func (r *CourseRepository) Clone(ctx context.Context, id uint, req *dto.CloneCourseRequest, includeMedia bool) (*models.Course, error) {
+	var course models.Course
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("id = ?", id).First(&course).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errConstant.ErrCourseNotFound
+			}
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+
+		course.ID = 0
+		course.Title = req.Title
+		course.JlptLevelID = req.JlptLevelID
+		course.IsPublished = false
+		course.PublishedAt = nil
+		course.CreatedAt = nil
+		course.UpdatedAt = nil
+		if !includeMedia {
+			course.ThumbnailURL = ""
+			course.ThumbnailMediaID = nil
+		}
+		err = tx.Omit(clause.Associations).Create(&course).Error
+		if err != nil {
+			// Check for unique constraint violation on title within JLPT level
+			if strings.Contains(err.Error(), "idx_course_title_jlpt") ||
+				strings.Contains(err.Error(), "duplicate key") {
+				return errConstant.ErrCourseDuplicate
+			}
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+
+		// Lessons
+		var lessons []models.Lesson
+		if err = tx.Where("course_id = ?", id).Order("id ASC").Find(&lessons).Error; err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		if len(lessons) == 0 {
+			return nil
+		}
+		lessonIDs := make(map[uint]uint, len(lessons))
+		sourceLessonIDs := make([]uint, len(lessons))
+		for i := range lessons {
+			sourceLessonIDs[i] = lessons[i].ID
+			lessons[i].ID = 0
+			lessons[i].CourseID = course.ID
+			lessons[i].IsPublished = false
+			lessons[i].PublishedAt = nil
+			lessons[i].CreatedAt = nil
+			lessons[i].UpdatedAt = nil
+		}
+		if err = tx.Omit(clause.Associations).Create(&lessons).Error; err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		for i := range lessons {
+			lessonIDs[sourceLessonIDs[i]] = lessons[i].ID
+		}
+
+		// Lesson vocabulary, which links to the same words
+		var lessonVocabularies []models.LessonVocabulary
+		if err = tx.Where("lesson_id IN ?", sourceLessonIDs).Order("id ASC").Find(&lessonVocabularies).Error; err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		if len(lessonVocabularies) > 0 {
+			for i := range lessonVocabularies {
+				lessonVocabularies[i].ID = 0
+				lessonVocabularies[i].LessonID = lessonIDs[lessonVocabularies[i].LessonID]
+				lessonVocabularies[i].CreatedAt = nil
+			}
+			if err = tx.Omit(clause.Associations).Create(&lessonVocabularies).Error; err != nil {
+				return errWrap.WrapError(errConstant.ErrSQLError)
+			}
+		}
+
+		// Exercises
+		var exercises []models.Exercise
+		if err = tx.Where("lesson_id IN ?", sourceLessonIDs).Order("id ASC").Find(&exercises).Error; err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		if len(exercises) == 0 {
+			return nil
+		}
+		exerciseIDs := make(map[uint]uint, len(exercises))
+		sourceExerciseIDs := make([]uint, len(exercises))
+		for i := range exercises {
+			sourceExerciseIDs[i] = exercises[i].ID
+			exercises[i].ID = 0
+			exercises[i].LessonID = lessonIDs[exercises[i].LessonID]
+			exercises[i].IsPublished = false
+			exercises[i].PublishedAt = nil
+			exercises[i].CreatedAt = nil
+			exercises[i].UpdatedAt = nil
+		}
+		if err = tx.Omit(clause.Associations).Create(&exercises).Error; err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		for i := range exercises {
+			exerciseIDs[sourceExerciseIDs[i]] = exercises[i].ID
+		}
+
+		// Questions
+		var questions []models.ExerciseQuestion
+		if err = tx.Where("exercise_id IN ?", sourceExerciseIDs).Order("id ASC").Find(&questions).Error; err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		if len(questions) == 0 {
+			return nil
+		}
+		for i := range questions {
+			questions[i].ID = 0
+			questions[i].ExerciseID = exerciseIDs[questions[i].ExerciseID]
+			questions[i].IsPublished = false
+			questions[i].PublishedAt = nil
+			questions[i].CreatedAt = nil
+			questions[i].UpdatedAt = nil
+			if !includeMedia {
+				questions[i].AudioURL = ""
+				questions[i].AudioMediaID = nil
+				questions[i].ImageURL = ""
+				questions[i].ImageMediaID = nil
+			}
+		}
+		if err = tx.Omit(clause.Associations).Create(&questions).Error; err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Load relationships using Preload for efficiency
+	err = r.db.WithContext(ctx).
+		Preload("JlptLevel").
+		First(&course, course.ID).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return &course, nil
+}
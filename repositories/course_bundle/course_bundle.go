@@ -0,0 +1,243 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	errWrap "manabu-service/common/error"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CourseTree is a course with its lessons, exercises and questions in order
+type CourseTree struct {
+	Course  models.Course
+	Lessons []LessonTree
+}
+
+// LessonTree is a lesson with its exercises and the vocabulary it teaches, in lesson order.
+// Vocabularies point at words that exist or are created along with the tree.
+// When Blocks is set on create, it replaces the stored blocks of the lesson, with the
+// vocabulary ID of each block in BlockVocabularies taken from the word it points at.
+type LessonTree struct {
+	Lesson            models.Lesson
+	Exercises         []ExerciseTree
+	Vocabularies      []*models.Vocabulary
+	Blocks            []dto.LessonBlock
+	BlockVocabularies map[int]*models.Vocabulary
+}
+
+type ExerciseTree struct {
+	Exercise  models.Exercise
+	Questions []models.ExerciseQuestion
+}
+
+type CourseBundleRepository struct {
+	db *gorm.DB
+}
+
+// ICourseBundleRepository defines the contract for reading and writing a whole course tree,
+// used to export and import course bundles.
+type ICourseBundleRepository interface {
+	// GetTree retrieves a course with its lessons, exercises and questions ordered by order_index,
+	// and the vocabulary of each lesson with its JLPT level and category.
+	GetTree(context.Context, uint) (*CourseTree, error)
+
+	// Create inserts the vocabularies and the course tree in a single transaction and returns
+	// the created course. IDs and parent IDs of the tree are assigned by the insert, so lesson
+	// vocabularies may point into the given vocabularies.
+	Create(context.Context, *CourseTree, []models.Vocabulary) (*models.Course, error)
+}
+
+func NewCourseBundleRepository(db *gorm.DB) ICourseBundleRepository {
+	return &CourseBundleRepository{db: db}
+}
+
+func (r *CourseBundleRepository) GetTree(ctx context.Context, id uint) (*CourseTree, error) {
+	tree := &CourseTree{}
+	err := r.db.WithContext(ctx).
+		Preload("JlptLevel").
+		Where("id = ?", id).
+		First(&tree.Course).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrCourseNotFound
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	var lessons []models.Lesson
+	err = r.db.WithContext(ctx).
+		Where("course_id = ?", id).
+		Order("order_index ASC").
+		Find(&lessons).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	if len(lessons) == 0 {
+		return tree, nil
+	}
+
+	lessonIDs := make([]uint, len(lessons))
+	for i, lesson := range lessons {
+		lessonIDs[i] = lesson.ID
+	}
+	var exercises []models.Exercise
+	err = r.db.WithContext(ctx).
+		Where("lesson_id IN ?", lessonIDs).
+		Order("order_index ASC").
+		Find(&exercises).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	questionsByExercise := make(map[uint][]models.ExerciseQuestion)
+	if len(exercises) > 0 {
+		exerciseIDs := make([]uint, len(exercises))
+		for i, exercise := range exercises {
+			exerciseIDs[i] = exercise.ID
+		}
+		var questions []models.ExerciseQuestion
+		err = r.db.WithContext(ctx).
+			Where("exercise_id IN ?", exerciseIDs).
+			Order("order_index ASC").
+			Find(&questions).Error
+		if err != nil {
+			return nil, errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		for _, question := range questions {
+			questionsByExercise[question.ExerciseID] = append(questionsByExercise[question.ExerciseID], question)
+		}
+	}
+
+	var lessonVocabularies []models.LessonVocabulary
+	err = r.db.WithContext(ctx).
+		Preload("Vocabulary.JlptLevel").
+		Preload("Vocabulary.Category").
+		Where("lesson_id IN ?", lessonIDs).
+		Order("order_index ASC, id ASC").
+		Find(&lessonVocabularies).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	vocabulariesByLesson := make(map[uint][]*models.Vocabulary)
+	for i := range lessonVocabularies {
+		lessonVocabulary := &lessonVocabularies[i]
+		vocabulariesByLesson[lessonVocabulary.LessonID] = append(vocabulariesByLesson[lessonVocabulary.LessonID], &lessonVocabulary.Vocabulary)
+	}
+
+	exercisesByLesson := make(map[uint][]ExerciseTree)
+	for _, exercise := range exercises {
+		exercisesByLesson[exercise.LessonID] = append(exercisesByLesson[exercise.LessonID], ExerciseTree{
+			Exercise:  exercise,
+			Questions: questionsByExercise[exercise.ID],
+		})
+	}
+	for _, lesson := range lessons {
+		tree.Lessons = append(tree.Lessons, LessonTree{
+			Lesson:       lesson,
+			Exercises:    exercisesByLesson[lesson.ID],
+			Vocabularies: vocabulariesByLesson[lesson.ID],
+		})
+	}
+
+	return tree, nil
+}
+
+func (r *CourseBundleRepository) Create(ctx context.Context, tree *CourseTree, vocabularies []models.Vocabulary) (*models.Course, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(vocabularies) > 0 {
+			err := tx.Omit(clause.Associations).Create(&vocabularies).Error
+			if err != nil {
+				// Check for unique constraint violation on word within JLPT level
+				if strings.Contains(err.Error(), "idx_vocabulary_word_jlpt") ||
+					strings.Contains(err.Error(), "duplicate key") {
+					return errConstant.ErrVocabularyDuplicate
+				}
+				return errWrap.WrapError(errConstant.ErrSQLError)
+			}
+		}
+
+		err := tx.Omit(clause.Associations).Create(&tree.Course).Error
+		if err != nil {
+			// Check for unique constraint violation on title within JLPT level
+			if strings.Contains(err.Error(), "idx_course_title_jlpt") ||
+				strings.Contains(err.Error(), "duplicate key") {
+				return errConstant.ErrCourseDuplicate
+			}
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+
+		for i := range tree.Lessons {
+			lesson := &tree.Lessons[i]
+			lesson.Lesson.CourseID = tree.Course.ID
+			if len(lesson.Blocks) > 0 {
+				for j, vocabulary := range lesson.BlockVocabularies {
+					lesson.Blocks[j].VocabularyID = vocabulary.ID
+				}
+				blocks, err := json.Marshal(lesson.Blocks)
+				if err != nil {
+					return errConstant.ErrInvalidLessonBlock
+				}
+				encoded := string(blocks)
+				lesson.Lesson.Blocks = &encoded
+			}
+			if err := tx.Omit(clause.Associations).Create(&lesson.Lesson).Error; err != nil {
+				return errWrap.WrapError(errConstant.ErrSQLError)
+			}
+
+			if len(lesson.Vocabularies) > 0 {
+				lessonVocabularies := make([]models.LessonVocabulary, 0, len(lesson.Vocabularies))
+				for j, vocabulary := range lesson.Vocabularies {
+					lessonVocabularies = append(lessonVocabularies, models.LessonVocabulary{
+						LessonID:     lesson.Lesson.ID,
+						VocabularyID: vocabulary.ID,
+						OrderIndex:   j + 1,
+					})
+				}
+				if err := tx.Omit(clause.Associations).Create(&lessonVocabularies).Error; err != nil {
+					return errWrap.WrapError(errConstant.ErrSQLError)
+				}
+			}
+
+			for j := range lesson.Exercises {
+				exercise := &lesson.Exercises[j]
+				exercise.Exercise.LessonID = lesson.Lesson.ID
+				if err := tx.Omit(clause.Associations).Create(&exercise.Exercise).Error; err != nil {
+					return errWrap.WrapError(errConstant.ErrSQLError)
+				}
+
+				if len(exercise.Questions) == 0 {
+					continue
+				}
+				for k := range exercise.Questions {
+					exercise.Questions[k].ExerciseID = exercise.Exercise.ID
+				}
+				if err := tx.Omit(clause.Associations).Create(&exercise.Questions).Error; err != nil {
+					return errWrap.WrapError(errConstant.ErrSQLError)
+				}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Load relationships using Preload for efficiency
+	var course models.Course
+	err = r.db.WithContext(ctx).
+		Preload("JlptLevel").
+		First(&course, tree.Course.ID).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return &course, nil
+}
@@ -0,0 +1,78 @@
+package repositories
+
+import (
+	"context"
+	errWrap "manabu-service/common/error"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CoursePrerequisiteRepository struct {
+	db *gorm.DB
+}
+
+// ICoursePrerequisiteRepository defines the contract for course prerequisite data access operations.
+type ICoursePrerequisiteRepository interface {
+	// GetByCourseID retrieves the prerequisites of a course with the prerequisite courses.
+	GetByCourseID(context.Context, uint) ([]models.CoursePrerequisite, error)
+
+	// GetAll retrieves every prerequisite, used to detect cycles.
+	GetAll(context.Context) ([]models.CoursePrerequisite, error)
+
+	// Replace sets the prerequisites of a course to the given course IDs.
+	Replace(context.Context, uint, []uint) error
+}
+
+func NewCoursePrerequisiteRepository(db *gorm.DB) ICoursePrerequisiteRepository {
+	return &CoursePrerequisiteRepository{db: db}
+}
+
+func (r *CoursePrerequisiteRepository) GetByCourseID(ctx context.Context, courseID uint) ([]models.CoursePrerequisite, error) {
+	var prerequisites []models.CoursePrerequisite
+	err := r.db.WithContext(ctx).
+		Preload("PrerequisiteCourse").
+		Joins("JOIN courses ON courses.id = course_prerequisites.prerequisite_course_id AND courses.deleted_at IS NULL").
+		Where("course_prerequisites.course_id = ?", courseID).
+		Order("course_prerequisites.id ASC").
+		Find(&prerequisites).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return prerequisites, nil
+}
+
+func (r *CoursePrerequisiteRepository) GetAll(ctx context.Context) ([]models.CoursePrerequisite, error) {
+	var prerequisites []models.CoursePrerequisite
+	err := r.db.WithContext(ctx).Find(&prerequisites).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return prerequisites, nil
+}
+
+func (r *CoursePrerequisiteRepository) Replace(ctx context.Context, courseID uint, prerequisiteIDs []uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("course_id = ?", courseID).Delete(&models.CoursePrerequisite{}).Error
+		if err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		if len(prerequisiteIDs) == 0 {
+			return nil
+		}
+
+		prerequisites := make([]models.CoursePrerequisite, 0, len(prerequisiteIDs))
+		for _, prerequisiteID := range prerequisiteIDs {
+			prerequisites = append(prerequisites, models.CoursePrerequisite{
+				CourseID:             courseID,
+				PrerequisiteCourseID: prerequisiteID,
+			})
+		}
+		if err := tx.Omit(clause.Associations).Create(&prerequisites).Error; err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		return nil
+	})
+}
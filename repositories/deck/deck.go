@@ -0,0 +1,349 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	errWrap "manabu-service/common/error"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type DeckRepository struct {
+	db *gorm.DB
+}
+
+// IDeckRepository defines the contract for deck data access operations.
+type IDeckRepository interface {
+	// Create inserts a new deck.
+	Create(context.Context, *models.Deck) error
+
+	// GetByID retrieves a single deck with its owner by ID.
+	GetByID(context.Context, uint) (*models.Deck, error)
+
+	// GetPublic retrieves public decks with optional search, sorting and pagination.
+	GetPublic(context.Context, *dto.DeckFilterRequest) ([]models.Deck, int64, error)
+
+	// GetByUserID retrieves the decks owned by a user, newest first.
+	GetByUserID(context.Context, string, *dto.PaginationRequest) ([]models.Deck, int64, error)
+
+	// GetSubscribed retrieves the decks a user is subscribed to, most recently subscribed first.
+	GetSubscribed(context.Context, string, *dto.PaginationRequest) ([]models.Deck, int64, error)
+
+	// Update writes the title, description and visibility of a deck.
+	Update(context.Context, *models.Deck) error
+
+	// Delete removes a deck with its words, cards and subscriptions.
+	Delete(context.Context, uint) error
+
+	// GetVocabularies retrieves the words of a deck in deck order.
+	GetVocabularies(context.Context, uint) ([]models.DeckVocabulary, error)
+
+	// ReplaceVocabularies replaces the words of a deck in a single transaction.
+	// Words are ordered by their position in the given IDs.
+	ReplaceVocabularies(context.Context, uint, []uint) error
+
+	// CountVocabularies returns the number of words of each of the given decks.
+	CountVocabularies(context.Context, []uint) (map[uint]int64, error)
+
+	// Subscribe subscribes a user to a deck and increments its subscriber count in a single
+	// transaction. It reports false if the user was already subscribed.
+	Subscribe(context.Context, uint, uuid.UUID) (bool, error)
+
+	// Unsubscribe removes a user's subscription to a deck and decrements its subscriber count in a
+	// single transaction. It reports false if the user was not subscribed.
+	Unsubscribe(context.Context, uint, uuid.UUID) (bool, error)
+
+	// GetSubscribedDeckIDs returns which of the given decks a user is subscribed to.
+	GetSubscribedDeckIDs(context.Context, string, []uint) ([]uint, error)
+
+	// GetSubscriberIDs returns the UUIDs of the users subscribed to a deck.
+	GetSubscriberIDs(context.Context, uint) ([]uuid.UUID, error)
+}
+
+func NewDeckRepository(db *gorm.DB) IDeckRepository {
+	return &DeckRepository{db: db}
+}
+
+// paginate applies pagination to a query
+func paginate(query *gorm.DB, pagination *dto.PaginationRequest) *gorm.DB {
+	if pagination == nil || pagination.Limit <= 0 {
+		return query
+	}
+	page := pagination.Page
+	if page < 1 {
+		page = 1
+	}
+	return query.Limit(pagination.Limit).Offset((page - 1) * pagination.Limit)
+}
+
+func (r *DeckRepository) Create(ctx context.Context, deck *models.Deck) error {
+	err := r.db.WithContext(ctx).Omit(clause.Associations).Create(deck).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+func (r *DeckRepository) GetByID(ctx context.Context, id uint) (*models.Deck, error) {
+	var deck models.Deck
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		Where("id = ?", id).
+		First(&deck).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrDeckNotFound
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &deck, nil
+}
+
+func (r *DeckRepository) GetPublic(ctx context.Context, filter *dto.DeckFilterRequest) ([]models.Deck, int64, error) {
+	var decks []models.Deck
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&models.Deck{}).Where("visibility = ?", models.DeckVisibilityPublic)
+
+	// Apply filters
+	if filter != nil && filter.Search != "" {
+		searchPattern := "%" + strings.ToLower(filter.Search) + "%"
+		query = query.Where("(LOWER(title) LIKE ? OR LOWER(description) LIKE ?)", searchPattern, searchPattern)
+	}
+
+	// Count total records with filters applied
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	// Apply sorting with whitelist validation (defense in depth)
+	allowedSortOrders := map[string]string{
+		"popular": "subscriber_count DESC, id DESC",
+		"newest":  "created_at DESC, id DESC",
+		"title":   "title ASC, id ASC",
+	}
+	order := allowedSortOrders["popular"]
+	if filter != nil {
+		if validOrder, ok := allowedSortOrders[filter.SortBy]; ok {
+			order = validOrder
+		}
+	}
+	query = query.Preload("User").Order(order)
+
+	if filter != nil {
+		query = paginate(query, &filter.PaginationRequest)
+	}
+
+	err := query.Find(&decks).Error
+	if err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return decks, total, nil
+}
+
+func (r *DeckRepository) GetByUserID(ctx context.Context, userID string, pagination *dto.PaginationRequest) ([]models.Deck, int64, error) {
+	var decks []models.Deck
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&models.Deck{}).Where("user_id = ?::uuid", userID)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	err := paginate(query.Preload("User").Order("created_at DESC, id DESC"), pagination).Find(&decks).Error
+	if err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return decks, total, nil
+}
+
+func (r *DeckRepository) GetSubscribed(ctx context.Context, userID string, pagination *dto.PaginationRequest) ([]models.Deck, int64, error) {
+	var decks []models.Deck
+	var total int64
+
+	query := r.db.WithContext(ctx).
+		Model(&models.Deck{}).
+		Joins("JOIN deck_subscriptions ON deck_subscriptions.deck_id = decks.id").
+		Where("deck_subscriptions.user_id = ?::uuid", userID)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	query = query.Select("decks.*").Preload("User").Order("deck_subscriptions.created_at DESC, decks.id DESC")
+	err := paginate(query, pagination).Find(&decks).Error
+	if err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return decks, total, nil
+}
+
+func (r *DeckRepository) Update(ctx context.Context, deck *models.Deck) error {
+	// Every editable field is written so the description can be cleared again
+	err := r.db.WithContext(ctx).
+		Model(deck).
+		Select("title", "description", "visibility", "updated_at").
+		Updates(deck).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+func (r *DeckRepository) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&models.Deck{})
+	if result.Error != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	if result.RowsAffected == 0 {
+		return errConstant.ErrDeckNotFound
+	}
+	return nil
+}
+
+func (r *DeckRepository) GetVocabularies(ctx context.Context, deckID uint) ([]models.DeckVocabulary, error) {
+	var vocabularies []models.DeckVocabulary
+	err := r.db.WithContext(ctx).
+		Preload("Vocabulary").
+		Where("deck_id = ?", deckID).
+		Order("order_index ASC, id ASC").
+		Find(&vocabularies).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return vocabularies, nil
+}
+
+func (r *DeckRepository) ReplaceVocabularies(ctx context.Context, deckID uint, vocabularyIDs []uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("deck_id = ?", deckID).Delete(&models.DeckVocabulary{}).Error
+		if err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		if len(vocabularyIDs) == 0 {
+			return nil
+		}
+
+		vocabularies := make([]models.DeckVocabulary, 0, len(vocabularyIDs))
+		for i, vocabularyID := range vocabularyIDs {
+			vocabularies = append(vocabularies, models.DeckVocabulary{
+				DeckID:       deckID,
+				VocabularyID: vocabularyID,
+				OrderIndex:   i + 1,
+			})
+		}
+		if err := tx.Omit(clause.Associations).Create(&vocabularies).Error; err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		return nil
+	})
+}
+
+func (r *DeckRepository) CountVocabularies(ctx context.Context, deckIDs []uint) (map[uint]int64, error) {
+	counts := make(map[uint]int64)
+	if len(deckIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		DeckID uint
+		Count  int64
+	}
+	err := r.db.WithContext(ctx).
+		Model(&models.DeckVocabulary{}).
+		Select("deck_id, COUNT(*) AS count").
+		Where("deck_id IN ?", deckIDs).
+		Group("deck_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	for _, row := range rows {
+		counts[row.DeckID] = row.Count
+	}
+	return counts, nil
+}
+
+func (r *DeckRepository) Subscribe(ctx context.Context, deckID uint, userID uuid.UUID) (bool, error) {
+	added := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Omit(clause.Associations).
+			Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&models.DeckSubscription{DeckID: deckID, UserID: userID})
+		if result.Error != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		err := tx.Model(&models.Deck{}).
+			Where("id = ?", deckID).
+			UpdateColumn("subscriber_count", gorm.Expr("subscriber_count + 1")).Error
+		if err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		added = true
+		return nil
+	})
+	return added, err
+}
+
+func (r *DeckRepository) Unsubscribe(ctx context.Context, deckID uint, userID uuid.UUID) (bool, error) {
+	removed := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("deck_id = ? AND user_id = ?", deckID, userID).Delete(&models.DeckSubscription{})
+		if result.Error != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		err := tx.Model(&models.Deck{}).
+			Where("id = ? AND subscriber_count > 0", deckID).
+			UpdateColumn("subscriber_count", gorm.Expr("subscriber_count - 1")).Error
+		if err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		removed = true
+		return nil
+	})
+	return removed, err
+}
+
+func (r *DeckRepository) GetSubscribedDeckIDs(ctx context.Context, userID string, deckIDs []uint) ([]uint, error) {
+	var subscribedIDs []uint
+	if len(deckIDs) == 0 {
+		return subscribedIDs, nil
+	}
+
+	err := r.db.WithContext(ctx).
+		Model(&models.DeckSubscription{}).
+		Where("user_id = ?::uuid AND deck_id IN ?", userID, deckIDs).
+		Pluck("deck_id", &subscribedIDs).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return subscribedIDs, nil
+}
+
+func (r *DeckRepository) GetSubscriberIDs(ctx context.Context, deckID uint) ([]uuid.UUID, error) {
+	var userIDs []uuid.UUID
+	err := r.db.WithContext(ctx).
+		Model(&models.DeckSubscription{}).
+		Where("deck_id = ?", deckID).
+		Pluck("user_id", &userIDs).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return userIDs, nil
+}
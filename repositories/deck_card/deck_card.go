@@ -0,0 +1,274 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	errWrap "manabu-service/common/error"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type DeckCardRepository struct {
+	db *gorm.DB
+}
+
+// IDeckCardRepository defines the contract for custom deck card data access operations,
+// including the learning progress of users on the cards.
+type IDeckCardRepository interface {
+	// Create inserts a new card.
+	Create(context.Context, *models.DeckCard) error
+
+	// GetByID retrieves a single card by its ID.
+	GetByID(context.Context, uint) (*models.DeckCard, error)
+
+	// GetByDeckID retrieves the cards of a deck in deck order.
+	GetByDeckID(context.Context, uint) ([]models.DeckCard, error)
+
+	// Update writes the content of a card.
+	Update(context.Context, *models.DeckCard) error
+
+	// Delete removes a card by ID, together with the progress of users on it.
+	Delete(context.Context, uint) error
+
+	// CountByDeckIDs returns the number of cards of each of the given decks.
+	CountByDeckIDs(context.Context, []uint) (map[uint]int64, error)
+
+	// CreateStatuses starts tracking a user's progress on the given cards, skipping cards
+	// that are already tracked, and returns how many were added.
+	CreateStatuses(context.Context, uuid.UUID, []uint) (int, error)
+
+	// CreateStatusesForUsers starts tracking the progress of the given users on a card,
+	// skipping users who already track it.
+	CreateStatusesForUsers(context.Context, []uuid.UUID, uint) error
+
+	// DeleteStatusesByDeckID stops tracking a user's progress on the cards of a deck.
+	DeleteStatusesByDeckID(context.Context, uuid.UUID, uint) error
+
+	// GetStatuses retrieves a user's progress on cards with optional filtering and pagination,
+	// least recently reviewed first.
+	GetStatuses(context.Context, string, *dto.DeckCardStatusListRequest) ([]models.UserDeckCardStatus, int64, error)
+
+	// GetStatusByUserAndCard retrieves a user's progress on a card.
+	GetStatusByUserAndCard(context.Context, string, uint) (*models.UserDeckCardStatus, error)
+
+	// UpdateStatus writes the progress of a user on a card.
+	UpdateStatus(context.Context, *models.UserDeckCardStatus) error
+}
+
+func NewDeckCardRepository(db *gorm.DB) IDeckCardRepository {
+	return &DeckCardRepository{db: db}
+}
+
+func (r *DeckCardRepository) Create(ctx context.Context, card *models.DeckCard) error {
+	err := r.db.WithContext(ctx).Omit(clause.Associations).Create(card).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+func (r *DeckCardRepository) GetByID(ctx context.Context, id uint) (*models.DeckCard, error) {
+	var card models.DeckCard
+	err := r.db.WithContext(ctx).
+		Where("id = ?", id).
+		First(&card).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrDeckCardNotFound
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &card, nil
+}
+
+func (r *DeckCardRepository) GetByDeckID(ctx context.Context, deckID uint) ([]models.DeckCard, error) {
+	var cards []models.DeckCard
+	err := r.db.WithContext(ctx).
+		Where("deck_id = ?", deckID).
+		Order("order_index ASC, id ASC").
+		Find(&cards).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return cards, nil
+}
+
+func (r *DeckCardRepository) Update(ctx context.Context, card *models.DeckCard) error {
+	// Every editable field is written so optional fields can be cleared again
+	err := r.db.WithContext(ctx).
+		Model(card).
+		Select("front", "reading", "back", "note", "updated_at").
+		Updates(card).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+func (r *DeckCardRepository) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&models.DeckCard{})
+	if result.Error != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	if result.RowsAffected == 0 {
+		return errConstant.ErrDeckCardNotFound
+	}
+	return nil
+}
+
+func (r *DeckCardRepository) CountByDeckIDs(ctx context.Context, deckIDs []uint) (map[uint]int64, error) {
+	counts := make(map[uint]int64)
+	if len(deckIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		DeckID uint
+		Count  int64
+	}
+	err := r.db.WithContext(ctx).
+		Model(&models.DeckCard{}).
+		Select("deck_id, COUNT(*) AS count").
+		Where("deck_id IN ?", deckIDs).
+		Group("deck_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	for _, row := range rows {
+		counts[row.DeckID] = row.Count
+	}
+	return counts, nil
+}
+
+func (r *DeckCardRepository) CreateStatuses(ctx context.Context, userID uuid.UUID, cardIDs []uint) (int, error) {
+	if len(cardIDs) == 0 {
+		return 0, nil
+	}
+
+	statuses := make([]models.UserDeckCardStatus, 0, len(cardIDs))
+	for _, cardID := range cardIDs {
+		statuses = append(statuses, models.UserDeckCardStatus{
+			UserID: userID,
+			CardID: cardID,
+			Status: "learning",
+		})
+	}
+
+	result := r.db.WithContext(ctx).
+		Omit(clause.Associations).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&statuses)
+	if result.Error != nil {
+		return 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return int(result.RowsAffected), nil
+}
+
+func (r *DeckCardRepository) CreateStatusesForUsers(ctx context.Context, userIDs []uuid.UUID, cardID uint) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	statuses := make([]models.UserDeckCardStatus, 0, len(userIDs))
+	for _, userID := range userIDs {
+		statuses = append(statuses, models.UserDeckCardStatus{
+			UserID: userID,
+			CardID: cardID,
+			Status: "learning",
+		})
+	}
+
+	err := r.db.WithContext(ctx).
+		Omit(clause.Associations).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&statuses).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+func (r *DeckCardRepository) DeleteStatusesByDeckID(ctx context.Context, userID uuid.UUID, deckID uint) error {
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND card_id IN (?)", userID,
+			r.db.WithContext(ctx).Model(&models.DeckCard{}).Select("id").Where("deck_id = ?", deckID)).
+		Delete(&models.UserDeckCardStatus{}).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+func (r *DeckCardRepository) GetStatuses(ctx context.Context, userID string, filter *dto.DeckCardStatusListRequest) ([]models.UserDeckCardStatus, int64, error) {
+	var statuses []models.UserDeckCardStatus
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&models.UserDeckCardStatus{}).Where("user_id = ?::uuid", userID)
+
+	// Apply filters
+	if filter != nil {
+		if filter.DeckID > 0 {
+			query = query.Where("card_id IN (?)",
+				r.db.WithContext(ctx).Model(&models.DeckCard{}).Select("id").Where("deck_id = ?", filter.DeckID))
+		}
+		if filter.Status != "" {
+			query = query.Where("status = ?", filter.Status)
+		}
+	}
+
+	// Count total records with filters applied
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	query = query.Preload("Card").Order("last_reviewed_at ASC NULLS FIRST, id ASC")
+
+	// Apply pagination
+	if filter != nil && filter.Limit > 0 {
+		page := filter.Page
+		if page < 1 {
+			page = 1
+		}
+		query = query.Limit(filter.Limit).Offset((page - 1) * filter.Limit)
+	}
+
+	err := query.Find(&statuses).Error
+	if err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return statuses, total, nil
+}
+
+func (r *DeckCardRepository) GetStatusByUserAndCard(ctx context.Context, userID string, cardID uint) (*models.UserDeckCardStatus, error) {
+	var status models.UserDeckCardStatus
+	err := r.db.WithContext(ctx).
+		Preload("Card").
+		Where("user_id = ?::uuid AND card_id = ?", userID, cardID).
+		First(&status).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrDeckCardStatusNotFound
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &status, nil
+}
+
+func (r *DeckCardRepository) UpdateStatus(ctx context.Context, status *models.UserDeckCardStatus) error {
+	err := r.db.WithContext(ctx).
+		Model(status).
+		Select("status", "repetitions", "last_reviewed_at", "updated_at").
+		Updates(status).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
@@ -0,0 +1,270 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	errWrap "manabu-service/common/error"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type ExamRepository struct {
+	db *gorm.DB
+}
+
+// IExamRepository defines the contract for exam data access operations.
+type IExamRepository interface {
+	// Create inserts a new exam and its sections into the database.
+	Create(context.Context, *dto.CreateExamRequest) (*models.Exam, error)
+
+	// GetAll retrieves all exams with optional filtering and pagination.
+	// Returns the list of exams and total count.
+	GetAll(context.Context, *dto.ExamFilterRequest) ([]models.Exam, int64, error)
+
+	// GetByID retrieves a single exam with its sections by its ID.
+	GetByID(context.Context, uint) (*models.Exam, error)
+
+	// Update modifies an existing exam by ID and replaces its sections.
+	Update(context.Context, *dto.UpdateExamRequest, uint) (*models.Exam, error)
+
+	// Delete removes an exam by ID.
+	Delete(context.Context, uint) error
+
+	// Publish sets an exam as published with the current timestamp.
+	Publish(context.Context, uint) (*models.Exam, error)
+
+	// Unpublish sets an exam as unpublished.
+	Unpublish(context.Context, uint) (*models.Exam, error)
+}
+
+func NewExamRepository(db *gorm.DB) IExamRepository {
+	return &ExamRepository{db: db}
+}
+
+// toSectionModels converts section requests into models ordered as requested
+func toSectionModels(examID uint, sections []dto.ExamSectionRequest) []models.ExamSection {
+	sectionModels := make([]models.ExamSection, 0, len(sections))
+	for i, section := range sections {
+		sectionModels = append(sectionModels, models.ExamSection{
+			ExamID:        examID,
+			SectionType:   section.SectionType,
+			QuestionCount: section.QuestionCount,
+			OrderIndex:    i,
+		})
+	}
+	return sectionModels
+}
+
+// preloadExam loads the relationships returned with an exam
+func preloadExam(db *gorm.DB) *gorm.DB {
+	return db.Preload("JlptLevel").
+		Preload("Sections", func(db *gorm.DB) *gorm.DB {
+			return db.Order("order_index ASC")
+		})
+}
+
+func (r *ExamRepository) Create(ctx context.Context, req *dto.CreateExamRequest) (*models.Exam, error) {
+	exam := models.Exam{
+		Title:           req.Title,
+		Description:     req.Description,
+		JlptLevelID:     req.JlptLevelID,
+		DurationMinutes: req.DurationMinutes,
+		IsPublished:     false,
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&exam).Error; err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+
+		sections := toSectionModels(exam.ID, req.Sections)
+		if err := tx.Create(&sections).Error; err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(ctx, exam.ID)
+}
+
+func (r *ExamRepository) GetAll(ctx context.Context, filter *dto.ExamFilterRequest) ([]models.Exam, int64, error) {
+	var exams []models.Exam
+	var total int64
+
+	// Build base query with filters
+	query := r.db.WithContext(ctx).Model(&models.Exam{})
+
+	// Apply filters
+	if filter != nil {
+		if filter.JlptLevelID > 0 {
+			query = query.Where("jlpt_level_id = ?", filter.JlptLevelID)
+		}
+		if filter.IsPublished != nil {
+			query = query.Where("is_published = ?", *filter.IsPublished)
+		}
+		if filter.Search != "" {
+			searchPattern := "%" + strings.ToLower(filter.Search) + "%"
+			query = query.Where("LOWER(title) LIKE ? OR LOWER(description) LIKE ?",
+				searchPattern, searchPattern)
+		}
+	}
+
+	// Count total records with filters applied
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	// Apply sorting with whitelist validation (defense in depth)
+	allowedSortFields := map[string]string{
+		"title":      "title",
+		"created_at": "created_at",
+	}
+	allowedSortOrders := map[string]string{
+		"asc":  "ASC",
+		"desc": "DESC",
+	}
+
+	sortBy := "created_at"
+	sortOrder := "DESC"
+	if filter != nil {
+		if filter.SortBy != "" {
+			if validField, ok := allowedSortFields[filter.SortBy]; ok {
+				sortBy = validField
+			}
+		}
+		if filter.SortOrder != "" {
+			if validOrder, ok := allowedSortOrders[filter.SortOrder]; ok {
+				sortOrder = validOrder
+			}
+		}
+	}
+
+	query = preloadExam(query).Order(sortBy + " " + sortOrder)
+
+	// Apply pagination
+	if filter != nil && filter.Limit > 0 {
+		page := filter.Page
+		if page < 1 {
+			page = 1
+		}
+		query = query.Limit(filter.Limit).Offset((page - 1) * filter.Limit)
+	}
+
+	err := query.Find(&exams).Error
+	if err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return exams, total, nil
+}
+
+func (r *ExamRepository) GetByID(ctx context.Context, id uint) (*models.Exam, error) {
+	var exam models.Exam
+	err := preloadExam(r.db.WithContext(ctx)).
+		Where("id = ?", id).
+		First(&exam).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrExamNotFound
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &exam, nil
+}
+
+func (r *ExamRepository) Update(ctx context.Context, req *dto.UpdateExamRequest, id uint) (*models.Exam, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.Exam{}).
+			Where("id = ?", id).
+			Updates(map[string]interface{}{
+				"title":            req.Title,
+				"description":      req.Description,
+				"jlpt_level_id":    req.JlptLevelID,
+				"duration_minutes": req.DurationMinutes,
+			})
+		if result.Error != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		if result.RowsAffected == 0 {
+			return errConstant.ErrExamNotFound
+		}
+
+		// Replace the sections; attempts keep the questions they already drew
+		if err := tx.Where("exam_id = ?", id).Delete(&models.ExamSection{}).Error; err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		sections := toSectionModels(id, req.Sections)
+		if err := tx.Create(&sections).Error; err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+func (r *ExamRepository) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).
+		Where("id = ?", id).
+		Delete(&models.Exam{})
+
+	if result.Error != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	// Check if any rows were affected
+	if result.RowsAffected == 0 {
+		return errConstant.ErrExamNotFound
+	}
+
+	return nil
+}
+
+func (r *ExamRepository) Publish(ctx context.Context, id uint) (*models.Exam, error) {
+	result := r.db.WithContext(ctx).
+		Model(&models.Exam{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"is_published": true,
+			"published_at": time.Now(),
+		})
+
+	if result.Error != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	if result.RowsAffected == 0 {
+		return nil, errConstant.ErrExamNotFound
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+func (r *ExamRepository) Unpublish(ctx context.Context, id uint) (*models.Exam, error) {
+	result := r.db.WithContext(ctx).
+		Model(&models.Exam{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"is_published": false,
+			"published_at": nil,
+		})
+
+	if result.Error != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	if result.RowsAffected == 0 {
+		return nil, errConstant.ErrExamNotFound
+	}
+
+	return r.GetByID(ctx, id)
+}
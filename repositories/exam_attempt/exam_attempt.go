@@ -0,0 +1,247 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	errWrap "manabu-service/common/error"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ExamAttemptRepository struct {
+	db *gorm.DB
+}
+
+// IExamAttemptRepository defines the contract for exam attempt data access operations.
+type IExamAttemptRepository interface {
+	// Create inserts a new attempt together with its drawn questions.
+	Create(context.Context, *models.ExamAttempt) (*models.ExamAttempt, error)
+
+	// GetByID retrieves an attempt with its exam, questions and saved answers.
+	GetByID(context.Context, uuid.UUID) (*models.ExamAttempt, error)
+
+	// GetInProgressByUserAndExam retrieves the unfinished attempt of a user for an exam.
+	GetInProgressByUserAndExam(context.Context, string, uint) (*models.ExamAttempt, error)
+
+	// GetOverdueIDs returns the IDs of a user's in-progress attempts that expired before the given time.
+	GetOverdueIDs(context.Context, string, time.Time) ([]uuid.UUID, error)
+
+	// GetAll retrieves a user's attempts with optional filtering and pagination, newest first.
+	GetAll(context.Context, string, *dto.ExamAttemptFilterRequest) ([]models.ExamAttempt, int64, error)
+
+	// SaveAnswers stores answers (JSON keyed by question ID) of an in-progress attempt.
+	SaveAnswers(context.Context, uuid.UUID, map[uint]string) error
+
+	// LockInProgress locks an in-progress attempt until the end of the transaction it runs in and
+	// retrieves it with its exam, questions and saved answers.
+	LockInProgress(context.Context, uuid.UUID) (*models.ExamAttempt, error)
+
+	// Finish stores the graded answers and scores of an attempt and closes it.
+	// The attempt row is locked so an attempt can only be finished once.
+	Finish(context.Context, *models.ExamAttempt) error
+}
+
+func NewExamAttemptRepository(db *gorm.DB) IExamAttemptRepository {
+	return &ExamAttemptRepository{db: db}
+}
+
+func (r *ExamAttemptRepository) Create(ctx context.Context, attempt *models.ExamAttempt) (*models.ExamAttempt, error) {
+	err := r.db.WithContext(ctx).Create(attempt).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return r.GetByID(ctx, attempt.ID)
+}
+
+func (r *ExamAttemptRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.ExamAttempt, error) {
+	var attempt models.ExamAttempt
+	err := r.db.WithContext(ctx).
+		Preload("Exam").
+		Preload("Exam.JlptLevel").
+		Preload("Exam.Sections", func(db *gorm.DB) *gorm.DB {
+			return db.Order("order_index ASC")
+		}).
+		Preload("Answers", func(db *gorm.DB) *gorm.DB {
+			return db.Order("order_index ASC")
+		}).
+		Preload("Answers.Question").
+		Where("id = ?", id).
+		First(&attempt).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrExamAttemptNotFound
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &attempt, nil
+}
+
+func (r *ExamAttemptRepository) GetInProgressByUserAndExam(ctx context.Context, userID string, examID uint) (*models.ExamAttempt, error) {
+	var attempt models.ExamAttempt
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?::uuid AND exam_id = ? AND status = ?", userID, examID, models.ExamAttemptStatusInProgress).
+		Order("started_at DESC").
+		First(&attempt).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrExamAttemptNotFound
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &attempt, nil
+}
+
+func (r *ExamAttemptRepository) GetOverdueIDs(ctx context.Context, userID string, before time.Time) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := r.db.WithContext(ctx).
+		Model(&models.ExamAttempt{}).
+		Where("user_id = ?::uuid AND status = ? AND expires_at < ?", userID, models.ExamAttemptStatusInProgress, before).
+		Pluck("id", &ids).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return ids, nil
+}
+
+func (r *ExamAttemptRepository) GetAll(ctx context.Context, userID string, filter *dto.ExamAttemptFilterRequest) ([]models.ExamAttempt, int64, error) {
+	var attempts []models.ExamAttempt
+	var total int64
+
+	// Build base query with user filter
+	query := r.db.WithContext(ctx).Model(&models.ExamAttempt{}).Where("user_id = ?::uuid", userID)
+
+	// Apply filters
+	if filter != nil {
+		if filter.ExamID > 0 {
+			query = query.Where("exam_id = ?", filter.ExamID)
+		}
+		if filter.Status != "" {
+			query = query.Where("status = ?", filter.Status)
+		}
+	}
+
+	// Count total records with filters applied
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	query = query.Preload("Exam").
+		Preload("Exam.JlptLevel").
+		Preload("Exam.Sections", func(db *gorm.DB) *gorm.DB {
+			return db.Order("order_index ASC")
+		}).
+		Order("started_at DESC")
+
+	// Apply pagination
+	if filter != nil && filter.Limit > 0 {
+		page := filter.Page
+		if page < 1 {
+			page = 1
+		}
+		query = query.Limit(filter.Limit).Offset((page - 1) * filter.Limit)
+	}
+
+	err := query.Find(&attempts).Error
+	if err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return attempts, total, nil
+}
+
+// lockInProgress locks an attempt row and checks that it can still be changed
+func lockInProgress(tx *gorm.DB, id uuid.UUID) error {
+	var attempt models.ExamAttempt
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&attempt).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errConstant.ErrExamAttemptNotFound
+		}
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	if attempt.Status != models.ExamAttemptStatusInProgress {
+		return errConstant.ErrExamAttemptAlreadySubmitted
+	}
+	return nil
+}
+
+func (r *ExamAttemptRepository) SaveAnswers(ctx context.Context, id uuid.UUID, answers map[uint]string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockInProgress(tx, id); err != nil {
+			return err
+		}
+
+		for questionID, answer := range answers {
+			result := tx.Model(&models.ExamAttemptAnswer{}).
+				Where("attempt_id = ? AND question_id = ?", id, questionID).
+				Update("answer", answer)
+			if result.Error != nil {
+				return errWrap.WrapError(errConstant.ErrSQLError)
+			}
+			if result.RowsAffected == 0 {
+				return errConstant.ErrExamAnswerQuestionNotFound
+			}
+		}
+
+		// Touch the attempt so the last autosave time is visible
+		err := tx.Model(&models.ExamAttempt{}).
+			Where("id = ?", id).
+			Update("updated_at", time.Now()).Error
+		if err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		return nil
+	})
+}
+
+func (r *ExamAttemptRepository) LockInProgress(ctx context.Context, id uuid.UUID) (*models.ExamAttempt, error) {
+	if err := lockInProgress(r.db.WithContext(ctx), id); err != nil {
+		return nil, err
+	}
+	return r.GetByID(ctx, id)
+}
+
+func (r *ExamAttemptRepository) Finish(ctx context.Context, attempt *models.ExamAttempt) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockInProgress(tx, attempt.ID); err != nil {
+			return err
+		}
+
+		for _, answer := range attempt.Answers {
+			err := tx.Model(&models.ExamAttemptAnswer{}).
+				Where("id = ?", answer.ID).
+				Updates(map[string]interface{}{
+					"is_correct":    answer.IsCorrect,
+					"points_earned": answer.PointsEarned,
+				}).Error
+			if err != nil {
+				return errWrap.WrapError(errConstant.ErrSQLError)
+			}
+		}
+
+		err := tx.Model(&models.ExamAttempt{}).
+			Where("id = ?", attempt.ID).
+			Updates(map[string]interface{}{
+				"status":       attempt.Status,
+				"submitted_at": attempt.SubmittedAt,
+				"total_score":  attempt.TotalScore,
+				"max_score":    attempt.MaxScore,
+				"pass_score":   attempt.PassScore,
+				"passed":       attempt.Passed,
+				"scores":       attempt.Scores,
+			}).Error
+		if err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		return nil
+	})
+}
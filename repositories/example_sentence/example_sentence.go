@@ -0,0 +1,252 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	errWrap "manabu-service/common/error"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+type ExampleSentenceRepository struct {
+	db *gorm.DB
+}
+
+// IExampleSentenceRepository defines the contract for example sentence data access operations.
+type IExampleSentenceRepository interface {
+	// Create inserts a new example sentence and links it to the given vocabularies.
+	Create(context.Context, *dto.CreateExampleSentenceRequest) (*models.ExampleSentence, error)
+
+	// GetAll retrieves all example sentences with optional filtering and pagination.
+	// Returns the list of example sentences and total count.
+	GetAll(context.Context, *dto.ExampleSentenceFilterRequest) ([]models.ExampleSentence, int64, error)
+
+	// GetByID retrieves a single example sentence by its ID.
+	GetByID(context.Context, uint) (*models.ExampleSentence, error)
+
+	// GetByVocabularyID retrieves all example sentences linked to a vocabulary entry.
+	GetByVocabularyID(context.Context, uint) ([]models.ExampleSentence, error)
+
+	// Update modifies an existing example sentence by ID and replaces its vocabulary links.
+	Update(context.Context, *dto.UpdateExampleSentenceRequest, uint) (*models.ExampleSentence, error)
+
+	// Delete removes an example sentence and its vocabulary links by ID.
+	Delete(context.Context, uint) error
+}
+
+func NewExampleSentenceRepository(db *gorm.DB) IExampleSentenceRepository {
+	return &ExampleSentenceRepository{db: db}
+}
+
+// toVocabularyRefs builds association placeholders for the given vocabulary IDs
+func toVocabularyRefs(vocabularyIDs []uint) []models.Vocabulary {
+	vocabularies := make([]models.Vocabulary, 0, len(vocabularyIDs))
+	for _, id := range vocabularyIDs {
+		vocabularies = append(vocabularies, models.Vocabulary{ID: id})
+	}
+	return vocabularies
+}
+
+func (r *ExampleSentenceRepository) Create(ctx context.Context, req *dto.CreateExampleSentenceRequest) (*models.ExampleSentence, error) {
+	// Set default difficulty if not provided
+	difficulty := req.Difficulty
+	if difficulty == 0 {
+		difficulty = 1
+	}
+
+	sentence := models.ExampleSentence{
+		Text:        req.Text,
+		Reading:     req.Reading,
+		Translation: req.Translation,
+		AudioURL:    req.AudioURL,
+		Source:      req.Source,
+		Difficulty:  difficulty,
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Vocabularies").Create(&sentence).Error; err != nil {
+			return err
+		}
+		if len(req.VocabularyIDs) > 0 {
+			return tx.Model(&sentence).Association("Vocabularies").Append(toVocabularyRefs(req.VocabularyIDs))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return r.GetByID(ctx, sentence.ID)
+}
+
+func (r *ExampleSentenceRepository) GetAll(ctx context.Context, filter *dto.ExampleSentenceFilterRequest) ([]models.ExampleSentence, int64, error) {
+	var sentences []models.ExampleSentence
+	var total int64
+
+	// Build base query with filters
+	query := r.db.WithContext(ctx).Model(&models.ExampleSentence{})
+
+	// Apply filters
+	if filter != nil {
+		if filter.VocabularyID > 0 {
+			query = query.Where("id IN (?)", r.db.Table("vocabulary_example_sentences").
+				Select("example_sentence_id").
+				Where("vocabulary_id = ?", filter.VocabularyID))
+		}
+		if filter.Difficulty > 0 {
+			query = query.Where("difficulty = ?", filter.Difficulty)
+		}
+		if filter.Search != "" {
+			searchPattern := "%" + strings.ToLower(filter.Search) + "%"
+			query = query.Where("text LIKE ? OR reading LIKE ? OR LOWER(translation) LIKE ?",
+				searchPattern, searchPattern, searchPattern)
+		}
+	}
+
+	// Count total records with filters applied
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	// Apply sorting with whitelist validation (defense in depth)
+	allowedSortFields := map[string]string{
+		"difficulty": "difficulty",
+		"created_at": "created_at",
+	}
+	allowedSortOrders := map[string]string{
+		"asc":  "ASC",
+		"desc": "DESC",
+	}
+
+	sortBy := "created_at"
+	sortOrder := "DESC"
+	if filter != nil {
+		if filter.SortBy != "" {
+			if validField, ok := allowedSortFields[filter.SortBy]; ok {
+				sortBy = validField
+			}
+		}
+		if filter.SortOrder != "" {
+			if validOrder, ok := allowedSortOrders[filter.SortOrder]; ok {
+				sortOrder = validOrder
+			}
+		}
+	}
+
+	query = query.Preload("Vocabularies").
+		Order(sortBy + " " + sortOrder)
+
+	// Apply pagination
+	if filter != nil && filter.Limit > 0 {
+		// Defensive validation: ensure page is at least 1
+		page := filter.Page
+		if page < 1 {
+			page = 1
+		}
+
+		offset := (page - 1) * filter.Limit
+		// Ensure offset is never negative
+		if offset < 0 {
+			offset = 0
+		}
+
+		query = query.Limit(filter.Limit).Offset(offset)
+	}
+
+	err := query.Find(&sentences).Error
+	if err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return sentences, total, nil
+}
+
+func (r *ExampleSentenceRepository) GetByID(ctx context.Context, id uint) (*models.ExampleSentence, error) {
+	var sentence models.ExampleSentence
+	err := r.db.WithContext(ctx).
+		Preload("Vocabularies").
+		Where("id = ?", id).
+		First(&sentence).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrExampleSentenceNotFound
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &sentence, nil
+}
+
+func (r *ExampleSentenceRepository) GetByVocabularyID(ctx context.Context, vocabularyID uint) ([]models.ExampleSentence, error) {
+	var sentences []models.ExampleSentence
+	err := r.db.WithContext(ctx).
+		Joins("JOIN vocabulary_example_sentences ves ON ves.example_sentence_id = example_sentences.id").
+		Where("ves.vocabulary_id = ?", vocabularyID).
+		Preload("Vocabularies").
+		Order("example_sentences.difficulty ASC, example_sentences.id ASC").
+		Find(&sentences).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return sentences, nil
+}
+
+func (r *ExampleSentenceRepository) Update(ctx context.Context, req *dto.UpdateExampleSentenceRequest, id uint) (*models.ExampleSentence, error) {
+	// Set default difficulty if not provided
+	difficulty := req.Difficulty
+	if difficulty == 0 {
+		difficulty = 1
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.ExampleSentence{}).
+			Where("id = ?", id).
+			Updates(map[string]interface{}{
+				"text":        req.Text,
+				"reading":     req.Reading,
+				"translation": req.Translation,
+				"audio_url":   req.AudioURL,
+				"source":      req.Source,
+				"difficulty":  difficulty,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		// Check if any rows were affected
+		if result.RowsAffected == 0 {
+			return errConstant.ErrExampleSentenceNotFound
+		}
+
+		sentence := models.ExampleSentence{ID: id}
+		return tx.Model(&sentence).Association("Vocabularies").Replace(toVocabularyRefs(req.VocabularyIDs))
+	})
+	if err != nil {
+		if errors.Is(err, errConstant.ErrExampleSentenceNotFound) {
+			return nil, err
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+func (r *ExampleSentenceRepository) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).
+		Select("Vocabularies").
+		Delete(&models.ExampleSentence{ID: id})
+
+	if result.Error != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	// Check if any rows were affected
+	if result.RowsAffected == 0 {
+		return errConstant.ErrExampleSentenceNotFound
+	}
+
+	return nil
+}
@@ -8,7 +8,6 @@ import (
 	"manabu-service/domain/dto"
 	"manabu-service/domain/models"
 	"strings"
-	"time"
 
 	"gorm.io/gorm"
 )
@@ -39,14 +38,9 @@ type IExerciseRepository interface {
 	// Update modifies an existing exercise entry by ID.
 	Update(context.Context, *dto.UpdateExerciseRequest, uint) (*models.Exercise, error)
 
-	// Delete removes an exercise entry by ID.
-	Delete(context.Context, uint) error
-
-	// Publish sets an exercise as published with the current timestamp.
-	Publish(context.Context, uint) (*models.Exercise, error)
-
-	// Unpublish sets an exercise as unpublished.
-	Unpublish(context.Context, uint) (*models.Exercise, error)
+	// Reorder sets the order_index of every exercise of a lesson to its position in the given IDs, starting at 1,
+	// in a single transaction. It fails with ErrInvalidExerciseOrder unless the IDs list each exercise exactly once.
+	Reorder(context.Context, uint, []uint) error
 }
 
 func NewExerciseRepository(db *gorm.DB) IExerciseRepository {
@@ -62,6 +56,9 @@ func (r *ExerciseRepository) Create(ctx context.Context, req *dto.CreateExercise
 		OrderIndex:       req.OrderIndex,
 		DifficultyLevel:  req.DifficultyLevel,
 		EstimatedMinutes: req.EstimatedMinutes,
+		QuestionPoolSize: req.QuestionPoolSize,
+		ShuffleQuestions: req.ShuffleQuestions,
+		ShuffleOptions:   req.ShuffleOptions,
 		IsPublished:      false,
 	}
 
@@ -251,59 +248,21 @@ func (r *ExerciseRepository) Update(ctx context.Context, req *dto.UpdateExercise
 		return nil, errConstant.ErrExerciseNotFound
 	}
 
-	// Fetch the updated record with preloaded relationships
+	// Attempt settings are always written so they can be turned off again
 	err := r.db.WithContext(ctx).
-		Preload("Lesson").
-		Preload("Lesson.Course").
-		Preload("Lesson.Course.JlptLevel").
-		Where("id = ?", id).
-		First(&exercise).Error
-	if err != nil {
-		return nil, errWrap.WrapError(errConstant.ErrSQLError)
-	}
-
-	return &exercise, nil
-}
-
-func (r *ExerciseRepository) Delete(ctx context.Context, id uint) error {
-	result := r.db.WithContext(ctx).
-		Where("id = ?", id).
-		Delete(&models.Exercise{})
-
-	if result.Error != nil {
-		return errWrap.WrapError(errConstant.ErrSQLError)
-	}
-
-	// Check if any rows were affected
-	if result.RowsAffected == 0 {
-		return errConstant.ErrExerciseNotFound
-	}
-
-	return nil
-}
-
-func (r *ExerciseRepository) Publish(ctx context.Context, id uint) (*models.Exercise, error) {
-	now := time.Now()
-	result := r.db.WithContext(ctx).
 		Model(&models.Exercise{}).
 		Where("id = ?", id).
 		Updates(map[string]interface{}{
-			"is_published": true,
-			"published_at": now,
-		})
-
-	if result.Error != nil {
+			"question_pool_size": req.QuestionPoolSize,
+			"shuffle_questions":  req.ShuffleQuestions,
+			"shuffle_options":    req.ShuffleOptions,
+		}).Error
+	if err != nil {
 		return nil, errWrap.WrapError(errConstant.ErrSQLError)
 	}
 
-	// Check if any rows were affected
-	if result.RowsAffected == 0 {
-		return nil, errConstant.ErrExerciseNotFound
-	}
-
 	// Fetch the updated record with preloaded relationships
-	var exercise models.Exercise
-	err := r.db.WithContext(ctx).
+	err = r.db.WithContext(ctx).
 		Preload("Lesson").
 		Preload("Lesson.Course").
 		Preload("Lesson.Course.JlptLevel").
@@ -316,35 +275,32 @@ func (r *ExerciseRepository) Publish(ctx context.Context, id uint) (*models.Exer
 	return &exercise, nil
 }
 
-func (r *ExerciseRepository) Unpublish(ctx context.Context, id uint) (*models.Exercise, error) {
-	result := r.db.WithContext(ctx).
-		Model(&models.Exercise{}).
-		Where("id = ?", id).
-		Updates(map[string]interface{}{
-			"is_published": false,
-			"published_at": nil,
-		})
-
-	if result.Error != nil {
-		return nil, errWrap.WrapError(errConstant.ErrSQLError)
-	}
-
-	// Check if any rows were affected
-	if result.RowsAffected == 0 {
-		return nil, errConstant.ErrExerciseNotFound
-	}
+func (r *ExerciseRepository) Reorder(ctx context.Context, lessonID uint, ids []uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// The unique order index is checked row by row and cannot be deferred, so every exercise
+		// first moves to a free negative order_index and then takes its new position
+		result := tx.Model(&models.Exercise{}).
+			Where("lesson_id = ?", lessonID).
+			Update("order_index", gorm.Expr("-order_index - 1"))
+		if result.Error != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		if result.RowsAffected != int64(len(ids)) {
+			return errConstant.ErrInvalidExerciseOrder
+		}
 
-	// Fetch the updated record with preloaded relationships
-	var exercise models.Exercise
-	err := r.db.WithContext(ctx).
-		Preload("Lesson").
-		Preload("Lesson.Course").
-		Preload("Lesson.Course.JlptLevel").
-		Where("id = ?", id).
-		First(&exercise).Error
-	if err != nil {
-		return nil, errWrap.WrapError(errConstant.ErrSQLError)
-	}
+		for position, id := range ids {
+			result = tx.Model(&models.Exercise{}).
+				Where("id = ? AND lesson_id = ?", id, lessonID).
+				Update("order_index", position+1)
+			if result.Error != nil {
+				return errWrap.WrapError(errConstant.ErrSQLError)
+			}
+			if result.RowsAffected == 0 {
+				return errConstant.ErrInvalidExerciseOrder
+			}
+		}
 
-	return &exercise, nil
+		return nil
+	})
 }
@@ -0,0 +1,128 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	errWrap "manabu-service/common/error"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ExerciseAttemptRepository struct {
+	db *gorm.DB
+}
+
+// IExerciseAttemptRepository defines the contract for exercise attempt data access operations.
+type IExerciseAttemptRepository interface {
+	// Create inserts a new attempt with its seed and drawn questions.
+	Create(context.Context, *models.ExerciseAttempt) (*models.ExerciseAttempt, error)
+
+	// GetByID retrieves an attempt with its exercise.
+	GetByID(context.Context, uuid.UUID) (*models.ExerciseAttempt, error)
+
+	// Submit stores the graded results of an attempt and closes it.
+	// The attempt row is locked so an attempt can only be submitted once.
+	Submit(context.Context, *models.ExerciseAttempt) error
+
+	// GetLessonScores returns the score percentage of a user on the published exercises of each
+	// lesson: the average of the best submitted attempt of every exercise, counting exercises
+	// without a submitted attempt as 0. Lessons without published exercises are left out.
+	GetLessonScores(context.Context, uuid.UUID, []uint) (map[uint]float64, error)
+}
+
+func NewExerciseAttemptRepository(db *gorm.DB) IExerciseAttemptRepository {
+	return &ExerciseAttemptRepository{db: db}
+}
+
+func (r *ExerciseAttemptRepository) Create(ctx context.Context, attempt *models.ExerciseAttempt) (*models.ExerciseAttempt, error) {
+	err := r.db.WithContext(ctx).Create(attempt).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return r.GetByID(ctx, attempt.ID)
+}
+
+func (r *ExerciseAttemptRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.ExerciseAttempt, error) {
+	var attempt models.ExerciseAttempt
+	err := r.db.WithContext(ctx).
+		Preload("Exercise").
+		Where("id = ?", id).
+		First(&attempt).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrExerciseAttemptNotFound
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &attempt, nil
+}
+
+func (r *ExerciseAttemptRepository) Submit(ctx context.Context, attempt *models.ExerciseAttempt) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing models.ExerciseAttempt
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", attempt.ID).
+			First(&existing).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errConstant.ErrExerciseAttemptNotFound
+			}
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		if existing.Status != models.ExerciseAttemptStatusInProgress {
+			return errConstant.ErrExerciseAttemptAlreadySubmitted
+		}
+
+		err = tx.Model(&models.ExerciseAttempt{}).
+			Where("id = ?", attempt.ID).
+			Updates(map[string]interface{}{
+				"status":       attempt.Status,
+				"score":        attempt.Score,
+				"max_score":    attempt.MaxScore,
+				"results":      attempt.Results,
+				"submitted_at": attempt.SubmittedAt,
+			}).Error
+		if err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		return nil
+	})
+}
+
+func (r *ExerciseAttemptRepository) GetLessonScores(ctx context.Context, userID uuid.UUID, lessonIDs []uint) (map[uint]float64, error) {
+	scores := make(map[uint]float64, len(lessonIDs))
+	if len(lessonIDs) == 0 {
+		return scores, nil
+	}
+
+	best := r.db.
+		Model(&models.ExerciseAttempt{}).
+		Select("exercise_id, MAX(score * 100.0 / max_score) AS percentage").
+		Where("user_id = ? AND status = ? AND max_score > 0", userID, models.ExerciseAttemptStatusSubmitted).
+		Group("exercise_id")
+
+	var rows []struct {
+		LessonID uint
+		Score    float64
+	}
+	err := r.db.WithContext(ctx).
+		Table("exercises").
+		Select("exercises.lesson_id, AVG(COALESCE(best.percentage, 0)) AS score").
+		Joins("LEFT JOIN (?) AS best ON best.exercise_id = exercises.id", best).
+		Where("exercises.lesson_id IN ? AND exercises.is_published = ? AND exercises.deleted_at IS NULL", lessonIDs, true).
+		Group("exercises.lesson_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	for _, row := range rows {
+		scores[row.LessonID] = row.Score
+	}
+	return scores, nil
+}
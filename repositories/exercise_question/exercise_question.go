@@ -2,13 +2,14 @@ package repositories
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	errWrap "manabu-service/common/error"
+	"manabu-service/constants"
 	errConstant "manabu-service/constants/error"
 	"manabu-service/domain/dto"
 	"manabu-service/domain/models"
 	"strings"
-	"time"
 
 	"gorm.io/gorm"
 )
@@ -22,6 +23,10 @@ type IExerciseQuestionRepository interface {
 	// Create inserts a new exercise question entry into the database.
 	Create(context.Context, *dto.CreateExerciseQuestionRequest) (*models.ExerciseQuestion, error)
 
+	// CreateBatch inserts several exercise question entries in a single transaction.
+	// Either every question is inserted or none is.
+	CreateBatch(context.Context, []*dto.CreateExerciseQuestionRequest) ([]models.ExerciseQuestion, error)
+
 	// GetAll retrieves all exercise questions with optional filtering and pagination.
 	// Returns the list of exercise questions and total count.
 	GetAll(context.Context, *dto.ExerciseQuestionFilterRequest) ([]models.ExerciseQuestion, int64, error)
@@ -39,58 +44,164 @@ type IExerciseQuestionRepository interface {
 	// Update modifies an existing exercise question entry by ID.
 	Update(context.Context, *dto.UpdateExerciseQuestionRequest, uint) (*models.ExerciseQuestion, error)
 
-	// Delete removes an exercise question entry by ID.
-	Delete(context.Context, uint) error
+	// CountExamPool counts the published questions of a JLPT level and exam section
+	// that can be graded automatically.
+	CountExamPool(context.Context, uint, string) (int64, error)
+
+	// GetRandomFromExamPool draws up to limit random questions from the exam pool
+	// of a JLPT level and exam section.
+	GetRandomFromExamPool(context.Context, uint, string, int) ([]models.ExerciseQuestion, error)
+
+	// GetRandomForPlacement draws a random automatically gradable question of a JLPT level,
+	// skipping the given question IDs.
+	GetRandomForPlacement(context.Context, uint, []uint) (*models.ExerciseQuestion, error)
 
-	// Publish sets an exercise question as published with the current timestamp.
-	Publish(context.Context, uint) (*models.ExerciseQuestion, error)
+	// GetPublishedIDsByExerciseID returns the IDs of an exercise's published questions in stored order.
+	GetPublishedIDsByExerciseID(context.Context, uint) ([]uint, error)
 
-	// Unpublish sets an exercise question as unpublished.
-	Unpublish(context.Context, uint) (*models.ExerciseQuestion, error)
+	// GetByIDs retrieves the questions with the given IDs.
+	GetByIDs(context.Context, []uint) ([]models.ExerciseQuestion, error)
+
+	// Reorder sets the order_index of every question of an exercise to its position in the given IDs, starting at 1,
+	// in a single transaction. It fails with ErrInvalidQuestionOrder unless the IDs list each question exactly once.
+	Reorder(context.Context, uint, []uint) error
 }
 
 func NewExerciseQuestionRepository(db *gorm.DB) IExerciseQuestionRepository {
 	return &ExerciseQuestionRepository{db: db}
 }
 
-func (r *ExerciseQuestionRepository) Create(ctx context.Context, req *dto.CreateExerciseQuestionRequest) (*models.ExerciseQuestion, error) {
-	question := models.ExerciseQuestion{
-		ExerciseID:    req.ExerciseID,
-		QuestionText:  req.QuestionText,
-		QuestionType:  req.QuestionType,
-		Options:       req.Options,
-		CorrectAnswer: req.CorrectAnswer,
-		Explanation:   req.Explanation,
-		AudioURL:      req.AudioURL,
-		ImageURL:      req.ImageURL,
-		OrderIndex:    req.OrderIndex,
-		Points:        req.Points,
-		IsPublished:   false,
-	}
-
-	err := r.db.WithContext(ctx).Create(&question).Error
-	if err != nil {
-		// Check for unique constraint violation on order_index within exercise
-		if strings.Contains(err.Error(), "idx_question_exercise_order") ||
-			strings.Contains(err.Error(), "duplicate key") ||
-			strings.Contains(err.Error(), "UNIQUE constraint") {
-			return nil, errConstant.ErrDuplicateQuestionOrderIndex
+// encodeQuestionSchema serializes the typed options and correct answer for JSONB storage
+func encodeQuestionSchema(options *dto.QuestionOptions, answer *dto.QuestionAnswer) (*string, string, error) {
+	var encodedOptions *string
+	if options != nil {
+		optionsJSON, err := json.Marshal(options)
+		if err != nil {
+			return nil, "", err
 		}
-		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+		optionsStr := string(optionsJSON)
+		encodedOptions = &optionsStr
 	}
 
-	// Load relationships using Preload for efficiency
-	err = r.db.WithContext(ctx).
+	answerJSON, err := json.Marshal(answer)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return encodedOptions, string(answerJSON), nil
+}
+
+// toQuestionModel builds a new unpublished question from a create request
+func toQuestionModel(req *dto.CreateExerciseQuestionRequest) (*models.ExerciseQuestion, error) {
+	options, correctAnswer, err := encodeQuestionSchema(req.Options, req.CorrectAnswer)
+	if err != nil {
+		return nil, errConstant.ErrInvalidQuestionOptions
+	}
+
+	// Set default answer strictness if not provided
+	answerStrictness := req.AnswerStrictness
+	if answerStrictness == "" {
+		answerStrictness = constants.DefaultAnswerStrictness
+	}
+
+	return &models.ExerciseQuestion{
+		ExerciseID:       req.ExerciseID,
+		QuestionText:     req.QuestionText,
+		QuestionType:     req.QuestionType,
+		Options:          options,
+		CorrectAnswer:    correctAnswer,
+		AnswerStrictness: answerStrictness,
+		JlptSection:      req.JlptSection,
+		Explanation:      req.Explanation,
+		AudioURL:         req.AudioURL,
+		ImageURL:         req.ImageURL,
+		AudioMediaID:     req.AudioMediaID,
+		ImageMediaID:     req.ImageMediaID,
+		OrderIndex:       req.OrderIndex,
+		Points:           req.Points,
+		IsPublished:      false,
+	}, nil
+}
+
+// toCreateError maps an insert error, reporting unique constraint violations on order_index within exercise
+func toCreateError(err error) error {
+	if strings.Contains(err.Error(), "idx_question_exercise_order") ||
+		strings.Contains(err.Error(), "duplicate key") ||
+		strings.Contains(err.Error(), "UNIQUE constraint") {
+		return errConstant.ErrDuplicateQuestionOrderIndex
+	}
+	return errWrap.WrapError(errConstant.ErrSQLError)
+}
+
+// withRelations loads the exercise, lesson, course and JLPT level of questions
+func (r *ExerciseQuestionRepository) withRelations(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).
 		Preload("Exercise").
 		Preload("Exercise.Lesson").
 		Preload("Exercise.Lesson.Course").
-		Preload("Exercise.Lesson.Course.JlptLevel").
-		First(&question, question.ID).Error
+		Preload("Exercise.Lesson.Course.JlptLevel")
+}
+
+func (r *ExerciseQuestionRepository) Create(ctx context.Context, req *dto.CreateExerciseQuestionRequest) (*models.ExerciseQuestion, error) {
+	question, err := toQuestionModel(req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.db.WithContext(ctx).Create(question).Error
+	if err != nil {
+		return nil, toCreateError(err)
+	}
+
+	// Load relationships using Preload for efficiency
+	err = r.withRelations(ctx).First(question, question.ID).Error
 	if err != nil {
 		return nil, errWrap.WrapError(errConstant.ErrSQLError)
 	}
 
-	return &question, nil
+	return question, nil
+}
+
+func (r *ExerciseQuestionRepository) CreateBatch(ctx context.Context, reqs []*dto.CreateExerciseQuestionRequest) ([]models.ExerciseQuestion, error) {
+	questions := make([]models.ExerciseQuestion, 0, len(reqs))
+	for _, req := range reqs {
+		question, err := toQuestionModel(req)
+		if err != nil {
+			return nil, err
+		}
+		questions = append(questions, *question)
+	}
+	if len(questions) == 0 {
+		return questions, nil
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i := range questions {
+			if err := tx.Create(&questions[i]).Error; err != nil {
+				return toCreateError(err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Load relationships using Preload for efficiency
+	ids := make([]uint, 0, len(questions))
+	for _, question := range questions {
+		ids = append(ids, question.ID)
+	}
+	var created []models.ExerciseQuestion
+	err = r.withRelations(ctx).
+		Where("id IN ?", ids).
+		Order("order_index ASC, id ASC").
+		Find(&created).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return created, nil
 }
 
 func (r *ExerciseQuestionRepository) GetAll(ctx context.Context, filter *dto.ExerciseQuestionFilterRequest) ([]models.ExerciseQuestion, int64, error) {
@@ -108,6 +219,9 @@ func (r *ExerciseQuestionRepository) GetAll(ctx context.Context, filter *dto.Exe
 		if filter.QuestionType != "" {
 			query = query.Where("question_type = ?", filter.QuestionType)
 		}
+		if filter.JlptSection != "" {
+			query = query.Where("jlpt_section = ?", filter.JlptSection)
+		}
 		if filter.IsPublished != nil {
 			query = query.Where("is_published = ?", *filter.IsPublished)
 		}
@@ -229,21 +343,39 @@ func (r *ExerciseQuestionRepository) GetByExerciseID(ctx context.Context, exerci
 }
 
 func (r *ExerciseQuestionRepository) Update(ctx context.Context, req *dto.UpdateExerciseQuestionRequest, id uint) (*models.ExerciseQuestion, error) {
+	options, correctAnswer, err := encodeQuestionSchema(req.Options, req.CorrectAnswer)
+	if err != nil {
+		return nil, errConstant.ErrInvalidQuestionOptions
+	}
+
+	// Set default answer strictness if not provided
+	answerStrictness := req.AnswerStrictness
+	if answerStrictness == "" {
+		answerStrictness = constants.DefaultAnswerStrictness
+	}
+
 	question := models.ExerciseQuestion{
-		ExerciseID:    req.ExerciseID,
-		QuestionText:  req.QuestionText,
-		QuestionType:  req.QuestionType,
-		Options:       req.Options,
-		CorrectAnswer: req.CorrectAnswer,
-		Explanation:   req.Explanation,
-		AudioURL:      req.AudioURL,
-		ImageURL:      req.ImageURL,
-		OrderIndex:    req.OrderIndex,
-		Points:        req.Points,
+		ExerciseID:       req.ExerciseID,
+		QuestionText:     req.QuestionText,
+		QuestionType:     req.QuestionType,
+		Options:          options,
+		CorrectAnswer:    correctAnswer,
+		AnswerStrictness: answerStrictness,
+		JlptSection:      req.JlptSection,
+		Explanation:      req.Explanation,
+		AudioURL:         req.AudioURL,
+		ImageURL:         req.ImageURL,
+		AudioMediaID:     req.AudioMediaID,
+		ImageMediaID:     req.ImageMediaID,
+		OrderIndex:       req.OrderIndex,
+		Points:           req.Points,
 	}
 
+	// Select the editable columns so cleared options and optional fields are persisted
 	result := r.db.WithContext(ctx).
 		Where("id = ?", id).
+		Select("exercise_id", "question_text", "question_type", "options", "correct_answer", "answer_strictness", "jlpt_section",
+			"explanation", "audio_url", "image_url", "order_index", "points").
 		Updates(&question)
 
 	if result.Error != nil {
@@ -262,7 +394,7 @@ func (r *ExerciseQuestionRepository) Update(ctx context.Context, req *dto.Update
 	}
 
 	// Fetch the updated record with preloaded relationships
-	err := r.db.WithContext(ctx).
+	err = r.db.WithContext(ctx).
 		Preload("Exercise").
 		Preload("Exercise.Lesson").
 		Preload("Exercise.Lesson.Course").
@@ -276,88 +408,119 @@ func (r *ExerciseQuestionRepository) Update(ctx context.Context, req *dto.Update
 	return &question, nil
 }
 
-func (r *ExerciseQuestionRepository) Delete(ctx context.Context, id uint) error {
-	result := r.db.WithContext(ctx).
-		Where("id = ?", id).
-		Delete(&models.ExerciseQuestion{})
+// levelPoolQuery selects the published, automatically gradable questions of courses at a JLPT level
+func (r *ExerciseQuestionRepository) levelPoolQuery(ctx context.Context, jlptLevelID uint) *gorm.DB {
+	return r.db.WithContext(ctx).
+		Model(&models.ExerciseQuestion{}).
+		Joins("JOIN exercises ON exercises.id = exercise_questions.exercise_id").
+		Joins("JOIN lessons ON lessons.id = exercises.lesson_id").
+		Joins("JOIN courses ON courses.id = lessons.course_id").
+		Where("courses.jlpt_level_id = ?", jlptLevelID).
+		Where("exercise_questions.is_published = ?", true).
+		Where("exercise_questions.question_type <> ?", "speaking")
+}
 
-	if result.Error != nil {
-		return errWrap.WrapError(errConstant.ErrSQLError)
-	}
+// examPoolQuery narrows the level pool to the questions assigned to an exam section
+func (r *ExerciseQuestionRepository) examPoolQuery(ctx context.Context, jlptLevelID uint, section string) *gorm.DB {
+	return r.levelPoolQuery(ctx, jlptLevelID).
+		Where("exercise_questions.jlpt_section = ?", section)
+}
 
-	// Check if any rows were affected
-	if result.RowsAffected == 0 {
-		return errConstant.ErrExerciseQuestionNotFound
+func (r *ExerciseQuestionRepository) CountExamPool(ctx context.Context, jlptLevelID uint, section string) (int64, error) {
+	var total int64
+	err := r.examPoolQuery(ctx, jlptLevelID, section).Count(&total).Error
+	if err != nil {
+		return 0, errWrap.WrapError(errConstant.ErrSQLError)
 	}
-
-	return nil
+	return total, nil
 }
 
-func (r *ExerciseQuestionRepository) Publish(ctx context.Context, id uint) (*models.ExerciseQuestion, error) {
-	now := time.Now()
-	result := r.db.WithContext(ctx).
-		Model(&models.ExerciseQuestion{}).
-		Where("id = ?", id).
-		Updates(map[string]interface{}{
-			"is_published": true,
-			"published_at": now,
-		})
-
-	if result.Error != nil {
+func (r *ExerciseQuestionRepository) GetRandomFromExamPool(ctx context.Context, jlptLevelID uint, section string, limit int) ([]models.ExerciseQuestion, error) {
+	var questions []models.ExerciseQuestion
+	err := r.examPoolQuery(ctx, jlptLevelID, section).
+		Select("exercise_questions.*").
+		Order("RANDOM()").
+		Limit(limit).
+		Find(&questions).Error
+	if err != nil {
 		return nil, errWrap.WrapError(errConstant.ErrSQLError)
 	}
+	return questions, nil
+}
 
-	// Check if any rows were affected
-	if result.RowsAffected == 0 {
-		return nil, errConstant.ErrExerciseQuestionNotFound
+func (r *ExerciseQuestionRepository) GetRandomForPlacement(ctx context.Context, jlptLevelID uint, excludeIDs []uint) (*models.ExerciseQuestion, error) {
+	var question models.ExerciseQuestion
+	query := r.levelPoolQuery(ctx, jlptLevelID)
+	if len(excludeIDs) > 0 {
+		query = query.Where("exercise_questions.id NOT IN ?", excludeIDs)
 	}
 
-	// Fetch the updated record with preloaded relationships
-	var question models.ExerciseQuestion
-	err := r.db.WithContext(ctx).
-		Preload("Exercise").
-		Preload("Exercise.Lesson").
-		Preload("Exercise.Lesson.Course").
-		Preload("Exercise.Lesson.Course.JlptLevel").
-		Where("id = ?", id).
+	err := query.Select("exercise_questions.*").
+		Order("RANDOM()").
 		First(&question).Error
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrExerciseQuestionNotFound
+		}
 		return nil, errWrap.WrapError(errConstant.ErrSQLError)
 	}
-
 	return &question, nil
 }
 
-func (r *ExerciseQuestionRepository) Unpublish(ctx context.Context, id uint) (*models.ExerciseQuestion, error) {
-	result := r.db.WithContext(ctx).
+func (r *ExerciseQuestionRepository) GetPublishedIDsByExerciseID(ctx context.Context, exerciseID uint) ([]uint, error) {
+	var ids []uint
+	err := r.db.WithContext(ctx).
 		Model(&models.ExerciseQuestion{}).
-		Where("id = ?", id).
-		Updates(map[string]interface{}{
-			"is_published": false,
-			"published_at": nil,
-		})
-
-	if result.Error != nil {
+		Where("exercise_id = ? AND is_published = ?", exerciseID, true).
+		Order("order_index ASC, id ASC").
+		Pluck("id", &ids).Error
+	if err != nil {
 		return nil, errWrap.WrapError(errConstant.ErrSQLError)
 	}
+	return ids, nil
+}
 
-	// Check if any rows were affected
-	if result.RowsAffected == 0 {
-		return nil, errConstant.ErrExerciseQuestionNotFound
+func (r *ExerciseQuestionRepository) GetByIDs(ctx context.Context, ids []uint) ([]models.ExerciseQuestion, error) {
+	var questions []models.ExerciseQuestion
+	if len(ids) == 0 {
+		return questions, nil
 	}
 
-	// Fetch the updated record with preloaded relationships
-	var question models.ExerciseQuestion
 	err := r.db.WithContext(ctx).
-		Preload("Exercise").
-		Preload("Exercise.Lesson").
-		Preload("Exercise.Lesson.Course").
-		Preload("Exercise.Lesson.Course.JlptLevel").
-		Where("id = ?", id).
-		First(&question).Error
+		Where("id IN ?", ids).
+		Find(&questions).Error
 	if err != nil {
 		return nil, errWrap.WrapError(errConstant.ErrSQLError)
 	}
+	return questions, nil
+}
 
-	return &question, nil
+func (r *ExerciseQuestionRepository) Reorder(ctx context.Context, exerciseID uint, ids []uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// The unique order index is checked row by row and cannot be deferred, so every question
+		// first moves to a free negative order_index and then takes its new position
+		result := tx.Model(&models.ExerciseQuestion{}).
+			Where("exercise_id = ?", exerciseID).
+			Update("order_index", gorm.Expr("-order_index - 1"))
+		if result.Error != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		if result.RowsAffected != int64(len(ids)) {
+			return errConstant.ErrInvalidQuestionOrder
+		}
+
+		for position, id := range ids {
+			result = tx.Model(&models.ExerciseQuestion{}).
+				Where("id = ? AND exercise_id = ?", id, exerciseID).
+				Update("order_index", position+1)
+			if result.Error != nil {
+				return errWrap.WrapError(errConstant.ErrSQLError)
+			}
+			if result.RowsAffected == 0 {
+				return errConstant.ErrInvalidQuestionOrder
+			}
+		}
+
+		return nil
+	})
 }
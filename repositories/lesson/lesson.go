@@ -2,13 +2,13 @@ package repositories
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	errWrap "manabu-service/common/error"
 	errConstant "manabu-service/constants/error"
 	"manabu-service/domain/dto"
 	"manabu-service/domain/models"
 	"strings"
-	"time"
 
 	"gorm.io/gorm"
 )
@@ -39,31 +39,50 @@ type ILessonRepository interface {
 	// Update modifies an existing lesson entry by ID.
 	Update(context.Context, *dto.UpdateLessonRequest, uint) (*models.Lesson, error)
 
-	// Delete removes a lesson entry by ID.
-	Delete(context.Context, uint) error
-
-	// Publish sets a lesson as published with the current timestamp.
-	Publish(context.Context, uint) (*models.Lesson, error)
-
-	// Unpublish sets a lesson as unpublished.
-	Unpublish(context.Context, uint) (*models.Lesson, error)
+	// Reorder sets the order_index of every lesson of a course to its position in the given IDs, starting at 1,
+	// in a single transaction. It fails with ErrInvalidLessonOrder unless the IDs list each lesson exactly once.
+	Reorder(context.Context, uint, []uint) error
 }
 
 func NewLessonRepository(db *gorm.DB) ILessonRepository {
 	return &LessonRepository{db: db}
 }
 
+// encodeLessonBlocks serializes the content blocks of a lesson for JSONB storage, returning nil for no blocks
+func encodeLessonBlocks(blocks []dto.LessonBlock) (*string, error) {
+	if len(blocks) == 0 {
+		return nil, nil
+	}
+	blocksJSON, err := json.Marshal(blocks)
+	if err != nil {
+		return nil, err
+	}
+	encoded := string(blocksJSON)
+	return &encoded, nil
+}
+
 func (r *LessonRepository) Create(ctx context.Context, req *dto.CreateLessonRequest) (*models.Lesson, error) {
+	blocks, err := encodeLessonBlocks(req.Blocks)
+	if err != nil {
+		return nil, errConstant.ErrInvalidLessonBlock
+	}
+
 	lesson := models.Lesson{
 		CourseID:         req.CourseID,
 		Title:            req.Title,
 		Content:          req.Content,
+		Blocks:           blocks,
 		OrderIndex:       req.OrderIndex,
 		EstimatedMinutes: req.EstimatedMinutes,
+		UnlockRule:       req.UnlockRule,
+		UnlockMinScore:   req.UnlockMinScore,
 		IsPublished:      false,
 	}
+	if lesson.UnlockRule == "" {
+		lesson.UnlockRule = models.LessonUnlockRuleNone
+	}
 
-	err := r.db.WithContext(ctx).Create(&lesson).Error
+	err = r.db.WithContext(ctx).Create(&lesson).Error
 	if err != nil {
 		// Check for unique constraint violation on order_index within course
 		if strings.Contains(err.Error(), "idx_lesson_course_order") ||
@@ -212,6 +231,11 @@ func (r *LessonRepository) GetByCourseID(ctx context.Context, courseID uint) ([]
 }
 
 func (r *LessonRepository) Update(ctx context.Context, req *dto.UpdateLessonRequest, id uint) (*models.Lesson, error) {
+	blocks, err := encodeLessonBlocks(req.Blocks)
+	if err != nil {
+		return nil, errConstant.ErrInvalidLessonBlock
+	}
+
 	lesson := models.Lesson{
 		CourseID:         req.CourseID,
 		Title:            req.Title,
@@ -239,58 +263,25 @@ func (r *LessonRepository) Update(ctx context.Context, req *dto.UpdateLessonRequ
 		return nil, errConstant.ErrLessonNotFound
 	}
 
-	// Fetch the updated record with preloaded relationships
-	err := r.db.WithContext(ctx).
-		Preload("Course").
-		Preload("Course.JlptLevel").
-		Where("id = ?", id).
-		First(&lesson).Error
-	if err != nil {
-		return nil, errWrap.WrapError(errConstant.ErrSQLError)
-	}
-
-	return &lesson, nil
-}
-
-func (r *LessonRepository) Delete(ctx context.Context, id uint) error {
-	result := r.db.WithContext(ctx).
-		Where("id = ?", id).
-		Delete(&models.Lesson{})
-
-	if result.Error != nil {
-		return errWrap.WrapError(errConstant.ErrSQLError)
-	}
-
-	// Check if any rows were affected
-	if result.RowsAffected == 0 {
-		return errConstant.ErrLessonNotFound
+	// Unlock rules and blocks are always written so they can be turned off or removed again
+	unlockRule := req.UnlockRule
+	if unlockRule == "" {
+		unlockRule = models.LessonUnlockRuleNone
 	}
-
-	return nil
-}
-
-func (r *LessonRepository) Publish(ctx context.Context, id uint) (*models.Lesson, error) {
-	now := time.Now()
-	result := r.db.WithContext(ctx).
+	err = r.db.WithContext(ctx).
 		Model(&models.Lesson{}).
 		Where("id = ?", id).
 		Updates(map[string]interface{}{
-			"is_published": true,
-			"published_at": now,
-		})
-
-	if result.Error != nil {
+			"unlock_rule":      unlockRule,
+			"unlock_min_score": req.UnlockMinScore,
+			"blocks":           blocks,
+		}).Error
+	if err != nil {
 		return nil, errWrap.WrapError(errConstant.ErrSQLError)
 	}
 
-	// Check if any rows were affected
-	if result.RowsAffected == 0 {
-		return nil, errConstant.ErrLessonNotFound
-	}
-
 	// Fetch the updated record with preloaded relationships
-	var lesson models.Lesson
-	err := r.db.WithContext(ctx).
+	err = r.db.WithContext(ctx).
 		Preload("Course").
 		Preload("Course.JlptLevel").
 		Where("id = ?", id).
@@ -302,34 +293,32 @@ func (r *LessonRepository) Publish(ctx context.Context, id uint) (*models.Lesson
 	return &lesson, nil
 }
 
-func (r *LessonRepository) Unpublish(ctx context.Context, id uint) (*models.Lesson, error) {
-	result := r.db.WithContext(ctx).
-		Model(&models.Lesson{}).
-		Where("id = ?", id).
-		Updates(map[string]interface{}{
-			"is_published": false,
-			"published_at": nil,
-		})
-
-	if result.Error != nil {
-		return nil, errWrap.WrapError(errConstant.ErrSQLError)
-	}
-
-	// Check if any rows were affected
-	if result.RowsAffected == 0 {
-		return nil, errConstant.ErrLessonNotFound
-	}
+func (r *LessonRepository) Reorder(ctx context.Context, courseID uint, ids []uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// The unique order index is checked row by row and cannot be deferred, so every lesson
+		// first moves to a free negative order_index and then takes its new position
+		result := tx.Model(&models.Lesson{}).
+			Where("course_id = ?", courseID).
+			Update("order_index", gorm.Expr("-order_index - 1"))
+		if result.Error != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		if result.RowsAffected != int64(len(ids)) {
+			return errConstant.ErrInvalidLessonOrder
+		}
 
-	// Fetch the updated record with preloaded relationships
-	var lesson models.Lesson
-	err := r.db.WithContext(ctx).
-		Preload("Course").
-		Preload("Course.JlptLevel").
-		Where("id = ?", id).
-		First(&lesson).Error
-	if err != nil {
-		return nil, errWrap.WrapError(errConstant.ErrSQLError)
-	}
+		for position, id := range ids {
+			result = tx.Model(&models.Lesson{}).
+				Where("id = ? AND course_id = ?", id, courseID).
+				Update("order_index", position+1)
+			if result.Error != nil {
+				return errWrap.WrapError(errConstant.ErrSQLError)
+			}
+			if result.RowsAffected == 0 {
+				return errConstant.ErrInvalidLessonOrder
+			}
+		}
 
-	return &lesson, nil
+		return nil
+	})
 }
@@ -0,0 +1,105 @@
+package repositories
+
+import (
+	"context"
+	errWrap "manabu-service/common/error"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type LessonVocabularyRepository struct {
+	db *gorm.DB
+}
+
+// ILessonVocabularyRepository defines the contract for lesson vocabulary data access operations.
+type ILessonVocabularyRepository interface {
+	// GetByLessonID retrieves the vocabulary of a lesson with the vocabulary words, in lesson order.
+	GetByLessonID(context.Context, uint) ([]models.LessonVocabulary, error)
+
+	// GetVocabularyIDsByLessonIDs retrieves the IDs of the vocabulary attached to any of the given lessons.
+	GetVocabularyIDsByLessonIDs(context.Context, []uint) ([]uint, error)
+
+	// CountVocabularies counts how many of the given vocabulary IDs exist.
+	CountVocabularies(context.Context, []uint) (int64, error)
+
+	// Replace sets the vocabulary of a lesson to the given vocabulary IDs, ordered by position.
+	Replace(context.Context, uint, []uint) error
+}
+
+func NewLessonVocabularyRepository(db *gorm.DB) ILessonVocabularyRepository {
+	return &LessonVocabularyRepository{db: db}
+}
+
+func (r *LessonVocabularyRepository) GetByLessonID(ctx context.Context, lessonID uint) ([]models.LessonVocabulary, error) {
+	var vocabularies []models.LessonVocabulary
+	err := r.db.WithContext(ctx).
+		Preload("Vocabulary").
+		Where("lesson_id = ?", lessonID).
+		Order("order_index ASC, id ASC").
+		Find(&vocabularies).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return vocabularies, nil
+}
+
+func (r *LessonVocabularyRepository) GetVocabularyIDsByLessonIDs(ctx context.Context, lessonIDs []uint) ([]uint, error) {
+	var vocabularyIDs []uint
+	if len(lessonIDs) == 0 {
+		return vocabularyIDs, nil
+	}
+
+	err := r.db.WithContext(ctx).
+		Model(&models.LessonVocabulary{}).
+		Where("lesson_id IN ?", lessonIDs).
+		Distinct().
+		Pluck("vocabulary_id", &vocabularyIDs).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return vocabularyIDs, nil
+}
+
+func (r *LessonVocabularyRepository) CountVocabularies(ctx context.Context, vocabularyIDs []uint) (int64, error) {
+	var count int64
+	if len(vocabularyIDs) == 0 {
+		return count, nil
+	}
+
+	err := r.db.WithContext(ctx).
+		Model(&models.Vocabulary{}).
+		Where("id IN ?", vocabularyIDs).
+		Count(&count).Error
+	if err != nil {
+		return 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return count, nil
+}
+
+func (r *LessonVocabularyRepository) Replace(ctx context.Context, lessonID uint, vocabularyIDs []uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("lesson_id = ?", lessonID).Delete(&models.LessonVocabulary{}).Error
+		if err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		if len(vocabularyIDs) == 0 {
+			return nil
+		}
+
+		vocabularies := make([]models.LessonVocabulary, 0, len(vocabularyIDs))
+		for i, vocabularyID := range vocabularyIDs {
+			vocabularies = append(vocabularies, models.LessonVocabulary{
+				LessonID:     lessonID,
+				VocabularyID: vocabularyID,
+				OrderIndex:   i + 1,
+			})
+		}
+		if err := tx.Omit(clause.Associations).Create(&vocabularies).Error; err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,53 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	errWrap "manabu-service/common/error"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type MediaRepository struct {
+	db *gorm.DB
+}
+
+// IMediaRepository defines the contract for media record data access operations.
+type IMediaRepository interface {
+	// Create inserts a new media record.
+	Create(context.Context, *models.Media) (*models.Media, error)
+
+	// GetByID retrieves a media record by its ID.
+	GetByID(context.Context, uuid.UUID) (*models.Media, error)
+}
+
+func NewMediaRepository(db *gorm.DB) IMediaRepository {
+	return &MediaRepository{db: db}
+}
+
+func (r *MediaRepository) Create(ctx context.Context, media *models.Media) (*models.Media, error) {
+	err := r.db.WithContext(ctx).Create(media).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return media, nil
+}
+
+func (r *MediaRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Media, error) {
+	var media models.Media
+	err := r.db.WithContext(ctx).
+		Where("id = ?", id).
+		First(&media).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrMediaNotFound
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return &media, nil
+}
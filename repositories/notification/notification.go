@@ -0,0 +1,117 @@
+package repositories
+
+import (
+	"context"
+	errWrap "manabu-service/common/error"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type NotificationRepository struct {
+	db *gorm.DB
+}
+
+// INotificationRepository defines the contract for notification data access operations.
+type INotificationRepository interface {
+	// Create inserts a new notification.
+	Create(context.Context, *models.Notification) error
+
+	// GetAll retrieves a user's notifications with optional filtering and pagination, newest first.
+	GetAll(context.Context, string, *dto.NotificationFilterRequest) ([]models.Notification, int64, error)
+
+	// MarkAsRead marks a notification of a user as read and returns it.
+	MarkAsRead(context.Context, string, uint) (*models.Notification, error)
+
+	// MarkAllAsRead marks every unread notification of a user as read.
+	MarkAllAsRead(context.Context, string) error
+}
+
+func NewNotificationRepository(db *gorm.DB) INotificationRepository {
+	return &NotificationRepository{db: db}
+}
+
+func (r *NotificationRepository) Create(ctx context.Context, notification *models.Notification) error {
+	err := r.db.WithContext(ctx).Create(notification).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+func (r *NotificationRepository) GetAll(ctx context.Context, userID string, filter *dto.NotificationFilterRequest) ([]models.Notification, int64, error) {
+	var notifications []models.Notification
+	var total int64
+
+	// Build base query with user filter
+	query := r.db.WithContext(ctx).Model(&models.Notification{}).Where("user_id = ?::uuid", userID)
+
+	// Apply filters
+	if filter != nil && filter.Unread != nil {
+		if *filter.Unread {
+			query = query.Where("read_at IS NULL")
+		} else {
+			query = query.Where("read_at IS NOT NULL")
+		}
+	}
+
+	// Count total records with filters applied
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	query = query.Order("created_at DESC")
+
+	// Apply pagination
+	if filter != nil && filter.Limit > 0 {
+		page := filter.Page
+		if page < 1 {
+			page = 1
+		}
+		query = query.Limit(filter.Limit).Offset((page - 1) * filter.Limit)
+	}
+
+	err := query.Find(&notifications).Error
+	if err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return notifications, total, nil
+}
+
+func (r *NotificationRepository) MarkAsRead(ctx context.Context, userID string, id uint) (*models.Notification, error) {
+	// Keep the first read time when a notification is read again
+	result := r.db.WithContext(ctx).
+		Model(&models.Notification{}).
+		Where("id = ? AND user_id = ?::uuid", id, userID).
+		Update("read_at", gorm.Expr("COALESCE(read_at, ?)", time.Now()))
+	if result.Error != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	if result.RowsAffected == 0 {
+		return nil, errConstant.ErrNotificationNotFound
+	}
+
+	var notification models.Notification
+	err := r.db.WithContext(ctx).
+		Where("id = ?", id).
+		First(&notification).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &notification, nil
+}
+
+func (r *NotificationRepository) MarkAllAsRead(ctx context.Context, userID string) error {
+	err := r.db.WithContext(ctx).
+		Model(&models.Notification{}).
+		Where("user_id = ?::uuid AND read_at IS NULL", userID).
+		Update("read_at", time.Now()).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	errWrap "manabu-service/common/error"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/models"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PlacementRepository struct {
+	db *gorm.DB
+}
+
+// IPlacementRepository defines the contract for placement test data access operations.
+type IPlacementRepository interface {
+	// Create inserts a new placement test.
+	Create(context.Context, *models.PlacementTest) (*models.PlacementTest, error)
+
+	// GetByID retrieves a placement test with its estimated level and served questions.
+	GetByID(context.Context, uuid.UUID) (*models.PlacementTest, error)
+
+	// GetInProgressByUser retrieves the unfinished placement test of a user.
+	GetInProgressByUser(context.Context, string) (*models.PlacementTest, error)
+
+	// AddQuestion serves the next question of an in-progress placement test.
+	AddQuestion(context.Context, *models.PlacementTestAnswer) error
+
+	// SaveAnswer grades the current (unanswered) question of an in-progress placement test.
+	SaveAnswer(context.Context, uuid.UUID, uint, string, bool) error
+
+	// Complete closes a placement test and stores its estimated level on the user profile.
+	Complete(context.Context, *models.PlacementTest) error
+}
+
+func NewPlacementRepository(db *gorm.DB) IPlacementRepository {
+	return &PlacementRepository{db: db}
+}
+
+func (r *PlacementRepository) Create(ctx context.Context, test *models.PlacementTest) (*models.PlacementTest, error) {
+	err := r.db.WithContext(ctx).Create(test).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return r.GetByID(ctx, test.ID)
+}
+
+func (r *PlacementRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.PlacementTest, error) {
+	var test models.PlacementTest
+	err := r.db.WithContext(ctx).
+		Preload("EstimatedJlptLevel").
+		Preload("Answers", func(db *gorm.DB) *gorm.DB {
+			return db.Order("order_index ASC")
+		}).
+		Preload("Answers.Question").
+		Where("id = ?", id).
+		First(&test).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrPlacementTestNotFound
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &test, nil
+}
+
+func (r *PlacementRepository) GetInProgressByUser(ctx context.Context, userID string) (*models.PlacementTest, error) {
+	var test models.PlacementTest
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?::uuid AND status = ?", userID, models.PlacementTestStatusInProgress).
+		Order("started_at DESC").
+		First(&test).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrPlacementTestNotFound
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &test, nil
+}
+
+// lockInProgress locks a placement test row and checks that it can still be changed
+func lockInProgress(tx *gorm.DB, id uuid.UUID) error {
+	var test models.PlacementTest
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&test).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errConstant.ErrPlacementTestNotFound
+		}
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	if test.Status != models.PlacementTestStatusInProgress {
+		return errConstant.ErrPlacementTestCompleted
+	}
+	return nil
+}
+
+func (r *PlacementRepository) AddQuestion(ctx context.Context, question *models.PlacementTestAnswer) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockInProgress(tx, question.PlacementTestID); err != nil {
+			return err
+		}
+
+		// Only one question is served at a time
+		var pending int64
+		err := tx.Model(&models.PlacementTestAnswer{}).
+			Where("placement_test_id = ? AND answered_at IS NULL", question.PlacementTestID).
+			Count(&pending).Error
+		if err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		if pending > 0 {
+			return nil
+		}
+
+		if err := tx.Create(question).Error; err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		return nil
+	})
+}
+
+func (r *PlacementRepository) SaveAnswer(ctx context.Context, id uuid.UUID, questionID uint, answer string, isCorrect bool) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockInProgress(tx, id); err != nil {
+			return err
+		}
+
+		result := tx.Model(&models.PlacementTestAnswer{}).
+			Where("placement_test_id = ? AND question_id = ? AND answered_at IS NULL", id, questionID).
+			Updates(map[string]interface{}{
+				"answer":      answer,
+				"is_correct":  isCorrect,
+				"answered_at": time.Now(),
+			})
+		if result.Error != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		if result.RowsAffected == 0 {
+			return errConstant.ErrPlacementQuestionMismatch
+		}
+		return nil
+	})
+}
+
+func (r *PlacementRepository) Complete(ctx context.Context, test *models.PlacementTest) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockInProgress(tx, test.ID); err != nil {
+			return err
+		}
+
+		// Drop a question served but never answered before the test stopped
+		err := tx.Where("placement_test_id = ? AND answered_at IS NULL", test.ID).
+			Delete(&models.PlacementTestAnswer{}).Error
+		if err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+
+		err = tx.Model(&models.PlacementTest{}).
+			Where("id = ?", test.ID).
+			Updates(map[string]interface{}{
+				"status":                  models.PlacementTestStatusCompleted,
+				"completed_at":            test.CompletedAt,
+				"estimated_jlpt_level_id": test.EstimatedJlptLevelID,
+			}).Error
+		if err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+
+		// Store the result on the user profile
+		err = tx.Model(&models.User{}).
+			Where("uuid = ?", test.UserID).
+			Updates(map[string]interface{}{
+				"jlpt_level_id": test.EstimatedJlptLevelID,
+				"placed_at":     test.CompletedAt,
+			}).Error
+		if err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		return nil
+	})
+}
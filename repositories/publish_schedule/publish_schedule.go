@@ -0,0 +1,150 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	errWrap "manabu-service/common/error"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/models"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// schedulerLockKey identifies the Postgres advisory lock held by the instance running the scheduler
+const schedulerLockKey int64 = 48721037
+
+type PublishScheduleRepository struct {
+	db *gorm.DB
+}
+
+// IPublishScheduleRepository defines the contract for publish schedule data access operations.
+type IPublishScheduleRepository interface {
+	// GetByContent retrieves the schedule of a content item.
+	GetByContent(context.Context, string, uint) (*models.PublishSchedule, error)
+
+	// Upsert creates the schedule of a content item or replaces the existing one.
+	Upsert(context.Context, *models.PublishSchedule) (*models.PublishSchedule, error)
+
+	// Delete removes the schedule of a content item.
+	Delete(context.Context, string, uint) error
+
+	// GetDue retrieves schedules with a pending publish or unpublish time at or before the given time.
+	GetDue(context.Context, time.Time) ([]models.PublishSchedule, error)
+
+	// MarkProcessed stores the outcome of a pending publish ("publish") or unpublish ("unpublish").
+	// It does nothing if that part of the schedule is no longer pending.
+	MarkProcessed(context.Context, uint, string, string, string) error
+
+	// WithSchedulerLock runs fn while holding the scheduler advisory lock, so only one
+	// instance applies schedules at a time. It reports false without running fn if
+	// another instance holds the lock.
+	WithSchedulerLock(context.Context, func(context.Context) error) (bool, error)
+}
+
+func NewPublishScheduleRepository(db *gorm.DB) IPublishScheduleRepository {
+	return &PublishScheduleRepository{db: db}
+}
+
+func (r *PublishScheduleRepository) GetByContent(ctx context.Context, contentType string, contentID uint) (*models.PublishSchedule, error) {
+	var schedule models.PublishSchedule
+	err := r.db.WithContext(ctx).
+		Where("content_type = ? AND content_id = ?", contentType, contentID).
+		First(&schedule).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrPublishScheduleNotFound
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return &schedule, nil
+}
+
+func (r *PublishScheduleRepository) Upsert(ctx context.Context, schedule *models.PublishSchedule) (*models.PublishSchedule, error) {
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "content_type"}, {Name: "content_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"publish_at", "publish_status", "cascade", "unpublish_at", "unpublish_status",
+				"last_error", "scheduled_by", "updated_at",
+			}),
+		}).
+		Create(schedule).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return r.GetByContent(ctx, schedule.ContentType, schedule.ContentID)
+}
+
+func (r *PublishScheduleRepository) Delete(ctx context.Context, contentType string, contentID uint) error {
+	result := r.db.WithContext(ctx).
+		Where("content_type = ? AND content_id = ?", contentType, contentID).
+		Delete(&models.PublishSchedule{})
+	if result.Error != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	if result.RowsAffected == 0 {
+		return errConstant.ErrPublishScheduleNotFound
+	}
+
+	return nil
+}
+
+func (r *PublishScheduleRepository) GetDue(ctx context.Context, now time.Time) ([]models.PublishSchedule, error) {
+	var schedules []models.PublishSchedule
+	err := r.db.WithContext(ctx).
+		Where("(publish_status = ? AND publish_at <= ?) OR (unpublish_status = ? AND unpublish_at <= ?)",
+			models.PublishScheduleStatusPending, now, models.PublishScheduleStatusPending, now).
+		Order("id ASC").
+		Find(&schedules).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return schedules, nil
+}
+
+func (r *PublishScheduleRepository) MarkProcessed(ctx context.Context, id uint, part string, status string, lastError string) error {
+	statusColumn := part + "_status"
+	err := r.db.WithContext(ctx).
+		Model(&models.PublishSchedule{}).
+		Where("id = ? AND "+statusColumn+" = ?", id, models.PublishScheduleStatusPending).
+		Updates(map[string]interface{}{
+			statusColumn: status,
+			"last_error": lastError,
+		}).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return nil
+}
+
+func (r *PublishScheduleRepository) WithSchedulerLock(ctx context.Context, fn func(context.Context) error) (bool, error) {
+	sqlDB, err := r.db.DB()
+	if err != nil {
+		return false, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	// Session-level advisory locks belong to a connection, so hold one for the whole run
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return false, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	defer conn.Close()
+
+	var locked bool
+	err = conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", schedulerLockKey).Scan(&locked)
+	if err != nil {
+		return false, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	if !locked {
+		return false, nil
+	}
+	defer conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", schedulerLockKey)
+
+	return true, fn(ctx)
+}
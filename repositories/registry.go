@@ -1,17 +1,38 @@
 package repositories
 
 import (
+	"context"
+	bookmarkRepo "manabu-service/repositories/bookmark"
 	categoryRepo "manabu-service/repositories/category"
+	contentRevisionRepo "manabu-service/repositories/content_revision"
+	contentWorkflowRepo "manabu-service/repositories/content_workflow"
 	courseRepo "manabu-service/repositories/course"
+	courseBundleRepo "manabu-service/repositories/course_bundle"
+	coursePrerequisiteRepo "manabu-service/repositories/course_prerequisite"
+	deckRepo "manabu-service/repositories/deck"
+	deckCardRepo "manabu-service/repositories/deck_card"
+	examRepo "manabu-service/repositories/exam"
+	examAttemptRepo "manabu-service/repositories/exam_attempt"
+	exampleSentenceRepo "manabu-service/repositories/example_sentence"
 	exerciseRepo "manabu-service/repositories/exercise"
+	exerciseAttemptRepo "manabu-service/repositories/exercise_attempt"
 	exerciseQuestionRepo "manabu-service/repositories/exercise_question"
 	jlptLevelRepo "manabu-service/repositories/jlpt_level"
 	lessonRepo "manabu-service/repositories/lesson"
+	lessonVocabularyRepo "manabu-service/repositories/lesson_vocabulary"
+	mediaRepo "manabu-service/repositories/media"
+	notificationRepo "manabu-service/repositories/notification"
+	placementRepo "manabu-service/repositories/placement"
+	publishScheduleRepo "manabu-service/repositories/publish_schedule"
+	speakingSubmissionRepo "manabu-service/repositories/speaking_submission"
 	tagRepo "manabu-service/repositories/tag"
+	translationRepo "manabu-service/repositories/translation"
+	trashRepo "manabu-service/repositories/trash"
 	repositories "manabu-service/repositories/user"
 	userCourseProgressRepo "manabu-service/repositories/user_course_progress"
 	userVocabStatusRepo "manabu-service/repositories/user_vocabulary_status"
 	vocabularyRepo "manabu-service/repositories/vocabulary"
+	vocabularyNoteRepo "manabu-service/repositories/vocabulary_note"
 
 	"gorm.io/gorm"
 )
@@ -32,6 +53,30 @@ type IRepositoryRegistry interface {
 	GetExercise() exerciseRepo.IExerciseRepository
 	GetExerciseQuestion() exerciseQuestionRepo.IExerciseQuestionRepository
 	GetUserCourseProgress() userCourseProgressRepo.IUserCourseProgressRepository
+	GetExampleSentence() exampleSentenceRepo.IExampleSentenceRepository
+	GetTranslation() translationRepo.ITranslationRepository
+	GetExam() examRepo.IExamRepository
+	GetExamAttempt() examAttemptRepo.IExamAttemptRepository
+	GetPlacement() placementRepo.IPlacementRepository
+	GetExerciseAttempt() exerciseAttemptRepo.IExerciseAttemptRepository
+	GetSpeakingSubmission() speakingSubmissionRepo.ISpeakingSubmissionRepository
+	GetNotification() notificationRepo.INotificationRepository
+	GetMedia() mediaRepo.IMediaRepository
+	GetContentWorkflow() contentWorkflowRepo.IContentWorkflowRepository
+	GetPublishSchedule() publishScheduleRepo.IPublishScheduleRepository
+	GetContentRevision() contentRevisionRepo.IContentRevisionRepository
+	GetTrash() trashRepo.ITrashRepository
+	GetCourseBundle() courseBundleRepo.ICourseBundleRepository
+	GetCoursePrerequisite() coursePrerequisiteRepo.ICoursePrerequisiteRepository
+	GetLessonVocabulary() lessonVocabularyRepo.ILessonVocabularyRepository
+	GetVocabularyNote() vocabularyNoteRepo.IVocabularyNoteRepository
+	GetDeck() deckRepo.IDeckRepository
+	GetDeckCard() deckCardRepo.IDeckCardRepository
+	GetBookmark() bookmarkRepo.IBookmarkRepository
+
+	// Transaction runs fn with a registry whose repositories share one database transaction,
+	// committing it when fn succeeds and rolling it back when fn fails.
+	Transaction(context.Context, func(IRepositoryRegistry) error) error
 }
 
 func NewRepositoryRegistry(db *gorm.DB) IRepositoryRegistry {
@@ -81,3 +126,89 @@ func (r *Registry) GetExerciseQuestion() exerciseQuestionRepo.IExerciseQuestionR
 func (r *Registry) GetUserCourseProgress() userCourseProgressRepo.IUserCourseProgressRepository {
 	return userCourseProgressRepo.NewUserCourseProgressRepository(r.db)
 }
+
+func (r *Registry) GetExampleSentence() exampleSentenceRepo.IExampleSentenceRepository {
+	return exampleSentenceRepo.NewExampleSentenceRepository(r.db)
+}
+
+func (r *Registry) GetTranslation() translationRepo.ITranslationRepository {
+	return translationRepo.NewTranslationRepository(r.db)
+}
+
+func (r *Registry) GetExam() examRepo.IExamRepository {
+	return examRepo.NewExamRepository(r.db)
+}
+
+func (r *Registry) GetExamAttempt() examAttemptRepo.IExamAttemptRepository {
+	return examAttemptRepo.NewExamAttemptRepository(r.db)
+}
+
+func (r *Registry) GetPlacement() placementRepo.IPlacementRepository {
+	return placementRepo.NewPlacementRepository(r.db)
+}
+
+func (r *Registry) GetExerciseAttempt() exerciseAttemptRepo.IExerciseAttemptRepository {
+	return exerciseAttemptRepo.NewExerciseAttemptRepository(r.db)
+}
+
+func (r *Registry) GetSpeakingSubmission() speakingSubmissionRepo.ISpeakingSubmissionRepository {
+	return speakingSubmissionRepo.NewSpeakingSubmissionRepository(r.db)
+}
+
+func (r *Registry) GetNotification() notificationRepo.INotificationRepository {
+	return notificationRepo.NewNotificationRepository(r.db)
+}
+
+func (r *Registry) GetMedia() mediaRepo.IMediaRepository {
+	return mediaRepo.NewMediaRepository(r.db)
+}
+
+func (r *Registry) GetContentWorkflow() contentWorkflowRepo.IContentWorkflowRepository {
+	return contentWorkflowRepo.NewContentWorkflowRepository(r.db)
+}
+
+func (r *Registry) GetPublishSchedule() publishScheduleRepo.IPublishScheduleRepository {
+	return publishScheduleRepo.NewPublishScheduleRepository(r.db)
+}
+
+func (r *Registry) GetContentRevision() contentRevisionRepo.IContentRevisionRepository {
+	return contentRevisionRepo.NewContentRevisionRepository(r.db)
+}
+
+func (r *Registry) GetTrash() trashRepo.ITrashRepository {
+	return trashRepo.NewTrashRepository(r.db)
+}
+
+func (r *Registry) GetCourseBundle() courseBundleRepo.ICourseBundleRepository {
+	return courseBundleRepo.NewCourseBundleRepository(r.db)
+}
+
+func (r *Registry) GetCoursePrerequisite() coursePrerequisiteRepo.ICoursePrerequisiteRepository {
+	return coursePrerequisiteRepo.NewCoursePrerequisiteRepository(r.db)
+}
+
+func (r *Registry) GetLessonVocabulary() lessonVocabularyRepo.ILessonVocabularyRepository {
+	return lessonVocabularyRepo.NewLessonVocabularyRepository(r.db)
+}
+
+func (r *Registry) GetVocabularyNote() vocabularyNoteRepo.IVocabularyNoteRepository {
+	return vocabularyNoteRepo.NewVocabularyNoteRepository(r.db)
+}
+
+func (r *Registry) GetDeck() deckRepo.IDeckRepository {
+	return deckRepo.NewDeckRepository(r.db)
+}
+
+func (r *Registry) GetDeckCard() deckCardRepo.IDeckCardRepository {
+	return deckCardRepo.NewDeckCardRepository(r.db)
+}
+
+func (r *Registry) GetBookmark() bookmarkRepo.IBookmarkRepository {
+	return bookmarkRepo.NewBookmarkRepository(r.db)
+}
+
+func (r *Registry) Transaction(ctx context.Context, fn func(IRepositoryRegistry) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(&Registry{db: tx})
+	})
+}
@@ -0,0 +1,152 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	errWrap "manabu-service/common/error"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SpeakingSubmissionRepository struct {
+	db *gorm.DB
+}
+
+// ISpeakingSubmissionRepository defines the contract for speaking submission data access operations.
+type ISpeakingSubmissionRepository interface {
+	// Create inserts a new speaking submission.
+	Create(context.Context, *models.SpeakingSubmission) (*models.SpeakingSubmission, error)
+
+	// GetByID retrieves a speaking submission with its question.
+	GetByID(context.Context, uuid.UUID) (*models.SpeakingSubmission, error)
+
+	// GetAllByUser retrieves a user's submissions with optional filtering and pagination, newest first.
+	GetAllByUser(context.Context, string, *dto.SpeakingSubmissionFilterRequest) ([]models.SpeakingSubmission, int64, error)
+
+	// GetReviewQueue retrieves submissions of every learner for review, oldest first.
+	GetReviewQueue(context.Context, *dto.SpeakingSubmissionFilterRequest) ([]models.SpeakingSubmission, int64, error)
+
+	// Review stores the score and feedback of a pending submission and notifies the learner.
+	// The submission row is locked so a submission can only be reviewed once.
+	Review(context.Context, *models.SpeakingSubmission, *models.Notification) error
+}
+
+func NewSpeakingSubmissionRepository(db *gorm.DB) ISpeakingSubmissionRepository {
+	return &SpeakingSubmissionRepository{db: db}
+}
+
+func (r *SpeakingSubmissionRepository) Create(ctx context.Context, submission *models.SpeakingSubmission) (*models.SpeakingSubmission, error) {
+	err := r.db.WithContext(ctx).Create(submission).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return r.GetByID(ctx, submission.ID)
+}
+
+func (r *SpeakingSubmissionRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.SpeakingSubmission, error) {
+	var submission models.SpeakingSubmission
+	err := r.db.WithContext(ctx).
+		Preload("Question").
+		Where("id = ?", id).
+		First(&submission).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrSpeakingSubmissionNotFound
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &submission, nil
+}
+
+// list applies the filters and pagination shared by the learner list and the review queue
+func (r *SpeakingSubmissionRepository) list(query *gorm.DB, filter *dto.SpeakingSubmissionFilterRequest, order string) ([]models.SpeakingSubmission, int64, error) {
+	var submissions []models.SpeakingSubmission
+	var total int64
+
+	// Apply filters
+	if filter != nil {
+		if filter.QuestionID > 0 {
+			query = query.Where("question_id = ?", filter.QuestionID)
+		}
+		if filter.Status != "" {
+			query = query.Where("status = ?", filter.Status)
+		}
+	}
+
+	// Count total records with filters applied
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	query = query.Preload("Question").Order(order)
+
+	// Apply pagination
+	if filter != nil && filter.Limit > 0 {
+		page := filter.Page
+		if page < 1 {
+			page = 1
+		}
+		query = query.Limit(filter.Limit).Offset((page - 1) * filter.Limit)
+	}
+
+	err := query.Find(&submissions).Error
+	if err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return submissions, total, nil
+}
+
+func (r *SpeakingSubmissionRepository) GetAllByUser(ctx context.Context, userID string, filter *dto.SpeakingSubmissionFilterRequest) ([]models.SpeakingSubmission, int64, error) {
+	query := r.db.WithContext(ctx).
+		Model(&models.SpeakingSubmission{}).
+		Where("user_id = ?::uuid", userID)
+	return r.list(query, filter, "created_at DESC")
+}
+
+func (r *SpeakingSubmissionRepository) GetReviewQueue(ctx context.Context, filter *dto.SpeakingSubmissionFilterRequest) ([]models.SpeakingSubmission, int64, error) {
+	query := r.db.WithContext(ctx).Model(&models.SpeakingSubmission{})
+	return r.list(query, filter, "created_at ASC")
+}
+
+func (r *SpeakingSubmissionRepository) Review(ctx context.Context, submission *models.SpeakingSubmission, notification *models.Notification) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing models.SpeakingSubmission
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", submission.ID).
+			First(&existing).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errConstant.ErrSpeakingSubmissionNotFound
+			}
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		if existing.Status != models.SpeakingSubmissionStatusPending {
+			return errConstant.ErrSpeakingAlreadyReviewed
+		}
+
+		err = tx.Model(&models.SpeakingSubmission{}).
+			Where("id = ?", submission.ID).
+			Updates(map[string]interface{}{
+				"status":      models.SpeakingSubmissionStatusReviewed,
+				"score":       submission.Score,
+				"feedback":    submission.Feedback,
+				"reviewer_id": submission.ReviewerID,
+				"reviewed_at": submission.ReviewedAt,
+			}).Error
+		if err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+
+		if err := tx.Create(notification).Error; err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		return nil
+	})
+}
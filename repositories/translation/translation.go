@@ -0,0 +1,247 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	errWrap "manabu-service/common/error"
+	"manabu-service/constants"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TranslationRepository struct {
+	db *gorm.DB
+}
+
+// MissingTranslation is an entity whose translatable field has no translation for a locale.
+type MissingTranslation struct {
+	EntityID   uint
+	SourceText string
+}
+
+// ITranslationRepository defines the contract for translation data access operations.
+type ITranslationRepository interface {
+	// GetAll retrieves all translations with optional filtering and pagination.
+	// Returns the list of translations and total count.
+	GetAll(context.Context, *dto.TranslationFilterRequest) ([]models.Translation, int64, error)
+
+	// GetByID retrieves a single translation by its ID.
+	GetByID(context.Context, uint) (*models.Translation, error)
+
+	// GetByEntities retrieves the translations of a field for the given entities and locale.
+	GetByEntities(context.Context, string, string, []uint, string) ([]models.Translation, error)
+
+	// GetMissing retrieves entities with a non-empty source field but no translation for the locale.
+	// Returns the list of missing entries and total count.
+	GetMissing(context.Context, *dto.MissingTranslationFilterRequest) ([]MissingTranslation, int64, error)
+
+	// Upsert inserts a translation or updates its value if one already exists
+	// for the same entity, field and locale.
+	Upsert(context.Context, *dto.UpsertTranslationRequest) (*models.Translation, error)
+
+	// Delete removes a translation by ID.
+	Delete(context.Context, uint) error
+}
+
+func NewTranslationRepository(db *gorm.DB) ITranslationRepository {
+	return &TranslationRepository{db: db}
+}
+
+// sourceTables maps translatable entity types to the table holding their source text
+var sourceTables = map[string]string{
+	constants.TranslationEntityVocabulary:       "vocabularies",
+	constants.TranslationEntityCourse:           "courses",
+	constants.TranslationEntityLesson:           "lessons",
+	constants.TranslationEntityExerciseQuestion: "exercise_questions",
+}
+
+func (r *TranslationRepository) GetAll(ctx context.Context, filter *dto.TranslationFilterRequest) ([]models.Translation, int64, error) {
+	var translations []models.Translation
+	var total int64
+
+	// Build base query with filters
+	query := r.db.WithContext(ctx).Model(&models.Translation{})
+
+	// Apply filters
+	if filter != nil {
+		if filter.EntityType != "" {
+			query = query.Where("entity_type = ?", filter.EntityType)
+		}
+		if filter.EntityID > 0 {
+			query = query.Where("entity_id = ?", filter.EntityID)
+		}
+		if filter.Locale != "" {
+			query = query.Where("locale = ?", filter.Locale)
+		}
+	}
+
+	// Count total records with filters applied
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	query = query.Order("entity_type ASC, entity_id ASC, locale ASC")
+
+	// Apply pagination
+	if filter != nil && filter.Limit > 0 {
+		// Defensive validation: ensure page is at least 1
+		page := filter.Page
+		if page < 1 {
+			page = 1
+		}
+
+		offset := (page - 1) * filter.Limit
+		// Ensure offset is never negative
+		if offset < 0 {
+			offset = 0
+		}
+
+		query = query.Limit(filter.Limit).Offset(offset)
+	}
+
+	err := query.Find(&translations).Error
+	if err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return translations, total, nil
+}
+
+func (r *TranslationRepository) GetByID(ctx context.Context, id uint) (*models.Translation, error) {
+	var translation models.Translation
+	err := r.db.WithContext(ctx).
+		Where("id = ?", id).
+		First(&translation).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrTranslationNotFound
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &translation, nil
+}
+
+func (r *TranslationRepository) GetByEntities(ctx context.Context, entityType, field string, entityIDs []uint, locale string) ([]models.Translation, error) {
+	var translations []models.Translation
+	if len(entityIDs) == 0 {
+		return translations, nil
+	}
+
+	err := r.db.WithContext(ctx).
+		Where("entity_type = ? AND field = ? AND locale = ?", entityType, field, locale).
+		Where("entity_id IN ?", entityIDs).
+		Find(&translations).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return translations, nil
+}
+
+func (r *TranslationRepository) GetMissing(ctx context.Context, filter *dto.MissingTranslationFilterRequest) ([]MissingTranslation, int64, error) {
+	var missing []MissingTranslation
+	var total int64
+
+	// Resolve table and column from whitelists (defense in depth)
+	table, ok := sourceTables[filter.EntityType]
+	if !ok {
+		return nil, 0, errConstant.ErrInvalidTranslationEntityType
+	}
+	column := constants.TranslatableFields[filter.EntityType]
+
+	query := r.db.WithContext(ctx).
+		Table(table+" AS source").
+		Where("COALESCE(source."+column+", '') <> ''").
+		Where("NOT EXISTS (?)", r.db.Model(&models.Translation{}).
+			Select("1").
+			Where("translations.entity_type = ? AND translations.field = ? AND translations.locale = ?",
+				filter.EntityType, column, filter.Locale).
+			Where("translations.entity_id = source.id"))
+
+	// Content in the trash needs no translation; vocabulary is never trashed
+	if filter.EntityType != constants.TranslationEntityVocabulary {
+		query = query.Where("source.deleted_at IS NULL")
+	}
+
+	// Count total records with filters applied
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	query = query.Select("source.id AS entity_id, source." + column + " AS source_text").
+		Order("source.id ASC")
+
+	// Apply pagination
+	if filter.Limit > 0 {
+		// Defensive validation: ensure page is at least 1
+		page := filter.Page
+		if page < 1 {
+			page = 1
+		}
+
+		offset := (page - 1) * filter.Limit
+		// Ensure offset is never negative
+		if offset < 0 {
+			offset = 0
+		}
+
+		query = query.Limit(filter.Limit).Offset(offset)
+	}
+
+	err := query.Scan(&missing).Error
+	if err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return missing, total, nil
+}
+
+func (r *TranslationRepository) Upsert(ctx context.Context, req *dto.UpsertTranslationRequest) (*models.Translation, error) {
+	translation := models.Translation{
+		EntityType: req.EntityType,
+		EntityID:   req.EntityID,
+		Field:      req.Field,
+		Locale:     req.Locale,
+		Value:      req.Value,
+	}
+
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "entity_type"}, {Name: "entity_id"}, {Name: "field"}, {Name: "locale"}},
+			DoUpdates: clause.AssignmentColumns([]string{"value", "updated_at"}),
+		}).
+		Create(&translation).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	// Reload to get the persisted row when the insert turned into an update
+	var saved models.Translation
+	err = r.db.WithContext(ctx).
+		Where("entity_type = ? AND entity_id = ? AND field = ? AND locale = ?",
+			req.EntityType, req.EntityID, req.Field, req.Locale).
+		First(&saved).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return &saved, nil
+}
+
+func (r *TranslationRepository) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&models.Translation{}, id)
+
+	if result.Error != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	// Check if any rows were affected
+	if result.RowsAffected == 0 {
+		return errConstant.ErrTranslationNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,278 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	errWrap "manabu-service/common/error"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// trashContentTypes lists the content types that can be trashed, parents before children
+var trashContentTypes = []string{
+	models.ContentTypeCourse,
+	models.ContentTypeLesson,
+	models.ContentTypeExercise,
+	models.ContentTypeExerciseQuestion,
+}
+
+// TrashItem is a content item in the trash
+type TrashItem struct {
+	ContentType string
+	ContentID   uint
+	Title       string
+	ParentID    *uint
+	DeletedAt   time.Time
+}
+
+type TrashRepository struct {
+	db *gorm.DB
+}
+
+// ITrashRepository defines the contract for soft deleting, restoring and purging curriculum content.
+// A content item is trashed together with its descendants under a single deletion time, which is
+// how a restore finds the descendants to bring back.
+type ITrashRepository interface {
+	// SoftDelete moves a content item and its descendants to the trash, or returns ErrContentNotFound.
+	SoftDelete(context.Context, string, uint) error
+
+	// GetAll retrieves trashed items with filtering and pagination, most recently deleted first.
+	// Descendants deleted together with their parent are left out.
+	GetAll(context.Context, *dto.TrashFilterRequest) ([]TrashItem, int64, error)
+
+	// Restore takes a content item out of the trash with the descendants deleted together with it.
+	// It fails with ErrTrashParentDeleted while the parent is in the trash and with
+	// ErrTrashRestoreConflict when a restored item clashes with a unique title or order.
+	Restore(context.Context, string, uint) error
+
+	// Purge permanently deletes content trashed before the given time along with its workflow
+	// and publish schedule, and returns the number of content items removed.
+	Purge(context.Context, time.Time) (int64, error)
+}
+
+func NewTrashRepository(db *gorm.DB) ITrashRepository {
+	return &TrashRepository{db: db}
+}
+
+func (r *TrashRepository) SoftDelete(ctx context.Context, contentType string, contentID uint) error {
+	level, ok := models.ContentHierarchy[contentType]
+	if !ok {
+		return errConstant.ErrInvalidContentType
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		result := tx.Table(models.ContentTypeTables[contentType]).
+			Where("id = ? AND deleted_at IS NULL", contentID).
+			Update("deleted_at", now)
+		if result.Error != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		if result.RowsAffected == 0 {
+			return errConstant.ErrContentNotFound
+		}
+
+		ids := []uint{contentID}
+		for childType := level.ChildType; childType != ""; childType = models.ContentHierarchy[childType].ChildType {
+			table := models.ContentTypeTables[childType]
+			var childIDs []uint
+			err := tx.Table(table).
+				Where(models.ContentHierarchy[childType].ParentColumn+" IN ? AND deleted_at IS NULL", ids).
+				Pluck("id", &childIDs).Error
+			if err != nil {
+				return errWrap.WrapError(errConstant.ErrSQLError)
+			}
+			if len(childIDs) == 0 {
+				break
+			}
+
+			err = tx.Table(table).Where("id IN ?", childIDs).Update("deleted_at", now).Error
+			if err != nil {
+				return errWrap.WrapError(errConstant.ErrSQLError)
+			}
+			ids = childIDs
+		}
+
+		return nil
+	})
+}
+
+// trashQuery selects the trashed items of a content type, leaving out those deleted with their parent
+func (r *TrashRepository) trashQuery(contentType string) string {
+	level := models.ContentHierarchy[contentType]
+	table := models.ContentTypeTables[contentType]
+
+	if level.ParentType == "" {
+		return fmt.Sprintf("SELECT '%s' AS content_type, item.id AS content_id, item.%s AS title, "+
+			"NULL::bigint AS parent_id, item.deleted_at FROM %s AS item WHERE item.deleted_at IS NOT NULL",
+			contentType, level.TitleColumn, table)
+	}
+
+	return fmt.Sprintf("SELECT '%s' AS content_type, item.id AS content_id, item.%s AS title, "+
+		"item.%s AS parent_id, item.deleted_at FROM %s AS item JOIN %s AS parent ON parent.id = item.%s "+
+		"WHERE item.deleted_at IS NOT NULL AND (parent.deleted_at IS NULL OR parent.deleted_at <> item.deleted_at)",
+		contentType, level.TitleColumn, level.ParentColumn, table,
+		models.ContentTypeTables[level.ParentType], level.ParentColumn)
+}
+
+func (r *TrashRepository) GetAll(ctx context.Context, filter *dto.TrashFilterRequest) ([]TrashItem, int64, error) {
+	var items []TrashItem
+	var total int64
+
+	// Content types come from a whitelist, so building the union is safe
+	queries := make([]string, 0, len(trashContentTypes))
+	for _, contentType := range trashContentTypes {
+		if filter.ContentType != "" && filter.ContentType != contentType {
+			continue
+		}
+		queries = append(queries, r.trashQuery(contentType))
+	}
+	if len(queries) == 0 {
+		return nil, 0, errConstant.ErrInvalidContentType
+	}
+	union := strings.Join(queries, " UNION ALL ")
+
+	err := r.db.WithContext(ctx).Raw("SELECT COUNT(*) FROM (" + union + ") AS trash").Scan(&total).Error
+	if err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	// Apply pagination
+	offset := (filter.Page - 1) * filter.Limit
+	err = r.db.WithContext(ctx).
+		Raw("SELECT * FROM ("+union+") AS trash ORDER BY deleted_at DESC, content_type ASC, content_id ASC LIMIT ? OFFSET ?",
+			filter.Limit, offset).
+		Scan(&items).Error
+	if err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return items, total, nil
+}
+
+// restoreRows clears the deletion time of content rows
+func (r *TrashRepository) restoreRows(tx *gorm.DB, table string, ids []uint) error {
+	err := tx.Table(table).Where("id IN ?", ids).Update("deleted_at", nil).Error
+	if err != nil {
+		// A live item took the title or order of the restored one
+		if strings.Contains(err.Error(), "duplicate key") {
+			return errConstant.ErrTrashRestoreConflict
+		}
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+func (r *TrashRepository) Restore(ctx context.Context, contentType string, contentID uint) error {
+	level, ok := models.ContentHierarchy[contentType]
+	if !ok {
+		return errConstant.ErrInvalidContentType
+	}
+	parentColumn := "NULL::bigint"
+	if level.ParentColumn != "" {
+		parentColumn = level.ParentColumn
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var items []TrashItem
+		err := tx.Table(models.ContentTypeTables[contentType]).
+			Select("id AS content_id, "+parentColumn+" AS parent_id, deleted_at").
+			Where("id = ? AND deleted_at IS NOT NULL", contentID).
+			Scan(&items).Error
+		if err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		if len(items) == 0 {
+			return errConstant.ErrTrashItemNotFound
+		}
+
+		if level.ParentType != "" && items[0].ParentID != nil {
+			var deletedParents int64
+			err = tx.Table(models.ContentTypeTables[level.ParentType]).
+				Where("id = ? AND deleted_at IS NOT NULL", *items[0].ParentID).
+				Count(&deletedParents).Error
+			if err != nil {
+				return errWrap.WrapError(errConstant.ErrSQLError)
+			}
+			if deletedParents > 0 {
+				return errConstant.ErrTrashParentDeleted
+			}
+		}
+
+		ids := []uint{contentID}
+		if err = r.restoreRows(tx, models.ContentTypeTables[contentType], ids); err != nil {
+			return err
+		}
+
+		// Descendants deleted on their own before the item stay in the trash
+		deletedAt := items[0].DeletedAt
+		for childType := level.ChildType; childType != ""; childType = models.ContentHierarchy[childType].ChildType {
+			table := models.ContentTypeTables[childType]
+			var childIDs []uint
+			err = tx.Table(table).
+				Where(models.ContentHierarchy[childType].ParentColumn+" IN ? AND deleted_at = ?", ids, deletedAt).
+				Pluck("id", &childIDs).Error
+			if err != nil {
+				return errWrap.WrapError(errConstant.ErrSQLError)
+			}
+			if len(childIDs) == 0 {
+				break
+			}
+
+			if err = r.restoreRows(tx, table, childIDs); err != nil {
+				return err
+			}
+			ids = childIDs
+		}
+
+		return nil
+	})
+}
+
+func (r *TrashRepository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	var purged int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Children go first so the foreign key cascades never reach content still in use
+		for i := len(trashContentTypes) - 1; i >= 0; i-- {
+			contentType := trashContentTypes[i]
+			table := models.ContentTypeTables[contentType]
+
+			var ids []uint
+			err := tx.Table(table).Where("deleted_at < ?", before).Pluck("id", &ids).Error
+			if err != nil {
+				return errWrap.WrapError(errConstant.ErrSQLError)
+			}
+			if len(ids) == 0 {
+				continue
+			}
+
+			err = tx.Where("content_type = ? AND content_id IN ?", contentType, ids).
+				Delete(&models.ContentWorkflow{}).Error
+			if err != nil {
+				return errWrap.WrapError(errConstant.ErrSQLError)
+			}
+			err = tx.Where("content_type = ? AND content_id IN ?", contentType, ids).
+				Delete(&models.PublishSchedule{}).Error
+			if err != nil {
+				return errWrap.WrapError(errConstant.ErrSQLError)
+			}
+
+			result := tx.Exec("DELETE FROM "+table+" WHERE id IN ?", ids)
+			if result.Error != nil {
+				return errWrap.WrapError(errConstant.ErrSQLError)
+			}
+			purged += result.RowsAffected
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return purged, nil
+}
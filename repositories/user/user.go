@@ -22,6 +22,7 @@ type IUserRepository interface {
 	FindByUsername(context.Context, string) (*models.User, error)
 	FindByEmail(context.Context, string) (*models.User, error)
 	FindByUUID(context.Context, string) (*models.User, error)
+	FindByID(context.Context, uint) (*models.User, error)
 }
 
 func NewUserRepository(db *gorm.DB) IUserRepository {
@@ -107,3 +108,18 @@ func (r *UserRepository) FindByUUID(ctx context.Context, uuid string) (*models.U
 	}
 	return &user, nil
 }
+
+func (r *UserRepository) FindByID(ctx context.Context, id uint) (*models.User, error) {
+	var user models.User
+	err := r.db.WithContext(ctx).
+		Preload("Role").
+		Where("id = ?", id).
+		First(&user).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrUserNotFound
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &user, nil
+}
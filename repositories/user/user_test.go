@@ -72,6 +72,8 @@ func (s *UserRepositoryTestSuite) TestRegister_Success() {
 			req.Password,
 			req.Email,
 			req.RoleID,
+			sqlmock.AnyArg(), // JlptLevelID
+			sqlmock.AnyArg(), // PlacedAt
 			sqlmock.AnyArg(), // CreatedAt
 			sqlmock.AnyArg(), // UpdatedAt
 		).
@@ -345,6 +347,52 @@ func (s *UserRepositoryTestSuite) TestFindByUUID_NotFound() {
 	assert.Equal(s.T(), errConstant.ErrUserNotFound, err)
 }
 
+// Test FindByID - Success
+func (s *UserRepositoryTestSuite) TestFindByID_Success() {
+	// Arrange
+	userUUID := uuid.New()
+
+	userRows := sqlmock.NewRows([]string{
+		"id", "uuid", "name", "username", "password", "email", "role_id",
+	}).AddRow(7, userUUID, "John Doe", "johndoe", "hashedpassword", "john@example.com", constants.User)
+
+	roleRows := sqlmock.NewRows([]string{
+		"id", "code", "name",
+	}).AddRow(constants.User, "USER", "User")
+
+	s.sqlMock.ExpectQuery(`SELECT \* FROM "users"`).
+		WithArgs(uint(7), 1).
+		WillReturnRows(userRows)
+
+	s.sqlMock.ExpectQuery(`SELECT \* FROM "roles"`).
+		WithArgs(constants.User).
+		WillReturnRows(roleRows)
+
+	// Act
+	result, err := s.repository.FindByID(s.ctx, 7)
+
+	// Assert
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), result)
+	assert.Equal(s.T(), userUUID, result.UUID)
+}
+
+// Test FindByID - Not Found
+func (s *UserRepositoryTestSuite) TestFindByID_NotFound() {
+	// Arrange
+	s.sqlMock.ExpectQuery(`SELECT \* FROM "users"`).
+		WithArgs(uint(7), 1).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	// Act
+	result, err := s.repository.FindByID(s.ctx, 7)
+
+	// Assert
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), result)
+	assert.Equal(s.T(), errConstant.ErrUserNotFound, err)
+}
+
 // Test Update - Success
 func (s *UserRepositoryTestSuite) TestUpdate_Success() {
 	// Arrange
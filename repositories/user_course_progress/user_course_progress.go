@@ -92,6 +92,9 @@ func (r *UserCourseProgressRepository) GetAll(ctx context.Context, userID uint,
 	// Build base query with user filter
 	query := r.db.WithContext(ctx).Model(&models.UserCourseProgress{}).Where("user_id = ?", userID)
 
+	// Progress is kept while its course is in the trash, but only shown for live courses
+	query = query.Where("course_id IN (?)", r.db.Model(&models.Course{}).Select("id"))
+
 	// Apply filters
 	if filter != nil {
 		if filter.Status != "" {
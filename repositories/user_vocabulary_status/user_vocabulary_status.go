@@ -10,7 +10,9 @@ import (
 	"manabu-service/domain/models"
 	"time"
 
+	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type UserVocabularyStatusRepository struct {
@@ -25,6 +27,8 @@ type IUserVocabularyStatusRepository interface {
 	GetByUserID(context.Context, string, *dto.UserVocabStatusListRequest) ([]*models.UserVocabularyStatus, int64, error)
 	GetDueForReview(context.Context, string) ([]*models.UserVocabularyStatus, error)
 	Update(context.Context, *models.UserVocabularyStatus) (*models.UserVocabularyStatus, error)
+	CreateMany(context.Context, uuid.UUID, []uint) (int, error)
+	CreateForDeckSubscribers(context.Context, uint) error
 }
 
 func NewUserVocabularyStatusRepository(db *gorm.DB) IUserVocabularyStatusRepository {
@@ -158,3 +162,45 @@ func (r *UserVocabularyStatusRepository) Update(ctx context.Context, status *mod
 
 	return status, nil
 }
+
+// CreateMany starts learning the given vocabularies for a user, skipping the ones the user is
+// already learning, and returns how many were added
+func (r *UserVocabularyStatusRepository) CreateMany(ctx context.Context, userID uuid.UUID, vocabularyIDs []uint) (int, error) {
+	if len(vocabularyIDs) == 0 {
+		return 0, nil
+	}
+
+	statuses := make([]models.UserVocabularyStatus, 0, len(vocabularyIDs))
+	for _, vocabularyID := range vocabularyIDs {
+		statuses = append(statuses, models.UserVocabularyStatus{
+			UserID:       userID,
+			VocabularyID: vocabularyID,
+			Status:       "learning",
+		})
+	}
+
+	result := r.db.WithContext(ctx).
+		Omit(clause.Associations).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&statuses)
+	if result.Error != nil {
+		return 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return int(result.RowsAffected), nil
+}
+
+// CreateForDeckSubscribers starts learning the words of a deck for every user subscribed to it,
+// skipping words a user is already learning, in a single statement
+func (r *UserVocabularyStatusRepository) CreateForDeckSubscribers(ctx context.Context, deckID uint) error {
+	err := r.db.WithContext(ctx).Exec(`INSERT INTO user_vocabulary_status (user_id, vocabulary_id, status, repetitions, created_at, updated_at)
+		SELECT deck_subscriptions.user_id, deck_vocabularies.vocabulary_id, 'learning', 0, NOW(), NOW()
+		FROM deck_subscriptions
+		JOIN deck_vocabularies ON deck_vocabularies.deck_id = deck_subscriptions.deck_id
+		WHERE deck_subscriptions.deck_id = ?
+		ON CONFLICT (user_id, vocabulary_id) DO NOTHING`, deckID).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
@@ -27,10 +27,23 @@ type IVocabularyRepository interface {
 	// GetByID retrieves a single vocabulary entry by its ID.
 	GetByID(context.Context, uint) (*models.Vocabulary, error)
 
+	// GetByIDs retrieves the vocabulary entries with the given IDs.
+	GetByIDs(context.Context, []uint) ([]models.Vocabulary, error)
+
+	// GetRandom retrieves up to limit vocabulary entries in random order, optionally
+	// restricted to a category (when non-zero) and to the given JLPT levels (when non-empty).
+	// Used to pick quiz words and distractors.
+	GetRandom(context.Context, uint, []uint, int) ([]models.Vocabulary, error)
+
 	// GetByWordAndJlptLevel retrieves a vocabulary entry by word and JLPT level.
 	// Used for duplicate detection.
 	GetByWordAndJlptLevel(context.Context, string, uint) (*models.Vocabulary, error)
 
+	// GetDictionaryEntries retrieves vocabularies with a reading whose word matches
+	// one of the given words or starts with one of the given prefixes.
+	// Used to build furigana dictionaries.
+	GetDictionaryEntries(context.Context, []string, []string) ([]models.Vocabulary, error)
+
 	// Update modifies an existing vocabulary entry by ID.
 	Update(context.Context, *dto.UpdateVocabularyRequest, uint) (*models.Vocabulary, error)
 
@@ -61,6 +74,8 @@ func (r *VocabularyRepository) Create(ctx context.Context, req *dto.CreateVocabu
 		ExampleSentenceMeaning: req.ExampleSentenceMeaning,
 		AudioURL:               req.AudioURL,
 		ImageURL:               req.ImageURL,
+		AudioMediaID:           req.AudioMediaID,
+		ImageMediaID:           req.ImageMediaID,
 		Difficulty:             difficulty,
 	}
 
@@ -163,6 +178,9 @@ func (r *VocabularyRepository) GetByID(ctx context.Context, id uint) (*models.Vo
 		Preload("JlptLevel").
 		Preload("Category").
 		Preload("Category.JlptLevel").
+		Preload("ExampleSentences", func(db *gorm.DB) *gorm.DB {
+			return db.Order("example_sentences.difficulty ASC, example_sentences.id ASC")
+		}).
 		Where("id = ?", id).
 		First(&vocabulary).Error
 	if err != nil {
@@ -174,6 +192,42 @@ func (r *VocabularyRepository) GetByID(ctx context.Context, id uint) (*models.Vo
 	return &vocabulary, nil
 }
 
+func (r *VocabularyRepository) GetByIDs(ctx context.Context, ids []uint) ([]models.Vocabulary, error) {
+	var vocabularies []models.Vocabulary
+	if len(ids) == 0 {
+		return vocabularies, nil
+	}
+
+	err := r.db.WithContext(ctx).
+		Where("id IN ?", ids).
+		Find(&vocabularies).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return vocabularies, nil
+}
+
+func (r *VocabularyRepository) GetRandom(ctx context.Context, categoryID uint, jlptLevelIDs []uint, limit int) ([]models.Vocabulary, error) {
+	var vocabularies []models.Vocabulary
+
+	query := r.db.WithContext(ctx).Model(&models.Vocabulary{})
+	if categoryID > 0 {
+		query = query.Where("category_id = ?", categoryID)
+	}
+	if len(jlptLevelIDs) > 0 {
+		query = query.Where("jlpt_level_id IN ?", jlptLevelIDs)
+	}
+
+	err := query.
+		Order("RANDOM()").
+		Limit(limit).
+		Find(&vocabularies).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return vocabularies, nil
+}
+
 func (r *VocabularyRepository) GetByWordAndJlptLevel(ctx context.Context, word string, jlptLevelID uint) (*models.Vocabulary, error) {
 	var vocabulary models.Vocabulary
 	err := r.db.WithContext(ctx).
@@ -188,6 +242,30 @@ func (r *VocabularyRepository) GetByWordAndJlptLevel(ctx context.Context, word s
 	return &vocabulary, nil
 }
 
+func (r *VocabularyRepository) GetDictionaryEntries(ctx context.Context, words []string, prefixes []string) ([]models.Vocabulary, error) {
+	var vocabularies []models.Vocabulary
+	if len(words) == 0 && len(prefixes) == 0 {
+		return vocabularies, nil
+	}
+
+	// Match exact words, plus okurigana words (e.g. 食べる) starting with a kanji run
+	matches := r.db.Where("word IN ?", words)
+	for _, prefix := range prefixes {
+		matches = matches.Or("word LIKE ?", prefix+"%")
+	}
+
+	err := r.db.WithContext(ctx).
+		Select("id", "word", "reading").
+		Where("reading <> ''").
+		Where(matches).
+		Order("id ASC").
+		Find(&vocabularies).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return vocabularies, nil
+}
+
 func (r *VocabularyRepository) Update(ctx context.Context, req *dto.UpdateVocabularyRequest, id uint) (*models.Vocabulary, error) {
 	// Set default difficulty if not provided
 	difficulty := req.Difficulty
@@ -207,6 +285,8 @@ func (r *VocabularyRepository) Update(ctx context.Context, req *dto.UpdateVocabu
 		ExampleSentenceMeaning: req.ExampleSentenceMeaning,
 		AudioURL:               req.AudioURL,
 		ImageURL:               req.ImageURL,
+		AudioMediaID:           req.AudioMediaID,
+		ImageMediaID:           req.ImageMediaID,
 		Difficulty:             difficulty,
 	}
 
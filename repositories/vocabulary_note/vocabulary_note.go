@@ -0,0 +1,258 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	errWrap "manabu-service/common/error"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type VocabularyNoteRepository struct {
+	db *gorm.DB
+}
+
+// IVocabularyNoteRepository defines the contract for vocabulary note data access operations.
+type IVocabularyNoteRepository interface {
+	// Create inserts a new note. It fails with ErrVocabularyNoteExists if the user already has a note for the word.
+	Create(context.Context, *models.VocabularyNote) error
+
+	// GetByID retrieves a single note by its ID.
+	GetByID(context.Context, uint) (*models.VocabularyNote, error)
+
+	// GetAll retrieves a user's notes with optional filtering and pagination, newest first.
+	GetAll(context.Context, string, *dto.VocabularyNoteFilterRequest) ([]models.VocabularyNote, int64, error)
+
+	// GetByUserAndVocabularyIDs retrieves a user's notes for the given vocabulary IDs.
+	GetByUserAndVocabularyIDs(context.Context, string, []uint) ([]models.VocabularyNote, error)
+
+	// GetShared retrieves the shared mnemonics of a word with their authors, most upvoted first.
+	GetShared(context.Context, uint, *dto.PaginationRequest) ([]models.VocabularyNote, int64, error)
+
+	// GetUpvotedNoteIDs returns which of the given notes a user has upvoted.
+	GetUpvotedNoteIDs(context.Context, string, []uint) ([]uint, error)
+
+	// Update writes the editable fields of a note.
+	Update(context.Context, *models.VocabularyNote) error
+
+	// Delete removes a note by ID.
+	Delete(context.Context, uint) error
+
+	// Upvote records a user's upvote of a note and increments its upvote count in a single
+	// transaction. It reports false if the user had already upvoted the note.
+	Upvote(context.Context, uint, uuid.UUID) (bool, error)
+
+	// RemoveUpvote removes a user's upvote of a note and decrements its upvote count in a single
+	// transaction. It reports false if the user had not upvoted the note.
+	RemoveUpvote(context.Context, uint, uuid.UUID) (bool, error)
+}
+
+func NewVocabularyNoteRepository(db *gorm.DB) IVocabularyNoteRepository {
+	return &VocabularyNoteRepository{db: db}
+}
+
+func (r *VocabularyNoteRepository) Create(ctx context.Context, note *models.VocabularyNote) error {
+	err := r.db.WithContext(ctx).Omit(clause.Associations).Create(note).Error
+	if err != nil {
+		if strings.Contains(err.Error(), "idx_vocabulary_note_user") ||
+			strings.Contains(err.Error(), "duplicate key") ||
+			strings.Contains(err.Error(), "UNIQUE constraint") {
+			return errConstant.ErrVocabularyNoteExists
+		}
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+func (r *VocabularyNoteRepository) GetByID(ctx context.Context, id uint) (*models.VocabularyNote, error) {
+	var note models.VocabularyNote
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		Where("id = ?", id).
+		First(&note).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errConstant.ErrVocabularyNoteNotFound
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &note, nil
+}
+
+func (r *VocabularyNoteRepository) GetAll(ctx context.Context, userID string, filter *dto.VocabularyNoteFilterRequest) ([]models.VocabularyNote, int64, error) {
+	var notes []models.VocabularyNote
+	var total int64
+
+	// Build base query with user filter
+	query := r.db.WithContext(ctx).Model(&models.VocabularyNote{}).Where("user_id = ?::uuid", userID)
+
+	// Apply filters
+	if filter != nil {
+		if filter.VocabularyID > 0 {
+			query = query.Where("vocabulary_id = ?", filter.VocabularyID)
+		}
+		if filter.IsShared != nil {
+			query = query.Where("is_shared = ?", *filter.IsShared)
+		}
+	}
+
+	// Count total records with filters applied
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	query = query.Order("created_at DESC, id DESC")
+
+	// Apply pagination
+	if filter != nil && filter.Limit > 0 {
+		page := filter.Page
+		if page < 1 {
+			page = 1
+		}
+		query = query.Limit(filter.Limit).Offset((page - 1) * filter.Limit)
+	}
+
+	err := query.Find(&notes).Error
+	if err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return notes, total, nil
+}
+
+func (r *VocabularyNoteRepository) GetByUserAndVocabularyIDs(ctx context.Context, userID string, vocabularyIDs []uint) ([]models.VocabularyNote, error) {
+	var notes []models.VocabularyNote
+	if len(vocabularyIDs) == 0 {
+		return notes, nil
+	}
+
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?::uuid AND vocabulary_id IN ?", userID, vocabularyIDs).
+		Find(&notes).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return notes, nil
+}
+
+func (r *VocabularyNoteRepository) GetShared(ctx context.Context, vocabularyID uint, pagination *dto.PaginationRequest) ([]models.VocabularyNote, int64, error) {
+	var notes []models.VocabularyNote
+	var total int64
+
+	query := r.db.WithContext(ctx).
+		Model(&models.VocabularyNote{}).
+		Where("vocabulary_id = ? AND is_shared = ? AND mnemonic <> ''", vocabularyID, true)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	query = query.Preload("User").Order("upvotes DESC, created_at ASC, id ASC")
+
+	// Apply pagination
+	if pagination != nil && pagination.Limit > 0 {
+		page := pagination.Page
+		if page < 1 {
+			page = 1
+		}
+		query = query.Limit(pagination.Limit).Offset((page - 1) * pagination.Limit)
+	}
+
+	err := query.Find(&notes).Error
+	if err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return notes, total, nil
+}
+
+func (r *VocabularyNoteRepository) GetUpvotedNoteIDs(ctx context.Context, userID string, noteIDs []uint) ([]uint, error) {
+	var upvotedIDs []uint
+	if len(noteIDs) == 0 {
+		return upvotedIDs, nil
+	}
+
+	err := r.db.WithContext(ctx).
+		Model(&models.VocabularyNoteUpvote{}).
+		Where("user_id = ?::uuid AND note_id IN ?", userID, noteIDs).
+		Pluck("note_id", &upvotedIDs).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return upvotedIDs, nil
+}
+
+func (r *VocabularyNoteRepository) Update(ctx context.Context, note *models.VocabularyNote) error {
+	// Every editable field is written so it can be cleared again
+	err := r.db.WithContext(ctx).
+		Model(note).
+		Select("note", "mnemonic", "image_url", "image_media_id", "is_shared", "updated_at").
+		Updates(note).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+func (r *VocabularyNoteRepository) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&models.VocabularyNote{})
+	if result.Error != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	if result.RowsAffected == 0 {
+		return errConstant.ErrVocabularyNoteNotFound
+	}
+	return nil
+}
+
+func (r *VocabularyNoteRepository) Upvote(ctx context.Context, noteID uint, userID uuid.UUID) (bool, error) {
+	added := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Omit(clause.Associations).
+			Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&models.VocabularyNoteUpvote{NoteID: noteID, UserID: userID})
+		if result.Error != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		err := tx.Model(&models.VocabularyNote{}).
+			Where("id = ?", noteID).
+			UpdateColumn("upvotes", gorm.Expr("upvotes + 1")).Error
+		if err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		added = true
+		return nil
+	})
+	return added, err
+}
+
+func (r *VocabularyNoteRepository) RemoveUpvote(ctx context.Context, noteID uint, userID uuid.UUID) (bool, error) {
+	removed := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("note_id = ? AND user_id = ?", noteID, userID).Delete(&models.VocabularyNoteUpvote{})
+		if result.Error != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		err := tx.Model(&models.VocabularyNote{}).
+			Where("id = ? AND upvotes > 0", noteID).
+			UpdateColumn("upvotes", gorm.Expr("upvotes - 1")).Error
+		if err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		removed = true
+		return nil
+	})
+	return removed, err
+}
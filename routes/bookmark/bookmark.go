@@ -0,0 +1,41 @@
+package routes
+
+import (
+	"manabu-service/controllers"
+	"manabu-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BookmarkRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IBookmarkRoute interface {
+	Run()
+}
+
+func NewBookmarkRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IBookmarkRoute {
+	return &BookmarkRoute{controller: controller, group: group}
+}
+
+func (r *BookmarkRoute) Run() {
+	// Bookmarks of the logged in user (all require authentication)
+	bookmarkGroup := r.group.Group("/me/bookmarks")
+	bookmarkGroup.Use(middlewares.Authenticate())
+
+	bookmarkGroup.GET("", r.controller.GetBookmarkController().GetAll)
+	bookmarkGroup.POST("", r.controller.GetBookmarkController().Create)
+	bookmarkGroup.DELETE("", r.controller.GetBookmarkController().Delete)
+	bookmarkGroup.PUT("/:id", r.controller.GetBookmarkController().Move)
+
+	// Bookmark folders of the logged in user (all require authentication)
+	folderGroup := r.group.Group("/me/bookmark-folders")
+	folderGroup.Use(middlewares.Authenticate())
+
+	folderGroup.GET("", r.controller.GetBookmarkController().GetFolders)
+	folderGroup.POST("", r.controller.GetBookmarkController().CreateFolder)
+	folderGroup.PUT("/:id", r.controller.GetBookmarkController().RenameFolder)
+	folderGroup.DELETE("/:id", r.controller.GetBookmarkController().DeleteFolder)
+}
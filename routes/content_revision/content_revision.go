@@ -0,0 +1,32 @@
+package routes
+
+import (
+	"manabu-service/controllers"
+	"manabu-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ContentRevisionRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IContentRevisionRoute interface {
+	Run()
+}
+
+func NewContentRevisionRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IContentRevisionRoute {
+	return &ContentRevisionRoute{controller: controller, group: group}
+}
+
+func (r *ContentRevisionRoute) Run() {
+	// Content revision routes (all require authentication)
+	revisionGroup := r.group.Group("/revisions")
+	revisionGroup.Use(middlewares.Authenticate())
+
+	revisionGroup.GET("/:contentType/:id", r.controller.GetContentRevisionController().GetAll)
+	revisionGroup.GET("/:contentType/:id/diff", r.controller.GetContentRevisionController().Diff)
+	revisionGroup.GET("/:contentType/:id/:version", r.controller.GetContentRevisionController().GetByVersion)
+	revisionGroup.POST("/:contentType/:id/:version/restore", r.controller.GetContentRevisionController().Restore)
+}
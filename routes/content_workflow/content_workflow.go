@@ -0,0 +1,36 @@
+package routes
+
+import (
+	"manabu-service/controllers"
+	"manabu-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ContentWorkflowRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IContentWorkflowRoute interface {
+	Run()
+}
+
+func NewContentWorkflowRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IContentWorkflowRoute {
+	return &ContentWorkflowRoute{controller: controller, group: group}
+}
+
+func (r *ContentWorkflowRoute) Run() {
+	// Content workflow routes (all require authentication)
+	workflowGroup := r.group.Group("/workflows")
+	workflowGroup.Use(middlewares.Authenticate())
+
+	workflowGroup.GET("/review-queue", r.controller.GetContentWorkflowController().GetReviewQueue)
+	workflowGroup.GET("/:contentType/:id", r.controller.GetContentWorkflowController().GetByContent)
+	workflowGroup.POST("/:contentType/:id/transitions", r.controller.GetContentWorkflowController().Transition)
+	workflowGroup.PUT("/:contentType/:id/reviewer", r.controller.GetContentWorkflowController().AssignReviewer)
+	workflowGroup.POST("/:contentType/:id/comments", r.controller.GetContentWorkflowController().AddComment)
+	workflowGroup.GET("/:contentType/:id/publish-schedule", r.controller.GetContentWorkflowController().GetPublishSchedule)
+	workflowGroup.PUT("/:contentType/:id/publish-schedule", r.controller.GetContentWorkflowController().SchedulePublish)
+	workflowGroup.DELETE("/:contentType/:id/publish-schedule", r.controller.GetContentWorkflowController().CancelPublishSchedule)
+}
@@ -24,15 +24,20 @@ func (r *CourseRoute) Run() {
 	group := r.group.Group("/courses")
 
 	// Public endpoints
-	group.GET("", r.controller.GetCourseController().GetAll)
-	group.GET("/published", r.controller.GetCourseController().GetPublished)
-	group.GET("/:id", r.controller.GetCourseController().GetByID)
-	group.GET("/:id/lessons", r.controller.GetLessonController().GetByCourseID)
+	group.GET("", middlewares.LenientAuthenticate(), r.controller.GetCourseController().GetAll)
+	group.GET("/published", middlewares.LenientAuthenticate(), r.controller.GetCourseController().GetPublished)
+	group.GET("/:id", middlewares.LenientAuthenticate(), r.controller.GetCourseController().GetByID)
+	group.GET("/:id/lessons", middlewares.LenientAuthenticate(), r.controller.GetLessonController().GetByCourseID)
+	group.GET("/:id/prerequisites", r.controller.GetCoursePrerequisiteController().GetByCourseID)
 
 	// Admin endpoints (require authentication)
 	group.POST("", middlewares.Authenticate(), r.controller.GetCourseController().Create)
 	group.PUT("/:id", middlewares.Authenticate(), r.controller.GetCourseController().Update)
 	group.DELETE("/:id", middlewares.Authenticate(), r.controller.GetCourseController().Delete)
-	group.POST("/:id/publish", middlewares.Authenticate(), r.controller.GetCourseController().Publish)
-	group.POST("/:id/unpublish", middlewares.Authenticate(), r.controller.GetCourseController().Unpublish)
+	group.GET("/:id/publish-readiness", middlewares.Authenticate(), r.controller.GetCourseController().GetPublishReadiness)
+	group.POST("/:id/clone", middlewares.Authenticate(), r.controller.GetCourseController().Clone)
+	group.GET("/:id/bundle", middlewares.Authenticate(), r.controller.GetCourseBundleController().Export)
+	group.POST("/bundles", middlewares.Authenticate(), r.controller.GetCourseBundleController().Import)
+	group.PUT("/:id/lessons/order", middlewares.Authenticate(), r.controller.GetLessonController().Reorder)
+	group.PUT("/:id/prerequisites", middlewares.Authenticate(), r.controller.GetCoursePrerequisiteController().Set)
 }
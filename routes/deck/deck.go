@@ -0,0 +1,45 @@
+package routes
+
+import (
+	"manabu-service/controllers"
+	"manabu-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DeckRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IDeckRoute interface {
+	Run()
+}
+
+func NewDeckRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IDeckRoute {
+	return &DeckRoute{controller: controller, group: group}
+}
+
+func (r *DeckRoute) Run() {
+	group := r.group.Group("/decks")
+	group.GET("", middlewares.LenientAuthenticate(), r.controller.GetDeckController().GetPublic)
+	group.GET("/:id", middlewares.LenientAuthenticate(), r.controller.GetDeckController().GetByID)
+	group.POST("", middlewares.Authenticate(), r.controller.GetDeckController().Create)
+	group.PUT("/:id", middlewares.Authenticate(), r.controller.GetDeckController().Update)
+	group.DELETE("/:id", middlewares.Authenticate(), r.controller.GetDeckController().Delete)
+	group.PUT("/:id/vocabularies", middlewares.Authenticate(), r.controller.GetDeckController().SetVocabularies)
+	group.POST("/:id/cards", middlewares.Authenticate(), r.controller.GetDeckController().AddCard)
+	group.PUT("/:id/cards/:cardId", middlewares.Authenticate(), r.controller.GetDeckController().UpdateCard)
+	group.DELETE("/:id/cards/:cardId", middlewares.Authenticate(), r.controller.GetDeckController().DeleteCard)
+	group.POST("/:id/subscription", middlewares.Authenticate(), r.controller.GetDeckController().Subscribe)
+	group.DELETE("/:id/subscription", middlewares.Authenticate(), r.controller.GetDeckController().Unsubscribe)
+
+	// Decks and custom card reviews of the logged in user (all require authentication)
+	meGroup := r.group.Group("/me")
+	meGroup.Use(middlewares.Authenticate())
+
+	meGroup.GET("/decks", r.controller.GetDeckController().GetMine)
+	meGroup.GET("/deck-subscriptions", r.controller.GetDeckController().GetSubscribed)
+	meGroup.GET("/deck-cards", r.controller.GetDeckCardController().GetAll)
+	meGroup.POST("/deck-cards/:cardId/review", r.controller.GetDeckCardController().Review)
+}
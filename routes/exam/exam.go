@@ -0,0 +1,39 @@
+package routes
+
+import (
+	"manabu-service/controllers"
+	"manabu-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ExamRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IExamRoute interface {
+	Run()
+}
+
+func NewExamRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IExamRoute {
+	return &ExamRoute{controller: controller, group: group}
+}
+
+func (r *ExamRoute) Run() {
+	group := r.group.Group("/exams")
+
+	// Public endpoints
+	group.GET("", r.controller.GetExamController().GetAll)
+	group.GET("/:id", r.controller.GetExamController().GetByID)
+
+	// Learner endpoints (require authentication)
+	group.POST("/:id/start", middlewares.Authenticate(), r.controller.GetExamAttemptController().Start)
+
+	// Admin endpoints (require authentication)
+	group.POST("", middlewares.Authenticate(), r.controller.GetExamController().Create)
+	group.PUT("/:id", middlewares.Authenticate(), r.controller.GetExamController().Update)
+	group.DELETE("/:id", middlewares.Authenticate(), r.controller.GetExamController().Delete)
+	group.POST("/:id/publish", middlewares.Authenticate(), r.controller.GetExamController().Publish)
+	group.POST("/:id/unpublish", middlewares.Authenticate(), r.controller.GetExamController().Unpublish)
+}
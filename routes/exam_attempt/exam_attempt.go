@@ -0,0 +1,32 @@
+package routes
+
+import (
+	"manabu-service/controllers"
+	"manabu-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ExamAttemptRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IExamAttemptRoute interface {
+	Run()
+}
+
+func NewExamAttemptRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IExamAttemptRoute {
+	return &ExamAttemptRoute{controller: controller, group: group}
+}
+
+func (r *ExamAttemptRoute) Run() {
+	// Exam attempt routes (all require authentication)
+	attemptGroup := r.group.Group("/exam-attempts")
+	attemptGroup.Use(middlewares.Authenticate())
+
+	attemptGroup.GET("", r.controller.GetExamAttemptController().GetAll)
+	attemptGroup.GET("/:id", r.controller.GetExamAttemptController().GetByID)
+	attemptGroup.PUT("/:id/answers", r.controller.GetExamAttemptController().SaveAnswers)
+	attemptGroup.POST("/:id/submit", r.controller.GetExamAttemptController().Submit)
+}
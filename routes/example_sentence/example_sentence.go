@@ -0,0 +1,30 @@
+package routes
+
+import (
+	"manabu-service/controllers"
+	"manabu-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ExampleSentenceRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IExampleSentenceRoute interface {
+	Run()
+}
+
+func NewExampleSentenceRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IExampleSentenceRoute {
+	return &ExampleSentenceRoute{controller: controller, group: group}
+}
+
+func (r *ExampleSentenceRoute) Run() {
+	group := r.group.Group("/example-sentences")
+	group.GET("", r.controller.GetExampleSentenceController().GetAll)
+	group.GET("/:id", r.controller.GetExampleSentenceController().GetByID)
+	group.POST("", middlewares.Authenticate(), r.controller.GetExampleSentenceController().Create)
+	group.PUT("/:id", middlewares.Authenticate(), r.controller.GetExampleSentenceController().Update)
+	group.DELETE("/:id", middlewares.Authenticate(), r.controller.GetExampleSentenceController().Delete)
+}
@@ -28,11 +28,15 @@ func (r *ExerciseRoute) Run() {
 	exerciseGroup.GET("", r.controller.GetExerciseController().GetAll)
 	exerciseGroup.GET("/:id", r.controller.GetExerciseController().GetByID)
 	// Nested route: Get questions by exercise ID
-	exerciseGroup.GET("/:id/questions", r.controller.GetExerciseQuestionController().GetByExerciseID)
+	exerciseGroup.GET("/:id/questions", middlewares.LenientAuthenticate(), r.controller.GetExerciseQuestionController().GetByExerciseID)
+
+	// Learner endpoints (require authentication)
+	exerciseGroup.POST("/:id/attempts", middlewares.Authenticate(), r.controller.GetExerciseAttemptController().Start)
 
 	// Admin endpoints (require authentication)
 	exerciseGroup.POST("", middlewares.Authenticate(), r.controller.GetExerciseController().Create)
 	exerciseGroup.PUT("/:id", middlewares.Authenticate(), r.controller.GetExerciseController().Update)
 	exerciseGroup.DELETE("/:id", middlewares.Authenticate(), r.controller.GetExerciseController().Delete)
-	exerciseGroup.PATCH("/:id/publish", middlewares.Authenticate(), r.controller.GetExerciseController().UpdatePublishStatus)
+	exerciseGroup.PUT("/:id/questions/order", middlewares.Authenticate(), r.controller.GetExerciseQuestionController().Reorder)
+	exerciseGroup.POST("/:id/questions/generate", middlewares.Authenticate(), r.controller.GetQuizController().CreateQuestions)
 }
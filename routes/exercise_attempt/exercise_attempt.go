@@ -0,0 +1,30 @@
+package routes
+
+import (
+	"manabu-service/controllers"
+	"manabu-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ExerciseAttemptRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IExerciseAttemptRoute interface {
+	Run()
+}
+
+func NewExerciseAttemptRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IExerciseAttemptRoute {
+	return &ExerciseAttemptRoute{controller: controller, group: group}
+}
+
+func (r *ExerciseAttemptRoute) Run() {
+	// Exercise attempt routes (all require authentication)
+	attemptGroup := r.group.Group("/exercise-attempts")
+	attemptGroup.Use(middlewares.Authenticate())
+
+	attemptGroup.GET("/:id", r.controller.GetExerciseAttemptController().GetByID)
+	attemptGroup.POST("/:id/submit", r.controller.GetExerciseAttemptController().Submit)
+}
@@ -28,9 +28,12 @@ func (r *ExerciseQuestionRoute) Run() {
 	questionGroup.GET("", r.controller.GetExerciseQuestionController().GetAll)
 	questionGroup.GET("/:id", r.controller.GetExerciseQuestionController().GetByID)
 
+	// Learner endpoints (require authentication)
+	questionGroup.POST("/:id/check", middlewares.Authenticate(), r.controller.GetExerciseQuestionController().CheckAnswer)
+	questionGroup.POST("/:id/recordings", middlewares.Authenticate(), r.controller.GetSpeakingSubmissionController().Submit)
+
 	// Admin endpoints (require authentication)
 	questionGroup.POST("", middlewares.Authenticate(), r.controller.GetExerciseQuestionController().Create)
 	questionGroup.PUT("/:id", middlewares.Authenticate(), r.controller.GetExerciseQuestionController().Update)
 	questionGroup.DELETE("/:id", middlewares.Authenticate(), r.controller.GetExerciseQuestionController().Delete)
-	questionGroup.PATCH("/:id/publish", middlewares.Authenticate(), r.controller.GetExerciseQuestionController().UpdatePublishStatus)
 }
@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"manabu-service/controllers"
+
+	"github.com/gin-gonic/gin"
+)
+
+type FuriganaRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IFuriganaRoute interface {
+	Run()
+}
+
+func NewFuriganaRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IFuriganaRoute {
+	return &FuriganaRoute{controller: controller, group: group}
+}
+
+func (r *FuriganaRoute) Run() {
+	group := r.group.Group("/tools")
+	group.POST("/furigana", r.controller.GetFuriganaController().Generate)
+}
@@ -25,16 +25,23 @@ func (r *LessonRoute) Run() {
 	lessonGroup := r.group.Group("/lessons")
 
 	// Public endpoints
-	lessonGroup.GET("", r.controller.GetLessonController().GetAll)
-	lessonGroup.GET("/:id", r.controller.GetLessonController().GetByID)
+	lessonGroup.GET("", middlewares.LenientAuthenticate(), r.controller.GetLessonController().GetAll)
+	lessonGroup.GET("/:id", middlewares.LenientAuthenticate(), r.controller.GetLessonController().GetByID)
 
 	// Nested route: Get exercises by lesson ID
-	lessonGroup.GET("/:id/exercises", r.controller.GetExerciseController().GetByLessonID)
+	lessonGroup.GET("/:id/exercises", middlewares.LenientAuthenticate(), r.controller.GetExerciseController().GetByLessonID)
+
+	// Nested route: Get vocabulary taught by a lesson
+	lessonGroup.GET("/:id/vocabularies", middlewares.LenientAuthenticate(), r.controller.GetLessonVocabularyController().GetByLessonID)
 
 	// Admin endpoints (require authentication)
 	lessonGroup.POST("", middlewares.Authenticate(), r.controller.GetLessonController().Create)
 	lessonGroup.PUT("/:id", middlewares.Authenticate(), r.controller.GetLessonController().Update)
 	lessonGroup.DELETE("/:id", middlewares.Authenticate(), r.controller.GetLessonController().Delete)
-	lessonGroup.POST("/:id/publish", middlewares.Authenticate(), r.controller.GetLessonController().Publish)
-	lessonGroup.POST("/:id/unpublish", middlewares.Authenticate(), r.controller.GetLessonController().Unpublish)
+	lessonGroup.PUT("/:id/exercises/order", middlewares.Authenticate(), r.controller.GetExerciseController().Reorder)
+	lessonGroup.PUT("/:id/vocabularies", middlewares.Authenticate(), r.controller.GetLessonVocabularyController().Set)
+
+	// Learner endpoints (require authentication)
+	lessonGroup.POST("/:id/vocabularies/study", middlewares.Authenticate(), r.controller.GetLessonVocabularyController().Study)
+	lessonGroup.POST("/:id/blocks/:index/check", middlewares.Authenticate(), r.controller.GetLessonController().CheckBlockAnswer)
 }
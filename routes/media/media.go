@@ -0,0 +1,33 @@
+package routes
+
+import (
+	"manabu-service/controllers"
+	"manabu-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MediaRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IMediaRoute interface {
+	Run()
+}
+
+func NewMediaRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IMediaRoute {
+	return &MediaRoute{controller: controller, group: group}
+}
+
+func (r *MediaRoute) Run() {
+	mediaGroup := r.group.Group("/media")
+
+	// Public endpoints
+	mediaGroup.GET("/:id", r.controller.GetMediaController().GetByID)
+	mediaGroup.GET("/:id/file", r.controller.GetMediaController().GetFile)
+	mediaGroup.GET("/:id/thumbnail", r.controller.GetMediaController().GetThumbnail)
+
+	// Admin endpoints (require authentication)
+	mediaGroup.POST("", middlewares.Authenticate(), r.controller.GetMediaController().Upload)
+}
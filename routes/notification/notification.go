@@ -0,0 +1,31 @@
+package routes
+
+import (
+	"manabu-service/controllers"
+	"manabu-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type NotificationRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type INotificationRoute interface {
+	Run()
+}
+
+func NewNotificationRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) INotificationRoute {
+	return &NotificationRoute{controller: controller, group: group}
+}
+
+func (r *NotificationRoute) Run() {
+	// Notification routes (all require authentication)
+	notificationGroup := r.group.Group("/notifications")
+	notificationGroup.Use(middlewares.Authenticate())
+
+	notificationGroup.GET("", r.controller.GetNotificationController().GetAll)
+	notificationGroup.PATCH("/read-all", r.controller.GetNotificationController().MarkAllAsRead)
+	notificationGroup.PATCH("/:id/read", r.controller.GetNotificationController().MarkAsRead)
+}
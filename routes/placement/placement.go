@@ -0,0 +1,31 @@
+package routes
+
+import (
+	"manabu-service/controllers"
+	"manabu-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PlacementRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IPlacementRoute interface {
+	Run()
+}
+
+func NewPlacementRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IPlacementRoute {
+	return &PlacementRoute{controller: controller, group: group}
+}
+
+func (r *PlacementRoute) Run() {
+	// Placement test routes (all require authentication)
+	placementGroup := r.group.Group("/placement-tests")
+	placementGroup.Use(middlewares.Authenticate())
+
+	placementGroup.POST("", r.controller.GetPlacementController().Start)
+	placementGroup.GET("/:id", r.controller.GetPlacementController().GetByID)
+	placementGroup.POST("/:id/answers", r.controller.GetPlacementController().SubmitAnswer)
+}
@@ -2,17 +2,33 @@ package routes
 
 import (
 	"manabu-service/controllers"
+	bookmarkRoute "manabu-service/routes/bookmark"
 	categoryRoute "manabu-service/routes/category"
+	contentRevisionRoute "manabu-service/routes/content_revision"
+	contentWorkflowRoute "manabu-service/routes/content_workflow"
 	courseRoute "manabu-service/routes/course"
+	deckRoute "manabu-service/routes/deck"
+	examRoute "manabu-service/routes/exam"
+	examAttemptRoute "manabu-service/routes/exam_attempt"
+	exampleSentenceRoute "manabu-service/routes/example_sentence"
 	exerciseRoute "manabu-service/routes/exercise"
+	exerciseAttemptRoute "manabu-service/routes/exercise_attempt"
 	exerciseQuestionRoute "manabu-service/routes/exercise_question"
+	furiganaRoute "manabu-service/routes/furigana"
 	jlptLevelRoute "manabu-service/routes/jlpt_level"
 	lessonRoute "manabu-service/routes/lesson"
+	mediaRoute "manabu-service/routes/media"
+	notificationRoute "manabu-service/routes/notification"
+	placementRoute "manabu-service/routes/placement"
+	speakingSubmissionRoute "manabu-service/routes/speaking_submission"
 	tagRoute "manabu-service/routes/tag"
+	translationRoute "manabu-service/routes/translation"
+	trashRoute "manabu-service/routes/trash"
 	routes "manabu-service/routes/user"
 	userCourseProgressRoute "manabu-service/routes/user_course_progress"
 	userVocabStatusRoute "manabu-service/routes/user_vocabulary_status"
 	vocabularyRoute "manabu-service/routes/vocabulary"
+	vocabularyNoteRoute "manabu-service/routes/vocabulary_note"
 
 	"github.com/gin-gonic/gin"
 )
@@ -42,6 +58,22 @@ func (r *Registry) Serve() {
 	r.exerciseRoute().Run()
 	r.exerciseQuestionRoute().Run()
 	r.userCourseProgressRoute().Run()
+	r.exampleSentenceRoute().Run()
+	r.furiganaRoute().Run()
+	r.translationRoute().Run()
+	r.examRoute().Run()
+	r.examAttemptRoute().Run()
+	r.placementRoute().Run()
+	r.exerciseAttemptRoute().Run()
+	r.speakingSubmissionRoute().Run()
+	r.notificationRoute().Run()
+	r.mediaRoute().Run()
+	r.contentWorkflowRoute().Run()
+	r.contentRevisionRoute().Run()
+	r.trashRoute().Run()
+	r.vocabularyNoteRoute().Run()
+	r.deckRoute().Run()
+	r.bookmarkRoute().Run()
 }
 
 func (r *Registry) userRoute() routes.IUserRoute {
@@ -87,3 +119,67 @@ func (r *Registry) exerciseQuestionRoute() exerciseQuestionRoute.IExerciseQuesti
 func (r *Registry) userCourseProgressRoute() userCourseProgressRoute.IUserCourseProgressRoute {
 	return userCourseProgressRoute.NewUserCourseProgressRoute(r.controller, r.group)
 }
+
+func (r *Registry) exampleSentenceRoute() exampleSentenceRoute.IExampleSentenceRoute {
+	return exampleSentenceRoute.NewExampleSentenceRoute(r.controller, r.group)
+}
+
+func (r *Registry) furiganaRoute() furiganaRoute.IFuriganaRoute {
+	return furiganaRoute.NewFuriganaRoute(r.controller, r.group)
+}
+
+func (r *Registry) translationRoute() translationRoute.ITranslationRoute {
+	return translationRoute.NewTranslationRoute(r.controller, r.group)
+}
+
+func (r *Registry) examRoute() examRoute.IExamRoute {
+	return examRoute.NewExamRoute(r.controller, r.group)
+}
+
+func (r *Registry) examAttemptRoute() examAttemptRoute.IExamAttemptRoute {
+	return examAttemptRoute.NewExamAttemptRoute(r.controller, r.group)
+}
+
+func (r *Registry) placementRoute() placementRoute.IPlacementRoute {
+	return placementRoute.NewPlacementRoute(r.controller, r.group)
+}
+
+func (r *Registry) exerciseAttemptRoute() exerciseAttemptRoute.IExerciseAttemptRoute {
+	return exerciseAttemptRoute.NewExerciseAttemptRoute(r.controller, r.group)
+}
+
+func (r *Registry) speakingSubmissionRoute() speakingSubmissionRoute.ISpeakingSubmissionRoute {
+	return speakingSubmissionRoute.NewSpeakingSubmissionRoute(r.controller, r.group)
+}
+
+func (r *Registry) notificationRoute() notificationRoute.INotificationRoute {
+	return notificationRoute.NewNotificationRoute(r.controller, r.group)
+}
+
+func (r *Registry) mediaRoute() mediaRoute.IMediaRoute {
+	return mediaRoute.NewMediaRoute(r.controller, r.group)
+}
+
+func (r *Registry) contentWorkflowRoute() contentWorkflowRoute.IContentWorkflowRoute {
+	return contentWorkflowRoute.NewContentWorkflowRoute(r.controller, r.group)
+}
+
+func (r *Registry) contentRevisionRoute() contentRevisionRoute.IContentRevisionRoute {
+	return contentRevisionRoute.NewContentRevisionRoute(r.controller, r.group)
+}
+
+func (r *Registry) trashRoute() trashRoute.ITrashRoute {
+	return trashRoute.NewTrashRoute(r.controller, r.group)
+}
+
+func (r *Registry) vocabularyNoteRoute() vocabularyNoteRoute.IVocabularyNoteRoute {
+	return vocabularyNoteRoute.NewVocabularyNoteRoute(r.controller, r.group)
+}
+
+func (r *Registry) deckRoute() deckRoute.IDeckRoute {
+	return deckRoute.NewDeckRoute(r.controller, r.group)
+}
+
+func (r *Registry) bookmarkRoute() bookmarkRoute.IBookmarkRoute {
+	return bookmarkRoute.NewBookmarkRoute(r.controller, r.group)
+}
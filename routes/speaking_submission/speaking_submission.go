@@ -0,0 +1,33 @@
+package routes
+
+import (
+	"manabu-service/controllers"
+	"manabu-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SpeakingSubmissionRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type ISpeakingSubmissionRoute interface {
+	Run()
+}
+
+func NewSpeakingSubmissionRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) ISpeakingSubmissionRoute {
+	return &SpeakingSubmissionRoute{controller: controller, group: group}
+}
+
+func (r *SpeakingSubmissionRoute) Run() {
+	// Speaking submission routes (all require authentication)
+	submissionGroup := r.group.Group("/speaking-submissions")
+	submissionGroup.Use(middlewares.Authenticate())
+
+	submissionGroup.GET("", r.controller.GetSpeakingSubmissionController().GetAll)
+	submissionGroup.GET("/review-queue", r.controller.GetSpeakingSubmissionController().GetReviewQueue)
+	submissionGroup.GET("/:id", r.controller.GetSpeakingSubmissionController().GetByID)
+	submissionGroup.GET("/:id/audio", r.controller.GetSpeakingSubmissionController().GetAudio)
+	submissionGroup.POST("/:id/review", r.controller.GetSpeakingSubmissionController().Review)
+}
@@ -0,0 +1,30 @@
+package routes
+
+import (
+	"manabu-service/controllers"
+	"manabu-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TranslationRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type ITranslationRoute interface {
+	Run()
+}
+
+func NewTranslationRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) ITranslationRoute {
+	return &TranslationRoute{controller: controller, group: group}
+}
+
+func (r *TranslationRoute) Run() {
+	// Admin endpoints (require authentication)
+	group := r.group.Group("/translations", middlewares.Authenticate())
+	group.GET("", r.controller.GetTranslationController().GetAll)
+	group.GET("/missing", r.controller.GetTranslationController().GetMissing)
+	group.PUT("", r.controller.GetTranslationController().Upsert)
+	group.DELETE("/:id", r.controller.GetTranslationController().Delete)
+}
@@ -0,0 +1,30 @@
+package routes
+
+import (
+	"manabu-service/controllers"
+	"manabu-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TrashRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type ITrashRoute interface {
+	Run()
+}
+
+func NewTrashRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) ITrashRoute {
+	return &TrashRoute{controller: controller, group: group}
+}
+
+func (r *TrashRoute) Run() {
+	// Trash routes (all require authentication, admins only)
+	trashGroup := r.group.Group("/trash")
+	trashGroup.Use(middlewares.Authenticate())
+
+	trashGroup.GET("", r.controller.GetTrashController().GetAll)
+	trashGroup.POST("/:contentType/:id/restore", r.controller.GetTrashController().Restore)
+}
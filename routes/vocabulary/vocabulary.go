@@ -22,8 +22,11 @@ func NewVocabularyRoute(controller controllers.IControllerRegistry, group *gin.R
 
 func (r *VocabularyRoute) Run() {
 	group := r.group.Group("/vocabularies")
-	group.GET("", r.controller.GetVocabularyController().GetAll)
-	group.GET("/:id", r.controller.GetVocabularyController().GetByID)
+	group.GET("", middlewares.LenientAuthenticate(), r.controller.GetVocabularyController().GetAll)
+	group.GET("/quiz", r.controller.GetQuizController().Generate)
+	group.GET("/:id", middlewares.LenientAuthenticate(), r.controller.GetVocabularyController().GetByID)
+	group.GET("/:id/example-sentences", r.controller.GetExampleSentenceController().GetByVocabularyID)
+	group.GET("/:id/mnemonics", middlewares.LenientAuthenticate(), r.controller.GetVocabularyNoteController().GetShared)
 	group.POST("", middlewares.Authenticate(), r.controller.GetVocabularyController().Create)
 	group.PUT("/:id", middlewares.Authenticate(), r.controller.GetVocabularyController().Update)
 	group.DELETE("/:id", middlewares.Authenticate(), r.controller.GetVocabularyController().Delete)
@@ -0,0 +1,40 @@
+package routes
+
+import (
+	"manabu-service/controllers"
+	"manabu-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type VocabularyNoteRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IVocabularyNoteRoute interface {
+	Run()
+}
+
+func NewVocabularyNoteRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IVocabularyNoteRoute {
+	return &VocabularyNoteRoute{controller: controller, group: group}
+}
+
+func (r *VocabularyNoteRoute) Run() {
+	// Personal notes of the logged in user (all require authentication)
+	noteGroup := r.group.Group("/me/notes")
+	noteGroup.Use(middlewares.Authenticate())
+
+	noteGroup.GET("", r.controller.GetVocabularyNoteController().GetAll)
+	noteGroup.POST("", r.controller.GetVocabularyNoteController().Create)
+	noteGroup.GET("/:id", r.controller.GetVocabularyNoteController().GetByID)
+	noteGroup.PUT("/:id", r.controller.GetVocabularyNoteController().Update)
+	noteGroup.DELETE("/:id", r.controller.GetVocabularyNoteController().Delete)
+
+	// Upvotes of shared mnemonics (require authentication)
+	mnemonicGroup := r.group.Group("/mnemonics")
+	mnemonicGroup.Use(middlewares.Authenticate())
+
+	mnemonicGroup.POST("/:id/upvote", r.controller.GetVocabularyNoteController().Upvote)
+	mnemonicGroup.DELETE("/:id/upvote", r.controller.GetVocabularyNoteController().RemoveUpvote)
+}
@@ -0,0 +1,332 @@
+package services
+
+import (
+	"context"
+	"manabu-service/constants"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+	"manabu-service/repositories"
+	"math"
+	"strings"
+)
+
+type BookmarkService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+// IBookmarkService defines the contract for saving courses, lessons, vocabulary and questions for later.
+type IBookmarkService interface {
+	// Create bookmarks an item for the logged in user, optionally in one of their folders.
+	Create(context.Context, *dto.CreateBookmarkRequest) (*dto.BookmarkResponse, error)
+
+	// GetAll retrieves the bookmarks of the logged in user, newest first.
+	GetAll(context.Context, *dto.BookmarkFilterRequest) (*dto.BookmarkListResponse, error)
+
+	// Move moves a bookmark of the logged in user into one of their folders, or out of its folder.
+	Move(context.Context, uint, *dto.MoveBookmarkRequest) (*dto.BookmarkResponse, error)
+
+	// Delete removes the logged in user's bookmark of an item.
+	Delete(context.Context, *dto.BookmarkItemRequest) error
+
+	// GetFolders retrieves the bookmark folders of the logged in user by name.
+	GetFolders(context.Context) ([]dto.BookmarkFolderResponse, error)
+
+	// CreateFolder adds a bookmark folder for the logged in user.
+	CreateFolder(context.Context, *dto.BookmarkFolderRequest) (*dto.BookmarkFolderResponse, error)
+
+	// RenameFolder renames a bookmark folder of the logged in user.
+	RenameFolder(context.Context, uint, *dto.BookmarkFolderRequest) (*dto.BookmarkFolderResponse, error)
+
+	// DeleteFolder removes a bookmark folder of the logged in user. Its bookmarks are kept without a folder.
+	DeleteFolder(context.Context, uint) error
+}
+
+func NewBookmarkService(repository repositories.IRepositoryRegistry) IBookmarkService {
+	return &BookmarkService{repository: repository}
+}
+
+// getUserLogin returns the logged in user from context
+func (s *BookmarkService) getUserLogin(ctx context.Context) (*dto.UserResponse, error) {
+	userLogin, ok := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	if !ok || userLogin == nil {
+		return nil, errConstant.ErrUnauthorized
+	}
+	return userLogin, nil
+}
+
+// getOwnFolder retrieves a folder of the logged in user. Folders of other users are reported as not found.
+func (s *BookmarkService) getOwnFolder(ctx context.Context, userLogin *dto.UserResponse, id uint) (*models.BookmarkFolder, error) {
+	folder, err := s.repository.GetBookmark().GetFolderByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if folder.UserID != userLogin.UUID {
+		return nil, errConstant.ErrBookmarkFolderNotFound
+	}
+	return folder, nil
+}
+
+// toBookmarkResponses converts Bookmark models to BookmarkResponse DTOs with the titles of their items
+func (s *BookmarkService) toBookmarkResponses(ctx context.Context, bookmarks []models.Bookmark) ([]dto.BookmarkResponse, error) {
+	// Items are looked up once per content type
+	itemIDs := make(map[string][]uint)
+	for _, bookmark := range bookmarks {
+		itemIDs[bookmark.ItemType] = append(itemIDs[bookmark.ItemType], bookmark.ItemID)
+	}
+	titles := make(map[string]map[uint]string, len(itemIDs))
+	for itemType, ids := range itemIDs {
+		typeTitles, err := s.repository.GetBookmark().GetItemTitles(ctx, itemType, ids)
+		if err != nil {
+			return nil, err
+		}
+		titles[itemType] = typeTitles
+	}
+
+	responses := make([]dto.BookmarkResponse, 0, len(bookmarks))
+	for _, bookmark := range bookmarks {
+		title, available := titles[bookmark.ItemType][bookmark.ItemID]
+		response := dto.BookmarkResponse{
+			ID:          bookmark.ID,
+			ItemType:    bookmark.ItemType,
+			ItemID:      bookmark.ItemID,
+			Title:       title,
+			IsAvailable: available,
+			FolderID:    bookmark.FolderID,
+		}
+		if bookmark.Folder != nil {
+			response.FolderName = &bookmark.Folder.Name
+		}
+		if bookmark.CreatedAt != nil {
+			response.CreatedAt = bookmark.CreatedAt.Format("2006-01-02T15:04:05Z07:00")
+		}
+		responses = append(responses, response)
+	}
+	return responses, nil
+}
+
+// toBookmarkResponse converts a Bookmark model to BookmarkResponse DTO
+func (s *BookmarkService) toBookmarkResponse(ctx context.Context, bookmark *models.Bookmark) (*dto.BookmarkResponse, error) {
+	responses, err := s.toBookmarkResponses(ctx, []models.Bookmark{*bookmark})
+	if err != nil {
+		return nil, err
+	}
+	return &responses[0], nil
+}
+
+// toFolderResponse converts a BookmarkFolder model to BookmarkFolderResponse DTO
+func (s *BookmarkService) toFolderResponse(folder *models.BookmarkFolder, count int64) *dto.BookmarkFolderResponse {
+	response := &dto.BookmarkFolderResponse{
+		ID:            folder.ID,
+		Name:          folder.Name,
+		BookmarkCount: count,
+	}
+	if folder.CreatedAt != nil {
+		response.CreatedAt = folder.CreatedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	return response
+}
+
+func (s *BookmarkService) Create(ctx context.Context, req *dto.CreateBookmarkRequest) (*dto.BookmarkResponse, error) {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := s.repository.GetBookmark().ItemExists(ctx, req.ItemType, req.ItemID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errConstant.ErrBookmarkItemNotFound
+	}
+
+	bookmark := &models.Bookmark{
+		UserID:   userLogin.UUID,
+		ItemType: req.ItemType,
+		ItemID:   req.ItemID,
+	}
+	if req.FolderID != nil {
+		folder, err := s.getOwnFolder(ctx, userLogin, *req.FolderID)
+		if err != nil {
+			return nil, err
+		}
+		bookmark.FolderID = &folder.ID
+		bookmark.Folder = folder
+	}
+
+	err = s.repository.GetBookmark().Create(ctx, bookmark)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toBookmarkResponse(ctx, bookmark)
+}
+
+func (s *BookmarkService) GetAll(ctx context.Context, filter *dto.BookmarkFilterRequest) (*dto.BookmarkListResponse, error) {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set default pagination values
+	if filter == nil {
+		filter = &dto.BookmarkFilterRequest{}
+	}
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.Limit < 1 {
+		filter.Limit = 10
+	}
+	if filter.Limit > 100 {
+		filter.Limit = 100
+	}
+
+	bookmarks, total, err := s.repository.GetBookmark().GetAll(ctx, userLogin.UUID.String(), filter)
+	if err != nil {
+		return nil, err
+	}
+
+	responses, err := s.toBookmarkResponses(ctx, bookmarks)
+	if err != nil {
+		return nil, err
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(filter.Limit)))
+
+	return &dto.BookmarkListResponse{
+		Data: responses,
+		Pagination: dto.PaginationResponse{
+			Page:       filter.Page,
+			Limit:      filter.Limit,
+			TotalPages: totalPages,
+			TotalItems: total,
+		},
+	}, nil
+}
+
+func (s *BookmarkService) Move(ctx context.Context, id uint, req *dto.MoveBookmarkRequest) (*dto.BookmarkResponse, error) {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bookmark, err := s.repository.GetBookmark().GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if bookmark.UserID != userLogin.UUID {
+		return nil, errConstant.ErrBookmarkNotFound
+	}
+
+	bookmark.FolderID = nil
+	bookmark.Folder = nil
+	if req.FolderID != nil {
+		folder, err := s.getOwnFolder(ctx, userLogin, *req.FolderID)
+		if err != nil {
+			return nil, err
+		}
+		bookmark.FolderID = &folder.ID
+		bookmark.Folder = folder
+	}
+
+	err = s.repository.GetBookmark().Move(ctx, bookmark)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toBookmarkResponse(ctx, bookmark)
+}
+
+func (s *BookmarkService) Delete(ctx context.Context, req *dto.BookmarkItemRequest) error {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.repository.GetBookmark().DeleteByItem(ctx, userLogin.UUID.String(), req.ItemType, req.ItemID)
+}
+
+func (s *BookmarkService) GetFolders(ctx context.Context) ([]dto.BookmarkFolderResponse, error) {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	folders, err := s.repository.GetBookmark().GetFolders(ctx, userLogin.UUID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	folderIDs := make([]uint, 0, len(folders))
+	for _, folder := range folders {
+		folderIDs = append(folderIDs, folder.ID)
+	}
+	counts, err := s.repository.GetBookmark().CountByFolderIDs(ctx, folderIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dto.BookmarkFolderResponse, 0, len(folders))
+	for _, folder := range folders {
+		responses = append(responses, *s.toFolderResponse(&folder, counts[folder.ID]))
+	}
+	return responses, nil
+}
+
+func (s *BookmarkService) CreateFolder(ctx context.Context, req *dto.BookmarkFolderRequest) (*dto.BookmarkFolderResponse, error) {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	folder := &models.BookmarkFolder{
+		UserID: userLogin.UUID,
+		Name:   strings.TrimSpace(req.Name),
+	}
+	err = s.repository.GetBookmark().CreateFolder(ctx, folder)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toFolderResponse(folder, 0), nil
+}
+
+func (s *BookmarkService) RenameFolder(ctx context.Context, id uint, req *dto.BookmarkFolderRequest) (*dto.BookmarkFolderResponse, error) {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	folder, err := s.getOwnFolder(ctx, userLogin, id)
+	if err != nil {
+		return nil, err
+	}
+
+	folder.Name = strings.TrimSpace(req.Name)
+	err = s.repository.GetBookmark().RenameFolder(ctx, folder)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := s.repository.GetBookmark().CountByFolderIDs(ctx, []uint{folder.ID})
+	if err != nil {
+		return nil, err
+	}
+	return s.toFolderResponse(folder, counts[folder.ID]), nil
+}
+
+func (s *BookmarkService) DeleteFolder(ctx context.Context, id uint) error {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.getOwnFolder(ctx, userLogin, id)
+	if err != nil {
+		return err
+	}
+
+	return s.repository.GetBookmark().DeleteFolder(ctx, id)
+}
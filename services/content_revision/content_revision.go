@@ -0,0 +1,241 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"manabu-service/common/revision"
+	"manabu-service/constants"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+	"manabu-service/repositories"
+	"math"
+)
+
+type ContentRevisionService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+// IContentRevisionService defines the contract for the revision history of courses, lessons,
+// exercise questions and vocabulary. Content services record a revision in the transaction of
+// every change; restoring a revision is done by the content service so the snapshot is
+// validated again.
+type IContentRevisionService interface {
+	// Record stores a snapshot of a content item after a change. An update that changes
+	// nothing is not recorded. restoredFrom is the restored version for restore actions.
+	// It must run on a registry from Transaction after the content row was written, so the
+	// revision is stored together with the change and concurrent changes of the item wait
+	// for each other's version.
+	Record(context.Context, string, uint, string, interface{}, *int) error
+
+	// LoadSnapshot decodes the snapshot of a revision into target.
+	LoadSnapshot(context.Context, string, uint, int, interface{}) error
+
+	// GetAll retrieves the revisions of a content item with their changes, newest first.
+	GetAll(context.Context, string, uint, *dto.ContentRevisionFilterRequest) (*dto.ContentRevisionListResponse, error)
+
+	// GetByVersion retrieves a revision of a content item with its full snapshot.
+	GetByVersion(context.Context, string, uint, int) (*dto.ContentRevisionResponse, error)
+
+	// Diff compares two revisions of a content item.
+	Diff(context.Context, string, uint, *dto.ContentRevisionDiffRequest) (*dto.ContentRevisionDiffResponse, error)
+}
+
+func NewContentRevisionService(repository repositories.IRepositoryRegistry) IContentRevisionService {
+	return &ContentRevisionService{repository: repository}
+}
+
+// toChanges converts snapshot changes to their DTO form
+func (s *ContentRevisionService) toChanges(changes map[string]revision.Change) map[string]dto.RevisionChange {
+	response := make(map[string]dto.RevisionChange, len(changes))
+	for field, change := range changes {
+		response[field] = dto.RevisionChange{From: change.From, To: change.To}
+	}
+	return response
+}
+
+// toContentRevisionResponse converts a ContentRevision model to ContentRevisionResponse DTO
+func (s *ContentRevisionService) toContentRevisionResponse(contentRevision *models.ContentRevision) (*dto.ContentRevisionResponse, error) {
+	response := &dto.ContentRevisionResponse{
+		ContentType:  contentRevision.ContentType,
+		ContentID:    contentRevision.ContentID,
+		Version:      contentRevision.Version,
+		Action:       contentRevision.Action,
+		RestoredFrom: contentRevision.RestoredFrom,
+		Changes:      map[string]dto.RevisionChange{},
+	}
+
+	if contentRevision.Diff != "" {
+		var changes map[string]revision.Change
+		if err := json.Unmarshal([]byte(contentRevision.Diff), &changes); err != nil {
+			return nil, err
+		}
+		response.Changes = s.toChanges(changes)
+	}
+	if contentRevision.Snapshot != "" {
+		response.Snapshot = json.RawMessage(contentRevision.Snapshot)
+	}
+	if contentRevision.AuthorID != nil {
+		authorID := contentRevision.AuthorID.String()
+		response.AuthorID = &authorID
+	}
+	if contentRevision.CreatedAt != nil {
+		response.CreatedAt = contentRevision.CreatedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	return response, nil
+}
+
+func (s *ContentRevisionService) validateContentType(contentType string) error {
+	if !models.RevisionContentTypes[contentType] {
+		return errConstant.ErrInvalidRevisionContentType
+	}
+	return nil
+}
+
+func (s *ContentRevisionService) Record(ctx context.Context, contentType string, contentID uint, action string, snapshot interface{}, restoredFrom *int) error {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+
+	version := 1
+	previousSnapshot := ""
+	latest, err := s.repository.GetContentRevision().GetLatest(ctx, contentType, contentID)
+	if err != nil && err != errConstant.ErrContentRevisionNotFound {
+		return err
+	}
+	if latest != nil {
+		version = latest.Version + 1
+		previousSnapshot = latest.Snapshot
+	}
+
+	changes, err := revision.Diff(previousSnapshot, string(data))
+	if err != nil {
+		return err
+	}
+	if latest != nil && len(changes) == 0 && action == models.RevisionActionUpdate {
+		return nil
+	}
+	diff, err := json.Marshal(changes)
+	if err != nil {
+		return err
+	}
+
+	contentRevision := &models.ContentRevision{
+		ContentType:  contentType,
+		ContentID:    contentID,
+		Version:      version,
+		Action:       action,
+		Snapshot:     string(data),
+		Diff:         string(diff),
+		RestoredFrom: restoredFrom,
+	}
+	// Changes made outside a request, e.g. by seeders, have no author
+	if userLogin, ok := ctx.Value(constants.UserLogin).(*dto.UserResponse); ok && userLogin != nil {
+		contentRevision.AuthorID = &userLogin.UUID
+	}
+
+	return s.repository.GetContentRevision().Create(ctx, contentRevision)
+}
+
+func (s *ContentRevisionService) LoadSnapshot(ctx context.Context, contentType string, contentID uint, version int, target interface{}) error {
+	contentRevision, err := s.repository.GetContentRevision().GetByVersion(ctx, contentType, contentID, version)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal([]byte(contentRevision.Snapshot), target)
+}
+
+func (s *ContentRevisionService) GetAll(ctx context.Context, contentType string, contentID uint, filter *dto.ContentRevisionFilterRequest) (*dto.ContentRevisionListResponse, error) {
+	if err := s.validateContentType(contentType); err != nil {
+		return nil, err
+	}
+
+	// Set default pagination values
+	if filter == nil {
+		filter = &dto.ContentRevisionFilterRequest{
+			PaginationRequest: dto.PaginationRequest{
+				Page:  1,
+				Limit: 10,
+			},
+		}
+	}
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.Limit < 1 {
+		filter.Limit = 10
+	}
+	if filter.Limit > 100 {
+		filter.Limit = 100
+	}
+
+	revisions, total, err := s.repository.GetContentRevision().GetAll(ctx, contentType, contentID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dto.ContentRevisionResponse, 0, len(revisions))
+	for i := range revisions {
+		response, err := s.toContentRevisionResponse(&revisions[i])
+		if err != nil {
+			return nil, err
+		}
+		responses = append(responses, *response)
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(filter.Limit)))
+
+	return &dto.ContentRevisionListResponse{
+		Data: responses,
+		Pagination: dto.PaginationResponse{
+			Page:       filter.Page,
+			Limit:      filter.Limit,
+			TotalPages: totalPages,
+			TotalItems: total,
+		},
+	}, nil
+}
+
+func (s *ContentRevisionService) GetByVersion(ctx context.Context, contentType string, contentID uint, version int) (*dto.ContentRevisionResponse, error) {
+	if err := s.validateContentType(contentType); err != nil {
+		return nil, err
+	}
+
+	contentRevision, err := s.repository.GetContentRevision().GetByVersion(ctx, contentType, contentID, version)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toContentRevisionResponse(contentRevision)
+}
+
+func (s *ContentRevisionService) Diff(ctx context.Context, contentType string, contentID uint, req *dto.ContentRevisionDiffRequest) (*dto.ContentRevisionDiffResponse, error) {
+	if err := s.validateContentType(contentType); err != nil {
+		return nil, err
+	}
+
+	from, err := s.repository.GetContentRevision().GetByVersion(ctx, contentType, contentID, req.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.repository.GetContentRevision().GetByVersion(ctx, contentType, contentID, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := revision.Diff(from.Snapshot, to.Snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ContentRevisionDiffResponse{
+		ContentType: contentType,
+		ContentID:   contentID,
+		FromVersion: req.From,
+		ToVersion:   req.To,
+		Changes:     s.toChanges(changes),
+	}, nil
+}
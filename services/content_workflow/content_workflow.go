@@ -0,0 +1,403 @@
+package services
+
+import (
+	"context"
+	"manabu-service/common/workflow"
+	"manabu-service/constants"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+	"manabu-service/repositories"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ContentWorkflowService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+// IContentWorkflowService defines the contract for the editorial workflow of courses,
+// lessons, exercises and exercise questions. Teachers and admins write content and submit it
+// for review, and also approve, reject, publish and archive it. Learners take no part.
+type IContentWorkflowService interface {
+	// GetByContent retrieves the workflow status, history and comments of a content item.
+	GetByContent(context.Context, string, uint) (*dto.ContentWorkflowResponse, error)
+
+	// Transition applies a workflow action to a content item.
+	Transition(context.Context, string, uint, *dto.WorkflowTransitionRequest) (*dto.ContentWorkflowResponse, error)
+
+	// AssignReviewer assigns the teacher or admin responsible for reviewing a content item.
+	AssignReviewer(context.Context, string, uint, *dto.AssignWorkflowReviewerRequest) (*dto.ContentWorkflowResponse, error)
+
+	// AddComment adds a discussion comment to the workflow of a content item (teachers and admins only).
+	AddComment(context.Context, string, uint, *dto.WorkflowCommentRequest) (*dto.ContentWorkflowResponse, error)
+
+	// GetReviewQueue retrieves workflows waiting for review (reviewers only).
+	GetReviewQueue(context.Context, *dto.ContentWorkflowFilterRequest) (*dto.ContentWorkflowListResponse, error)
+}
+
+func NewContentWorkflowService(repository repositories.IRepositoryRegistry) IContentWorkflowService {
+	return &ContentWorkflowService{repository: repository}
+}
+
+func (s *ContentWorkflowService) getUserLogin(ctx context.Context) (*dto.UserResponse, error) {
+	userLogin, ok := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	if !ok || userLogin == nil {
+		return nil, errConstant.ErrUnauthorized
+	}
+	return userLogin, nil
+}
+
+// isReviewer reports whether the user can review content
+func (s *ContentWorkflowService) isReviewer(role string) bool {
+	return role == constants.RoleTeacher || role == constants.RoleAdmin
+}
+
+// isAuthor reports whether the user writes content and can take part in its workflow.
+// Learners only ever see published content.
+func (s *ContentWorkflowService) isAuthor(role string) bool {
+	return role == constants.RoleTeacher || role == constants.RoleAdmin
+}
+
+// initialStatus is the status of content that has no workflow yet
+func (s *ContentWorkflowService) initialStatus(ctx context.Context, contentType string, contentID uint) (string, error) {
+	if _, ok := models.ContentTypeTables[contentType]; !ok {
+		return "", errConstant.ErrInvalidContentType
+	}
+
+	isPublished, err := s.repository.GetContentWorkflow().IsContentPublished(ctx, contentType, contentID)
+	if err != nil {
+		return "", err
+	}
+	if isPublished {
+		return models.WorkflowStatusPublished, nil
+	}
+	return models.WorkflowStatusDraft, nil
+}
+
+// getOrCreate loads the workflow of existing content, creating it on first use
+func (s *ContentWorkflowService) getOrCreate(ctx context.Context, contentType string, contentID uint) (*models.ContentWorkflow, error) {
+	status, err := s.initialStatus(ctx, contentType, contentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repository.GetContentWorkflow().GetOrCreate(ctx, contentType, contentID, status)
+}
+
+// toContentWorkflowResponse converts a ContentWorkflow model to ContentWorkflowResponse DTO
+func (s *ContentWorkflowService) toContentWorkflowResponse(contentWorkflow *models.ContentWorkflow, isReviewer bool) *dto.ContentWorkflowResponse {
+	response := &dto.ContentWorkflowResponse{
+		ContentType:     contentWorkflow.ContentType,
+		ContentID:       contentWorkflow.ContentID,
+		Status:          contentWorkflow.Status,
+		RejectionReason: contentWorkflow.RejectionReason,
+		AllowedActions:  workflow.Actions(contentWorkflow.Status, isReviewer),
+	}
+
+	if contentWorkflow.ReviewerID != nil {
+		reviewerID := contentWorkflow.ReviewerID.String()
+		response.ReviewerID = &reviewerID
+	}
+	if contentWorkflow.SubmittedBy != nil {
+		submittedBy := contentWorkflow.SubmittedBy.String()
+		response.SubmittedBy = &submittedBy
+	}
+	if contentWorkflow.SubmittedAt != nil {
+		submittedAtStr := contentWorkflow.SubmittedAt.Format("2006-01-02T15:04:05Z07:00")
+		response.SubmittedAt = &submittedAtStr
+	}
+	if contentWorkflow.UpdatedAt != nil {
+		updatedAtStr := contentWorkflow.UpdatedAt.Format("2006-01-02T15:04:05Z07:00")
+		response.UpdatedAt = &updatedAtStr
+	}
+
+	for _, transition := range contentWorkflow.Transitions {
+		item := dto.WorkflowTransitionResponse{
+			ID:         transition.ID,
+			Action:     transition.Action,
+			FromStatus: transition.FromStatus,
+			ToStatus:   transition.ToStatus,
+			ActorID:    transition.ActorID.String(),
+			Reason:     transition.Reason,
+		}
+		if transition.CreatedAt != nil {
+			item.CreatedAt = transition.CreatedAt.Format("2006-01-02T15:04:05Z07:00")
+		}
+		response.Transitions = append(response.Transitions, item)
+	}
+
+	for _, comment := range contentWorkflow.Comments {
+		item := dto.WorkflowCommentResponse{
+			ID:       comment.ID,
+			AuthorID: comment.AuthorID.String(),
+			Body:     comment.Body,
+		}
+		if comment.CreatedAt != nil {
+			item.CreatedAt = comment.CreatedAt.Format("2006-01-02T15:04:05Z07:00")
+		}
+		response.Comments = append(response.Comments, item)
+	}
+
+	return response
+}
+
+func (s *ContentWorkflowService) GetByContent(ctx context.Context, contentType string, contentID uint) (*dto.ContentWorkflowResponse, error) {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := s.initialStatus(ctx, contentType, contentID)
+	if err != nil {
+		return nil, err
+	}
+
+	contentWorkflow, err := s.repository.GetContentWorkflow().GetByContent(ctx, contentType, contentID)
+	if err == errConstant.ErrContentWorkflowNotFound {
+		// Content that never entered the workflow is reported in its initial status
+		contentWorkflow = &models.ContentWorkflow{
+			ContentType: contentType,
+			ContentID:   contentID,
+			Status:      status,
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	response := s.toContentWorkflowResponse(contentWorkflow, s.isReviewer(userLogin.Role))
+	if !s.isAuthor(userLogin.Role) {
+		response.AllowedActions = []string{}
+	}
+	return response, nil
+}
+
+func (s *ContentWorkflowService) Transition(ctx context.Context, contentType string, contentID uint, req *dto.WorkflowTransitionRequest) (*dto.ContentWorkflowResponse, error) {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.isAuthor(userLogin.Role) {
+		return nil, errConstant.ErrWorkflowAuthorOnly
+	}
+
+	rule, err := workflow.Lookup(req.Action)
+	if err != nil {
+		return nil, err
+	}
+	if rule.ReviewerOnly && !s.isReviewer(userLogin.Role) {
+		return nil, errConstant.ErrWorkflowReviewerOnly
+	}
+	reason := strings.TrimSpace(req.Reason)
+	if rule.ReasonRequired && reason == "" {
+		return nil, errConstant.ErrWorkflowReasonRequired
+	}
+
+	if req.Action == workflow.ActionPublish {
+		return s.publish(ctx, userLogin, contentType, contentID, req.Cascade, reason)
+	}
+
+	contentWorkflow, err := s.getOrCreate(ctx, contentType, contentID)
+	if err != nil {
+		return nil, err
+	}
+
+	fromStatus := contentWorkflow.Status
+	toStatus, err := workflow.Next(fromStatus, req.Action)
+	if err != nil {
+		return nil, err
+	}
+
+	// Approvals are made by the assigned reviewer, and never by the submitter, unless an admin steps in
+	isAdmin := userLogin.Role == constants.RoleAdmin
+	if req.Action == workflow.ActionApprove || req.Action == workflow.ActionReject {
+		if contentWorkflow.ReviewerID != nil && *contentWorkflow.ReviewerID != userLogin.UUID && !isAdmin {
+			return nil, errConstant.ErrWorkflowNotAssignedToUser
+		}
+	}
+	if req.Action == workflow.ActionApprove && !isAdmin &&
+		contentWorkflow.SubmittedBy != nil && *contentWorkflow.SubmittedBy == userLogin.UUID {
+		return nil, errConstant.ErrWorkflowSelfApproval
+	}
+
+	contentWorkflow.Status = toStatus
+	switch req.Action {
+	case workflow.ActionSubmit:
+		now := time.Now()
+		contentWorkflow.SubmittedBy = &userLogin.UUID
+		contentWorkflow.SubmittedAt = &now
+		contentWorkflow.RejectionReason = ""
+	case workflow.ActionReject:
+		contentWorkflow.RejectionReason = reason
+	}
+
+	err = s.repository.GetContentWorkflow().ApplyTransition(ctx, contentWorkflow, fromStatus, &models.ContentWorkflowTransition{
+		Action:     req.Action,
+		FromStatus: fromStatus,
+		ToStatus:   toStatus,
+		ActorID:    userLogin.UUID,
+		Reason:     reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetByContent(ctx, contentType, contentID)
+}
+
+// publish publishes approved content, and in cascade mode its approved descendants, once
+// the publish rules of the course hierarchy are met
+func (s *ContentWorkflowService) publish(ctx context.Context, userLogin *dto.UserResponse, contentType string, contentID uint, cascade bool, reason string) (*dto.ContentWorkflowResponse, error) {
+	root, parentPublished, err := s.repository.GetContentWorkflow().GetContentTree(ctx, contentType, contentID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Published content can only be published again to cascade to its children
+	if root.Status != models.WorkflowStatusApproved && !(cascade && root.Status == models.WorkflowStatusPublished) {
+		return nil, errConstant.ErrInvalidWorkflowTransition
+	}
+
+	publish, issues := workflow.Plan(root, parentPublished, cascade)
+	if len(issues) > 0 {
+		return nil, errConstant.ErrContentNotReadyToPublish
+	}
+
+	if len(publish) > 0 {
+		err = s.repository.GetContentWorkflow().Publish(ctx, publish, userLogin.UUID, reason)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s.GetByContent(ctx, contentType, contentID)
+}
+
+func (s *ContentWorkflowService) AssignReviewer(ctx context.Context, contentType string, contentID uint, req *dto.AssignWorkflowReviewerRequest) (*dto.ContentWorkflowResponse, error) {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !s.isReviewer(userLogin.Role) {
+		return nil, errConstant.ErrWorkflowReviewerOnly
+	}
+
+	reviewerID, err := uuid.Parse(req.ReviewerID)
+	if err != nil {
+		return nil, errConstant.ErrInvalidWorkflowReviewer
+	}
+
+	// The assignee must be able to review
+	reviewer, err := s.repository.GetUser().FindByUUID(ctx, reviewerID.String())
+	if err != nil {
+		if err == errConstant.ErrUserNotFound {
+			return nil, errConstant.ErrInvalidWorkflowReviewer
+		}
+		return nil, err
+	}
+	if !s.isReviewer(strings.ToLower(reviewer.Role.Code)) {
+		return nil, errConstant.ErrInvalidWorkflowReviewer
+	}
+
+	contentWorkflow, err := s.getOrCreate(ctx, contentType, contentID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repository.GetContentWorkflow().AssignReviewer(ctx, contentWorkflow.ID, reviewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetByContent(ctx, contentType, contentID)
+}
+
+func (s *ContentWorkflowService) AddComment(ctx context.Context, contentType string, contentID uint, req *dto.WorkflowCommentRequest) (*dto.ContentWorkflowResponse, error) {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !s.isAuthor(userLogin.Role) {
+		return nil, errConstant.ErrWorkflowAuthorOnly
+	}
+
+	contentWorkflow, err := s.getOrCreate(ctx, contentType, contentID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repository.GetContentWorkflow().AddComment(ctx, &models.ContentWorkflowComment{
+		WorkflowID: contentWorkflow.ID,
+		AuthorID:   userLogin.UUID,
+		Body:       strings.TrimSpace(req.Body),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetByContent(ctx, contentType, contentID)
+}
+
+func (s *ContentWorkflowService) GetReviewQueue(ctx context.Context, filter *dto.ContentWorkflowFilterRequest) (*dto.ContentWorkflowListResponse, error) {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !s.isReviewer(userLogin.Role) {
+		return nil, errConstant.ErrWorkflowReviewerOnly
+	}
+
+	// Set default pagination values
+	if filter == nil {
+		filter = &dto.ContentWorkflowFilterRequest{
+			PaginationRequest: dto.PaginationRequest{
+				Page:  1,
+				Limit: 10,
+			},
+		}
+	}
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.Limit < 1 {
+		filter.Limit = 10
+	}
+	if filter.Limit > 100 {
+		filter.Limit = 100
+	}
+
+	// The queue shows content waiting for review unless another status is requested
+	if filter.Status == "" {
+		filter.Status = models.WorkflowStatusInReview
+	}
+	reviewerID := ""
+	if filter.AssignedToMe {
+		reviewerID = userLogin.UUID.String()
+	}
+
+	workflows, total, err := s.repository.GetContentWorkflow().GetAll(ctx, filter, reviewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dto.ContentWorkflowResponse, 0, len(workflows))
+	for _, contentWorkflow := range workflows {
+		responses = append(responses, *s.toContentWorkflowResponse(&contentWorkflow, true))
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(filter.Limit)))
+
+	return &dto.ContentWorkflowListResponse{
+		Data: responses,
+		Pagination: dto.PaginationResponse{
+			Page:       filter.Page,
+			Limit:      filter.Limit,
+			TotalPages: totalPages,
+			TotalItems: total,
+		},
+	}, nil
+}
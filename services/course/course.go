@@ -2,10 +2,14 @@ package services
 
 import (
 	"context"
+	"manabu-service/common/workflow"
+	"manabu-service/constants"
 	errConstant "manabu-service/constants/error"
 	"manabu-service/domain/dto"
 	"manabu-service/domain/models"
 	"manabu-service/repositories"
+	revisionService "manabu-service/services/content_revision"
+	mediaService "manabu-service/services/media"
 	"math"
 )
 
@@ -29,17 +33,22 @@ type ICourseService interface {
 	// Validates JLPT level existence and checks for duplicates.
 	Update(context.Context, *dto.UpdateCourseRequest, uint) (*dto.CourseResponse, error)
 
-	// Delete removes a course entry by ID if it exists.
-	Delete(context.Context, uint) error
-
-	// Publish marks a course as published with the current timestamp.
-	Publish(context.Context, uint) (*dto.CourseResponse, error)
+	// RestoreRevision updates a course with the snapshot of one of its revisions, recording a new revision.
+	RestoreRevision(context.Context, uint, int) (*dto.CourseResponse, error)
 
-	// Unpublish marks a course as unpublished.
-	Unpublish(context.Context, uint) (*dto.CourseResponse, error)
+	// Delete moves a course and its lessons, exercises and questions to the trash if it exists.
+	Delete(context.Context, uint) error
 
 	// GetPublished retrieves only published courses with filtering, sorting, and pagination.
 	GetPublished(context.Context, *dto.CourseFilterRequest) (*dto.CourseListResponse, error)
+
+	// GetPublishReadiness reports what publishing a course would publish and the issues blocking it,
+	// optionally cascading to its lessons, exercises and questions.
+	GetPublishReadiness(context.Context, uint, bool) (*dto.PublishReadinessResponse, error)
+
+	// Clone copies a course with all its lessons, exercises, questions and lesson vocabulary as an unpublished
+	// draft under a new title and JLPT level. Media references are copied unless excluded.
+	Clone(context.Context, uint, *dto.CloneCourseRequest) (*dto.CourseResponse, error)
 }
 
 func NewCourseService(repository repositories.IRepositoryRegistry) ICourseService {
@@ -59,6 +68,11 @@ func (s *CourseService) toCourseResponse(course *models.Course) *dto.CourseRespo
 		IsPublished:    course.IsPublished,
 	}
 
+	if course.ThumbnailMediaID != nil {
+		thumbnailMediaID := course.ThumbnailMediaID.String()
+		response.ThumbnailMediaID = &thumbnailMediaID
+	}
+
 	// Format PublishedAt as string if present
 	if course.PublishedAt != nil {
 		publishedAtStr := course.PublishedAt.Format("2006-01-02T15:04:05Z07:00")
@@ -78,6 +92,55 @@ func (s *CourseService) toCourseResponse(course *models.Course) *dto.CourseRespo
 	return response
 }
 
+// responsePointers returns pointers to the given course responses
+func (s *CourseService) responsePointers(responses []dto.CourseResponse) []*dto.CourseResponse {
+	pointers := make([]*dto.CourseResponse, len(responses))
+	for i := range responses {
+		pointers[i] = &responses[i]
+	}
+	return pointers
+}
+
+// addBookmarks marks which of the courses the logged in user has bookmarked. Responses to anonymous requests are left unmarked.
+func (s *CourseService) addBookmarks(ctx context.Context, responses ...*dto.CourseResponse) error {
+	userLogin, ok := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	if !ok || userLogin == nil || len(responses) == 0 {
+		return nil
+	}
+
+	ids := make([]uint, 0, len(responses))
+	for _, response := range responses {
+		ids = append(ids, response.ID)
+	}
+	bookmarkedIDs, err := s.repository.GetBookmark().GetBookmarkedIDs(ctx, userLogin.UUID.String(), models.ContentTypeCourse, ids)
+	if err != nil {
+		return err
+	}
+	bookmarked := make(map[uint]bool, len(bookmarkedIDs))
+	for _, id := range bookmarkedIDs {
+		bookmarked[id] = true
+	}
+
+	for _, response := range responses {
+		isBookmarked := bookmarked[response.ID]
+		response.IsBookmarked = &isBookmarked
+	}
+	return nil
+}
+
+// checkAuthor fails unless the logged in user writes content. A clone copies unpublished
+// content and the answers of every question into a course its creator controls.
+func (s *CourseService) checkAuthor(ctx context.Context) error {
+	userLogin, ok := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	if !ok || userLogin == nil {
+		return errConstant.ErrUnauthorized
+	}
+	if userLogin.Role != constants.RoleTeacher && userLogin.Role != constants.RoleAdmin {
+		return errConstant.ErrCourseCloneAuthorOnly
+	}
+	return nil
+}
+
 func (s *CourseService) isCourseExist(ctx context.Context, title string, jlptLevelID uint) bool {
 	course, err := s.repository.GetCourse().GetByTitleAndJlptLevel(ctx, title, jlptLevelID)
 	if err != nil {
@@ -108,6 +171,19 @@ func (s *CourseService) validateEstimatedHours(hours int) error {
 	return nil
 }
 
+// toRevisionSnapshot captures the editable fields of a course for its revision history
+func (s *CourseService) toRevisionSnapshot(course *models.Course) *dto.UpdateCourseRequest {
+	return &dto.UpdateCourseRequest{
+		Title:            course.Title,
+		Description:      course.Description,
+		JlptLevelID:      course.JlptLevelID,
+		ThumbnailURL:     course.ThumbnailURL,
+		ThumbnailMediaID: course.ThumbnailMediaID,
+		Difficulty:       course.Difficulty,
+		EstimatedHours:   course.EstimatedHours,
+	}
+}
+
 func (s *CourseService) Create(ctx context.Context, req *dto.CreateCourseRequest) (*dto.CourseResponse, error) {
 	// Validate JLPT level exists
 	if !s.isJlptLevelExist(ctx, req.JlptLevelID) {
@@ -133,7 +209,25 @@ func (s *CourseService) Create(ctx context.Context, req *dto.CreateCourseRequest
 		return nil, errConstant.ErrCourseDuplicate
 	}
 
-	course, err := s.repository.GetCourse().Create(ctx, req)
+	// Use the generated thumbnail of the referenced image
+	if req.ThumbnailMediaID != nil {
+		thumbnail, err := mediaService.NewMediaService(s.repository).Resolve(ctx, *req.ThumbnailMediaID, models.MediaKindImage)
+		if err != nil {
+			return nil, err
+		}
+		req.ThumbnailURL = thumbnail.ThumbnailURL
+	}
+
+	// The course and its first revision are stored together
+	var course *models.Course
+	err := s.repository.Transaction(ctx, func(tx repositories.IRepositoryRegistry) error {
+		var err error
+		course, err = tx.GetCourse().Create(ctx, req)
+		if err != nil {
+			return err
+		}
+		return revisionService.NewContentRevisionService(tx).Record(ctx, models.ContentTypeCourse, course.ID, models.RevisionActionCreate, s.toRevisionSnapshot(course), nil)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -177,6 +271,9 @@ func (s *CourseService) GetAll(ctx context.Context, filter *dto.CourseFilterRequ
 	for _, course := range courses {
 		responses = append(responses, *s.toCourseResponse(&course))
 	}
+	if err := s.addBookmarks(ctx, s.responsePointers(responses)...); err != nil {
+		return nil, err
+	}
 
 	totalPages := int(math.Ceil(float64(total) / float64(filter.Limit)))
 
@@ -197,10 +294,29 @@ func (s *CourseService) GetByID(ctx context.Context, id uint) (*dto.CourseRespon
 		return nil, err
 	}
 
-	return s.toCourseResponse(course), nil
+	response := s.toCourseResponse(course)
+	if err := s.addBookmarks(ctx, response); err != nil {
+		return nil, err
+	}
+	return response, nil
 }
 
 func (s *CourseService) Update(ctx context.Context, req *dto.UpdateCourseRequest, id uint) (*dto.CourseResponse, error) {
+	return s.update(ctx, req, id, nil)
+}
+
+func (s *CourseService) RestoreRevision(ctx context.Context, id uint, version int) (*dto.CourseResponse, error) {
+	req := &dto.UpdateCourseRequest{}
+	err := revisionService.NewContentRevisionService(s.repository).LoadSnapshot(ctx, models.ContentTypeCourse, id, version, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.update(ctx, req, id, &version)
+}
+
+// update validates and applies an update, recording it as a restore of the given version if set
+func (s *CourseService) update(ctx context.Context, req *dto.UpdateCourseRequest, id uint, restoredFrom *int) (*dto.CourseResponse, error) {
 	// Check if course exists
 	existingCourse, err := s.repository.GetCourse().GetByID(ctx, id)
 	if err != nil {
@@ -238,7 +354,30 @@ func (s *CourseService) Update(ctx context.Context, req *dto.UpdateCourseRequest
 		}
 	}
 
-	course, err := s.repository.GetCourse().Update(ctx, req, id)
+	// Use the generated thumbnail of the referenced image
+	if req.ThumbnailMediaID != nil {
+		thumbnail, err := mediaService.NewMediaService(s.repository).Resolve(ctx, *req.ThumbnailMediaID, models.MediaKindImage)
+		if err != nil {
+			return nil, err
+		}
+		req.ThumbnailURL = thumbnail.ThumbnailURL
+	}
+
+	action := models.RevisionActionUpdate
+	if restoredFrom != nil {
+		action = models.RevisionActionRestore
+	}
+
+	// The change and its revision are stored together
+	var course *models.Course
+	err = s.repository.Transaction(ctx, func(tx repositories.IRepositoryRegistry) error {
+		var err error
+		course, err = tx.GetCourse().Update(ctx, req, id)
+		if err != nil {
+			return err
+		}
+		return revisionService.NewContentRevisionService(tx).Record(ctx, models.ContentTypeCourse, course.ID, action, s.toRevisionSnapshot(course), restoredFrom)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -253,7 +392,7 @@ func (s *CourseService) Delete(ctx context.Context, id uint) error {
 		return err
 	}
 
-	err = s.repository.GetCourse().Delete(ctx, id)
+	err = s.repository.GetTrash().SoftDelete(ctx, models.ContentTypeCourse, id)
 	if err != nil {
 		return err
 	}
@@ -261,46 +400,6 @@ func (s *CourseService) Delete(ctx context.Context, id uint) error {
 	return nil
 }
 
-func (s *CourseService) Publish(ctx context.Context, id uint) (*dto.CourseResponse, error) {
-	// Check if course exists
-	existingCourse, err := s.repository.GetCourse().GetByID(ctx, id)
-	if err != nil {
-		return nil, err
-	}
-
-	// Check if already published
-	if existingCourse.IsPublished {
-		return nil, errConstant.ErrCourseAlreadyPublished
-	}
-
-	course, err := s.repository.GetCourse().Publish(ctx, id)
-	if err != nil {
-		return nil, err
-	}
-
-	return s.toCourseResponse(course), nil
-}
-
-func (s *CourseService) Unpublish(ctx context.Context, id uint) (*dto.CourseResponse, error) {
-	// Check if course exists
-	existingCourse, err := s.repository.GetCourse().GetByID(ctx, id)
-	if err != nil {
-		return nil, err
-	}
-
-	// Check if not published
-	if !existingCourse.IsPublished {
-		return nil, errConstant.ErrCourseNotPublished
-	}
-
-	course, err := s.repository.GetCourse().Unpublish(ctx, id)
-	if err != nil {
-		return nil, err
-	}
-
-	return s.toCourseResponse(course), nil
-}
-
 func (s *CourseService) GetPublished(ctx context.Context, filter *dto.CourseFilterRequest) (*dto.CourseListResponse, error) {
 	// Set default pagination values
 	if filter == nil {
@@ -337,6 +436,9 @@ func (s *CourseService) GetPublished(ctx context.Context, filter *dto.CourseFilt
 	for _, course := range courses {
 		responses = append(responses, *s.toCourseResponse(&course))
 	}
+	if err := s.addBookmarks(ctx, s.responsePointers(responses)...); err != nil {
+		return nil, err
+	}
 
 	totalPages := int(math.Ceil(float64(total) / float64(filter.Limit)))
 
@@ -350,3 +452,83 @@ func (s *CourseService) GetPublished(ctx context.Context, filter *dto.CourseFilt
 		},
 	}, nil
 }
+
+func (s *CourseService) GetPublishReadiness(ctx context.Context, id uint, cascade bool) (*dto.PublishReadinessResponse, error) {
+	root, parentPublished, err := s.repository.GetContentWorkflow().GetContentTree(ctx, models.ContentTypeCourse, id)
+	if err != nil {
+		if err == errConstant.ErrContentNotFound {
+			return nil, errConstant.ErrCourseNotFound
+		}
+		return nil, err
+	}
+
+	publish, issues := workflow.Plan(root, parentPublished, cascade)
+
+	response := &dto.PublishReadinessResponse{
+		ContentType: root.ContentType,
+		ContentID:   root.ContentID,
+		Status:      root.Status,
+		Cascade:     cascade,
+		Ready:       len(issues) == 0,
+		WillPublish: make([]dto.PublishReadinessItem, 0, len(publish)),
+		Issues:      make([]dto.PublishReadinessIssue, 0, len(issues)),
+	}
+	for _, node := range publish {
+		response.WillPublish = append(response.WillPublish, dto.PublishReadinessItem{
+			ContentType: node.ContentType,
+			ContentID:   node.ContentID,
+			Title:       node.Title,
+		})
+	}
+	for _, issue := range issues {
+		response.Issues = append(response.Issues, dto.PublishReadinessIssue{
+			ContentType: issue.ContentType,
+			ContentID:   issue.ContentID,
+			Title:       issue.Title,
+			Code:        issue.Code,
+			Message:     issue.Message,
+		})
+	}
+
+	return response, nil
+}
+
+func (s *CourseService) Clone(ctx context.Context, id uint, req *dto.CloneCourseRequest) (*dto.CourseResponse, error) {
+	if err := s.checkAuthor(ctx); err != nil {
+		return nil, err
+	}
+
+	// Check if course exists
+	_, err := s.repository.GetCourse().GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate JLPT level exists
+	if !s.isJlptLevelExist(ctx, req.JlptLevelID) {
+		return nil, errConstant.ErrInvalidJlptLevelIDCourse
+	}
+
+	// Check if course title already exists for this JLPT level
+	if s.isCourseExist(ctx, req.Title, req.JlptLevelID) {
+		return nil, errConstant.ErrCourseDuplicate
+	}
+
+	includeMedia := req.IncludeMedia == nil || *req.IncludeMedia
+
+	// The course and its first revision are stored together
+	var course *models.Course
+	err = s.repository.Transaction(ctx, func(tx repositories.IRepositoryRegistry) error {
+		var err error
+		course, err = tx.GetCourse().Clone(ctx, id, req, includeMedia)
+		if err != nil {
+			return err
+		}
+		return revisionService.NewContentRevisionService(tx).Record(ctx, models.ContentTypeCourse, course.ID, models.RevisionActionCreate, s.toRevisionSnapshot(course), nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toCourseResponse(course), nil
+}
@@ -0,0 +1,947 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"manabu-service/common/answer"
+	"manabu-service/common/bundle"
+	errWrap "manabu-service/common/error"
+	"manabu-service/common/markdown"
+	"manabu-service/constants"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+	"manabu-service/repositories"
+	courseBundleRepo "manabu-service/repositories/course_bundle"
+	revisionService "manabu-service/services/content_revision"
+	courseService "manabu-service/services/course"
+	exerciseQuestionService "manabu-service/services/exercise_question"
+	mediaService "manabu-service/services/media"
+	"path"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+// Conflict types reported by a bundle import
+const (
+	conflictValidation = "validation"
+	conflictJlptLevel  = "jlpt_level"
+	conflictCategory   = "category"
+	conflictCourse     = "course"
+	conflictVocabulary = "vocabulary"
+	conflictOrder      = "order"
+	conflictQuestion   = "question"
+	conflictMedia      = "media"
+)
+
+// unsafeFileName matches the characters replaced in the file name of an exported bundle
+var unsafeFileName = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+type CourseBundleService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+// ICourseBundleService defines the contract for exporting and importing courses as bundles:
+// zip archives holding a versioned manifest of the course tree and its vocabulary, plus the
+// media files the content references.
+type ICourseBundleService interface {
+	// Export builds the bundle of a course and returns it with its file name.
+	Export(context.Context, uint) ([]byte, string, error)
+
+	// Import validates a bundle, resolving JLPT levels and categories by code and name, and
+	// creates its course as an unpublished draft. Vocabulary that already exists is reused.
+	// A dry run only reports what would be imported. When the bundle has conflicts nothing is
+	// imported and ErrCourseBundleConflict is returned along with the report.
+	Import(context.Context, []byte, bool) (*dto.CourseBundleImportResponse, error)
+}
+
+func NewCourseBundleService(repository repositories.IRepositoryRegistry) ICourseBundleService {
+	return &CourseBundleService{repository: repository}
+}
+
+// mediaExporter collects the media files referenced by an exported course
+type mediaExporter struct {
+	ctx     context.Context
+	service mediaService.IMediaService
+	paths   map[uuid.UUID]string
+	media   []dto.BundleMedia
+	files   map[string][]byte
+}
+
+// add reads a media file into the bundle once and returns its path in the archive
+func (e *mediaExporter) add(id *uuid.UUID) (string, error) {
+	if id == nil {
+		return "", nil
+	}
+	if p, ok := e.paths[*id]; ok {
+		return p, nil
+	}
+
+	media, err := e.service.GetByID(e.ctx, *id)
+	if err != nil {
+		return "", err
+	}
+	file, _, err := e.service.Open(e.ctx, *id, false)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return "", err
+	}
+
+	p := bundle.MediaDir + id.String() + constants.MediaTypes[media.ContentType].Extension
+	e.paths[*id] = p
+	e.files[p] = content
+	e.media = append(e.media, dto.BundleMedia{
+		Path:        p,
+		Kind:        media.Kind,
+		FileName:    media.FileName,
+		ContentType: media.ContentType,
+	})
+	return p, nil
+}
+
+// checkAuthor fails unless the logged in user writes content. Bundles hold unpublished content
+// and the answers of every question, so learners cannot export or import them.
+func (s *CourseBundleService) checkAuthor(ctx context.Context) error {
+	userLogin, ok := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	if !ok || userLogin == nil {
+		return errConstant.ErrUnauthorized
+	}
+	if userLogin.Role != constants.RoleTeacher && userLogin.Role != constants.RoleAdmin {
+		return errConstant.ErrCourseBundleAuthorOnly
+	}
+	return nil
+}
+
+// exportURL keeps an external URL, dropping URLs of media files that are exported with the bundle
+func (s *CourseBundleService) exportURL(url, mediaPath string) string {
+	if mediaPath != "" {
+		return ""
+	}
+	return url
+}
+
+func (s *CourseBundleService) Export(ctx context.Context, id uint) ([]byte, string, error) {
+	if err := s.checkAuthor(ctx); err != nil {
+		return nil, "", err
+	}
+
+	tree, err := s.repository.GetCourseBundle().GetTree(ctx, id)
+	if err != nil {
+		return nil, "", err
+	}
+
+	media := &mediaExporter{
+		ctx:     ctx,
+		service: mediaService.NewMediaService(s.repository),
+		paths:   make(map[uuid.UUID]string),
+		files:   make(map[string][]byte),
+	}
+
+	thumbnail, err := media.add(tree.Course.ThumbnailMediaID)
+	if err != nil {
+		return nil, "", err
+	}
+	course := dto.BundleCourse{
+		Title:          tree.Course.Title,
+		Description:    tree.Course.Description,
+		JlptLevel:      tree.Course.JlptLevel.Code,
+		ThumbnailURL:   s.exportURL(tree.Course.ThumbnailURL, thumbnail),
+		Thumbnail:      thumbnail,
+		Difficulty:     tree.Course.Difficulty,
+		EstimatedHours: tree.Course.EstimatedHours,
+		Lessons:        make([]dto.BundleLesson, 0, len(tree.Lessons)),
+	}
+
+	// The vocabulary taught or shown by the lessons is exported once, however many lessons use it
+	vocabularies := make([]dto.BundleVocabulary, 0)
+	exportedVocabularies := make(map[uint]bool)
+	exportVocabulary := func(vocabulary *models.Vocabulary) error {
+		if exportedVocabularies[vocabulary.ID] {
+			return nil
+		}
+		exportedVocabularies[vocabulary.ID] = true
+
+		audio, err := media.add(vocabulary.AudioMediaID)
+		if err != nil {
+			return err
+		}
+		image, err := media.add(vocabulary.ImageMediaID)
+		if err != nil {
+			return err
+		}
+		vocabularies = append(vocabularies, dto.BundleVocabulary{
+			Word:                   vocabulary.Word,
+			Reading:                vocabulary.Reading,
+			Meaning:                vocabulary.Meaning,
+			PartOfSpeech:           vocabulary.PartOfSpeech,
+			JlptLevel:              vocabulary.JlptLevel.Code,
+			Category:               vocabulary.Category.Name,
+			ExampleSentence:        vocabulary.ExampleSentence,
+			ExampleSentenceReading: vocabulary.ExampleSentenceReading,
+			ExampleSentenceMeaning: vocabulary.ExampleSentenceMeaning,
+			AudioURL:               s.exportURL(vocabulary.AudioURL, audio),
+			ImageURL:               s.exportURL(vocabulary.ImageURL, image),
+			Audio:                  audio,
+			Image:                  image,
+			Difficulty:             vocabulary.Difficulty,
+		})
+		return nil
+	}
+
+	for _, lessonTree := range tree.Lessons {
+		lesson := dto.BundleLesson{
+			Title:            lessonTree.Lesson.Title,
+			Content:          lessonTree.Lesson.Content,
+			OrderIndex:       lessonTree.Lesson.OrderIndex,
+			EstimatedMinutes: lessonTree.Lesson.EstimatedMinutes,
+			UnlockRule:       lessonTree.Lesson.UnlockRule,
+			UnlockMinScore:   lessonTree.Lesson.UnlockMinScore,
+			Exercises:        make([]dto.BundleExercise, 0, len(lessonTree.Exercises)),
+		}
+
+		for _, exerciseTree := range lessonTree.Exercises {
+			exercise := dto.BundleExercise{
+				Title:            exerciseTree.Exercise.Title,
+				Description:      exerciseTree.Exercise.Description,
+				ExerciseType:     exerciseTree.Exercise.ExerciseType,
+				OrderIndex:       exerciseTree.Exercise.OrderIndex,
+				DifficultyLevel:  exerciseTree.Exercise.DifficultyLevel,
+				EstimatedMinutes: exerciseTree.Exercise.EstimatedMinutes,
+				QuestionPoolSize: exerciseTree.Exercise.QuestionPoolSize,
+				ShuffleQuestions: exerciseTree.Exercise.ShuffleQuestions,
+				ShuffleOptions:   exerciseTree.Exercise.ShuffleOptions,
+				Questions:        make([]dto.BundleQuestion, 0, len(exerciseTree.Questions)),
+			}
+
+			for _, question := range exerciseTree.Questions {
+				audio, err := media.add(question.AudioMediaID)
+				if err != nil {
+					return nil, "", err
+				}
+				image, err := media.add(question.ImageMediaID)
+				if err != nil {
+					return nil, "", err
+				}
+
+				exercise.Questions = append(exercise.Questions, dto.BundleQuestion{
+					QuestionText:     question.QuestionText,
+					QuestionType:     question.QuestionType,
+					Options:          answer.DecodeOptions(question.Options),
+					CorrectAnswer:    answer.DecodeCorrectAnswer(question.CorrectAnswer),
+					AnswerStrictness: question.AnswerStrictness,
+					JlptSection:      question.JlptSection,
+					Explanation:      question.Explanation,
+					AudioURL:         s.exportURL(question.AudioURL, audio),
+					ImageURL:         s.exportURL(question.ImageURL, image),
+					Audio:            audio,
+					Image:            image,
+					OrderIndex:       question.OrderIndex,
+					Points:           question.Points,
+				})
+			}
+
+			lesson.Exercises = append(lesson.Exercises, exercise)
+		}
+
+		for _, vocabulary := range lessonTree.Vocabularies {
+			lesson.Vocabularies = append(lesson.Vocabularies, dto.BundleWordRef{
+				Word:      vocabulary.Word,
+				JlptLevel: vocabulary.JlptLevel.Code,
+			})
+			if err := exportVocabulary(vocabulary); err != nil {
+				return nil, "", err
+			}
+		}
+
+		for _, block := range s.decodeLessonBlocks(lessonTree.Lesson.Blocks) {
+			bundleBlock := dto.BundleBlock{
+				Type:     block.Type,
+				Markdown: block.Markdown,
+				Caption:  block.Caption,
+				AltText:  block.AltText,
+				Grammar:  block.Grammar,
+				Question: block.Question,
+			}
+			bundleBlock.Media, err = media.add(block.MediaID)
+			if err != nil {
+				return nil, "", err
+			}
+			bundleBlock.URL = s.exportURL(block.URL, bundleBlock.Media)
+
+			if block.Type == models.LessonBlockVocabulary {
+				vocabulary, err := s.repository.GetVocabulary().GetByID(ctx, block.VocabularyID)
+				if errors.Is(err, errConstant.ErrVocabularyNotFound) {
+					// The word was deleted since the block was written
+					continue
+				}
+				if err != nil {
+					return nil, "", err
+				}
+				bundleBlock.Vocabulary = &dto.BundleWordRef{Word: vocabulary.Word, JlptLevel: vocabulary.JlptLevel.Code}
+				if err := exportVocabulary(vocabulary); err != nil {
+					return nil, "", err
+				}
+			}
+
+			lesson.Blocks = append(lesson.Blocks, bundleBlock)
+		}
+
+		course.Lessons = append(course.Lessons, lesson)
+	}
+
+	manifest := &dto.CourseBundle{
+		FormatVersion: constants.CourseBundleFormatVersion,
+		ExportedAt:    time.Now().Format("2006-01-02T15:04:05Z07:00"),
+		Course:        course,
+		Vocabularies:  vocabularies,
+		Media:         media.media,
+	}
+	if manifest.Media == nil {
+		manifest.Media = []dto.BundleMedia{}
+	}
+
+	var buf bytes.Buffer
+	if err := bundle.Write(&buf, manifest, media.files); err != nil {
+		return nil, "", errWrap.WrapError(err)
+	}
+
+	fileName := strings.Trim(unsafeFileName.ReplaceAllString(strings.ToLower(tree.Course.Title), "-"), "-.")
+	if fileName == "" {
+		fileName = "course"
+	}
+	return buf.Bytes(), fmt.Sprintf("%s-%d.zip", fileName, tree.Course.ID), nil
+}
+
+// decodeLessonBlocks parses the stored content blocks of a lesson, returning nil when absent or malformed
+func (s *CourseBundleService) decodeLessonBlocks(raw *string) []dto.LessonBlock {
+	if raw == nil || *raw == "" {
+		return nil
+	}
+	var blocks []dto.LessonBlock
+	if err := json.Unmarshal([]byte(*raw), &blocks); err != nil {
+		return nil
+	}
+	return blocks
+}
+
+// bundleImport holds the state of a bundle import while its manifest is checked
+type bundleImport struct {
+	ctx        context.Context
+	repository repositories.IRepositoryRegistry
+	manifest   *dto.CourseBundle
+	media      map[string]dto.BundleMedia
+	levels     map[string]*models.JlptLevel
+	report     *dto.CourseBundleImportResponse
+
+	// vocabularyMedia holds the audio and image references of the vocabularies to create
+	vocabularyMedia [][2]string
+
+	// existingVocabularies and newVocabularies resolve the vocabularies of the manifest by JLPT
+	// level code and word, to the reused entries and to the index of the entries to create
+	existingVocabularies map[string]*models.Vocabulary
+	newVocabularies      map[string]int
+}
+
+// vocabularyKey identifies a word within a JLPT level in the manifest
+func vocabularyKey(jlptLevel, word string) string {
+	return jlptLevel + "\x00" + word
+}
+
+// conflict records a problem that prevents the import
+func (b *bundleImport) conflict(conflictType, path, message string) {
+	b.report.Conflicts = append(b.report.Conflicts, dto.CourseBundleConflict{
+		Type:    conflictType,
+		Path:    path,
+		Message: message,
+	})
+}
+
+// jlptLevel resolves a JLPT level by code, reporting unknown codes
+func (b *bundleImport) jlptLevel(code, path string) (*models.JlptLevel, error) {
+	if level, ok := b.levels[code]; ok {
+		return level, nil
+	}
+
+	level, err := b.repository.GetJlptLevel().GetByCode(b.ctx, code)
+	if err != nil && !errors.Is(err, errConstant.ErrJlptLevelNotFound) {
+		return nil, err
+	}
+	if level == nil {
+		b.conflict(conflictJlptLevel, path, fmt.Sprintf("JLPT level %s does not exist", code))
+	}
+	b.levels[code] = level
+	return level, nil
+}
+
+// checkMedia reports a media reference that is not a file of the expected kind in the bundle
+func (b *bundleImport) checkMedia(ref, kind, path string) {
+	if ref == "" {
+		return
+	}
+	media, ok := b.media[ref]
+	if !ok {
+		b.conflict(conflictMedia, path, fmt.Sprintf("media file %s is not declared in the bundle", ref))
+		return
+	}
+	if media.Kind != kind {
+		b.conflict(conflictMedia, path, fmt.Sprintf("media file %s is not an %s file", ref, kind))
+	}
+}
+
+// validate checks the manifest fields, reporting each failed rule with its manifest path
+func (b *bundleImport) validate() {
+	validate := validator.New()
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+
+	err := validate.Struct(b.manifest)
+	var fieldErrors validator.ValidationErrors
+	if !errors.As(err, &fieldErrors) {
+		return
+	}
+	messages := errWrap.ErrValidationResponse(err)
+	for i, fieldError := range fieldErrors {
+		// Drop the name of the manifest type from the namespace
+		namespace := fieldError.Namespace()
+		if _, rest, ok := strings.Cut(namespace, "."); ok {
+			namespace = rest
+		}
+		b.conflict(conflictValidation, namespace, messages[i].Message)
+	}
+}
+
+func (s *CourseBundleService) Import(ctx context.Context, data []byte, dryRun bool) (*dto.CourseBundleImportResponse, error) {
+	if err := s.checkAuthor(ctx); err != nil {
+		return nil, err
+	}
+
+	manifest, files, err := bundle.Read(data)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &bundleImport{
+		ctx:        ctx,
+		repository: s.repository,
+		manifest:   manifest,
+		media:      make(map[string]dto.BundleMedia, len(manifest.Media)),
+		levels:     make(map[string]*models.JlptLevel),
+
+		existingVocabularies: make(map[string]*models.Vocabulary),
+		newVocabularies:      make(map[string]int),
+		report: &dto.CourseBundleImportResponse{
+			DryRun:    dryRun,
+			Conflicts: []dto.CourseBundleConflict{},
+		},
+	}
+
+	b.validate()
+	for i, media := range manifest.Media {
+		mediaType, ok := constants.MediaTypes[media.ContentType]
+		if !ok || mediaType.Kind != media.Kind {
+			b.conflict(conflictMedia, fmt.Sprintf("media[%d]", i), fmt.Sprintf("unsupported %s content type %s", media.Kind, media.ContentType))
+		}
+		b.media[media.Path] = media
+	}
+	b.report.Media = len(manifest.Media)
+
+	tree, err := s.buildCourse(b)
+	if err != nil {
+		return nil, err
+	}
+	vocabularies, err := s.buildVocabularies(b)
+	if err != nil {
+		return nil, err
+	}
+	err = s.linkVocabularies(b, tree, vocabularies)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(b.report.Conflicts) > 0 {
+		if dryRun {
+			return b.report, nil
+		}
+		return b.report, errConstant.ErrCourseBundleConflict
+	}
+	if dryRun {
+		return b.report, nil
+	}
+
+	// Store the media files, then point the content at them
+	mediaIDs := make(map[string]*dto.MediaResponse, len(manifest.Media))
+	media := mediaService.NewMediaService(s.repository)
+	for _, item := range manifest.Media {
+		fileName := item.FileName
+		if fileName == "" {
+			fileName = path.Base(item.Path)
+		}
+		saved, err := media.Save(ctx, fileName, item.ContentType, files[item.Path])
+		if err != nil {
+			return nil, err
+		}
+		mediaIDs[item.Path] = saved
+	}
+	s.applyMedia(b, tree, vocabularies, mediaIDs)
+
+	// The course and its first revision are stored together
+	var course *models.Course
+	err = s.repository.Transaction(ctx, func(tx repositories.IRepositoryRegistry) error {
+		var err error
+		course, err = tx.GetCourseBundle().Create(ctx, tree, vocabularies)
+		if err != nil {
+			return err
+		}
+		return revisionService.NewContentRevisionService(tx).Record(ctx, models.ContentTypeCourse, course.ID, models.RevisionActionCreate, &dto.UpdateCourseRequest{
+			Title:            course.Title,
+			Description:      course.Description,
+			JlptLevelID:      course.JlptLevelID,
+			ThumbnailURL:     course.ThumbnailURL,
+			ThumbnailMediaID: course.ThumbnailMediaID,
+			Difficulty:       course.Difficulty,
+			EstimatedHours:   course.EstimatedHours,
+		}, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	b.report.Course, err = courseService.NewCourseService(s.repository).GetByID(ctx, course.ID)
+	if err != nil {
+		return nil, err
+	}
+	b.report.Imported = true
+
+	return b.report, nil
+}
+
+// buildCourse checks the course of the manifest and converts it to a course tree.
+// Media references are resolved once the media files are stored.
+func (s *CourseBundleService) buildCourse(b *bundleImport) (*courseBundleRepo.CourseTree, error) {
+	bundleCourse := b.manifest.Course
+	tree := &courseBundleRepo.CourseTree{
+		Course: models.Course{
+			Title:          bundleCourse.Title,
+			Description:    bundleCourse.Description,
+			ThumbnailURL:   bundleCourse.ThumbnailURL,
+			Difficulty:     bundleCourse.Difficulty,
+			EstimatedHours: bundleCourse.EstimatedHours,
+		},
+	}
+
+	if bundleCourse.JlptLevel != "" {
+		level, err := b.jlptLevel(bundleCourse.JlptLevel, "course.jlptLevel")
+		if err != nil {
+			return nil, err
+		}
+		if level != nil {
+			tree.Course.JlptLevelID = level.ID
+			existing, err := b.repository.GetCourse().GetByTitleAndJlptLevel(b.ctx, bundleCourse.Title, level.ID)
+			if err == nil && existing != nil {
+				b.conflict(conflictCourse, "course.title", fmt.Sprintf("a course titled %q already exists for JLPT level %s", bundleCourse.Title, level.Code))
+			}
+		}
+	}
+	b.checkMedia(bundleCourse.Thumbnail, models.MediaKindImage, "course.thumbnail")
+
+	questionService := exerciseQuestionService.NewExerciseQuestionService(b.repository)
+	lessonOrders := make(map[int]bool, len(bundleCourse.Lessons))
+	for i, bundleLesson := range bundleCourse.Lessons {
+		lessonPath := fmt.Sprintf("course.lessons[%d]", i)
+		if lessonOrders[bundleLesson.OrderIndex] {
+			b.conflict(conflictOrder, lessonPath+".orderIndex", fmt.Sprintf("another lesson already has order index %d", bundleLesson.OrderIndex))
+		}
+		lessonOrders[bundleLesson.OrderIndex] = true
+
+		unlockRule := bundleLesson.UnlockRule
+		if unlockRule == "" {
+			unlockRule = models.LessonUnlockRuleNone
+		}
+		if (unlockRule == models.LessonUnlockRulePreviousScore) != (bundleLesson.UnlockMinScore > 0) {
+			b.conflict(conflictValidation, lessonPath+".unlockMinScore", errConstant.ErrInvalidLessonUnlockScore.Error())
+		}
+
+		lesson := courseBundleRepo.LessonTree{
+			Lesson: models.Lesson{
+				Title:            bundleLesson.Title,
+				Content:          markdown.Sanitize(bundleLesson.Content),
+				OrderIndex:       bundleLesson.OrderIndex,
+				EstimatedMinutes: bundleLesson.EstimatedMinutes,
+				UnlockRule:       unlockRule,
+				UnlockMinScore:   bundleLesson.UnlockMinScore,
+			},
+		}
+
+		for j, bundleBlock := range bundleLesson.Blocks {
+			blockPath := fmt.Sprintf("%s.blocks[%d]", lessonPath, j)
+			lesson.Blocks = append(lesson.Blocks, s.toBlock(b, &bundleBlock, blockPath))
+		}
+
+		exerciseOrders := make(map[int]bool, len(bundleLesson.Exercises))
+		for j, bundleExercise := range bundleLesson.Exercises {
+			exercisePath := fmt.Sprintf("%s.exercises[%d]", lessonPath, j)
+			if exerciseOrders[bundleExercise.OrderIndex] {
+				b.conflict(conflictOrder, exercisePath+".orderIndex", fmt.Sprintf("another exercise of the lesson already has order index %d", bundleExercise.OrderIndex))
+			}
+			exerciseOrders[bundleExercise.OrderIndex] = true
+
+			exercise := courseBundleRepo.ExerciseTree{
+				Exercise: models.Exercise{
+					Title:            bundleExercise.Title,
+					Description:      bundleExercise.Description,
+					ExerciseType:     bundleExercise.ExerciseType,
+					OrderIndex:       bundleExercise.OrderIndex,
+					DifficultyLevel:  bundleExercise.DifficultyLevel,
+					EstimatedMinutes: bundleExercise.EstimatedMinutes,
+					QuestionPoolSize: bundleExercise.QuestionPoolSize,
+					ShuffleQuestions: bundleExercise.ShuffleQuestions,
+					ShuffleOptions:   bundleExercise.ShuffleOptions,
+				},
+			}
+
+			questionOrders := make(map[int]bool, len(bundleExercise.Questions))
+			for k, bundleQuestion := range bundleExercise.Questions {
+				questionPath := fmt.Sprintf("%s.questions[%d]", exercisePath, k)
+				if questionOrders[bundleQuestion.OrderIndex] {
+					b.conflict(conflictOrder, questionPath+".orderIndex", fmt.Sprintf("another question of the exercise already has order index %d", bundleQuestion.OrderIndex))
+				}
+				questionOrders[bundleQuestion.OrderIndex] = true
+
+				err := questionService.ValidateContent(&dto.CreateExerciseQuestionRequest{
+					QuestionText:     bundleQuestion.QuestionText,
+					QuestionType:     bundleQuestion.QuestionType,
+					Options:          bundleQuestion.Options,
+					CorrectAnswer:    bundleQuestion.CorrectAnswer,
+					AnswerStrictness: bundleQuestion.AnswerStrictness,
+					OrderIndex:       bundleQuestion.OrderIndex,
+					Points:           bundleQuestion.Points,
+				})
+				if err != nil {
+					b.conflict(conflictQuestion, questionPath, err.Error())
+				}
+				b.checkMedia(bundleQuestion.Audio, models.MediaKindAudio, questionPath+".audio")
+				b.checkMedia(bundleQuestion.Image, models.MediaKindImage, questionPath+".image")
+
+				question, err := s.toQuestion(&bundleQuestion)
+				if err != nil {
+					b.conflict(conflictQuestion, questionPath, errConstant.ErrInvalidQuestionOptions.Error())
+					continue
+				}
+				exercise.Questions = append(exercise.Questions, *question)
+			}
+
+			lesson.Exercises = append(lesson.Exercises, exercise)
+		}
+
+		tree.Lessons = append(tree.Lessons, lesson)
+	}
+
+	b.report.Lessons = len(tree.Lessons)
+	for _, lesson := range tree.Lessons {
+		b.report.Exercises += len(lesson.Exercises)
+		for _, exercise := range lesson.Exercises {
+			b.report.Questions += len(exercise.Questions)
+		}
+	}
+
+	return tree, nil
+}
+
+// toBlock checks a content block of the manifest has the fields of its type and converts it,
+// sanitising its markdown. Media and vocabulary references are resolved later.
+func (s *CourseBundleService) toBlock(b *bundleImport, bundleBlock *dto.BundleBlock, blockPath string) dto.LessonBlock {
+	block := dto.LessonBlock{Type: bundleBlock.Type}
+	invalid := false
+
+	switch bundleBlock.Type {
+	case models.LessonBlockMarkdown:
+		block.Markdown = markdown.Sanitize(bundleBlock.Markdown)
+		invalid = block.Markdown == ""
+
+	case models.LessonBlockAudio, models.LessonBlockImage:
+		kind := models.MediaKindAudio
+		if bundleBlock.Type == models.LessonBlockImage {
+			kind = models.MediaKindImage
+			block.AltText = bundleBlock.AltText
+		}
+		block.URL = bundleBlock.URL
+		block.Caption = bundleBlock.Caption
+		b.checkMedia(bundleBlock.Media, kind, blockPath+".media")
+		invalid = bundleBlock.Media == "" && bundleBlock.URL == ""
+
+	case models.LessonBlockVocabulary:
+		invalid = bundleBlock.Vocabulary == nil
+
+	case models.LessonBlockGrammar:
+		invalid = bundleBlock.Grammar == nil
+		if !invalid {
+			grammar := *bundleBlock.Grammar
+			grammar.Explanation = markdown.Sanitize(grammar.Explanation)
+			block.Grammar = &grammar
+		}
+
+	case models.LessonBlockQuestion:
+		invalid = bundleBlock.Question == nil
+		if !invalid {
+			err := exerciseQuestionService.NewExerciseQuestionService(b.repository).ValidateContent(&dto.CreateExerciseQuestionRequest{
+				QuestionText:  bundleBlock.Question.QuestionText,
+				QuestionType:  bundleBlock.Question.QuestionType,
+				Options:       bundleBlock.Question.Options,
+				CorrectAnswer: bundleBlock.Question.CorrectAnswer,
+				Points:        1,
+			})
+			if err != nil {
+				b.conflict(conflictQuestion, blockPath+".question", err.Error())
+			}
+			block.Question = bundleBlock.Question
+		}
+	}
+
+	if invalid {
+		b.conflict(conflictValidation, blockPath, errConstant.ErrInvalidLessonBlock.Error())
+	}
+	return block
+}
+
+// toQuestion converts a question of the manifest to a model, encoding its options and answer
+func (s *CourseBundleService) toQuestion(bundleQuestion *dto.BundleQuestion) (*models.ExerciseQuestion, error) {
+	question := &models.ExerciseQuestion{
+		QuestionText:     bundleQuestion.QuestionText,
+		QuestionType:     bundleQuestion.QuestionType,
+		AnswerStrictness: bundleQuestion.AnswerStrictness,
+		JlptSection:      bundleQuestion.JlptSection,
+		Explanation:      bundleQuestion.Explanation,
+		AudioURL:         bundleQuestion.AudioURL,
+		ImageURL:         bundleQuestion.ImageURL,
+		OrderIndex:       bundleQuestion.OrderIndex,
+		Points:           bundleQuestion.Points,
+	}
+	if question.AnswerStrictness == "" {
+		question.AnswerStrictness = constants.DefaultAnswerStrictness
+	}
+
+	if bundleQuestion.Options != nil {
+		options, err := json.Marshal(bundleQuestion.Options)
+		if err != nil {
+			return nil, err
+		}
+		encoded := string(options)
+		question.Options = &encoded
+	}
+	answer, err := json.Marshal(bundleQuestion.CorrectAnswer)
+	if err != nil {
+		return nil, err
+	}
+	question.CorrectAnswer = string(answer)
+
+	return question, nil
+}
+
+// buildVocabularies checks the vocabulary of the manifest and returns the entries to create.
+// Entries whose word already exists for the JLPT level are reused.
+func (s *CourseBundleService) buildVocabularies(b *bundleImport) ([]models.Vocabulary, error) {
+	vocabularies := make([]models.Vocabulary, 0, len(b.manifest.Vocabularies))
+	seen := make(map[string]bool, len(b.manifest.Vocabularies))
+	categories := make(map[string]*models.Category)
+
+	for i, bundleVocabulary := range b.manifest.Vocabularies {
+		vocabularyPath := fmt.Sprintf("vocabularies[%d]", i)
+		if bundleVocabulary.JlptLevel == "" || bundleVocabulary.Word == "" {
+			continue
+		}
+
+		level, err := b.jlptLevel(bundleVocabulary.JlptLevel, vocabularyPath+".jlptLevel")
+		if err != nil {
+			return nil, err
+		}
+		if level == nil {
+			continue
+		}
+
+		key := vocabularyKey(bundleVocabulary.JlptLevel, bundleVocabulary.Word)
+		if seen[key] {
+			b.conflict(conflictVocabulary, vocabularyPath+".word", fmt.Sprintf("word %s is listed more than once for JLPT level %s", bundleVocabulary.Word, level.Code))
+			continue
+		}
+		seen[key] = true
+
+		existing, err := b.repository.GetVocabulary().GetByWordAndJlptLevel(b.ctx, bundleVocabulary.Word, level.ID)
+		if err != nil && !errors.Is(err, errConstant.ErrVocabularyNotFound) {
+			return nil, err
+		}
+		if existing != nil {
+			b.existingVocabularies[key] = existing
+			b.report.ReusedVocabularies++
+			continue
+		}
+
+		categoryKey := bundleVocabulary.JlptLevel + "\x00" + bundleVocabulary.Category
+		category, ok := categories[categoryKey]
+		if !ok {
+			category, err = b.repository.GetCategory().GetByNameAndJlptLevel(b.ctx, bundleVocabulary.Category, level.ID)
+			if err != nil && !errors.Is(err, errConstant.ErrCategoryNotFound) {
+				return nil, err
+			}
+			categories[categoryKey] = category
+		}
+		if category == nil {
+			b.conflict(conflictCategory, vocabularyPath+".category", fmt.Sprintf("category %q does not exist for JLPT level %s", bundleVocabulary.Category, level.Code))
+			continue
+		}
+
+		b.checkMedia(bundleVocabulary.Audio, models.MediaKindAudio, vocabularyPath+".audio")
+		b.checkMedia(bundleVocabulary.Image, models.MediaKindImage, vocabularyPath+".image")
+
+		b.newVocabularies[key] = len(vocabularies)
+		vocabularies = append(vocabularies, models.Vocabulary{
+			Word:                   bundleVocabulary.Word,
+			Reading:                bundleVocabulary.Reading,
+			Meaning:                bundleVocabulary.Meaning,
+			PartOfSpeech:           bundleVocabulary.PartOfSpeech,
+			JlptLevelID:            level.ID,
+			CategoryID:             category.ID,
+			ExampleSentence:        bundleVocabulary.ExampleSentence,
+			ExampleSentenceReading: bundleVocabulary.ExampleSentenceReading,
+			ExampleSentenceMeaning: bundleVocabulary.ExampleSentenceMeaning,
+			AudioURL:               bundleVocabulary.AudioURL,
+			ImageURL:               bundleVocabulary.ImageURL,
+			Difficulty:             bundleVocabulary.Difficulty,
+		})
+		b.vocabularyMedia = append(b.vocabularyMedia, [2]string{bundleVocabulary.Audio, bundleVocabulary.Image})
+	}
+
+	b.report.Vocabularies = len(vocabularies)
+	return vocabularies, nil
+}
+
+// linkVocabularies resolves the vocabulary references of the lessons and of their vocabulary
+// blocks to the vocabularies of the manifest or to words that already exist, reporting
+// references to unknown words. The lessons point into vocabularies, whose IDs are assigned
+// when the course is created.
+func (s *CourseBundleService) linkVocabularies(b *bundleImport, tree *courseBundleRepo.CourseTree, vocabularies []models.Vocabulary) error {
+	for i, bundleLesson := range b.manifest.Course.Lessons {
+		lesson := &tree.Lessons[i]
+
+		linked := make(map[string]bool, len(bundleLesson.Vocabularies))
+		for j, ref := range bundleLesson.Vocabularies {
+			refPath := fmt.Sprintf("course.lessons[%d].vocabularies[%d]", i, j)
+			key := vocabularyKey(ref.JlptLevel, ref.Word)
+			if linked[key] {
+				b.conflict(conflictVocabulary, refPath, fmt.Sprintf("word %s is listed more than once for the lesson", ref.Word))
+				continue
+			}
+			linked[key] = true
+
+			vocabulary, err := s.resolveWord(b, ref, refPath, vocabularies)
+			if err != nil {
+				return err
+			}
+			if vocabulary != nil {
+				lesson.Vocabularies = append(lesson.Vocabularies, vocabulary)
+			}
+		}
+
+		for j, block := range bundleLesson.Blocks {
+			if block.Type != models.LessonBlockVocabulary || block.Vocabulary == nil {
+				continue
+			}
+			vocabulary, err := s.resolveWord(b, *block.Vocabulary, fmt.Sprintf("course.lessons[%d].blocks[%d].vocabulary", i, j), vocabularies)
+			if err != nil {
+				return err
+			}
+			if vocabulary != nil {
+				if lesson.BlockVocabularies == nil {
+					lesson.BlockVocabularies = make(map[int]*models.Vocabulary)
+				}
+				lesson.BlockVocabularies[j] = vocabulary
+			}
+		}
+	}
+
+	return nil
+}
+
+// resolveWord resolves a word reference to a vocabulary of the manifest or to a word that
+// already exists, reporting unknown words
+func (s *CourseBundleService) resolveWord(b *bundleImport, ref dto.BundleWordRef, refPath string, vocabularies []models.Vocabulary) (*models.Vocabulary, error) {
+	if ref.JlptLevel == "" || ref.Word == "" {
+		return nil, nil
+	}
+
+	key := vocabularyKey(ref.JlptLevel, ref.Word)
+	if index, ok := b.newVocabularies[key]; ok {
+		return &vocabularies[index], nil
+	}
+
+	vocabulary, ok := b.existingVocabularies[key]
+	if !ok {
+		level, err := b.jlptLevel(ref.JlptLevel, refPath+".jlptLevel")
+		if err != nil {
+			return nil, err
+		}
+		if level == nil {
+			return nil, nil
+		}
+		vocabulary, err = b.repository.GetVocabulary().GetByWordAndJlptLevel(b.ctx, ref.Word, level.ID)
+		if err != nil && !errors.Is(err, errConstant.ErrVocabularyNotFound) {
+			return nil, err
+		}
+		b.existingVocabularies[key] = vocabulary
+	}
+	if vocabulary == nil {
+		b.conflict(conflictVocabulary, refPath, fmt.Sprintf("word %s does not exist for JLPT level %s and is not part of the bundle", ref.Word, ref.JlptLevel))
+	}
+	return vocabulary, nil
+}
+
+// applyMedia points the imported content at the stored media files. The course tree is in
+// manifest order, so it is walked together with the manifest.
+func (s *CourseBundleService) applyMedia(b *bundleImport, tree *courseBundleRepo.CourseTree, vocabularies []models.Vocabulary, media map[string]*dto.MediaResponse) {
+	apply := func(ref string, mediaID **uuid.UUID, url *string) {
+		saved, ok := media[ref]
+		if ref == "" || !ok {
+			return
+		}
+		id := uuid.MustParse(saved.ID)
+		*mediaID = &id
+		*url = saved.URL
+	}
+
+	apply(b.manifest.Course.Thumbnail, &tree.Course.ThumbnailMediaID, &tree.Course.ThumbnailURL)
+	for i, lesson := range b.manifest.Course.Lessons {
+		for j, block := range lesson.Blocks {
+			model := &tree.Lessons[i].Blocks[j]
+			apply(block.Media, &model.MediaID, &model.URL)
+		}
+		for j, exercise := range lesson.Exercises {
+			for k, question := range exercise.Questions {
+				model := &tree.Lessons[i].Exercises[j].Questions[k]
+				apply(question.Audio, &model.AudioMediaID, &model.AudioURL)
+				apply(question.Image, &model.ImageMediaID, &model.ImageURL)
+			}
+		}
+	}
+
+	for i, refs := range b.vocabularyMedia {
+		apply(refs[0], &vocabularies[i].AudioMediaID, &vocabularies[i].AudioURL)
+		apply(refs[1], &vocabularies[i].ImageMediaID, &vocabularies[i].ImageURL)
+	}
+}
@@ -0,0 +1,153 @@
+package services
+
+import (
+	"context"
+	"errors"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+	"manabu-service/repositories"
+)
+
+type CoursePrerequisiteService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+// ICoursePrerequisiteService defines the contract for course prerequisites: courses a learner
+// must complete before the lessons of another course unlock.
+type ICoursePrerequisiteService interface {
+	// GetByCourseID retrieves the prerequisite courses of a course.
+	GetByCourseID(context.Context, uint) ([]dto.CoursePrerequisiteResponse, error)
+
+	// Set replaces the prerequisites of a course. Prerequisites must exist, and a course cannot
+	// depend on itself directly or through other courses.
+	Set(context.Context, uint, *dto.SetCoursePrerequisitesRequest) ([]dto.CoursePrerequisiteResponse, error)
+
+	// GetMissing returns the titles of the prerequisite courses of a course that a user has not completed.
+	GetMissing(context.Context, uint, uint) ([]string, error)
+}
+
+func NewCoursePrerequisiteService(repository repositories.IRepositoryRegistry) ICoursePrerequisiteService {
+	return &CoursePrerequisiteService{repository: repository}
+}
+
+// toCoursePrerequisiteResponse converts a CoursePrerequisite model to CoursePrerequisiteResponse DTO
+func (s *CoursePrerequisiteService) toCoursePrerequisiteResponse(prerequisite *models.CoursePrerequisite) dto.CoursePrerequisiteResponse {
+	return dto.CoursePrerequisiteResponse{
+		CourseID:    prerequisite.PrerequisiteCourseID,
+		Title:       prerequisite.PrerequisiteCourse.Title,
+		JlptLevelID: prerequisite.PrerequisiteCourse.JlptLevelID,
+		IsPublished: prerequisite.PrerequisiteCourse.IsPublished,
+	}
+}
+
+// createsCycle reports whether making courseID depend on prerequisiteIDs lets a course reach
+// itself through its prerequisites
+func (s *CoursePrerequisiteService) createsCycle(ctx context.Context, courseID uint, prerequisiteIDs []uint) (bool, error) {
+	existing, err := s.repository.GetCoursePrerequisite().GetAll(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	edges := make(map[uint][]uint)
+	for _, prerequisite := range existing {
+		if prerequisite.CourseID == courseID {
+			continue
+		}
+		edges[prerequisite.CourseID] = append(edges[prerequisite.CourseID], prerequisite.PrerequisiteCourseID)
+	}
+	edges[courseID] = prerequisiteIDs
+
+	visited := make(map[uint]bool)
+	stack := append([]uint{}, prerequisiteIDs...)
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if current == courseID {
+			return true, nil
+		}
+		if visited[current] {
+			continue
+		}
+		visited[current] = true
+		stack = append(stack, edges[current]...)
+	}
+
+	return false, nil
+}
+
+func (s *CoursePrerequisiteService) GetByCourseID(ctx context.Context, courseID uint) ([]dto.CoursePrerequisiteResponse, error) {
+	// Check if course exists
+	_, err := s.repository.GetCourse().GetByID(ctx, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	prerequisites, err := s.repository.GetCoursePrerequisite().GetByCourseID(ctx, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dto.CoursePrerequisiteResponse, 0, len(prerequisites))
+	for _, prerequisite := range prerequisites {
+		responses = append(responses, s.toCoursePrerequisiteResponse(&prerequisite))
+	}
+
+	return responses, nil
+}
+
+func (s *CoursePrerequisiteService) Set(ctx context.Context, courseID uint, req *dto.SetCoursePrerequisitesRequest) ([]dto.CoursePrerequisiteResponse, error) {
+	// Check if course exists
+	_, err := s.repository.GetCourse().GetByID(ctx, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, prerequisiteID := range req.CourseIDs {
+		if prerequisiteID == courseID {
+			return nil, errConstant.ErrSelfPrerequisite
+		}
+		_, err := s.repository.GetCourse().GetByID(ctx, prerequisiteID)
+		if err != nil {
+			if errors.Is(err, errConstant.ErrCourseNotFound) {
+				return nil, errConstant.ErrPrerequisiteCourseNotFound
+			}
+			return nil, err
+		}
+	}
+
+	cycle, err := s.createsCycle(ctx, courseID, req.CourseIDs)
+	if err != nil {
+		return nil, err
+	}
+	if cycle {
+		return nil, errConstant.ErrPrerequisiteCycle
+	}
+
+	err = s.repository.GetCoursePrerequisite().Replace(ctx, courseID, req.CourseIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetByCourseID(ctx, courseID)
+}
+
+func (s *CoursePrerequisiteService) GetMissing(ctx context.Context, courseID uint, userID uint) ([]string, error) {
+	prerequisites, err := s.repository.GetCoursePrerequisite().GetByCourseID(ctx, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	missing := make([]string, 0)
+	for _, prerequisite := range prerequisites {
+		progress, err := s.repository.GetUserCourseProgress().GetByUserIDAndCourseID(ctx, userID, prerequisite.PrerequisiteCourseID)
+		if err != nil && !errors.Is(err, errConstant.ErrUserCourseProgressNotFound) {
+			return nil, err
+		}
+		if progress == nil || progress.Status != models.ProgressStatusCompleted {
+			missing = append(missing, prerequisite.PrerequisiteCourse.Title)
+		}
+	}
+
+	return missing, nil
+}
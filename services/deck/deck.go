@@ -0,0 +1,599 @@
+package services
+
+import (
+	"context"
+	"manabu-service/common/markdown"
+	"manabu-service/constants"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+	"manabu-service/repositories"
+	"math"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type DeckService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+// IDeckService defines the contract for learner-made decks of vocabulary and custom cards.
+type IDeckService interface {
+	// Create adds a deck owned by the logged in user, who is subscribed to it right away.
+	Create(context.Context, *dto.CreateDeckRequest) (*dto.DeckResponse, error)
+
+	// GetPublic browses public decks, most subscribed first unless sorted otherwise.
+	GetPublic(context.Context, *dto.DeckFilterRequest) (*dto.DeckListResponse, error)
+
+	// GetMine retrieves the decks of the logged in user, newest first.
+	GetMine(context.Context, *dto.PaginationRequest) (*dto.DeckListResponse, error)
+
+	// GetSubscribed retrieves the decks the logged in user is subscribed to.
+	GetSubscribed(context.Context, *dto.PaginationRequest) (*dto.DeckListResponse, error)
+
+	// GetByID retrieves a deck with its words and cards. Private decks are only visible to their
+	// owner and unlisted decks also to anyone with their share code.
+	GetByID(context.Context, uint, *dto.DeckAccessRequest) (*dto.DeckDetailResponse, error)
+
+	// Update changes the title, description and visibility of a deck of the logged in user.
+	Update(context.Context, uint, *dto.UpdateDeckRequest) (*dto.DeckResponse, error)
+
+	// Delete removes a deck of the logged in user with its cards and subscriptions.
+	Delete(context.Context, uint) error
+
+	// SetVocabularies replaces the words of a deck of the logged in user. New words are added
+	// to the review queue of every subscriber.
+	SetVocabularies(context.Context, uint, *dto.SetDeckVocabulariesRequest) (*dto.DeckDetailResponse, error)
+
+	// AddCard adds a custom card to a deck of the logged in user and to the review queue of every subscriber.
+	AddCard(context.Context, uint, *dto.DeckCardRequest) (*dto.DeckCardResponse, error)
+
+	// UpdateCard changes a custom card of a deck of the logged in user.
+	UpdateCard(context.Context, uint, uint, *dto.DeckCardRequest) (*dto.DeckCardResponse, error)
+
+	// DeleteCard removes a custom card of a deck of the logged in user.
+	DeleteCard(context.Context, uint, uint) error
+
+	// Subscribe subscribes the logged in user to a deck and adds its words and cards to their review queue.
+	Subscribe(context.Context, uint, *dto.DeckAccessRequest) (*dto.DeckSubscriptionResponse, error)
+
+	// Unsubscribe removes the subscription of the logged in user to a deck. The cards of the deck leave
+	// their review queue, while words stay since they are official vocabulary.
+	Unsubscribe(context.Context, uint) error
+}
+
+func NewDeckService(repository repositories.IRepositoryRegistry) IDeckService {
+	return &DeckService{repository: repository}
+}
+
+// getUserLogin returns the logged in user, or nil for anonymous requests
+func (s *DeckService) getUserLogin(ctx context.Context) *dto.UserResponse {
+	userLogin, ok := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	if !ok {
+		return nil
+	}
+	return userLogin
+}
+
+// requireUserLogin returns the logged in user, failing with ErrUnauthorized for anonymous requests
+func (s *DeckService) requireUserLogin(ctx context.Context) (*dto.UserResponse, error) {
+	userLogin := s.getUserLogin(ctx)
+	if userLogin == nil {
+		return nil, errConstant.ErrUnauthorized
+	}
+	return userLogin, nil
+}
+
+// canView reports whether a user may see a deck
+func (s *DeckService) canView(deck *models.Deck, userLogin *dto.UserResponse, access *dto.DeckAccessRequest) bool {
+	if userLogin != nil && userLogin.UUID == deck.UserID {
+		return true
+	}
+	switch deck.Visibility {
+	case models.DeckVisibilityPublic:
+		return true
+	case models.DeckVisibilityUnlisted:
+		return access != nil && access.ShareCode != "" && access.ShareCode == deck.ShareCode.String()
+	default:
+		return false
+	}
+}
+
+// getVisibleDeck retrieves a deck the logged in user may see. Decks they may not see are reported as not found.
+func (s *DeckService) getVisibleDeck(ctx context.Context, id uint, access *dto.DeckAccessRequest) (*models.Deck, error) {
+	deck, err := s.repository.GetDeck().GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !s.canView(deck, s.getUserLogin(ctx), access) {
+		return nil, errConstant.ErrDeckNotFound
+	}
+	return deck, nil
+}
+
+// getOwnDeck retrieves a deck of the logged in user. Decks of other users they may see are forbidden
+// and the others are reported as not found.
+func (s *DeckService) getOwnDeck(ctx context.Context, id uint) (*models.Deck, error) {
+	userLogin, err := s.requireUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deck, err := s.repository.GetDeck().GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if deck.UserID != userLogin.UUID {
+		if s.canView(deck, userLogin, nil) {
+			return nil, errConstant.ErrForbidden
+		}
+		return nil, errConstant.ErrDeckNotFound
+	}
+	return deck, nil
+}
+
+// toDeckResponses converts Deck models to DeckResponse DTOs with their word and card counts.
+// The share code is only given to the owner.
+func (s *DeckService) toDeckResponses(ctx context.Context, decks []models.Deck) ([]dto.DeckResponse, error) {
+	deckIDs := make([]uint, 0, len(decks))
+	for _, deck := range decks {
+		deckIDs = append(deckIDs, deck.ID)
+	}
+
+	vocabularyCounts, err := s.repository.GetDeck().CountVocabularies(ctx, deckIDs)
+	if err != nil {
+		return nil, err
+	}
+	cardCounts, err := s.repository.GetDeckCard().CountByDeckIDs(ctx, deckIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	userLogin := s.getUserLogin(ctx)
+	subscribed := make(map[uint]bool)
+	if userLogin != nil {
+		subscribedIDs, err := s.repository.GetDeck().GetSubscribedDeckIDs(ctx, userLogin.UUID.String(), deckIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range subscribedIDs {
+			subscribed[id] = true
+		}
+	}
+
+	responses := make([]dto.DeckResponse, 0, len(decks))
+	for _, deck := range decks {
+		response := dto.DeckResponse{
+			ID:              deck.ID,
+			Title:           deck.Title,
+			Description:     deck.Description,
+			Visibility:      deck.Visibility,
+			Owner:           deck.User.Name,
+			IsOwner:         userLogin != nil && userLogin.UUID == deck.UserID,
+			IsSubscribed:    subscribed[deck.ID],
+			SubscriberCount: deck.SubscriberCount,
+			VocabularyCount: vocabularyCounts[deck.ID],
+			CardCount:       cardCounts[deck.ID],
+		}
+		if response.IsOwner {
+			shareCode := deck.ShareCode.String()
+			response.ShareCode = &shareCode
+		}
+		if deck.CreatedAt != nil {
+			response.CreatedAt = deck.CreatedAt.Format("2006-01-02T15:04:05Z07:00")
+		}
+		if deck.UpdatedAt != nil {
+			response.UpdatedAt = deck.UpdatedAt.Format("2006-01-02T15:04:05Z07:00")
+		}
+		responses = append(responses, response)
+	}
+	return responses, nil
+}
+
+// toDeckResponse converts a Deck model to DeckResponse DTO
+func (s *DeckService) toDeckResponse(ctx context.Context, deck *models.Deck) (*dto.DeckResponse, error) {
+	responses, err := s.toDeckResponses(ctx, []models.Deck{*deck})
+	if err != nil {
+		return nil, err
+	}
+	return &responses[0], nil
+}
+
+// toDeckCardResponse converts a DeckCard model to DeckCardResponse DTO
+func (s *DeckService) toDeckCardResponse(card *models.DeckCard) *dto.DeckCardResponse {
+	return &dto.DeckCardResponse{
+		ID:         card.ID,
+		DeckID:     card.DeckID,
+		Front:      card.Front,
+		Reading:    card.Reading,
+		Back:       card.Back,
+		Note:       card.Note,
+		OrderIndex: card.OrderIndex,
+	}
+}
+
+// toDeckDetailResponse converts a Deck model to DeckDetailResponse DTO with its words and cards
+func (s *DeckService) toDeckDetailResponse(ctx context.Context, deck *models.Deck) (*dto.DeckDetailResponse, error) {
+	response, err := s.toDeckResponse(ctx, deck)
+	if err != nil {
+		return nil, err
+	}
+
+	vocabularies, err := s.repository.GetDeck().GetVocabularies(ctx, deck.ID)
+	if err != nil {
+		return nil, err
+	}
+	cards, err := s.repository.GetDeckCard().GetByDeckID(ctx, deck.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	detail := &dto.DeckDetailResponse{
+		DeckResponse: *response,
+		Vocabularies: make([]dto.DeckVocabularyResponse, 0, len(vocabularies)),
+		Cards:        make([]dto.DeckCardResponse, 0, len(cards)),
+	}
+	for _, vocabulary := range vocabularies {
+		detail.Vocabularies = append(detail.Vocabularies, dto.DeckVocabularyResponse{
+			VocabularyID: vocabulary.VocabularyID,
+			OrderIndex:   vocabulary.OrderIndex,
+			Word:         vocabulary.Vocabulary.Word,
+			Reading:      vocabulary.Vocabulary.Reading,
+			Meaning:      vocabulary.Vocabulary.Meaning,
+			PartOfSpeech: vocabulary.Vocabulary.PartOfSpeech,
+			JlptLevelID:  vocabulary.Vocabulary.JlptLevelID,
+			AudioURL:     vocabulary.Vocabulary.AudioURL,
+			ImageURL:     vocabulary.Vocabulary.ImageURL,
+		})
+	}
+	for _, card := range cards {
+		detail.Cards = append(detail.Cards, *s.toDeckCardResponse(&card))
+	}
+	return detail, nil
+}
+
+// toDeckListResponse builds a paginated DeckListResponse
+func (s *DeckService) toDeckListResponse(ctx context.Context, decks []models.Deck, total int64, pagination *dto.PaginationRequest) (*dto.DeckListResponse, error) {
+	responses, err := s.toDeckResponses(ctx, decks)
+	if err != nil {
+		return nil, err
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(pagination.Limit)))
+
+	return &dto.DeckListResponse{
+		Data: responses,
+		Pagination: dto.PaginationResponse{
+			Page:       pagination.Page,
+			Limit:      pagination.Limit,
+			TotalPages: totalPages,
+			TotalItems: total,
+		},
+	}, nil
+}
+
+// normalizePagination applies the default page and limit
+func (s *DeckService) normalizePagination(pagination *dto.PaginationRequest) {
+	if pagination.Page < 1 {
+		pagination.Page = 1
+	}
+	if pagination.Limit < 1 {
+		pagination.Limit = 10
+	}
+	if pagination.Limit > 100 {
+		pagination.Limit = 100
+	}
+}
+
+func (s *DeckService) Create(ctx context.Context, req *dto.CreateDeckRequest) (*dto.DeckResponse, error) {
+	userLogin, err := s.requireUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deck := &models.Deck{
+		UserID:      userLogin.UUID,
+		Title:       strings.TrimSpace(req.Title),
+		Description: markdown.Sanitize(req.Description),
+		Visibility:  req.Visibility,
+		ShareCode:   uuid.New(),
+	}
+	if deck.Visibility == "" {
+		deck.Visibility = models.DeckVisibilityPrivate
+	}
+
+	err = s.repository.GetDeck().Create(ctx, deck)
+	if err != nil {
+		return nil, err
+	}
+
+	// The owner studies their own deck
+	subscribed, err := s.repository.GetDeck().Subscribe(ctx, deck.ID, userLogin.UUID)
+	if err != nil {
+		return nil, err
+	}
+	if subscribed {
+		deck.SubscriberCount++
+	}
+
+	deck.User = models.User{Name: userLogin.Name}
+	return s.toDeckResponse(ctx, deck)
+}
+
+func (s *DeckService) GetPublic(ctx context.Context, filter *dto.DeckFilterRequest) (*dto.DeckListResponse, error) {
+	if filter == nil {
+		filter = &dto.DeckFilterRequest{}
+	}
+	s.normalizePagination(&filter.PaginationRequest)
+
+	decks, total, err := s.repository.GetDeck().GetPublic(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toDeckListResponse(ctx, decks, total, &filter.PaginationRequest)
+}
+
+func (s *DeckService) GetMine(ctx context.Context, pagination *dto.PaginationRequest) (*dto.DeckListResponse, error) {
+	userLogin, err := s.requireUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if pagination == nil {
+		pagination = &dto.PaginationRequest{}
+	}
+	s.normalizePagination(pagination)
+
+	decks, total, err := s.repository.GetDeck().GetByUserID(ctx, userLogin.UUID.String(), pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toDeckListResponse(ctx, decks, total, pagination)
+}
+
+func (s *DeckService) GetSubscribed(ctx context.Context, pagination *dto.PaginationRequest) (*dto.DeckListResponse, error) {
+	userLogin, err := s.requireUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if pagination == nil {
+		pagination = &dto.PaginationRequest{}
+	}
+	s.normalizePagination(pagination)
+
+	decks, total, err := s.repository.GetDeck().GetSubscribed(ctx, userLogin.UUID.String(), pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toDeckListResponse(ctx, decks, total, pagination)
+}
+
+func (s *DeckService) GetByID(ctx context.Context, id uint, access *dto.DeckAccessRequest) (*dto.DeckDetailResponse, error) {
+	deck, err := s.getVisibleDeck(ctx, id, access)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toDeckDetailResponse(ctx, deck)
+}
+
+func (s *DeckService) Update(ctx context.Context, id uint, req *dto.UpdateDeckRequest) (*dto.DeckResponse, error) {
+	deck, err := s.getOwnDeck(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	deck.Title = strings.TrimSpace(req.Title)
+	deck.Description = markdown.Sanitize(req.Description)
+	deck.Visibility = req.Visibility
+
+	err = s.repository.GetDeck().Update(ctx, deck)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toDeckResponse(ctx, deck)
+}
+
+func (s *DeckService) Delete(ctx context.Context, id uint) error {
+	_, err := s.getOwnDeck(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return s.repository.GetDeck().Delete(ctx, id)
+}
+
+func (s *DeckService) SetVocabularies(ctx context.Context, id uint, req *dto.SetDeckVocabulariesRequest) (*dto.DeckDetailResponse, error) {
+	deck, err := s.getOwnDeck(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	vocabularies, err := s.repository.GetVocabulary().GetByIDs(ctx, req.VocabularyIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(vocabularies) != len(req.VocabularyIDs) {
+		return nil, errConstant.ErrDeckVocabularyNotFound
+	}
+
+	err = s.repository.Transaction(ctx, func(tx repositories.IRepositoryRegistry) error {
+		err := tx.GetDeck().ReplaceVocabularies(ctx, id, req.VocabularyIDs)
+		if err != nil {
+			return err
+		}
+
+		// Subscribers start learning the words they are not learning yet
+		return tx.GetUserVocabularyStatus().CreateForDeckSubscribers(ctx, id)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toDeckDetailResponse(ctx, deck)
+}
+
+func (s *DeckService) AddCard(ctx context.Context, deckID uint, req *dto.DeckCardRequest) (*dto.DeckCardResponse, error) {
+	_, err := s.getOwnDeck(ctx, deckID)
+	if err != nil {
+		return nil, err
+	}
+
+	// New cards are placed after the existing cards of the deck
+	cards, err := s.repository.GetDeckCard().GetByDeckID(ctx, deckID)
+	if err != nil {
+		return nil, err
+	}
+	orderIndex := 0
+	for _, card := range cards {
+		orderIndex = max(orderIndex, card.OrderIndex)
+	}
+
+	card := &models.DeckCard{
+		DeckID:     deckID,
+		Front:      strings.TrimSpace(req.Front),
+		Reading:    strings.TrimSpace(req.Reading),
+		Back:       strings.TrimSpace(req.Back),
+		Note:       markdown.Sanitize(req.Note),
+		OrderIndex: orderIndex + 1,
+	}
+	err = s.repository.Transaction(ctx, func(tx repositories.IRepositoryRegistry) error {
+		err := tx.GetDeckCard().Create(ctx, card)
+		if err != nil {
+			return err
+		}
+
+		subscriberIDs, err := tx.GetDeck().GetSubscriberIDs(ctx, deckID)
+		if err != nil {
+			return err
+		}
+		return tx.GetDeckCard().CreateStatusesForUsers(ctx, subscriberIDs, card.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toDeckCardResponse(card), nil
+}
+
+func (s *DeckService) UpdateCard(ctx context.Context, deckID, cardID uint, req *dto.DeckCardRequest) (*dto.DeckCardResponse, error) {
+	_, err := s.getOwnDeck(ctx, deckID)
+	if err != nil {
+		return nil, err
+	}
+
+	card, err := s.repository.GetDeckCard().GetByID(ctx, cardID)
+	if err != nil {
+		return nil, err
+	}
+	if card.DeckID != deckID {
+		return nil, errConstant.ErrDeckCardNotFound
+	}
+
+	card.Front = strings.TrimSpace(req.Front)
+	card.Reading = strings.TrimSpace(req.Reading)
+	card.Back = strings.TrimSpace(req.Back)
+	card.Note = markdown.Sanitize(req.Note)
+
+	err = s.repository.GetDeckCard().Update(ctx, card)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toDeckCardResponse(card), nil
+}
+
+func (s *DeckService) DeleteCard(ctx context.Context, deckID, cardID uint) error {
+	_, err := s.getOwnDeck(ctx, deckID)
+	if err != nil {
+		return err
+	}
+
+	card, err := s.repository.GetDeckCard().GetByID(ctx, cardID)
+	if err != nil {
+		return err
+	}
+	if card.DeckID != deckID {
+		return errConstant.ErrDeckCardNotFound
+	}
+
+	return s.repository.GetDeckCard().Delete(ctx, cardID)
+}
+
+func (s *DeckService) Subscribe(ctx context.Context, id uint, access *dto.DeckAccessRequest) (*dto.DeckSubscriptionResponse, error) {
+	userLogin, err := s.requireUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.getVisibleDeck(ctx, id, access)
+	if err != nil {
+		return nil, err
+	}
+
+	// The subscription and the review queue of the subscriber are stored together
+	response := &dto.DeckSubscriptionResponse{DeckID: id}
+	err = s.repository.Transaction(ctx, func(tx repositories.IRepositoryRegistry) error {
+		subscribed, err := tx.GetDeck().Subscribe(ctx, id, userLogin.UUID)
+		if err != nil {
+			return err
+		}
+		if !subscribed {
+			return errConstant.ErrDeckAlreadySubscribed
+		}
+
+		// Words and cards of the deck join the review queue of the subscriber
+		vocabularies, err := tx.GetDeck().GetVocabularies(ctx, id)
+		if err != nil {
+			return err
+		}
+		vocabularyIDs := make([]uint, 0, len(vocabularies))
+		for _, vocabulary := range vocabularies {
+			vocabularyIDs = append(vocabularyIDs, vocabulary.VocabularyID)
+		}
+		response.AddedWords, err = tx.GetUserVocabularyStatus().CreateMany(ctx, userLogin.UUID, vocabularyIDs)
+		if err != nil {
+			return err
+		}
+		response.AlreadyLearning = len(vocabularyIDs) - response.AddedWords
+
+		cards, err := tx.GetDeckCard().GetByDeckID(ctx, id)
+		if err != nil {
+			return err
+		}
+		cardIDs := make([]uint, 0, len(cards))
+		for _, card := range cards {
+			cardIDs = append(cardIDs, card.ID)
+		}
+		response.AddedCards, err = tx.GetDeckCard().CreateStatuses(ctx, userLogin.UUID, cardIDs)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (s *DeckService) Unsubscribe(ctx context.Context, id uint) error {
+	userLogin, err := s.requireUserLogin(ctx)
+	if err != nil {
+		return err
+	}
+
+	unsubscribed, err := s.repository.GetDeck().Unsubscribe(ctx, id, userLogin.UUID)
+	if err != nil {
+		return err
+	}
+	if !unsubscribed {
+		return errConstant.ErrDeckNotSubscribed
+	}
+
+	return s.repository.GetDeckCard().DeleteStatusesByDeckID(ctx, userLogin.UUID, id)
+}
@@ -0,0 +1,149 @@
+package services
+
+import (
+	"context"
+	"manabu-service/constants"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+	"manabu-service/repositories"
+	"math"
+	"time"
+)
+
+type DeckCardService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+// IDeckCardService defines the contract for reviewing the custom cards of subscribed decks.
+type IDeckCardService interface {
+	// GetAll retrieves the progress of the logged in user on custom cards, least recently reviewed first.
+	GetAll(context.Context, *dto.DeckCardStatusListRequest) (*dto.DeckCardStatusListResponse, error)
+
+	// Review records a review of a custom card by the logged in user, the same way vocabulary is reviewed.
+	Review(context.Context, uint, *dto.ReviewUserVocabStatusRequest) (*dto.DeckCardStatusResponse, error)
+}
+
+func NewDeckCardService(repository repositories.IRepositoryRegistry) IDeckCardService {
+	return &DeckCardService{repository: repository}
+}
+
+// getUserLogin returns the logged in user from context
+func (s *DeckCardService) getUserLogin(ctx context.Context) (*dto.UserResponse, error) {
+	userLogin, ok := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	if !ok || userLogin == nil {
+		return nil, errConstant.ErrUnauthorized
+	}
+	return userLogin, nil
+}
+
+// toStatusResponse converts a UserDeckCardStatus model to DeckCardStatusResponse DTO
+func (s *DeckCardService) toStatusResponse(status *models.UserDeckCardStatus) dto.DeckCardStatusResponse {
+	response := dto.DeckCardStatusResponse{
+		ID:             status.ID,
+		CardID:         status.CardID,
+		Status:         status.Status,
+		Repetitions:    status.Repetitions,
+		LastReviewedAt: status.LastReviewedAt,
+	}
+	if status.Card.ID != 0 {
+		response.Card = &dto.DeckCardResponse{
+			ID:         status.Card.ID,
+			DeckID:     status.Card.DeckID,
+			Front:      status.Card.Front,
+			Reading:    status.Card.Reading,
+			Back:       status.Card.Back,
+			Note:       status.Card.Note,
+			OrderIndex: status.Card.OrderIndex,
+		}
+	}
+	if status.CreatedAt != nil {
+		response.CreatedAt = *status.CreatedAt
+	}
+	if status.UpdatedAt != nil {
+		response.UpdatedAt = *status.UpdatedAt
+	}
+	return response
+}
+
+func (s *DeckCardService) GetAll(ctx context.Context, filter *dto.DeckCardStatusListRequest) (*dto.DeckCardStatusListResponse, error) {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if filter == nil {
+		filter = &dto.DeckCardStatusListRequest{}
+	}
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.Limit < 1 {
+		filter.Limit = 10
+	}
+	if filter.Limit > 100 {
+		filter.Limit = 100
+	}
+
+	statuses, total, err := s.repository.GetDeckCard().GetStatuses(ctx, userLogin.UUID.String(), filter)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dto.DeckCardStatusResponse, 0, len(statuses))
+	for _, status := range statuses {
+		responses = append(responses, s.toStatusResponse(&status))
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(filter.Limit)))
+
+	return &dto.DeckCardStatusListResponse{
+		Data: responses,
+		Pagination: dto.PaginationResponse{
+			Page:       filter.Page,
+			Limit:      filter.Limit,
+			TotalPages: totalPages,
+			TotalItems: total,
+		},
+	}, nil
+}
+
+func (s *DeckCardService) Review(ctx context.Context, cardID uint, req *dto.ReviewUserVocabStatusRequest) (*dto.DeckCardStatusResponse, error) {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := s.repository.GetDeckCard().GetStatusByUserAndCard(ctx, userLogin.UUID.String(), cardID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Same review logic as vocabulary
+	now := time.Now()
+
+	if req.IsCorrect {
+		// Correct answer: increment progress
+		status.Repetitions++
+
+		// After 5 correct reviews, mark as completed
+		if status.Repetitions >= 5 {
+			status.Status = "completed"
+		}
+	} else {
+		// Incorrect answer: reset progress
+		status.Repetitions = 0
+		status.Status = "learning"
+	}
+
+	status.LastReviewedAt = &now
+	status.UpdatedAt = &now
+
+	err = s.repository.GetDeckCard().UpdateStatus(ctx, status)
+	if err != nil {
+		return nil, err
+	}
+
+	response := s.toStatusResponse(status)
+	return &response, nil
+}
@@ -0,0 +1,291 @@
+package services
+
+import (
+	"context"
+	"manabu-service/common/jlpt"
+	"manabu-service/constants"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+	"manabu-service/repositories"
+	"math"
+)
+
+type ExamService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+// IExamService defines the contract for JLPT mock exam business logic operations.
+type IExamService interface {
+	// Create validates and creates a new exam with its sections.
+	// Validates the JLPT level, its scoring rules and that every JLPT section is present once.
+	Create(context.Context, *dto.CreateExamRequest) (*dto.ExamResponse, error)
+
+	// GetAll retrieves all exams with filtering, sorting, and pagination.
+	GetAll(context.Context, *dto.ExamFilterRequest) (*dto.ExamListResponse, error)
+
+	// GetByID retrieves a single exam with its sections by its ID.
+	GetByID(context.Context, uint) (*dto.ExamResponse, error)
+
+	// Update validates and updates an existing exam, replacing its sections.
+	Update(context.Context, *dto.UpdateExamRequest, uint) (*dto.ExamResponse, error)
+
+	// Delete removes an exam by ID if it exists.
+	Delete(context.Context, uint) error
+
+	// Publish marks an exam as published once every section's question pool is large enough.
+	Publish(context.Context, uint) (*dto.ExamResponse, error)
+
+	// Unpublish marks an exam as unpublished.
+	Unpublish(context.Context, uint) (*dto.ExamResponse, error)
+}
+
+func NewExamService(repository repositories.IRepositoryRegistry) IExamService {
+	return &ExamService{repository: repository}
+}
+
+// toExamResponse converts an Exam model to ExamResponse DTO
+func (s *ExamService) toExamResponse(exam *models.Exam) *dto.ExamResponse {
+	response := &dto.ExamResponse{
+		ID:              exam.ID,
+		Title:           exam.Title,
+		Description:     exam.Description,
+		JlptLevelID:     exam.JlptLevelID,
+		DurationMinutes: exam.DurationMinutes,
+		IsPublished:     exam.IsPublished,
+		Sections:        make([]dto.ExamSectionResponse, 0, len(exam.Sections)),
+	}
+
+	// Format PublishedAt as string if present
+	if exam.PublishedAt != nil {
+		publishedAtStr := exam.PublishedAt.Format("2006-01-02T15:04:05Z07:00")
+		response.PublishedAt = &publishedAtStr
+	}
+
+	for _, section := range exam.Sections {
+		response.Sections = append(response.Sections, dto.ExamSectionResponse{
+			ID:            section.ID,
+			SectionType:   section.SectionType,
+			QuestionCount: section.QuestionCount,
+			OrderIndex:    section.OrderIndex,
+		})
+	}
+
+	if exam.JlptLevel.ID > 0 {
+		response.JlptLevel = &dto.JlptLevelResponse{
+			ID:          exam.JlptLevel.ID,
+			Code:        exam.JlptLevel.Code,
+			Name:        exam.JlptLevel.Name,
+			Description: exam.JlptLevel.Description,
+			LevelOrder:  exam.JlptLevel.LevelOrder,
+		}
+	}
+
+	return response
+}
+
+// validateJlptLevel checks that the JLPT level exists and has official scoring rules
+func (s *ExamService) validateJlptLevel(ctx context.Context, jlptLevelID uint) error {
+	jlptLevel, err := s.repository.GetJlptLevel().GetByID(ctx, jlptLevelID)
+	if err != nil || jlptLevel == nil {
+		return errConstant.ErrInvalidJlptLevelIDExam
+	}
+	if _, ok := jlpt.RuleFor(jlptLevel.Code); !ok {
+		return errConstant.ErrExamScoringRuleNotFound
+	}
+	return nil
+}
+
+// validateSections checks that the exam contains every JLPT section exactly once
+func (s *ExamService) validateSections(sections []dto.ExamSectionRequest) error {
+	if len(sections) != len(constants.ExamSections) {
+		return errConstant.ErrInvalidExamSections
+	}
+
+	seen := make(map[string]bool, len(sections))
+	for _, section := range sections {
+		if seen[section.SectionType] {
+			return errConstant.ErrInvalidExamSections
+		}
+		seen[section.SectionType] = true
+	}
+	for _, sectionType := range constants.ExamSections {
+		if !seen[sectionType] {
+			return errConstant.ErrInvalidExamSections
+		}
+	}
+	return nil
+}
+
+// validateQuestionPools checks that every section can draw its questions
+func (s *ExamService) validateQuestionPools(ctx context.Context, exam *models.Exam) error {
+	for _, section := range exam.Sections {
+		total, err := s.repository.GetExerciseQuestion().CountExamPool(ctx, exam.JlptLevelID, section.SectionType)
+		if err != nil {
+			return err
+		}
+		if total < int64(section.QuestionCount) {
+			return errConstant.ErrExamQuestionPoolTooSmall
+		}
+	}
+	return nil
+}
+
+func (s *ExamService) Create(ctx context.Context, req *dto.CreateExamRequest) (*dto.ExamResponse, error) {
+	// Validate JLPT level and scoring rules
+	if err := s.validateJlptLevel(ctx, req.JlptLevelID); err != nil {
+		return nil, err
+	}
+
+	// Validate sections
+	if err := s.validateSections(req.Sections); err != nil {
+		return nil, err
+	}
+
+	exam, err := s.repository.GetExam().Create(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toExamResponse(exam), nil
+}
+
+func (s *ExamService) GetAll(ctx context.Context, filter *dto.ExamFilterRequest) (*dto.ExamListResponse, error) {
+	// Set default pagination values
+	if filter == nil {
+		filter = &dto.ExamFilterRequest{
+			PaginationRequest: dto.PaginationRequest{
+				Page:  1,
+				Limit: 10,
+			},
+		}
+	}
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.Limit < 1 {
+		filter.Limit = 10
+	}
+	if filter.Limit > 100 {
+		filter.Limit = 100
+	}
+
+	exams, total, err := s.repository.GetExam().GetAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dto.ExamResponse, 0, len(exams))
+	for _, exam := range exams {
+		responses = append(responses, *s.toExamResponse(&exam))
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(filter.Limit)))
+
+	return &dto.ExamListResponse{
+		Data: responses,
+		Pagination: dto.PaginationResponse{
+			Page:       filter.Page,
+			Limit:      filter.Limit,
+			TotalPages: totalPages,
+			TotalItems: total,
+		},
+	}, nil
+}
+
+func (s *ExamService) GetByID(ctx context.Context, id uint) (*dto.ExamResponse, error) {
+	exam, err := s.repository.GetExam().GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toExamResponse(exam), nil
+}
+
+func (s *ExamService) Update(ctx context.Context, req *dto.UpdateExamRequest, id uint) (*dto.ExamResponse, error) {
+	// Check if exam exists
+	existingExam, err := s.repository.GetExam().GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate JLPT level and scoring rules
+	if err := s.validateJlptLevel(ctx, req.JlptLevelID); err != nil {
+		return nil, err
+	}
+
+	// Validate sections
+	if err := s.validateSections(req.Sections); err != nil {
+		return nil, err
+	}
+
+	// A published exam must stay startable with the new level and sections
+	if existingExam.IsPublished {
+		updatedExam := &models.Exam{JlptLevelID: req.JlptLevelID}
+		for _, section := range req.Sections {
+			updatedExam.Sections = append(updatedExam.Sections, models.ExamSection{
+				SectionType:   section.SectionType,
+				QuestionCount: section.QuestionCount,
+			})
+		}
+		if err := s.validateQuestionPools(ctx, updatedExam); err != nil {
+			return nil, err
+		}
+	}
+
+	exam, err := s.repository.GetExam().Update(ctx, req, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toExamResponse(exam), nil
+}
+
+func (s *ExamService) Delete(ctx context.Context, id uint) error {
+	return s.repository.GetExam().Delete(ctx, id)
+}
+
+func (s *ExamService) Publish(ctx context.Context, id uint) (*dto.ExamResponse, error) {
+	// Check if exam exists
+	existingExam, err := s.repository.GetExam().GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if already published
+	if existingExam.IsPublished {
+		return nil, errConstant.ErrExamAlreadyPublished
+	}
+
+	// Every section must be able to draw its questions
+	if err := s.validateQuestionPools(ctx, existingExam); err != nil {
+		return nil, err
+	}
+
+	exam, err := s.repository.GetExam().Publish(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toExamResponse(exam), nil
+}
+
+func (s *ExamService) Unpublish(ctx context.Context, id uint) (*dto.ExamResponse, error) {
+	// Check if exam exists
+	existingExam, err := s.repository.GetExam().GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if not published
+	if !existingExam.IsPublished {
+		return nil, errConstant.ErrExamNotPublished
+	}
+
+	exam, err := s.repository.GetExam().Unpublish(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toExamResponse(exam), nil
+}
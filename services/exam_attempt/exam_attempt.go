@@ -0,0 +1,493 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"manabu-service/common/answer"
+	"manabu-service/common/jlpt"
+	"manabu-service/constants"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+	"manabu-service/repositories"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ExamAttemptService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+// IExamAttemptService defines the contract for timed exam sessions of the authenticated user.
+// Attempts past their time limit are submitted automatically with the answers saved in time.
+type IExamAttemptService interface {
+	// Start draws the questions of a published exam and starts the timer.
+	// An unfinished attempt of the same exam is resumed instead.
+	Start(context.Context, uint) (*dto.ExamAttemptResponse, error)
+
+	// GetAll retrieves the user's attempts (result history) with filtering and pagination.
+	GetAll(context.Context, *dto.ExamAttemptFilterRequest) (*dto.ExamAttemptListResponse, error)
+
+	// GetByID retrieves an attempt with its questions. Correct answers are only
+	// included once the attempt is finished.
+	GetByID(context.Context, uuid.UUID) (*dto.ExamAttemptResponse, error)
+
+	// SaveAnswers autosaves answers of an in-progress attempt.
+	SaveAnswers(context.Context, uuid.UUID, *dto.SaveExamAnswersRequest) (*dto.ExamAttemptResponse, error)
+
+	// Submit grades an in-progress attempt and computes its scaled JLPT scores.
+	Submit(context.Context, uuid.UUID) (*dto.ExamAttemptResponse, error)
+}
+
+func NewExamAttemptService(repository repositories.IRepositoryRegistry) IExamAttemptService {
+	return &ExamAttemptService{repository: repository}
+}
+
+func (s *ExamAttemptService) getUserLogin(ctx context.Context) (*dto.UserResponse, error) {
+	userLogin, ok := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	if !ok || userLogin == nil {
+		return nil, errConstant.ErrUnauthorized
+	}
+	return userLogin, nil
+}
+
+// isOverdue reports whether the attempt's time limit, including the grace period, has passed
+func (s *ExamAttemptService) isOverdue(attempt *models.ExamAttempt, now time.Time) bool {
+	return attempt.Status == models.ExamAttemptStatusInProgress &&
+		now.After(attempt.ExpiresAt.Add(constants.ExamSubmitGracePeriod))
+}
+
+// getOwnedAttempt loads an attempt of the authenticated user
+func (s *ExamAttemptService) getOwnedAttempt(ctx context.Context, id uuid.UUID) (*models.ExamAttempt, error) {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	attempt, err := s.repository.GetExamAttempt().GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify ownership - return not found error if the attempt doesn't belong to the user
+	if attempt.UserID != userLogin.UUID {
+		return nil, errConstant.ErrExamAttemptNotFound
+	}
+
+	return attempt, nil
+}
+
+// expireOverdue submits the user's attempts whose time limit has passed
+func (s *ExamAttemptService) expireOverdue(ctx context.Context, userID string) error {
+	ids, err := s.repository.GetExamAttempt().GetOverdueIDs(ctx, userID, time.Now().Add(-constants.ExamSubmitGracePeriod))
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		_, err := s.finish(ctx, id, models.ExamAttemptStatusTimedOut)
+		// Another request may have finished the attempt concurrently
+		if err != nil && err != errConstant.ErrExamAttemptAlreadySubmitted {
+			return err
+		}
+	}
+	return nil
+}
+
+// gradeAnswer grades a saved answer; missing or malformed answers are incorrect
+func (s *ExamAttemptService) gradeAnswer(item *models.ExamAttemptAnswer) bool {
+	if item.Answer == nil {
+		return false
+	}
+
+	var submitted dto.SubmittedAnswer
+	if err := json.Unmarshal([]byte(*item.Answer), &submitted); err != nil {
+		return false
+	}
+	correct := answer.DecodeCorrectAnswer(item.Question.CorrectAnswer)
+
+	result, err := answer.Grade(item.Question.QuestionType, correct, &submitted, item.Question.AnswerStrictness)
+	if err != nil {
+		return false
+	}
+	return result.Correct
+}
+
+// finish closes an attempt with the given status. The attempt is locked and reloaded before
+// grading, so answers autosaved in the meantime are graded and a submission that is overdue
+// by then is graded as timed out.
+func (s *ExamAttemptService) finish(ctx context.Context, id uuid.UUID, status string) (*models.ExamAttempt, error) {
+	var attempt *models.ExamAttempt
+	err := s.repository.Transaction(ctx, func(tx repositories.IRepositoryRegistry) error {
+		var err error
+		attempt, err = tx.GetExamAttempt().LockInProgress(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if status == models.ExamAttemptStatusSubmitted && s.isOverdue(attempt, time.Now()) {
+			status = models.ExamAttemptStatusTimedOut
+		}
+		if err := s.grade(attempt, status); err != nil {
+			return err
+		}
+		return tx.GetExamAttempt().Finish(ctx, attempt)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return attempt, nil
+}
+
+// grade grades every answer, computes the scaled JLPT scores and sets the attempt's result
+func (s *ExamAttemptService) grade(attempt *models.ExamAttempt, status string) error {
+	rule, ok := jlpt.RuleFor(attempt.Exam.JlptLevel.Code)
+	if !ok {
+		return errConstant.ErrExamScoringRuleNotFound
+	}
+
+	points := make(map[string]jlpt.SectionPoints)
+	for i := range attempt.Answers {
+		item := &attempt.Answers[i]
+		isCorrect := s.gradeAnswer(item)
+		item.IsCorrect = &isCorrect
+		item.PointsEarned = 0
+		if isCorrect {
+			item.PointsEarned = item.Question.Points
+		}
+
+		sectionPoints := points[item.SectionType]
+		sectionPoints.Earned += item.PointsEarned
+		sectionPoints.Possible += item.Question.Points
+		points[item.SectionType] = sectionPoints
+	}
+
+	result := rule.Score(points)
+	scores := make([]dto.ExamScoreResponse, 0, len(result.Groups))
+	for _, group := range result.Groups {
+		scores = append(scores, dto.ExamScoreResponse{
+			Name:      group.Name,
+			Score:     group.Score,
+			MaxScore:  group.MaxScore,
+			PassScore: group.PassScore,
+			Passed:    group.Passed,
+		})
+	}
+	scoresJSON, err := json.Marshal(scores)
+	if err != nil {
+		return err
+	}
+	scoresStr := string(scoresJSON)
+
+	// Timed out attempts end at their time limit
+	submittedAt := time.Now()
+	if status == models.ExamAttemptStatusTimedOut {
+		submittedAt = attempt.ExpiresAt
+	}
+
+	attempt.Status = status
+	attempt.SubmittedAt = &submittedAt
+	attempt.TotalScore = &result.TotalScore
+	attempt.MaxScore = result.MaxScore
+	attempt.PassScore = result.PassScore
+	attempt.Passed = &result.Passed
+	attempt.Scores = &scoresStr
+	return nil
+}
+
+// toExamAttemptResponse converts an ExamAttempt model to ExamAttemptResponse DTO.
+// Questions are included when withQuestions is set; answers are revealed once the attempt is finished.
+func (s *ExamAttemptService) toExamAttemptResponse(attempt *models.ExamAttempt, withQuestions bool) *dto.ExamAttemptResponse {
+	response := &dto.ExamAttemptResponse{
+		ID:         attempt.ID.String(),
+		ExamID:     attempt.ExamID,
+		Status:     attempt.Status,
+		StartedAt:  attempt.StartedAt.Format("2006-01-02T15:04:05Z07:00"),
+		ExpiresAt:  attempt.ExpiresAt.Format("2006-01-02T15:04:05Z07:00"),
+		TotalScore: attempt.TotalScore,
+		MaxScore:   attempt.MaxScore,
+		PassScore:  attempt.PassScore,
+		Passed:     attempt.Passed,
+	}
+
+	finished := attempt.Status != models.ExamAttemptStatusInProgress
+	if !finished {
+		response.RemainingSeconds = max(0, int(time.Until(attempt.ExpiresAt).Seconds()))
+	}
+
+	if attempt.SubmittedAt != nil {
+		submittedAtStr := attempt.SubmittedAt.Format("2006-01-02T15:04:05Z07:00")
+		response.SubmittedAt = &submittedAtStr
+	}
+
+	if attempt.Scores != nil {
+		var scores []dto.ExamScoreResponse
+		if err := json.Unmarshal([]byte(*attempt.Scores), &scores); err == nil {
+			response.Scores = scores
+		}
+	}
+
+	// Include exam data if available
+	if attempt.Exam.ID > 0 {
+		exam := &dto.ExamResponse{
+			ID:              attempt.Exam.ID,
+			Title:           attempt.Exam.Title,
+			Description:     attempt.Exam.Description,
+			JlptLevelID:     attempt.Exam.JlptLevelID,
+			DurationMinutes: attempt.Exam.DurationMinutes,
+			IsPublished:     attempt.Exam.IsPublished,
+			Sections:        make([]dto.ExamSectionResponse, 0, len(attempt.Exam.Sections)),
+		}
+		for _, section := range attempt.Exam.Sections {
+			exam.Sections = append(exam.Sections, dto.ExamSectionResponse{
+				ID:            section.ID,
+				SectionType:   section.SectionType,
+				QuestionCount: section.QuestionCount,
+				OrderIndex:    section.OrderIndex,
+			})
+		}
+		if attempt.Exam.JlptLevel.ID > 0 {
+			exam.JlptLevel = &dto.JlptLevelResponse{
+				ID:          attempt.Exam.JlptLevel.ID,
+				Code:        attempt.Exam.JlptLevel.Code,
+				Name:        attempt.Exam.JlptLevel.Name,
+				Description: attempt.Exam.JlptLevel.Description,
+				LevelOrder:  attempt.Exam.JlptLevel.LevelOrder,
+			}
+		}
+		response.Exam = exam
+	}
+
+	if !withQuestions {
+		return response
+	}
+
+	response.Questions = make([]dto.ExamAttemptQuestionResponse, 0, len(attempt.Answers))
+	for _, item := range attempt.Answers {
+		question := dto.ExamAttemptQuestionResponse{
+			QuestionID:   item.QuestionID,
+			SectionType:  item.SectionType,
+			OrderIndex:   item.OrderIndex,
+			QuestionText: item.Question.QuestionText,
+			QuestionType: item.Question.QuestionType,
+			Options:      answer.DecodeOptions(item.Question.Options),
+			AudioURL:     item.Question.AudioURL,
+			ImageURL:     item.Question.ImageURL,
+			Points:       item.Question.Points,
+		}
+		if item.Answer != nil {
+			var submitted dto.SubmittedAnswer
+			if err := json.Unmarshal([]byte(*item.Answer), &submitted); err == nil {
+				question.Answer = &submitted
+			}
+		}
+		if finished {
+			question.IsCorrect = item.IsCorrect
+			question.PointsEarned = item.PointsEarned
+			question.CorrectAnswer = answer.DecodeCorrectAnswer(item.Question.CorrectAnswer)
+			question.Explanation = item.Question.Explanation
+		}
+		response.Questions = append(response.Questions, question)
+	}
+
+	return response
+}
+
+func (s *ExamAttemptService) Start(ctx context.Context, examID uint) (*dto.ExamAttemptResponse, error) {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only published exams can be taken
+	exam, err := s.repository.GetExam().GetByID(ctx, examID)
+	if err != nil {
+		return nil, err
+	}
+	if !exam.IsPublished {
+		return nil, errConstant.ErrExamNotFound
+	}
+
+	rule, ok := jlpt.RuleFor(exam.JlptLevel.Code)
+	if !ok {
+		return nil, errConstant.ErrExamScoringRuleNotFound
+	}
+
+	// Close attempts that ran out of time before resuming or starting a new one
+	if err := s.expireOverdue(ctx, userLogin.UUID.String()); err != nil {
+		return nil, err
+	}
+
+	existingAttempt, err := s.repository.GetExamAttempt().GetInProgressByUserAndExam(ctx, userLogin.UUID.String(), examID)
+	if err != nil && err != errConstant.ErrExamAttemptNotFound {
+		return nil, err
+	}
+	if existingAttempt != nil {
+		attempt, err := s.repository.GetExamAttempt().GetByID(ctx, existingAttempt.ID)
+		if err != nil {
+			return nil, err
+		}
+		return s.toExamAttemptResponse(attempt, true), nil
+	}
+
+	// Draw the questions of every section in exam order
+	answers := make([]models.ExamAttemptAnswer, 0)
+	for _, section := range exam.Sections {
+		questions, err := s.repository.GetExerciseQuestion().GetRandomFromExamPool(ctx, exam.JlptLevelID, section.SectionType, section.QuestionCount)
+		if err != nil {
+			return nil, err
+		}
+		if len(questions) < section.QuestionCount {
+			return nil, errConstant.ErrExamQuestionPoolTooSmall
+		}
+		for _, question := range questions {
+			answers = append(answers, models.ExamAttemptAnswer{
+				QuestionID:  question.ID,
+				SectionType: section.SectionType,
+				OrderIndex:  len(answers),
+			})
+		}
+	}
+
+	now := time.Now()
+	attempt, err := s.repository.GetExamAttempt().Create(ctx, &models.ExamAttempt{
+		ExamID:    exam.ID,
+		UserID:    userLogin.UUID,
+		Status:    models.ExamAttemptStatusInProgress,
+		StartedAt: now,
+		ExpiresAt: now.Add(time.Duration(exam.DurationMinutes) * time.Minute),
+		MaxScore:  rule.MaxScore(),
+		PassScore: rule.PassScore,
+		Answers:   answers,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toExamAttemptResponse(attempt, true), nil
+}
+
+func (s *ExamAttemptService) GetAll(ctx context.Context, filter *dto.ExamAttemptFilterRequest) (*dto.ExamAttemptListResponse, error) {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set default pagination values
+	if filter == nil {
+		filter = &dto.ExamAttemptFilterRequest{
+			PaginationRequest: dto.PaginationRequest{
+				Page:  1,
+				Limit: 10,
+			},
+		}
+	}
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.Limit < 1 {
+		filter.Limit = 10
+	}
+	if filter.Limit > 100 {
+		filter.Limit = 100
+	}
+
+	// Results must not list attempts as in progress after their time limit
+	if err := s.expireOverdue(ctx, userLogin.UUID.String()); err != nil {
+		return nil, err
+	}
+
+	attempts, total, err := s.repository.GetExamAttempt().GetAll(ctx, userLogin.UUID.String(), filter)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dto.ExamAttemptResponse, 0, len(attempts))
+	for _, attempt := range attempts {
+		responses = append(responses, *s.toExamAttemptResponse(&attempt, false))
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(filter.Limit)))
+
+	return &dto.ExamAttemptListResponse{
+		Data: responses,
+		Pagination: dto.PaginationResponse{
+			Page:       filter.Page,
+			Limit:      filter.Limit,
+			TotalPages: totalPages,
+			TotalItems: total,
+		},
+	}, nil
+}
+
+func (s *ExamAttemptService) GetByID(ctx context.Context, id uuid.UUID) (*dto.ExamAttemptResponse, error) {
+	attempt, err := s.getOwnedAttempt(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Submit automatically once the time limit has passed
+	if s.isOverdue(attempt, time.Now()) {
+		attempt, err = s.finish(ctx, attempt.ID, models.ExamAttemptStatusTimedOut)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s.toExamAttemptResponse(attempt, true), nil
+}
+
+func (s *ExamAttemptService) SaveAnswers(ctx context.Context, id uuid.UUID, req *dto.SaveExamAnswersRequest) (*dto.ExamAttemptResponse, error) {
+	attempt, err := s.getOwnedAttempt(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if attempt.Status != models.ExamAttemptStatusInProgress {
+		return nil, errConstant.ErrExamAttemptAlreadySubmitted
+	}
+
+	// Answers arriving after the time limit are rejected and the attempt is submitted as it was
+	if s.isOverdue(attempt, time.Now()) {
+		if _, err := s.finish(ctx, attempt.ID, models.ExamAttemptStatusTimedOut); err != nil {
+			return nil, err
+		}
+		return nil, errConstant.ErrExamAttemptExpired
+	}
+
+	answers := make(map[uint]string, len(req.Answers))
+	for _, item := range req.Answers {
+		answerJSON, err := json.Marshal(item.Answer)
+		if err != nil {
+			return nil, errConstant.ErrInvalidSubmittedAnswer
+		}
+		answers[item.QuestionID] = string(answerJSON)
+	}
+
+	if err := s.repository.GetExamAttempt().SaveAnswers(ctx, id, answers); err != nil {
+		return nil, err
+	}
+
+	return s.toExamAttemptResponse(attempt, false), nil
+}
+
+func (s *ExamAttemptService) Submit(ctx context.Context, id uuid.UUID) (*dto.ExamAttemptResponse, error) {
+	attempt, err := s.getOwnedAttempt(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if attempt.Status != models.ExamAttemptStatusInProgress {
+		return nil, errConstant.ErrExamAttemptAlreadySubmitted
+	}
+
+	// A late submission is graded as timed out with the answers saved in time
+	attempt, err = s.finish(ctx, attempt.ID, models.ExamAttemptStatusSubmitted)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toExamAttemptResponse(attempt, true), nil
+}
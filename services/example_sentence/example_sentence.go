@@ -0,0 +1,214 @@
+package services
+
+import (
+	"context"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+	"manabu-service/repositories"
+	"math"
+)
+
+type ExampleSentenceService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+// IExampleSentenceService defines the contract for example sentence business logic operations.
+type IExampleSentenceService interface {
+	// Create validates and creates a new example sentence.
+	// Validates that every linked vocabulary exists.
+	Create(context.Context, *dto.CreateExampleSentenceRequest) (*dto.ExampleSentenceResponse, error)
+
+	// GetAll retrieves all example sentences with filtering, sorting, and pagination.
+	GetAll(context.Context, *dto.ExampleSentenceFilterRequest) (*dto.ExampleSentenceListResponse, error)
+
+	// GetByID retrieves a single example sentence by its ID.
+	GetByID(context.Context, uint) (*dto.ExampleSentenceResponse, error)
+
+	// GetByVocabularyID retrieves all example sentences linked to a vocabulary entry.
+	GetByVocabularyID(context.Context, uint) ([]dto.ExampleSentenceResponse, error)
+
+	// Update validates and updates an existing example sentence.
+	// Validates that every linked vocabulary exists.
+	Update(context.Context, *dto.UpdateExampleSentenceRequest, uint) (*dto.ExampleSentenceResponse, error)
+
+	// Delete removes an example sentence by ID if it exists.
+	Delete(context.Context, uint) error
+}
+
+func NewExampleSentenceService(repository repositories.IRepositoryRegistry) IExampleSentenceService {
+	return &ExampleSentenceService{repository: repository}
+}
+
+// toExampleSentenceResponse converts an ExampleSentence model to ExampleSentenceResponse DTO
+func (s *ExampleSentenceService) toExampleSentenceResponse(sentence *models.ExampleSentence) *dto.ExampleSentenceResponse {
+	vocabularyIDs := make([]uint, 0, len(sentence.Vocabularies))
+	for _, vocabulary := range sentence.Vocabularies {
+		vocabularyIDs = append(vocabularyIDs, vocabulary.ID)
+	}
+
+	return &dto.ExampleSentenceResponse{
+		ID:            sentence.ID,
+		Text:          sentence.Text,
+		Reading:       sentence.Reading,
+		Translation:   sentence.Translation,
+		AudioURL:      sentence.AudioURL,
+		Source:        sentence.Source,
+		Difficulty:    sentence.Difficulty,
+		VocabularyIDs: vocabularyIDs,
+	}
+}
+
+func (s *ExampleSentenceService) validateDifficulty(difficulty int) error {
+	if difficulty < 1 || difficulty > 5 {
+		return errConstant.ErrInvalidExampleSentenceDifficulty
+	}
+	return nil
+}
+
+func (s *ExampleSentenceService) validateVocabularies(ctx context.Context, vocabularyIDs []uint) error {
+	for _, vocabularyID := range vocabularyIDs {
+		vocabulary, err := s.repository.GetVocabulary().GetByID(ctx, vocabularyID)
+		if err != nil || vocabulary == nil {
+			return errConstant.ErrInvalidVocabularyIDExampleSentence
+		}
+	}
+	return nil
+}
+
+func (s *ExampleSentenceService) Create(ctx context.Context, req *dto.CreateExampleSentenceRequest) (*dto.ExampleSentenceResponse, error) {
+	// Validate difficulty range
+	if req.Difficulty > 0 {
+		if err := s.validateDifficulty(req.Difficulty); err != nil {
+			return nil, err
+		}
+	}
+
+	// Validate linked vocabularies exist
+	if err := s.validateVocabularies(ctx, req.VocabularyIDs); err != nil {
+		return nil, err
+	}
+
+	sentence, err := s.repository.GetExampleSentence().Create(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toExampleSentenceResponse(sentence), nil
+}
+
+func (s *ExampleSentenceService) GetAll(ctx context.Context, filter *dto.ExampleSentenceFilterRequest) (*dto.ExampleSentenceListResponse, error) {
+	// Set default pagination values
+	if filter == nil {
+		filter = &dto.ExampleSentenceFilterRequest{
+			PaginationRequest: dto.PaginationRequest{
+				Page:  1,
+				Limit: 10,
+			},
+		}
+	}
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.Limit < 1 {
+		filter.Limit = 10
+	}
+	if filter.Limit > 100 {
+		filter.Limit = 100
+	}
+
+	// Validate difficulty if provided
+	if filter.Difficulty > 0 {
+		if err := s.validateDifficulty(filter.Difficulty); err != nil {
+			return nil, err
+		}
+	}
+
+	sentences, total, err := s.repository.GetExampleSentence().GetAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dto.ExampleSentenceResponse, 0, len(sentences))
+	for _, sentence := range sentences {
+		responses = append(responses, *s.toExampleSentenceResponse(&sentence))
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(filter.Limit)))
+
+	return &dto.ExampleSentenceListResponse{
+		Data: responses,
+		Pagination: dto.PaginationResponse{
+			Page:       filter.Page,
+			Limit:      filter.Limit,
+			TotalPages: totalPages,
+			TotalItems: total,
+		},
+	}, nil
+}
+
+func (s *ExampleSentenceService) GetByID(ctx context.Context, id uint) (*dto.ExampleSentenceResponse, error) {
+	sentence, err := s.repository.GetExampleSentence().GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toExampleSentenceResponse(sentence), nil
+}
+
+func (s *ExampleSentenceService) GetByVocabularyID(ctx context.Context, vocabularyID uint) ([]dto.ExampleSentenceResponse, error) {
+	// Check if vocabulary exists
+	_, err := s.repository.GetVocabulary().GetByID(ctx, vocabularyID)
+	if err != nil {
+		return nil, err
+	}
+
+	sentences, err := s.repository.GetExampleSentence().GetByVocabularyID(ctx, vocabularyID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dto.ExampleSentenceResponse, 0, len(sentences))
+	for _, sentence := range sentences {
+		responses = append(responses, *s.toExampleSentenceResponse(&sentence))
+	}
+
+	return responses, nil
+}
+
+func (s *ExampleSentenceService) Update(ctx context.Context, req *dto.UpdateExampleSentenceRequest, id uint) (*dto.ExampleSentenceResponse, error) {
+	// Check if example sentence exists
+	_, err := s.repository.GetExampleSentence().GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate difficulty range
+	if req.Difficulty > 0 {
+		if err := s.validateDifficulty(req.Difficulty); err != nil {
+			return nil, err
+		}
+	}
+
+	// Validate linked vocabularies exist
+	if err := s.validateVocabularies(ctx, req.VocabularyIDs); err != nil {
+		return nil, err
+	}
+
+	sentence, err := s.repository.GetExampleSentence().Update(ctx, req, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toExampleSentenceResponse(sentence), nil
+}
+
+func (s *ExampleSentenceService) Delete(ctx context.Context, id uint) error {
+	// Check if example sentence exists
+	_, err := s.repository.GetExampleSentence().GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return s.repository.GetExampleSentence().Delete(ctx, id)
+}
@@ -6,6 +6,7 @@ import (
 	"manabu-service/domain/dto"
 	"manabu-service/domain/models"
 	"manabu-service/repositories"
+	accessService "manabu-service/services/lesson_access"
 	"math"
 )
 
@@ -28,15 +29,15 @@ type IExerciseService interface {
 	// GetByLessonID retrieves all exercises for a specific lesson, ordered by order_index.
 	GetByLessonID(context.Context, uint) ([]dto.ExerciseResponse, error)
 
+	// Reorder sets the order of the exercises of a lesson to the order of the given IDs and returns them.
+	Reorder(context.Context, uint, *dto.ReorderRequest) ([]dto.ExerciseResponse, error)
+
 	// Update validates and updates an existing exercise entry.
 	// Validates lesson existence and checks for duplicate order_index.
 	Update(context.Context, *dto.UpdateExerciseRequest, uint) (*dto.ExerciseResponse, error)
 
-	// Delete removes an exercise entry by ID if it exists.
+	// Delete moves an exercise and its questions to the trash if it exists.
 	Delete(context.Context, uint) error
-
-	// UpdatePublishStatus marks an exercise as published or unpublished.
-	UpdatePublishStatus(context.Context, uint, bool) (*dto.ExerciseResponse, error)
 }
 
 func NewExerciseService(repository repositories.IRepositoryRegistry) IExerciseService {
@@ -54,6 +55,9 @@ func (s *ExerciseService) toExerciseResponse(exercise *models.Exercise) *dto.Exe
 		OrderIndex:       exercise.OrderIndex,
 		DifficultyLevel:  exercise.DifficultyLevel,
 		EstimatedMinutes: exercise.EstimatedMinutes,
+		QuestionPoolSize: exercise.QuestionPoolSize,
+		ShuffleQuestions: exercise.ShuffleQuestions,
+		ShuffleOptions:   exercise.ShuffleOptions,
 		IsPublished:      exercise.IsPublished,
 	}
 
@@ -268,6 +272,12 @@ func (s *ExerciseService) GetByLessonID(ctx context.Context, lessonID uint) ([]d
 		return nil, errConstant.ErrInvalidLessonIDExercise
 	}
 
+	// Exercises of locked lessons are hidden from learners
+	err := accessService.NewLessonAccessService(s.repository).CheckAccess(ctx, lessonID)
+	if err != nil {
+		return nil, err
+	}
+
 	exercises, err := s.repository.GetExercise().GetByLessonID(ctx, lessonID)
 	if err != nil {
 		return nil, err
@@ -281,6 +291,20 @@ func (s *ExerciseService) GetByLessonID(ctx context.Context, lessonID uint) ([]d
 	return responses, nil
 }
 
+func (s *ExerciseService) Reorder(ctx context.Context, lessonID uint, req *dto.ReorderRequest) ([]dto.ExerciseResponse, error) {
+	// Validate lesson exists
+	if !s.isLessonExist(ctx, lessonID) {
+		return nil, errConstant.ErrInvalidLessonIDExercise
+	}
+
+	err := s.repository.GetExercise().Reorder(ctx, lessonID, req.IDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetByLessonID(ctx, lessonID)
+}
+
 func (s *ExerciseService) Update(ctx context.Context, req *dto.UpdateExerciseRequest, id uint) (*dto.ExerciseResponse, error) {
 	// Check if exercise exists
 	existingExercise, err := s.repository.GetExercise().GetByID(ctx, id)
@@ -344,39 +368,10 @@ func (s *ExerciseService) Delete(ctx context.Context, id uint) error {
 		return err
 	}
 
-	err = s.repository.GetExercise().Delete(ctx, id)
+	err = s.repository.GetTrash().SoftDelete(ctx, models.ContentTypeExercise, id)
 	if err != nil {
 		return err
 	}
 
 	return nil
 }
-
-func (s *ExerciseService) UpdatePublishStatus(ctx context.Context, id uint, isPublished bool) (*dto.ExerciseResponse, error) {
-	// Check if exercise exists
-	existingExercise, err := s.repository.GetExercise().GetByID(ctx, id)
-	if err != nil {
-		return nil, err
-	}
-
-	// Check if already in the requested state
-	if isPublished {
-		if existingExercise.IsPublished {
-			return nil, errConstant.ErrExerciseAlreadyPublished
-		}
-		exercise, err := s.repository.GetExercise().Publish(ctx, id)
-		if err != nil {
-			return nil, err
-		}
-		return s.toExerciseResponse(exercise), nil
-	} else {
-		if !existingExercise.IsPublished {
-			return nil, errConstant.ErrExerciseNotPublished
-		}
-		exercise, err := s.repository.GetExercise().Unpublish(ctx, id)
-		if err != nil {
-			return nil, err
-		}
-		return s.toExerciseResponse(exercise), nil
-	}
-}
@@ -0,0 +1,289 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"manabu-service/common/answer"
+	"manabu-service/common/shuffle"
+	"manabu-service/constants"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+	"manabu-service/repositories"
+	lessonService "manabu-service/services/lesson"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ExerciseAttemptService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+// IExerciseAttemptService defines the contract for seeded exercise variants of the authenticated user.
+// The exercise settings decide how many questions are drawn and whether questions and options are shuffled.
+type IExerciseAttemptService interface {
+	// Start draws a new variant of a published exercise.
+	Start(context.Context, uint) (*dto.ExerciseAttemptResponse, error)
+
+	// GetByID reproduces the variant of an attempt, with its results once submitted.
+	GetByID(context.Context, uuid.UUID) (*dto.ExerciseAttemptResponse, error)
+
+	// Submit grades the answers to the questions of the variant.
+	Submit(context.Context, uuid.UUID, *dto.SubmitExerciseAttemptRequest) (*dto.ExerciseAttemptResponse, error)
+}
+
+func NewExerciseAttemptService(repository repositories.IRepositoryRegistry) IExerciseAttemptService {
+	return &ExerciseAttemptService{repository: repository}
+}
+
+func (s *ExerciseAttemptService) getUserLogin(ctx context.Context) (*dto.UserResponse, error) {
+	userLogin, ok := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	if !ok || userLogin == nil {
+		return nil, errConstant.ErrUnauthorized
+	}
+	return userLogin, nil
+}
+
+// getOwnedAttempt loads an attempt of the authenticated user
+func (s *ExerciseAttemptService) getOwnedAttempt(ctx context.Context, id uuid.UUID) (*models.ExerciseAttempt, error) {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	attempt, err := s.repository.GetExerciseAttempt().GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify ownership - return not found error if the attempt doesn't belong to the user
+	if attempt.UserID != userLogin.UUID {
+		return nil, errConstant.ErrExerciseAttemptNotFound
+	}
+
+	return attempt, nil
+}
+
+// getQuestions loads the drawn questions of an attempt in attempt order
+func (s *ExerciseAttemptService) getQuestions(ctx context.Context, attempt *models.ExerciseAttempt) ([]models.ExerciseQuestion, error) {
+	var questionIDs []uint
+	if err := json.Unmarshal([]byte(attempt.QuestionIDs), &questionIDs); err != nil {
+		return nil, err
+	}
+
+	questions, err := s.repository.GetExerciseQuestion().GetByIDs(ctx, questionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Questions deleted since the attempt started are skipped
+	questionsByID := make(map[uint]models.ExerciseQuestion, len(questions))
+	for _, question := range questions {
+		questionsByID[question.ID] = question
+	}
+	ordered := make([]models.ExerciseQuestion, 0, len(questionIDs))
+	for _, id := range questionIDs {
+		if question, ok := questionsByID[id]; ok {
+			ordered = append(ordered, question)
+		}
+	}
+	return ordered, nil
+}
+
+// toExerciseAttemptResponse converts an ExerciseAttempt model and its questions to the
+// variant served to the learner, shuffling options when the exercise asks for it
+func (s *ExerciseAttemptService) toExerciseAttemptResponse(attempt *models.ExerciseAttempt, questions []models.ExerciseQuestion) *dto.ExerciseAttemptResponse {
+	response := &dto.ExerciseAttemptResponse{
+		ID:         attempt.ID.String(),
+		ExerciseID: attempt.ExerciseID,
+		Seed:       attempt.Seed,
+		Status:     attempt.Status,
+		StartedAt:  attempt.StartedAt.Format("2006-01-02T15:04:05Z07:00"),
+		Score:      attempt.Score,
+		MaxScore:   attempt.MaxScore,
+		Questions:  make([]dto.ExerciseQuestionPublicResponse, 0, len(questions)),
+	}
+
+	if attempt.SubmittedAt != nil {
+		submittedAtStr := attempt.SubmittedAt.Format("2006-01-02T15:04:05Z07:00")
+		response.SubmittedAt = &submittedAtStr
+	}
+
+	for _, question := range questions {
+		options := answer.DecodeOptions(question.Options)
+		if attempt.Exercise.ShuffleOptions {
+			options = shuffle.Options(attempt.Seed, question.ID, options)
+		}
+		response.Questions = append(response.Questions, dto.ExerciseQuestionPublicResponse{
+			ID:           question.ID,
+			ExerciseID:   question.ExerciseID,
+			QuestionText: question.QuestionText,
+			QuestionType: question.QuestionType,
+			Options:      options,
+			AudioURL:     question.AudioURL,
+			ImageURL:     question.ImageURL,
+			OrderIndex:   question.OrderIndex,
+			Points:       question.Points,
+			IsPublished:  question.IsPublished,
+		})
+	}
+
+	if attempt.Results != nil {
+		var results []dto.CheckAnswerResponse
+		if err := json.Unmarshal([]byte(*attempt.Results), &results); err == nil {
+			response.Results = results
+		}
+	}
+
+	return response
+}
+
+func (s *ExerciseAttemptService) Start(ctx context.Context, exerciseID uint) (*dto.ExerciseAttemptResponse, error) {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only published exercises can be attempted
+	exercise, err := s.repository.GetExercise().GetByID(ctx, exerciseID)
+	if err != nil {
+		return nil, err
+	}
+	if !exercise.IsPublished {
+		return nil, errConstant.ErrExerciseNotFound
+	}
+
+	// Exercises of locked lessons cannot be attempted
+	err = lessonService.NewLessonService(s.repository).CheckAccess(ctx, exercise.LessonID)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := s.repository.GetExerciseQuestion().GetPublishedIDsByExerciseID(ctx, exerciseID)
+	if err != nil {
+		return nil, err
+	}
+	if len(pool) == 0 {
+		return nil, errConstant.ErrExerciseHasNoQuestions
+	}
+
+	seed := shuffle.NewSeed()
+	questionIDs, err := json.Marshal(shuffle.Draw(seed, pool, exercise.QuestionPoolSize, exercise.ShuffleQuestions))
+	if err != nil {
+		return nil, err
+	}
+
+	attempt, err := s.repository.GetExerciseAttempt().Create(ctx, &models.ExerciseAttempt{
+		ExerciseID:  exerciseID,
+		UserID:      userLogin.UUID,
+		Seed:        seed,
+		QuestionIDs: string(questionIDs),
+		Status:      models.ExerciseAttemptStatusInProgress,
+		StartedAt:   time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	questions, err := s.getQuestions(ctx, attempt)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toExerciseAttemptResponse(attempt, questions), nil
+}
+
+func (s *ExerciseAttemptService) GetByID(ctx context.Context, id uuid.UUID) (*dto.ExerciseAttemptResponse, error) {
+	attempt, err := s.getOwnedAttempt(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	questions, err := s.getQuestions(ctx, attempt)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toExerciseAttemptResponse(attempt, questions), nil
+}
+
+func (s *ExerciseAttemptService) Submit(ctx context.Context, id uuid.UUID, req *dto.SubmitExerciseAttemptRequest) (*dto.ExerciseAttemptResponse, error) {
+	attempt, err := s.getOwnedAttempt(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if attempt.Status != models.ExerciseAttemptStatusInProgress {
+		return nil, errConstant.ErrExerciseAttemptAlreadySubmitted
+	}
+
+	questions, err := s.getQuestions(ctx, attempt)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only questions of the variant can be answered
+	answers := make(map[uint]*dto.SubmittedAnswer, len(req.Answers))
+	questionsByID := make(map[uint]bool, len(questions))
+	for _, question := range questions {
+		questionsByID[question.ID] = true
+	}
+	for _, item := range req.Answers {
+		if !questionsByID[item.QuestionID] {
+			return nil, errConstant.ErrExerciseAttemptQuestionNotFound
+		}
+		answers[item.QuestionID] = item.Answer
+	}
+
+	score, maxScore := 0, 0
+	results := make([]dto.CheckAnswerResponse, 0, len(questions))
+	for _, question := range questions {
+		correctAnswer := answer.DecodeCorrectAnswer(question.CorrectAnswer)
+		result := dto.CheckAnswerResponse{
+			QuestionID:    question.ID,
+			CorrectAnswer: correctAnswer,
+			Explanation:   question.Explanation,
+		}
+
+		// Speaking answers are reviewed by a teacher and are not part of the automatic score
+		if question.QuestionType == "speaking" {
+			results = append(results, result)
+			continue
+		}
+
+		// Unanswered questions count as incorrect
+		if submitted, ok := answers[question.ID]; ok {
+			graded, err := answer.Grade(question.QuestionType, correctAnswer, submitted, question.AnswerStrictness)
+			if err != nil {
+				return nil, err
+			}
+			result.IsCorrect = graded.Correct
+			result.BlankResults = graded.BlankResults
+		}
+
+		maxScore += question.Points
+		if result.IsCorrect {
+			score += question.Points
+		}
+		results = append(results, result)
+	}
+
+	resultsJSON, err := json.Marshal(results)
+	if err != nil {
+		return nil, err
+	}
+	resultsStr := string(resultsJSON)
+	submittedAt := time.Now()
+
+	attempt.Status = models.ExerciseAttemptStatusSubmitted
+	attempt.Score = &score
+	attempt.MaxScore = maxScore
+	attempt.Results = &resultsStr
+	attempt.SubmittedAt = &submittedAt
+
+	if err := s.repository.GetExerciseAttempt().Submit(ctx, attempt); err != nil {
+		return nil, err
+	}
+
+	return s.toExerciseAttemptResponse(attempt, questions), nil
+}
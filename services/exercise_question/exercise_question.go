@@ -2,13 +2,24 @@ package services
 
 import (
 	"context"
+	"manabu-service/common/answer"
+	"manabu-service/constants"
 	errConstant "manabu-service/constants/error"
 	"manabu-service/domain/dto"
 	"manabu-service/domain/models"
 	"manabu-service/repositories"
+	revisionService "manabu-service/services/content_revision"
+	accessService "manabu-service/services/lesson_access"
+	mediaService "manabu-service/services/media"
 	"math"
+	"regexp"
+
+	"github.com/google/uuid"
 )
 
+// blankMarker matches a blank (three or more underscores) in a fill_blank template
+var blankMarker = regexp.MustCompile(`_{3,}`)
+
 type ExerciseQuestionService struct {
 	repository repositories.IRepositoryRegistry
 }
@@ -16,9 +27,18 @@ type ExerciseQuestionService struct {
 // IExerciseQuestionService defines the contract for exercise question business logic operations.
 type IExerciseQuestionService interface {
 	// Create validates and creates a new exercise question entry.
-	// Validates exercise existence and checks for duplicate order_index.
+	// Validates exercise existence, the options and answer schema of the question type,
+	// and checks for duplicate order_index.
 	Create(context.Context, *dto.CreateExerciseQuestionRequest) (*dto.ExerciseQuestionResponse, error)
 
+	// CreateBatch validates several exercise questions like Create and creates them in a single
+	// transaction, so either every question is created or none is.
+	CreateBatch(context.Context, []*dto.CreateExerciseQuestionRequest) ([]dto.ExerciseQuestionResponse, error)
+
+	// ValidateContent checks the order index, type, options and answer schema, answer strictness
+	// and points of a question without touching the database.
+	ValidateContent(*dto.CreateExerciseQuestionRequest) error
+
 	// GetAll retrieves all exercise questions with filtering, sorting, and pagination.
 	// Returns full response with CorrectAnswer (for admin use).
 	GetAll(context.Context, *dto.ExerciseQuestionFilterRequest) (*dto.ExerciseQuestionListResponse, error)
@@ -39,19 +59,27 @@ type IExerciseQuestionService interface {
 	// Returns full response with CorrectAnswer (for admin use).
 	GetByExerciseID(context.Context, uint) ([]dto.ExerciseQuestionResponse, error)
 
+	// Reorder sets the order of the questions of an exercise to the order of the given IDs and returns them.
+	Reorder(context.Context, uint, *dto.ReorderRequest) ([]dto.ExerciseQuestionResponse, error)
+
 	// GetByExerciseIDPublic retrieves all exercise questions for a specific exercise.
 	// Hides CorrectAnswer and Explanation fields (for public use).
 	GetByExerciseIDPublic(context.Context, uint) ([]dto.ExerciseQuestionPublicResponse, error)
 
 	// Update validates and updates an existing exercise question entry.
-	// Validates exercise existence and checks for duplicate order_index.
+	// Validates exercise existence, the options and answer schema of the question type,
+	// and checks for duplicate order_index.
 	Update(context.Context, *dto.UpdateExerciseQuestionRequest, uint) (*dto.ExerciseQuestionResponse, error)
 
-	// Delete removes an exercise question entry by ID if it exists.
+	// RestoreRevision updates an exercise question with the snapshot of one of its revisions, recording a new revision.
+	RestoreRevision(context.Context, uint, int) (*dto.ExerciseQuestionResponse, error)
+
+	// Delete moves an exercise question to the trash if it exists.
 	Delete(context.Context, uint) error
 
-	// UpdatePublishStatus marks an exercise question as published or unpublished.
-	UpdatePublishStatus(context.Context, uint, bool) (*dto.ExerciseQuestionResponse, error)
+	// CheckAnswer grades a submitted answer against a published question using
+	// the question's answer strictness. The correct answer and explanation are not revealed.
+	CheckAnswer(context.Context, uint, *dto.SubmittedAnswer) (*dto.CheckAnswerResponse, error)
 }
 
 func NewExerciseQuestionService(repository repositories.IRepositoryRegistry) IExerciseQuestionService {
@@ -61,18 +89,29 @@ func NewExerciseQuestionService(repository repositories.IRepositoryRegistry) IEx
 // toExerciseQuestionResponse converts an ExerciseQuestion model to ExerciseQuestionResponse DTO
 func (s *ExerciseQuestionService) toExerciseQuestionResponse(question *models.ExerciseQuestion) *dto.ExerciseQuestionResponse {
 	response := &dto.ExerciseQuestionResponse{
-		ID:            question.ID,
-		ExerciseID:    question.ExerciseID,
-		QuestionText:  question.QuestionText,
-		QuestionType:  question.QuestionType,
-		Options:       question.Options,
-		CorrectAnswer: question.CorrectAnswer,
-		Explanation:   question.Explanation,
-		AudioURL:      question.AudioURL,
-		ImageURL:      question.ImageURL,
-		OrderIndex:    question.OrderIndex,
-		Points:        question.Points,
-		IsPublished:   question.IsPublished,
+		ID:               question.ID,
+		ExerciseID:       question.ExerciseID,
+		QuestionText:     question.QuestionText,
+		QuestionType:     question.QuestionType,
+		Options:          answer.DecodeOptions(question.Options),
+		CorrectAnswer:    answer.DecodeCorrectAnswer(question.CorrectAnswer),
+		AnswerStrictness: question.AnswerStrictness,
+		JlptSection:      question.JlptSection,
+		Explanation:      question.Explanation,
+		AudioURL:         question.AudioURL,
+		ImageURL:         question.ImageURL,
+		OrderIndex:       question.OrderIndex,
+		Points:           question.Points,
+		IsPublished:      question.IsPublished,
+	}
+
+	if question.AudioMediaID != nil {
+		audioMediaID := question.AudioMediaID.String()
+		response.AudioMediaID = &audioMediaID
+	}
+	if question.ImageMediaID != nil {
+		imageMediaID := question.ImageMediaID.String()
+		response.ImageMediaID = &imageMediaID
 	}
 
 	// Format PublishedAt as string if present
@@ -169,7 +208,7 @@ func (s *ExerciseQuestionService) toExerciseQuestionPublicResponse(question *mod
 		ExerciseID:   question.ExerciseID,
 		QuestionText: question.QuestionText,
 		QuestionType: question.QuestionType,
-		Options:      question.Options,
+		Options:      answer.DecodeOptions(question.Options),
 		AudioURL:     question.AudioURL,
 		ImageURL:     question.ImageURL,
 		OrderIndex:   question.OrderIndex,
@@ -244,42 +283,288 @@ func (s *ExerciseQuestionService) validateQuestionType(questionType string) erro
 	return nil
 }
 
-func (s *ExerciseQuestionService) Create(ctx context.Context, req *dto.CreateExerciseQuestionRequest) (*dto.ExerciseQuestionResponse, error) {
-	// Validate exercise exists
-	if !s.isExerciseExist(ctx, req.ExerciseID) {
-		return nil, errConstant.ErrInvalidExerciseIDQuestion
+func (s *ExerciseQuestionService) validateAnswerStrictness(strictness string) error {
+	switch strictness {
+	case "", constants.AnswerStrictnessStrict, constants.AnswerStrictnessNormal, constants.AnswerStrictnessLenient:
+		return nil
 	}
+	return errConstant.ErrInvalidAnswerStrictness
+}
+
+// collectKeys returns the set of item keys, or false when keys are duplicated
+func (s *ExerciseQuestionService) collectKeys(items []dto.QuestionChoice) (map[string]bool, bool) {
+	keys := make(map[string]bool, len(items))
+	for _, item := range items {
+		if item.Key == "" || item.Text == "" || keys[item.Key] {
+			return nil, false
+		}
+		keys[item.Key] = true
+	}
+	return keys, true
+}
+
+// validateQuestionSchema checks that the options and correct answer follow the schema of the question type
+func (s *ExerciseQuestionService) validateQuestionSchema(questionType, questionText string, options *dto.QuestionOptions, answer *dto.QuestionAnswer) error {
+	if options == nil {
+		options = &dto.QuestionOptions{}
+	}
+	if answer == nil {
+		return errConstant.ErrInvalidQuestionAnswer
+	}
+
+	switch questionType {
+	case "multiple_choice", "listening":
+		if options.Template != "" || len(options.Left) > 0 || len(options.Right) > 0 {
+			return errConstant.ErrQuestionOptionsNotAllowed
+		}
+		if len(answer.Blanks) > 0 || len(answer.Pairs) > 0 || answer.Text != "" || answer.Key == "" {
+			return errConstant.ErrInvalidQuestionAnswer
+		}
+		if len(options.Choices) < 2 || len(options.Choices) > 10 {
+			return errConstant.ErrInvalidQuestionChoices
+		}
+		keys, ok := s.collectKeys(options.Choices)
+		if !ok {
+			return errConstant.ErrInvalidQuestionChoices
+		}
+		if !keys[answer.Key] {
+			return errConstant.ErrCorrectAnswerNotInChoices
+		}
+
+	case "fill_blank":
+		if len(options.Choices) > 0 || len(options.Left) > 0 || len(options.Right) > 0 {
+			return errConstant.ErrQuestionOptionsNotAllowed
+		}
+		if answer.Key != "" || len(answer.Pairs) > 0 || answer.Text != "" {
+			return errConstant.ErrInvalidQuestionAnswer
+		}
+
+		// Blanks are marked in the template, or in the question text when no template is given.
+		// A text without markers is a single free-text blank.
+		template := options.Template
+		if template == "" {
+			template = questionText
+		}
+		blankCount := len(blankMarker.FindAllStringIndex(template, -1))
+		if blankCount == 0 {
+			blankCount = 1
+		}
+		if len(answer.Blanks) != blankCount {
+			return errConstant.ErrInvalidBlankAnswers
+		}
+		for _, accepted := range answer.Blanks {
+			if len(accepted) == 0 {
+				return errConstant.ErrInvalidBlankAnswers
+			}
+			for _, value := range accepted {
+				if value == "" {
+					return errConstant.ErrInvalidBlankAnswers
+				}
+			}
+		}
 
+	case "matching":
+		if len(options.Choices) > 0 || options.Template != "" {
+			return errConstant.ErrQuestionOptionsNotAllowed
+		}
+		if answer.Key != "" || len(answer.Blanks) > 0 || answer.Text != "" {
+			return errConstant.ErrInvalidQuestionAnswer
+		}
+		if len(options.Left) < 2 || len(options.Left) > 10 || len(options.Right) < len(options.Left) || len(options.Right) > 10 {
+			return errConstant.ErrInvalidMatchingItems
+		}
+		leftKeys, ok := s.collectKeys(options.Left)
+		if !ok {
+			return errConstant.ErrInvalidMatchingItems
+		}
+		rightKeys, ok := s.collectKeys(options.Right)
+		if !ok {
+			return errConstant.ErrInvalidMatchingItems
+		}
+
+		// Every left item is paired exactly once, each with a distinct right item
+		if len(answer.Pairs) != len(options.Left) {
+			return errConstant.ErrInvalidMatchingPairs
+		}
+		pairedLeft := make(map[string]bool, len(answer.Pairs))
+		pairedRight := make(map[string]bool, len(answer.Pairs))
+		for _, pair := range answer.Pairs {
+			if !leftKeys[pair.Left] || !rightKeys[pair.Right] || pairedLeft[pair.Left] || pairedRight[pair.Right] {
+				return errConstant.ErrInvalidMatchingPairs
+			}
+			pairedLeft[pair.Left] = true
+			pairedRight[pair.Right] = true
+		}
+
+	case "speaking":
+		if len(options.Choices) > 0 || options.Template != "" || len(options.Left) > 0 || len(options.Right) > 0 {
+			return errConstant.ErrQuestionOptionsNotAllowed
+		}
+		if answer.Key != "" || len(answer.Blanks) > 0 || len(answer.Pairs) > 0 || answer.Text == "" {
+			return errConstant.ErrInvalidQuestionAnswer
+		}
+	}
+
+	return nil
+}
+
+// applyMedia points the audio and image URLs at the referenced media files
+func (s *ExerciseQuestionService) applyMedia(ctx context.Context, audioMediaID, imageMediaID *uuid.UUID, audioURL, imageURL *string) error {
+	media := mediaService.NewMediaService(s.repository)
+	if audioMediaID != nil {
+		audio, err := media.Resolve(ctx, *audioMediaID, models.MediaKindAudio)
+		if err != nil {
+			return err
+		}
+		*audioURL = audio.URL
+	}
+	if imageMediaID != nil {
+		image, err := media.Resolve(ctx, *imageMediaID, models.MediaKindImage)
+		if err != nil {
+			return err
+		}
+		*imageURL = image.URL
+	}
+	return nil
+}
+
+// toRevisionSnapshot captures the editable fields of an exercise question for its revision history
+func (s *ExerciseQuestionService) toRevisionSnapshot(question *models.ExerciseQuestion) *dto.UpdateExerciseQuestionRequest {
+	return &dto.UpdateExerciseQuestionRequest{
+		ExerciseID:       question.ExerciseID,
+		QuestionText:     question.QuestionText,
+		QuestionType:     question.QuestionType,
+		Options:          answer.DecodeOptions(question.Options),
+		CorrectAnswer:    answer.DecodeCorrectAnswer(question.CorrectAnswer),
+		AnswerStrictness: question.AnswerStrictness,
+		JlptSection:      question.JlptSection,
+		Explanation:      question.Explanation,
+		AudioURL:         question.AudioURL,
+		ImageURL:         question.ImageURL,
+		AudioMediaID:     question.AudioMediaID,
+		ImageMediaID:     question.ImageMediaID,
+		OrderIndex:       question.OrderIndex,
+		Points:           question.Points,
+	}
+}
+
+func (s *ExerciseQuestionService) ValidateContent(req *dto.CreateExerciseQuestionRequest) error {
 	// Validate order_index
 	if err := s.validateOrderIndex(req.OrderIndex); err != nil {
-		return nil, err
+		return err
 	}
 
 	// Validate question_type
 	if err := s.validateQuestionType(req.QuestionType); err != nil {
-		return nil, err
+		return err
+	}
+
+	// Validate options and correct answer against the question type schema
+	if err := s.validateQuestionSchema(req.QuestionType, req.QuestionText, req.Options, req.CorrectAnswer); err != nil {
+		return err
+	}
+
+	// Validate answer strictness
+	if err := s.validateAnswerStrictness(req.AnswerStrictness); err != nil {
+		return err
 	}
 
 	// Validate points
-	if err := s.validatePoints(req.Points); err != nil {
+	return s.validatePoints(req.Points)
+}
+
+func (s *ExerciseQuestionService) Create(ctx context.Context, req *dto.CreateExerciseQuestionRequest) (*dto.ExerciseQuestionResponse, error) {
+	if err := s.validateCreate(ctx, req); err != nil {
 		return nil, err
 	}
 
-	// Check if question with same order_index exists for this exercise
-	existingQuestion, err := s.repository.GetExerciseQuestion().GetByExerciseIDAndOrderIndex(ctx, req.ExerciseID, req.OrderIndex)
-	if err != nil && err != errConstant.ErrExerciseQuestionNotFound {
+	// The question and its first revision are stored together
+	var question *models.ExerciseQuestion
+	err := s.repository.Transaction(ctx, func(tx repositories.IRepositoryRegistry) error {
+		var err error
+		question, err = tx.GetExerciseQuestion().Create(ctx, req)
+		if err != nil {
+			return err
+		}
+		return revisionService.NewContentRevisionService(tx).Record(ctx, models.ContentTypeExerciseQuestion, question.ID, models.RevisionActionCreate, s.toRevisionSnapshot(question), nil)
+	})
+	if err != nil {
 		return nil, err
 	}
-	if existingQuestion != nil {
-		return nil, errConstant.ErrDuplicateQuestionOrderIndex
+
+	return s.toExerciseQuestionResponse(question), nil
+}
+
+func (s *ExerciseQuestionService) CreateBatch(ctx context.Context, reqs []*dto.CreateExerciseQuestionRequest) ([]dto.ExerciseQuestionResponse, error) {
+	orderIndexes := make(map[uint]map[int]bool)
+	for _, req := range reqs {
+		if err := s.validateCreate(ctx, req); err != nil {
+			return nil, err
+		}
+
+		// Questions of the batch cannot share an order_index either
+		if orderIndexes[req.ExerciseID] == nil {
+			orderIndexes[req.ExerciseID] = make(map[int]bool)
+		}
+		if orderIndexes[req.ExerciseID][req.OrderIndex] {
+			return nil, errConstant.ErrDuplicateQuestionOrderIndex
+		}
+		orderIndexes[req.ExerciseID][req.OrderIndex] = true
 	}
 
-	question, err := s.repository.GetExerciseQuestion().Create(ctx, req)
+	// The questions and their first revisions are stored together
+	var questions []models.ExerciseQuestion
+	err := s.repository.Transaction(ctx, func(tx repositories.IRepositoryRegistry) error {
+		var err error
+		questions, err = tx.GetExerciseQuestion().CreateBatch(ctx, reqs)
+		if err != nil {
+			return err
+		}
+
+		revisions := revisionService.NewContentRevisionService(tx)
+		for i := range questions {
+			err = revisions.Record(ctx, models.ContentTypeExerciseQuestion, questions[i].ID, models.RevisionActionCreate, s.toRevisionSnapshot(&questions[i]), nil)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return s.toExerciseQuestionResponse(question), nil
+	responses := make([]dto.ExerciseQuestionResponse, 0, len(questions))
+	for i := range questions {
+		responses = append(responses, *s.toExerciseQuestionResponse(&questions[i]))
+	}
+
+	return responses, nil
+}
+
+// validateCreate checks a new question against the database: its exercise exists, its content
+// is valid and its order_index is free. Referenced media files are resolved into their URLs.
+func (s *ExerciseQuestionService) validateCreate(ctx context.Context, req *dto.CreateExerciseQuestionRequest) error {
+	// Validate exercise exists
+	if !s.isExerciseExist(ctx, req.ExerciseID) {
+		return errConstant.ErrInvalidExerciseIDQuestion
+	}
+
+	if err := s.ValidateContent(req); err != nil {
+		return err
+	}
+
+	// Check if question with same order_index exists for this exercise
+	existingQuestion, err := s.repository.GetExerciseQuestion().GetByExerciseIDAndOrderIndex(ctx, req.ExerciseID, req.OrderIndex)
+	if err != nil && err != errConstant.ErrExerciseQuestionNotFound {
+		return err
+	}
+	if existingQuestion != nil {
+		return errConstant.ErrDuplicateQuestionOrderIndex
+	}
+
+	// Resolve referenced media files
+	return s.applyMedia(ctx, req.AudioMediaID, req.ImageMediaID, &req.AudioURL, &req.ImageURL)
 }
 
 func (s *ExerciseQuestionService) GetAll(ctx context.Context, filter *dto.ExerciseQuestionFilterRequest) (*dto.ExerciseQuestionListResponse, error) {
@@ -353,6 +638,20 @@ func (s *ExerciseQuestionService) GetByExerciseID(ctx context.Context, exerciseI
 	return responses, nil
 }
 
+func (s *ExerciseQuestionService) Reorder(ctx context.Context, exerciseID uint, req *dto.ReorderRequest) ([]dto.ExerciseQuestionResponse, error) {
+	// Validate exercise exists
+	if !s.isExerciseExist(ctx, exerciseID) {
+		return nil, errConstant.ErrInvalidExerciseIDQuestion
+	}
+
+	err := s.repository.GetExerciseQuestion().Reorder(ctx, exerciseID, req.IDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetByExerciseID(ctx, exerciseID)
+}
+
 func (s *ExerciseQuestionService) GetAllPublic(ctx context.Context, filter *dto.ExerciseQuestionFilterRequest) (*dto.ExerciseQuestionPublicListResponse, error) {
 	// Set default pagination values
 	if filter == nil {
@@ -407,10 +706,17 @@ func (s *ExerciseQuestionService) GetByIDPublic(ctx context.Context, id uint) (*
 
 func (s *ExerciseQuestionService) GetByExerciseIDPublic(ctx context.Context, exerciseID uint) ([]dto.ExerciseQuestionPublicResponse, error) {
 	// Validate exercise exists
-	if !s.isExerciseExist(ctx, exerciseID) {
+	exercise, err := s.repository.GetExercise().GetByID(ctx, exerciseID)
+	if err != nil {
 		return nil, errConstant.ErrInvalidExerciseIDQuestion
 	}
 
+	// Questions of locked lessons are hidden from learners
+	err = accessService.NewLessonAccessService(s.repository).CheckAccess(ctx, exercise.LessonID)
+	if err != nil {
+		return nil, err
+	}
+
 	questions, err := s.repository.GetExerciseQuestion().GetByExerciseID(ctx, exerciseID)
 	if err != nil {
 		return nil, err
@@ -425,6 +731,21 @@ func (s *ExerciseQuestionService) GetByExerciseIDPublic(ctx context.Context, exe
 }
 
 func (s *ExerciseQuestionService) Update(ctx context.Context, req *dto.UpdateExerciseQuestionRequest, id uint) (*dto.ExerciseQuestionResponse, error) {
+	return s.update(ctx, req, id, nil)
+}
+
+func (s *ExerciseQuestionService) RestoreRevision(ctx context.Context, id uint, version int) (*dto.ExerciseQuestionResponse, error) {
+	req := &dto.UpdateExerciseQuestionRequest{}
+	err := revisionService.NewContentRevisionService(s.repository).LoadSnapshot(ctx, models.ContentTypeExerciseQuestion, id, version, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.update(ctx, req, id, &version)
+}
+
+// update validates and applies an update, recording it as a restore of the given version if set
+func (s *ExerciseQuestionService) update(ctx context.Context, req *dto.UpdateExerciseQuestionRequest, id uint, restoredFrom *int) (*dto.ExerciseQuestionResponse, error) {
 	// Check if question exists
 	existingQuestion, err := s.repository.GetExerciseQuestion().GetByID(ctx, id)
 	if err != nil {
@@ -446,6 +767,16 @@ func (s *ExerciseQuestionService) Update(ctx context.Context, req *dto.UpdateExe
 		return nil, err
 	}
 
+	// Validate options and correct answer against the question type schema
+	if err := s.validateQuestionSchema(req.QuestionType, req.QuestionText, req.Options, req.CorrectAnswer); err != nil {
+		return nil, err
+	}
+
+	// Validate answer strictness
+	if err := s.validateAnswerStrictness(req.AnswerStrictness); err != nil {
+		return nil, err
+	}
+
 	// Validate points
 	if err := s.validatePoints(req.Points); err != nil {
 		return nil, err
@@ -463,7 +794,26 @@ func (s *ExerciseQuestionService) Update(ctx context.Context, req *dto.UpdateExe
 		}
 	}
 
-	question, err := s.repository.GetExerciseQuestion().Update(ctx, req, id)
+	// Resolve referenced media files
+	if err := s.applyMedia(ctx, req.AudioMediaID, req.ImageMediaID, &req.AudioURL, &req.ImageURL); err != nil {
+		return nil, err
+	}
+
+	action := models.RevisionActionUpdate
+	if restoredFrom != nil {
+		action = models.RevisionActionRestore
+	}
+
+	// The change and its revision are stored together
+	var question *models.ExerciseQuestion
+	err = s.repository.Transaction(ctx, func(tx repositories.IRepositoryRegistry) error {
+		var err error
+		question, err = tx.GetExerciseQuestion().Update(ctx, req, id)
+		if err != nil {
+			return err
+		}
+		return revisionService.NewContentRevisionService(tx).Record(ctx, models.ContentTypeExerciseQuestion, question.ID, action, s.toRevisionSnapshot(question), restoredFrom)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -472,34 +822,47 @@ func (s *ExerciseQuestionService) Update(ctx context.Context, req *dto.UpdateExe
 }
 
 func (s *ExerciseQuestionService) Delete(ctx context.Context, id uint) error {
-	return s.repository.GetExerciseQuestion().Delete(ctx, id)
+	// Check if exercise question exists
+	_, err := s.repository.GetExerciseQuestion().GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return s.repository.GetTrash().SoftDelete(ctx, models.ContentTypeExerciseQuestion, id)
 }
 
-func (s *ExerciseQuestionService) UpdatePublishStatus(ctx context.Context, id uint, isPublished bool) (*dto.ExerciseQuestionResponse, error) {
-	// Check if question exists
-	existingQuestion, err := s.repository.GetExerciseQuestion().GetByID(ctx, id)
+func (s *ExerciseQuestionService) CheckAnswer(ctx context.Context, id uint, submitted *dto.SubmittedAnswer) (*dto.CheckAnswerResponse, error) {
+	question, err := s.repository.GetExerciseQuestion().GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	// Check if already in the requested state
-	if isPublished {
-		if existingQuestion.IsPublished {
-			return nil, errConstant.ErrExerciseQuestionAlreadyPublished
-		}
-		question, err := s.repository.GetExerciseQuestion().Publish(ctx, id)
-		if err != nil {
-			return nil, err
-		}
-		return s.toExerciseQuestionResponse(question), nil
-	} else {
-		if !existingQuestion.IsPublished {
-			return nil, errConstant.ErrExerciseQuestionNotPublished
-		}
-		question, err := s.repository.GetExerciseQuestion().Unpublish(ctx, id)
-		if err != nil {
-			return nil, err
-		}
-		return s.toExerciseQuestionResponse(question), nil
+	// Learners can only check answers of published questions
+	if !question.IsPublished {
+		return nil, errConstant.ErrExerciseQuestionNotFound
+	}
+
+	// Questions of locked lessons cannot be checked
+	exercise, err := s.repository.GetExercise().GetByID(ctx, question.ExerciseID)
+	if err != nil {
+		return nil, err
+	}
+	err = accessService.NewLessonAccessService(s.repository).CheckAccess(ctx, exercise.LessonID)
+	if err != nil {
+		return nil, err
 	}
+
+	correctAnswer := answer.DecodeCorrectAnswer(question.CorrectAnswer)
+	result, err := answer.Grade(question.QuestionType, correctAnswer, submitted, question.AnswerStrictness)
+	if err != nil {
+		return nil, err
+	}
+
+	// The same questions are drawn for exams, placement tests and exercise attempts, so the
+	// correct answer and explanation are only revealed once those are submitted
+	return &dto.CheckAnswerResponse{
+		QuestionID:   question.ID,
+		IsCorrect:    result.Correct,
+		BlankResults: result.BlankResults,
+	}, nil
 }
@@ -0,0 +1,159 @@
+package services
+
+import (
+	"context"
+	"manabu-service/common/furigana"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/repositories"
+)
+
+// dictionaryBatchSize limits the number of lookup keys sent in a single dictionary query.
+const dictionaryBatchSize = 500
+
+type FuriganaService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+// IFuriganaService defines the contract for furigana (ruby) generation.
+// Readings are resolved from the vocabulary table, which acts as the dictionary.
+type IFuriganaService interface {
+	// Generate produces ruby segments and HTML markup for the given text.
+	// When a reading is supplied it is aligned against the text directly;
+	// otherwise readings are looked up in the vocabulary dictionary.
+	Generate(context.Context, *dto.FuriganaRequest) (*dto.FuriganaResponse, error)
+
+	// AnnotateVocabularies fills the furigana of each vocabulary word and of its
+	// linked example sentences.
+	AnnotateVocabularies(context.Context, []dto.VocabularyResponse) error
+
+	// AnnotateLessons fills the furigana of each lesson content.
+	AnnotateLessons(context.Context, []dto.LessonResponse) error
+}
+
+func NewFuriganaService(repository repositories.IRepositoryRegistry) IFuriganaService {
+	return &FuriganaService{repository: repository}
+}
+
+// toFuriganaResponse converts ruby segments to FuriganaResponse DTO
+func (s *FuriganaService) toFuriganaResponse(segments []furigana.Segment) *dto.FuriganaResponse {
+	responses := make([]dto.FuriganaSegment, 0, len(segments))
+	for _, segment := range segments {
+		responses = append(responses, dto.FuriganaSegment{
+			Text:    segment.Text,
+			Reading: segment.Reading,
+		})
+	}
+
+	return &dto.FuriganaResponse{
+		Segments: responses,
+		HTML:     furigana.ToHTML(segments),
+	}
+}
+
+// loadDictionary builds a dictionary covering every kanji word that may appear in the texts
+func (s *FuriganaService) loadDictionary(ctx context.Context, texts ...string) (*furigana.Dictionary, error) {
+	dictionary := furigana.NewDictionary()
+
+	words := make([]string, 0)
+	prefixes := make([]string, 0)
+	for _, text := range texts {
+		textWords, textPrefixes := furigana.Candidates(text)
+		words = append(words, textWords...)
+		prefixes = append(prefixes, textPrefixes...)
+	}
+
+	for len(words) > 0 || len(prefixes) > 0 {
+		wordBatch := words[:min(len(words), dictionaryBatchSize)]
+		prefixBatch := prefixes[:min(len(prefixes), dictionaryBatchSize)]
+		words = words[len(wordBatch):]
+		prefixes = prefixes[len(prefixBatch):]
+
+		vocabularies, err := s.repository.GetVocabulary().GetDictionaryEntries(ctx, wordBatch, prefixBatch)
+		if err != nil {
+			return nil, err
+		}
+		for _, vocabulary := range vocabularies {
+			dictionary.Add(vocabulary.Word, vocabulary.Reading)
+		}
+	}
+
+	return dictionary, nil
+}
+
+// annotate aligns text with its reading when available, falling back to the dictionary
+func (s *FuriganaService) annotate(text, reading string, dictionary *furigana.Dictionary) *dto.FuriganaResponse {
+	if reading != "" {
+		if segments, ok := furigana.Align(text, reading); ok {
+			return s.toFuriganaResponse(segments)
+		}
+	}
+	return s.toFuriganaResponse(furigana.Annotate(text, dictionary))
+}
+
+func (s *FuriganaService) Generate(ctx context.Context, req *dto.FuriganaRequest) (*dto.FuriganaResponse, error) {
+	// Align against the provided reading
+	if req.Reading != "" {
+		segments, ok := furigana.Align(req.Text, req.Reading)
+		if !ok {
+			return nil, errConstant.ErrFuriganaReadingMismatch
+		}
+		return s.toFuriganaResponse(segments), nil
+	}
+
+	dictionary, err := s.loadDictionary(ctx, req.Text)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toFuriganaResponse(furigana.Annotate(req.Text, dictionary)), nil
+}
+
+func (s *FuriganaService) AnnotateVocabularies(ctx context.Context, vocabularies []dto.VocabularyResponse) error {
+	// Only texts that cannot be aligned against their own reading need the dictionary
+	texts := make([]string, 0)
+	for _, vocabulary := range vocabularies {
+		if _, ok := furigana.Align(vocabulary.Word, vocabulary.Reading); !ok {
+			texts = append(texts, vocabulary.Word)
+		}
+		for _, sentence := range vocabulary.ExampleSentences {
+			if _, ok := furigana.Align(sentence.Text, sentence.Reading); !ok {
+				texts = append(texts, sentence.Text)
+			}
+		}
+	}
+
+	dictionary, err := s.loadDictionary(ctx, texts...)
+	if err != nil {
+		return err
+	}
+
+	for i := range vocabularies {
+		vocabulary := &vocabularies[i]
+		vocabulary.Furigana = s.annotate(vocabulary.Word, vocabulary.Reading, dictionary)
+		for j := range vocabulary.ExampleSentences {
+			sentence := &vocabulary.ExampleSentences[j]
+			sentence.Furigana = s.annotate(sentence.Text, sentence.Reading, dictionary)
+		}
+	}
+
+	return nil
+}
+
+func (s *FuriganaService) AnnotateLessons(ctx context.Context, lessons []dto.LessonResponse) error {
+	texts := make([]string, 0, len(lessons))
+	for _, lesson := range lessons {
+		texts = append(texts, lesson.Content)
+	}
+
+	dictionary, err := s.loadDictionary(ctx, texts...)
+	if err != nil {
+		return err
+	}
+
+	for i := range lessons {
+		lessons[i].ContentFurigana = s.toFuriganaResponse(furigana.Annotate(lessons[i].Content, dictionary))
+	}
+
+	return nil
+}
@@ -2,10 +2,19 @@ package services
 
 import (
 	"context"
+	"encoding/json"
+	"manabu-service/common/answer"
+	"manabu-service/common/markdown"
+	"manabu-service/common/unlock"
+	"manabu-service/constants"
 	errConstant "manabu-service/constants/error"
 	"manabu-service/domain/dto"
 	"manabu-service/domain/models"
 	"manabu-service/repositories"
+	revisionService "manabu-service/services/content_revision"
+	exerciseQuestionService "manabu-service/services/exercise_question"
+	accessService "manabu-service/services/lesson_access"
+	mediaService "manabu-service/services/media"
 	"math"
 )
 
@@ -28,18 +37,25 @@ type ILessonService interface {
 	// GetByCourseID retrieves all lessons for a specific course, ordered by order_index.
 	GetByCourseID(context.Context, uint) ([]dto.LessonResponse, error)
 
+	// Reorder sets the order of the lessons of a course to the order of the given IDs and returns them.
+	Reorder(context.Context, uint, *dto.ReorderRequest) ([]dto.LessonResponse, error)
+
 	// Update validates and updates an existing lesson entry.
 	// Validates course existence and checks for duplicate order_index.
 	Update(context.Context, *dto.UpdateLessonRequest, uint) (*dto.LessonResponse, error)
 
-	// Delete removes a lesson entry by ID if it exists.
+	// RestoreRevision updates a lesson with the snapshot of one of its revisions, recording a new revision.
+	RestoreRevision(context.Context, uint, int) (*dto.LessonResponse, error)
+
+	// Delete moves a lesson and its exercises and questions to the trash if it exists.
 	Delete(context.Context, uint) error
 
-	// Publish marks a lesson as published with the current timestamp.
-	Publish(context.Context, uint) (*dto.LessonResponse, error)
+	// CheckAccess fails with ErrLessonLocked when a lesson is locked for the logged in learner.
+	CheckAccess(context.Context, uint) error
 
-	// Unpublish marks a lesson as unpublished.
-	Unpublish(context.Context, uint) (*dto.LessonResponse, error)
+	// CheckBlockAnswer grades an answer to an inline question block of a published lesson.
+	// The block is addressed by its position in the lesson.
+	CheckBlockAnswer(context.Context, uint, int, *dto.SubmittedAnswer) (*dto.InlineCheckAnswerResponse, error)
 }
 
 func NewLessonService(repository repositories.IRepositoryRegistry) ILessonService {
@@ -53,8 +69,11 @@ func (s *LessonService) toLessonResponse(lesson *models.Lesson) *dto.LessonRespo
 		CourseID:         lesson.CourseID,
 		Title:            lesson.Title,
 		Content:          lesson.Content,
+		Blocks:           s.toBlockResponses(lesson),
 		OrderIndex:       lesson.OrderIndex,
 		EstimatedMinutes: lesson.EstimatedMinutes,
+		UnlockRule:       lesson.UnlockRule,
+		UnlockMinScore:   lesson.UnlockMinScore,
 		IsPublished:      lesson.IsPublished,
 	}
 
@@ -100,6 +119,235 @@ func (s *LessonService) toLessonResponse(lesson *models.Lesson) *dto.LessonRespo
 	return response
 }
 
+// decodeLessonBlocks parses the stored content blocks of a lesson, returning nil when absent or malformed
+func decodeLessonBlocks(raw *string) []dto.LessonBlock {
+	if raw == nil || *raw == "" {
+		return nil
+	}
+	var blocks []dto.LessonBlock
+	if err := json.Unmarshal([]byte(*raw), &blocks); err != nil {
+		return nil
+	}
+	return blocks
+}
+
+// toBlockResponses renders the content blocks of a lesson. Lessons written before blocks
+// existed show their content as a single markdown block.
+func (s *LessonService) toBlockResponses(lesson *models.Lesson) []dto.LessonBlockResponse {
+	blocks := decodeLessonBlocks(lesson.Blocks)
+	if len(blocks) == 0 && lesson.Content != "" {
+		blocks = []dto.LessonBlock{{Type: models.LessonBlockMarkdown, Markdown: lesson.Content}}
+	}
+
+	responses := make([]dto.LessonBlockResponse, 0, len(blocks))
+	for _, block := range blocks {
+		response := dto.LessonBlockResponse{
+			Type:         block.Type,
+			Markdown:     block.Markdown,
+			URL:          block.URL,
+			Caption:      block.Caption,
+			AltText:      block.AltText,
+			VocabularyID: block.VocabularyID,
+			Grammar:      block.Grammar,
+		}
+		// The correct answer and explanation are only revealed by CheckBlockAnswer
+		if block.Question != nil {
+			response.Question = &dto.InlineQuestionPublicBlock{
+				QuestionText: block.Question.QuestionText,
+				QuestionType: block.Question.QuestionType,
+				Options:      block.Question.Options,
+			}
+		}
+		if block.MediaID != nil {
+			mediaID := block.MediaID.String()
+			response.MediaID = &mediaID
+		}
+		switch block.Type {
+		case models.LessonBlockMarkdown:
+			response.HTML = markdown.Render(block.Markdown)
+		case models.LessonBlockGrammar:
+			if block.Grammar != nil && block.Grammar.Explanation != "" {
+				response.HTML = markdown.Render(block.Grammar.Explanation)
+			}
+		}
+		responses = append(responses, response)
+	}
+
+	return responses
+}
+
+// addVocabularyCards fills in the vocabulary cards of the vocabulary blocks of lessons.
+// Blocks whose vocabulary was deleted keep their vocabulary ID without a card.
+func (s *LessonService) addVocabularyCards(ctx context.Context, responses ...*dto.LessonResponse) error {
+	vocabularyIDs := make([]uint, 0)
+	for _, response := range responses {
+		for _, block := range response.Blocks {
+			if block.Type == models.LessonBlockVocabulary && block.VocabularyID > 0 {
+				vocabularyIDs = append(vocabularyIDs, block.VocabularyID)
+			}
+		}
+	}
+	if len(vocabularyIDs) == 0 {
+		return nil
+	}
+
+	vocabularies, err := s.repository.GetVocabulary().GetByIDs(ctx, vocabularyIDs)
+	if err != nil {
+		return err
+	}
+	cards := make(map[uint]*dto.VocabularyCard, len(vocabularies))
+	for _, vocabulary := range vocabularies {
+		cards[vocabulary.ID] = &dto.VocabularyCard{
+			Word:         vocabulary.Word,
+			Reading:      vocabulary.Reading,
+			Meaning:      vocabulary.Meaning,
+			PartOfSpeech: vocabulary.PartOfSpeech,
+			AudioURL:     vocabulary.AudioURL,
+			ImageURL:     vocabulary.ImageURL,
+		}
+	}
+
+	for _, response := range responses {
+		for i := range response.Blocks {
+			block := &response.Blocks[i]
+			if block.Type == models.LessonBlockVocabulary {
+				block.Vocabulary = cards[block.VocabularyID]
+			}
+		}
+	}
+
+	return nil
+}
+
+// addBookmarks marks which of the lessons the logged in user has bookmarked. Responses to anonymous requests are left unmarked.
+func (s *LessonService) addBookmarks(ctx context.Context, responses ...*dto.LessonResponse) error {
+	userLogin, ok := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	if !ok || userLogin == nil || len(responses) == 0 {
+		return nil
+	}
+
+	ids := make([]uint, 0, len(responses))
+	for _, response := range responses {
+		ids = append(ids, response.ID)
+	}
+	bookmarkedIDs, err := s.repository.GetBookmark().GetBookmarkedIDs(ctx, userLogin.UUID.String(), models.ContentTypeLesson, ids)
+	if err != nil {
+		return err
+	}
+	bookmarked := make(map[uint]bool, len(bookmarkedIDs))
+	for _, id := range bookmarkedIDs {
+		bookmarked[id] = true
+	}
+
+	for _, response := range responses {
+		isBookmarked := bookmarked[response.ID]
+		response.IsBookmarked = &isBookmarked
+	}
+	return nil
+}
+
+// responsePointers returns pointers to the given lesson responses
+func (s *LessonService) responsePointers(responses []dto.LessonResponse) []*dto.LessonResponse {
+	pointers := make([]*dto.LessonResponse, len(responses))
+	for i := range responses {
+		pointers[i] = &responses[i]
+	}
+	return pointers
+}
+
+// prepareBlocks sanitises the markdown of content blocks and checks each block has the fields
+// of its type, keeping only those. Referenced media files are resolved to their URLs and
+// referenced vocabulary must exist.
+func (s *LessonService) prepareBlocks(ctx context.Context, blocks []dto.LessonBlock) error {
+	media := mediaService.NewMediaService(s.repository)
+	questionService := exerciseQuestionService.NewExerciseQuestionService(s.repository)
+	vocabularyIDs := make(map[uint]bool)
+
+	for i, block := range blocks {
+		prepared := dto.LessonBlock{Type: block.Type}
+
+		switch block.Type {
+		case models.LessonBlockMarkdown:
+			prepared.Markdown = markdown.Sanitize(block.Markdown)
+			if prepared.Markdown == "" {
+				return errConstant.ErrInvalidLessonBlock
+			}
+
+		case models.LessonBlockAudio, models.LessonBlockImage:
+			kind := models.MediaKindAudio
+			if block.Type == models.LessonBlockImage {
+				kind = models.MediaKindImage
+				prepared.AltText = block.AltText
+			}
+			prepared.Caption = block.Caption
+			prepared.URL = block.URL
+			if block.MediaID != nil {
+				resolved, err := media.Resolve(ctx, *block.MediaID, kind)
+				if err != nil {
+					return err
+				}
+				prepared.MediaID = block.MediaID
+				prepared.URL = resolved.URL
+			}
+			if prepared.URL == "" {
+				return errConstant.ErrInvalidLessonBlock
+			}
+
+		case models.LessonBlockVocabulary:
+			if block.VocabularyID == 0 {
+				return errConstant.ErrInvalidLessonBlock
+			}
+			prepared.VocabularyID = block.VocabularyID
+			vocabularyIDs[block.VocabularyID] = true
+
+		case models.LessonBlockGrammar:
+			if block.Grammar == nil {
+				return errConstant.ErrInvalidLessonBlock
+			}
+			grammar := *block.Grammar
+			grammar.Explanation = markdown.Sanitize(grammar.Explanation)
+			prepared.Grammar = &grammar
+
+		case models.LessonBlockQuestion:
+			if block.Question == nil {
+				return errConstant.ErrInvalidLessonBlock
+			}
+			err := questionService.ValidateContent(&dto.CreateExerciseQuestionRequest{
+				QuestionText:  block.Question.QuestionText,
+				QuestionType:  block.Question.QuestionType,
+				Options:       block.Question.Options,
+				CorrectAnswer: block.Question.CorrectAnswer,
+				Points:        1,
+			})
+			if err != nil {
+				return errConstant.ErrInvalidLessonBlockQuestion
+			}
+			prepared.Question = block.Question
+
+		default:
+			return errConstant.ErrInvalidLessonBlock
+		}
+
+		blocks[i] = prepared
+	}
+
+	if len(vocabularyIDs) > 0 {
+		ids := make([]uint, 0, len(vocabularyIDs))
+		for id := range vocabularyIDs {
+			ids = append(ids, id)
+		}
+		vocabularies, err := s.repository.GetVocabulary().GetByIDs(ctx, ids)
+		if err != nil {
+			return err
+		}
+		if len(vocabularies) != len(ids) {
+			return errConstant.ErrLessonBlockVocabulary
+		}
+	}
+
+	return nil
+}
+
 func (s *LessonService) isCourseExist(ctx context.Context, courseID uint) bool {
 	course, err := s.repository.GetCourse().GetByID(ctx, courseID)
 	if err != nil {
@@ -122,6 +370,34 @@ func (s *LessonService) validateEstimatedMinutes(minutes int) error {
 	return nil
 }
 
+// validateUnlockRule checks that a minimum score is set for the previous_score rule only
+func (s *LessonService) validateUnlockRule(rule string, minScore int) error {
+	if rule == models.LessonUnlockRulePreviousScore {
+		if minScore < 1 || minScore > 100 {
+			return errConstant.ErrInvalidLessonUnlockScore
+		}
+		return nil
+	}
+	if minScore != 0 {
+		return errConstant.ErrInvalidLessonUnlockScore
+	}
+	return nil
+}
+
+// toRevisionSnapshot captures the editable fields of a lesson for its revision history
+func (s *LessonService) toRevisionSnapshot(lesson *models.Lesson) *dto.UpdateLessonRequest {
+	return &dto.UpdateLessonRequest{
+		CourseID:         lesson.CourseID,
+		Title:            lesson.Title,
+		Content:          lesson.Content,
+		Blocks:           decodeLessonBlocks(lesson.Blocks),
+		OrderIndex:       lesson.OrderIndex,
+		EstimatedMinutes: lesson.EstimatedMinutes,
+		UnlockRule:       lesson.UnlockRule,
+		UnlockMinScore:   lesson.UnlockMinScore,
+	}
+}
+
 func (s *LessonService) Create(ctx context.Context, req *dto.CreateLessonRequest) (*dto.LessonResponse, error) {
 	// Validate course exists
 	if !s.isCourseExist(ctx, req.CourseID) {
@@ -138,6 +414,17 @@ func (s *LessonService) Create(ctx context.Context, req *dto.CreateLessonRequest
 		return nil, err
 	}
 
+	// Validate unlock rule
+	if err := s.validateUnlockRule(req.UnlockRule, req.UnlockMinScore); err != nil {
+		return nil, err
+	}
+
+	// Validate content blocks and sanitise markdown
+	req.Content = markdown.Sanitize(req.Content)
+	if err := s.prepareBlocks(ctx, req.Blocks); err != nil {
+		return nil, err
+	}
+
 	// Check if lesson with same order_index exists for this course
 	existingLesson, err := s.repository.GetLesson().GetByCourseIDAndOrderIndex(ctx, req.CourseID, req.OrderIndex)
 	if err != nil && err != errConstant.ErrLessonNotFound {
@@ -147,12 +434,25 @@ func (s *LessonService) Create(ctx context.Context, req *dto.CreateLessonRequest
 		return nil, errConstant.ErrDuplicateOrderIndex
 	}
 
-	lesson, err := s.repository.GetLesson().Create(ctx, req)
+	// The lesson and its first revision are stored together
+	var lesson *models.Lesson
+	err = s.repository.Transaction(ctx, func(tx repositories.IRepositoryRegistry) error {
+		var err error
+		lesson, err = tx.GetLesson().Create(ctx, req)
+		if err != nil {
+			return err
+		}
+		return revisionService.NewContentRevisionService(tx).Record(ctx, models.ContentTypeLesson, lesson.ID, models.RevisionActionCreate, s.toRevisionSnapshot(lesson), nil)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return s.toLessonResponse(lesson), nil
+	response := s.toLessonResponse(lesson)
+	if err := s.addVocabularyCards(ctx, response); err != nil {
+		return nil, err
+	}
+	return response, nil
 }
 
 func (s *LessonService) GetAll(ctx context.Context, filter *dto.LessonFilterRequest) (*dto.LessonListResponse, error) {
@@ -180,9 +480,25 @@ func (s *LessonService) GetAll(ctx context.Context, filter *dto.LessonFilterRequ
 		return nil, err
 	}
 
+	// Learners see which lessons are locked for them
+	states, err := accessService.NewLessonAccessService(s.repository).EvaluateLessons(ctx, lessons)
+	if err != nil {
+		return nil, err
+	}
+
 	responses := make([]dto.LessonResponse, 0, len(lessons))
 	for _, lesson := range lessons {
-		responses = append(responses, *s.toLessonResponse(&lesson))
+		response := s.toLessonResponse(&lesson)
+		if state, ok := states[lesson.ID]; ok {
+			s.applyAccess(response, state)
+		}
+		responses = append(responses, *response)
+	}
+	if err := s.addVocabularyCards(ctx, s.responsePointers(responses)...); err != nil {
+		return nil, err
+	}
+	if err := s.addBookmarks(ctx, s.responsePointers(responses)...); err != nil {
+		return nil, err
 	}
 
 	totalPages := int(math.Ceil(float64(total) / float64(filter.Limit)))
@@ -204,7 +520,22 @@ func (s *LessonService) GetByID(ctx context.Context, id uint) (*dto.LessonRespon
 		return nil, err
 	}
 
-	return s.toLessonResponse(lesson), nil
+	// Learners see whether the lesson is locked for them
+	states, err := accessService.NewLessonAccessService(s.repository).EvaluateLessons(ctx, []models.Lesson{*lesson})
+	if err != nil {
+		return nil, err
+	}
+	response := s.toLessonResponse(lesson)
+	if state, ok := states[lesson.ID]; ok {
+		s.applyAccess(response, state)
+	}
+	if err := s.addVocabularyCards(ctx, response); err != nil {
+		return nil, err
+	}
+	if err := s.addBookmarks(ctx, response); err != nil {
+		return nil, err
+	}
+	return response, nil
 }
 
 func (s *LessonService) GetByCourseID(ctx context.Context, courseID uint) ([]dto.LessonResponse, error) {
@@ -218,15 +549,90 @@ func (s *LessonService) GetByCourseID(ctx context.Context, courseID uint) ([]dto
 		return nil, err
 	}
 
+	// Learners see which lessons are locked for them
+	states, err := accessService.NewLessonAccessService(s.repository).Evaluate(ctx, courseID, lessons)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dto.LessonResponse, 0, len(lessons))
+	for _, lesson := range lessons {
+		response := s.toLessonResponse(&lesson)
+		if state, ok := states[lesson.ID]; ok {
+			s.applyAccess(response, state)
+		}
+		responses = append(responses, *response)
+	}
+	if err := s.addVocabularyCards(ctx, s.responsePointers(responses)...); err != nil {
+		return nil, err
+	}
+	if err := s.addBookmarks(ctx, s.responsePointers(responses)...); err != nil {
+		return nil, err
+	}
+
+	return responses, nil
+}
+
+// applyAccess reports the lock state of a lesson and hides the body of locked lessons
+func (s *LessonService) applyAccess(response *dto.LessonResponse, state unlock.State) {
+	response.Access = &dto.LessonAccessResponse{
+		Locked:  state.Locked,
+		Reason:  state.Reason,
+		Message: state.Message,
+	}
+	if state.Locked {
+		response.Content = ""
+		response.Blocks = []dto.LessonBlockResponse{}
+	}
+}
+
+func (s *LessonService) CheckAccess(ctx context.Context, id uint) error {
+	return accessService.NewLessonAccessService(s.repository).CheckAccess(ctx, id)
+}
+
+func (s *LessonService) Reorder(ctx context.Context, courseID uint, req *dto.ReorderRequest) ([]dto.LessonResponse, error) {
+	// Validate course exists
+	if !s.isCourseExist(ctx, courseID) {
+		return nil, errConstant.ErrInvalidCourseIDLesson
+	}
+
+	err := s.repository.GetLesson().Reorder(ctx, courseID, req.IDs)
+	if err != nil {
+		return nil, err
+	}
+
+	lessons, err := s.repository.GetLesson().GetByCourseID(ctx, courseID)
+	if err != nil {
+		return nil, err
+	}
+
 	responses := make([]dto.LessonResponse, 0, len(lessons))
 	for _, lesson := range lessons {
 		responses = append(responses, *s.toLessonResponse(&lesson))
 	}
+	if err := s.addVocabularyCards(ctx, s.responsePointers(responses)...); err != nil {
+		return nil, err
+	}
 
 	return responses, nil
 }
 
 func (s *LessonService) Update(ctx context.Context, req *dto.UpdateLessonRequest, id uint) (*dto.LessonResponse, error) {
+	return s.update(ctx, req, id, nil)
+}
+
+func (s *LessonService) RestoreRevision(ctx context.Context, id uint, version int) (*dto.LessonResponse, error) {
+	req := &dto.UpdateLessonRequest{}
+	err := revisionService.NewContentRevisionService(s.repository).LoadSnapshot(ctx, models.ContentTypeLesson, id, version, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.update(ctx, req, id, &version)
+}
+
+// update validates and applies an update, recording it as a restore of the given version if set
+func (s *LessonService) update(ctx context.Context, req *dto.UpdateLessonRequest, id uint, restoredFrom *int) (*dto.LessonResponse, error) {
 	// Check if lesson exists
 	existingLesson, err := s.repository.GetLesson().GetByID(ctx, id)
 	if err != nil {
@@ -248,6 +654,17 @@ func (s *LessonService) Update(ctx context.Context, req *dto.UpdateLessonRequest
 		return nil, err
 	}
 
+	// Validate unlock rule
+	if err := s.validateUnlockRule(req.UnlockRule, req.UnlockMinScore); err != nil {
+		return nil, err
+	}
+
+	// Validate content blocks and sanitise markdown
+	req.Content = markdown.Sanitize(req.Content)
+	if err := s.prepareBlocks(ctx, req.Blocks); err != nil {
+		return nil, err
+	}
+
 	// Check if lesson with same order_index exists for this course (excluding current record)
 	// Only check if course_id or order_index is being changed
 	if existingLesson.CourseID != req.CourseID || existingLesson.OrderIndex != req.OrderIndex {
@@ -260,12 +677,30 @@ func (s *LessonService) Update(ctx context.Context, req *dto.UpdateLessonRequest
 		}
 	}
 
-	lesson, err := s.repository.GetLesson().Update(ctx, req, id)
+	action := models.RevisionActionUpdate
+	if restoredFrom != nil {
+		action = models.RevisionActionRestore
+	}
+
+	// The change and its revision are stored together
+	var lesson *models.Lesson
+	err = s.repository.Transaction(ctx, func(tx repositories.IRepositoryRegistry) error {
+		var err error
+		lesson, err = tx.GetLesson().Update(ctx, req, id)
+		if err != nil {
+			return err
+		}
+		return revisionService.NewContentRevisionService(tx).Record(ctx, models.ContentTypeLesson, lesson.ID, action, s.toRevisionSnapshot(lesson), restoredFrom)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return s.toLessonResponse(lesson), nil
+	response := s.toLessonResponse(lesson)
+	if err := s.addVocabularyCards(ctx, response); err != nil {
+		return nil, err
+	}
+	return response, nil
 }
 
 func (s *LessonService) Delete(ctx context.Context, id uint) error {
@@ -275,7 +710,7 @@ func (s *LessonService) Delete(ctx context.Context, id uint) error {
 		return err
 	}
 
-	err = s.repository.GetLesson().Delete(ctx, id)
+	err = s.repository.GetTrash().SoftDelete(ctx, models.ContentTypeLesson, id)
 	if err != nil {
 		return err
 	}
@@ -283,42 +718,42 @@ func (s *LessonService) Delete(ctx context.Context, id uint) error {
 	return nil
 }
 
-func (s *LessonService) Publish(ctx context.Context, id uint) (*dto.LessonResponse, error) {
-	// Check if lesson exists
-	existingLesson, err := s.repository.GetLesson().GetByID(ctx, id)
+func (s *LessonService) CheckBlockAnswer(ctx context.Context, id uint, index int, submitted *dto.SubmittedAnswer) (*dto.InlineCheckAnswerResponse, error) {
+	lesson, err := s.repository.GetLesson().GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	// Check if already published
-	if existingLesson.IsPublished {
-		return nil, errConstant.ErrLessonAlreadyPublished
+	// Learners can only check answers of published lessons they have unlocked
+	if !lesson.IsPublished {
+		return nil, errConstant.ErrLessonNotFound
 	}
-
-	lesson, err := s.repository.GetLesson().Publish(ctx, id)
+	states, err := accessService.NewLessonAccessService(s.repository).EvaluateLessons(ctx, []models.Lesson{*lesson})
 	if err != nil {
 		return nil, err
 	}
-
-	return s.toLessonResponse(lesson), nil
-}
-
-func (s *LessonService) Unpublish(ctx context.Context, id uint) (*dto.LessonResponse, error) {
-	// Check if lesson exists
-	existingLesson, err := s.repository.GetLesson().GetByID(ctx, id)
-	if err != nil {
-		return nil, err
+	if states[lesson.ID].Locked {
+		return nil, errConstant.ErrLessonLocked
 	}
 
-	// Check if not published
-	if !existingLesson.IsPublished {
-		return nil, errConstant.ErrLessonNotPublished
+	blocks := decodeLessonBlocks(lesson.Blocks)
+	if index < 0 || index >= len(blocks) || blocks[index].Type != models.LessonBlockQuestion || blocks[index].Question == nil {
+		return nil, errConstant.ErrLessonBlockNotQuestion
 	}
+	question := blocks[index].Question
 
-	lesson, err := s.repository.GetLesson().Unpublish(ctx, id)
+	// Inline questions have no strictness setting and are graded with the default one
+	result, err := answer.Grade(question.QuestionType, question.CorrectAnswer, submitted, constants.AnswerStrictnessNormal)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.toLessonResponse(lesson), nil
+	return &dto.InlineCheckAnswerResponse{
+		LessonID:      lesson.ID,
+		BlockIndex:    index,
+		IsCorrect:     result.Correct,
+		BlankResults:  result.BlankResults,
+		CorrectAnswer: question.CorrectAnswer,
+		Explanation:   question.Explanation,
+	}, nil
 }
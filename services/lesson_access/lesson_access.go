@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"manabu-service/common/unlock"
+	"manabu-service/constants"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+	"manabu-service/repositories"
+	prerequisiteService "manabu-service/services/course_prerequisite"
+)
+
+type LessonAccessService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+// ILessonAccessService defines the contract for working out which lessons are locked for the
+// logged in learner by the unlock rules of their course.
+type ILessonAccessService interface {
+	// Evaluate works out the lock state of the published lessons of a course, keyed by lesson ID.
+	Evaluate(context.Context, uint, []models.Lesson) (map[uint]unlock.State, error)
+
+	// EvaluateLessons works out the lock state of lessons that may belong to several courses.
+	EvaluateLessons(context.Context, []models.Lesson) (map[uint]unlock.State, error)
+
+	// CheckAccess fails with ErrLessonLocked when a lesson is locked for the logged in learner.
+	CheckAccess(context.Context, uint) error
+}
+
+func NewLessonAccessService(repository repositories.IRepositoryRegistry) ILessonAccessService {
+	return &LessonAccessService{repository: repository}
+}
+
+// EvaluateLessons works out the lock state of lessons for the logged in user, evaluating each of
+// their courses once. Lessons without a lock state, such as unpublished ones, are absent.
+func (s *LessonAccessService) EvaluateLessons(ctx context.Context, lessons []models.Lesson) (map[uint]unlock.State, error) {
+	states := make(map[uint]unlock.State)
+	evaluated := make(map[uint]bool)
+	for _, lesson := range lessons {
+		if evaluated[lesson.CourseID] {
+			continue
+		}
+		evaluated[lesson.CourseID] = true
+
+		courseLessons, err := s.repository.GetLesson().GetByCourseID(ctx, lesson.CourseID)
+		if err != nil {
+			return nil, err
+		}
+		courseStates, err := s.Evaluate(ctx, lesson.CourseID, courseLessons)
+		if err != nil {
+			return nil, err
+		}
+		for id, state := range courseStates {
+			states[id] = state
+		}
+	}
+	return states, nil
+}
+
+func (s *LessonAccessService) CheckAccess(ctx context.Context, id uint) error {
+	lesson, err := s.repository.GetLesson().GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	states, err := s.EvaluateLessons(ctx, []models.Lesson{*lesson})
+	if err != nil {
+		return err
+	}
+	if states[lesson.ID].Locked {
+		return errConstant.ErrLessonLocked
+	}
+	return nil
+}
+
+// Evaluate works out the lock state of the published lessons of a course for the logged in
+// user, keyed by lesson ID. Only published lessons count towards the position of a lesson.
+// Teachers and admins write the lessons and have no lock state; learners who are not logged in
+// are evaluated without any progress.
+func (s *LessonAccessService) Evaluate(ctx context.Context, courseID uint, lessons []models.Lesson) (map[uint]unlock.State, error) {
+	states := make(map[uint]unlock.State)
+	userLogin, _ := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	if userLogin != nil && (userLogin.Role == constants.RoleTeacher || userLogin.Role == constants.RoleAdmin) {
+		return states, nil
+	}
+
+	var userID uint
+	if userLogin != nil {
+		user, err := s.repository.GetUser().FindByUUID(ctx, userLogin.UUID.String())
+		if err != nil {
+			return nil, err
+		}
+		userID = user.ID
+	}
+
+	missing, err := prerequisiteService.NewCoursePrerequisiteService(s.repository).GetMissing(ctx, courseID, userID)
+	if err != nil {
+		return nil, err
+	}
+	progress := unlock.Progress{MissingCourses: missing}
+
+	lessonIDs := make([]uint, 0, len(lessons))
+	unlockLessons := make([]unlock.Lesson, 0, len(lessons))
+	for _, lesson := range lessons {
+		if !lesson.IsPublished {
+			continue
+		}
+		lessonIDs = append(lessonIDs, lesson.ID)
+		unlockLessons = append(unlockLessons, unlock.Lesson{
+			ID:       lesson.ID,
+			Rule:     lesson.UnlockRule,
+			MinScore: lesson.UnlockMinScore,
+		})
+	}
+
+	if userLogin != nil {
+		courseProgress, err := s.repository.GetUserCourseProgress().GetByUserIDAndCourseID(ctx, userID, courseID)
+		if err != nil && !errors.Is(err, errConstant.ErrUserCourseProgressNotFound) {
+			return nil, err
+		}
+		if courseProgress != nil {
+			progress.CompletedLessons = courseProgress.CompletedLessons
+			progress.CourseCompleted = courseProgress.Status == models.ProgressStatusCompleted
+		}
+
+		progress.Scores, err = s.repository.GetExerciseAttempt().GetLessonScores(ctx, userLogin.UUID, lessonIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for i, state := range unlock.Evaluate(unlockLessons, progress) {
+		states[unlockLessons[i].ID] = state
+	}
+	return states, nil
+}
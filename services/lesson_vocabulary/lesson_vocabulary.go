@@ -0,0 +1,153 @@
+package services
+
+import (
+	"context"
+	"manabu-service/constants"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+	"manabu-service/repositories"
+	accessService "manabu-service/services/lesson_access"
+
+	"github.com/google/uuid"
+)
+
+type LessonVocabularyService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+// ILessonVocabularyService defines the contract for the vocabulary taught by a lesson.
+type ILessonVocabularyService interface {
+	// GetByLessonID retrieves the vocabulary of a lesson in lesson order.
+	GetByLessonID(context.Context, uint) ([]dto.LessonVocabularyResponse, error)
+
+	// Set replaces the vocabulary of a lesson. Words are ordered by their position in the request.
+	Set(context.Context, uint, *dto.SetLessonVocabulariesRequest) ([]dto.LessonVocabularyResponse, error)
+
+	// Study adds the vocabulary of a lesson to the deck of the logged in user.
+	Study(context.Context, uint) (*dto.StudyLessonVocabulariesResponse, error)
+
+	// AddToDeck adds the vocabulary of the given lessons to the deck of a user, skipping words the
+	// user is already learning, and returns how many words were added out of how many.
+	AddToDeck(context.Context, uuid.UUID, []uint) (int, int, error)
+}
+
+func NewLessonVocabularyService(repository repositories.IRepositoryRegistry) ILessonVocabularyService {
+	return &LessonVocabularyService{repository: repository}
+}
+
+// toLessonVocabularyResponse converts a LessonVocabulary model to LessonVocabularyResponse DTO
+func (s *LessonVocabularyService) toLessonVocabularyResponse(vocabulary *models.LessonVocabulary) dto.LessonVocabularyResponse {
+	return dto.LessonVocabularyResponse{
+		VocabularyID: vocabulary.VocabularyID,
+		OrderIndex:   vocabulary.OrderIndex,
+		Word:         vocabulary.Vocabulary.Word,
+		Reading:      vocabulary.Vocabulary.Reading,
+		Meaning:      vocabulary.Vocabulary.Meaning,
+		PartOfSpeech: vocabulary.Vocabulary.PartOfSpeech,
+		JlptLevelID:  vocabulary.Vocabulary.JlptLevelID,
+		AudioURL:     vocabulary.Vocabulary.AudioURL,
+		ImageURL:     vocabulary.Vocabulary.ImageURL,
+	}
+}
+
+// checkLessonAccess fails unless the lesson exists and the logged in user may study it. Teachers
+// and admins see every lesson; other users only published lessons they have unlocked.
+func (s *LessonVocabularyService) checkLessonAccess(ctx context.Context, lessonID uint) error {
+	lesson, err := s.repository.GetLesson().GetByID(ctx, lessonID)
+	if err != nil {
+		return err
+	}
+
+	userLogin, _ := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	isAuthor := userLogin != nil && (userLogin.Role == constants.RoleTeacher || userLogin.Role == constants.RoleAdmin)
+	if !isAuthor && !lesson.IsPublished {
+		return errConstant.ErrLessonNotFound
+	}
+
+	return accessService.NewLessonAccessService(s.repository).CheckAccess(ctx, lessonID)
+}
+
+func (s *LessonVocabularyService) GetByLessonID(ctx context.Context, lessonID uint) ([]dto.LessonVocabularyResponse, error) {
+	// Check if lesson exists and is visible to the user
+	err := s.checkLessonAccess(ctx, lessonID)
+	if err != nil {
+		return nil, err
+	}
+
+	vocabularies, err := s.repository.GetLessonVocabulary().GetByLessonID(ctx, lessonID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dto.LessonVocabularyResponse, 0, len(vocabularies))
+	for _, vocabulary := range vocabularies {
+		responses = append(responses, s.toLessonVocabularyResponse(&vocabulary))
+	}
+
+	return responses, nil
+}
+
+func (s *LessonVocabularyService) Set(ctx context.Context, lessonID uint, req *dto.SetLessonVocabulariesRequest) ([]dto.LessonVocabularyResponse, error) {
+	// Check if lesson exists
+	_, err := s.repository.GetLesson().GetByID(ctx, lessonID)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.repository.GetLessonVocabulary().CountVocabularies(ctx, req.VocabularyIDs)
+	if err != nil {
+		return nil, err
+	}
+	if count != int64(len(req.VocabularyIDs)) {
+		return nil, errConstant.ErrLessonVocabularyNotFound
+	}
+
+	err = s.repository.GetLessonVocabulary().Replace(ctx, lessonID, req.VocabularyIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetByLessonID(ctx, lessonID)
+}
+
+func (s *LessonVocabularyService) Study(ctx context.Context, lessonID uint) (*dto.StudyLessonVocabulariesResponse, error) {
+	userLogin, ok := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	if !ok || userLogin == nil {
+		return nil, errConstant.ErrUnauthorized
+	}
+
+	// Only the vocabulary of published lessons the user has unlocked can be studied
+	err := s.checkLessonAccess(ctx, lessonID)
+	if err != nil {
+		return nil, err
+	}
+
+	added, total, err := s.AddToDeck(ctx, userLogin.UUID, []uint{lessonID})
+	if err != nil {
+		return nil, err
+	}
+	if total == 0 {
+		return nil, errConstant.ErrLessonNoVocabularies
+	}
+
+	return &dto.StudyLessonVocabulariesResponse{
+		LessonID:        lessonID,
+		Added:           added,
+		AlreadyLearning: total - added,
+	}, nil
+}
+
+func (s *LessonVocabularyService) AddToDeck(ctx context.Context, userID uuid.UUID, lessonIDs []uint) (int, int, error) {
+	vocabularyIDs, err := s.repository.GetLessonVocabulary().GetVocabularyIDsByLessonIDs(ctx, lessonIDs)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	added, err := s.repository.GetUserVocabularyStatus().CreateMany(ctx, userID, vocabularyIDs)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return added, len(vocabularyIDs), nil
+}
@@ -0,0 +1,282 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"manabu-service/common/storage"
+	"manabu-service/common/thumbnail"
+	"manabu-service/config"
+	"manabu-service/constants"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+	"manabu-service/repositories"
+	"mime"
+	"mime/multipart"
+	"path/filepath"
+
+	"github.com/google/uuid"
+)
+
+type MediaService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+// IMediaService defines the contract for uploaded audio and image files.
+type IMediaService interface {
+	// Upload validates and stores an audio or image file. Images get a generated thumbnail.
+	Upload(context.Context, *multipart.FileHeader) (*dto.MediaResponse, error)
+
+	// Save validates and stores the content of an audio or image file under the given file name.
+	Save(context.Context, string, string, []byte) (*dto.MediaResponse, error)
+
+	// GetByID retrieves a media record.
+	GetByID(context.Context, uuid.UUID) (*dto.MediaResponse, error)
+
+	// Open opens the stored file of a media record, or its thumbnail, and returns it with its content type.
+	Open(context.Context, uuid.UUID, bool) (io.ReadCloser, string, error)
+
+	// Resolve retrieves a media record referenced by content and checks it is of the expected kind.
+	Resolve(context.Context, uuid.UUID, string) (*dto.MediaResponse, error)
+}
+
+func NewMediaService(repository repositories.IRepositoryRegistry) IMediaService {
+	return &MediaService{repository: repository}
+}
+
+func (s *MediaService) getUserLogin(ctx context.Context) (*dto.UserResponse, error) {
+	userLogin, ok := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	if !ok || userLogin == nil {
+		return nil, errConstant.ErrUnauthorized
+	}
+	return userLogin, nil
+}
+
+// toMediaResponse converts a Media model to MediaResponse DTO
+func (s *MediaService) toMediaResponse(media *models.Media) *dto.MediaResponse {
+	response := &dto.MediaResponse{
+		ID:          media.ID.String(),
+		Kind:        media.Kind,
+		FileName:    media.FileName,
+		ContentType: media.ContentType,
+		SizeBytes:   media.SizeBytes,
+		URL:         fmt.Sprintf("/api/v1/media/%s/file", media.ID),
+		Width:       media.Width,
+		Height:      media.Height,
+	}
+
+	if media.ThumbnailKey != "" {
+		response.ThumbnailURL = fmt.Sprintf("/api/v1/media/%s/thumbnail", media.ID)
+	}
+	if media.CreatedAt != nil {
+		response.CreatedAt = media.CreatedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	return response
+}
+
+// makeThumbnail checks that the content is an image of the declared type and returns its
+// dimensions with an encoded JPEG thumbnail
+func (s *MediaService) makeThumbnail(content []byte, contentType string) (int, int, []byte, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil || "image/"+format != contentType {
+		return 0, 0, nil, errConstant.ErrInvalidImage
+	}
+	if cfg.Width*cfg.Height > constants.MaxImagePixels {
+		return 0, 0, nil, errConstant.ErrInvalidImage
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return 0, 0, nil, errConstant.ErrInvalidImage
+	}
+
+	thumb, err := thumbnail.JPEG(thumbnail.Resize(img, constants.ThumbnailSize))
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	return cfg.Width, cfg.Height, thumb, nil
+}
+
+func (s *MediaService) Upload(ctx context.Context, file *multipart.FileHeader) (*dto.MediaResponse, error) {
+	if _, err := s.getUserLogin(ctx); err != nil {
+		return nil, err
+	}
+
+	if file.Size == 0 {
+		return nil, errConstant.ErrMediaFileRequired
+	}
+
+	// Validate content type and size before reading the file
+	contentType, _, err := mime.ParseMediaType(file.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, errConstant.ErrUnsupportedMediaType
+	}
+	maxSize, err := s.maxSize(contentType)
+	if err != nil {
+		return nil, err
+	}
+	if file.Size > maxSize {
+		return nil, errConstant.ErrMediaTooLarge
+	}
+
+	body, err := file.Open()
+	if err != nil {
+		return nil, errConstant.ErrMediaFileRequired
+	}
+	defer body.Close()
+	content, err := io.ReadAll(io.LimitReader(body, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Save(ctx, file.Filename, contentType, content)
+}
+
+// maxSize returns the maximum size of a media file of the content type
+func (s *MediaService) maxSize(contentType string) (int64, error) {
+	mediaType, ok := constants.MediaTypes[contentType]
+	if !ok {
+		return 0, errConstant.ErrUnsupportedMediaType
+	}
+	if mediaType.Kind == models.MediaKindImage {
+		return constants.MaxImageMediaSize, nil
+	}
+	return constants.MaxAudioMediaSize, nil
+}
+
+func (s *MediaService) Save(ctx context.Context, fileName, contentType string, content []byte) (*dto.MediaResponse, error) {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(content) == 0 {
+		return nil, errConstant.ErrMediaFileRequired
+	}
+
+	// Validate content type and size
+	maxSize, err := s.maxSize(contentType)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(content)) > maxSize {
+		return nil, errConstant.ErrMediaTooLarge
+	}
+	mediaType := constants.MediaTypes[contentType]
+
+	mediaID := uuid.New()
+	media := &models.Media{
+		ID:          mediaID,
+		Kind:        mediaType.Kind,
+		FileName:    s.fileName(fileName),
+		ContentType: contentType,
+		SizeBytes:   int64(len(content)),
+		StorageKey:  fmt.Sprintf("media/%s/%s%s", mediaType.Kind, mediaID, mediaType.Extension),
+		UploadedBy:  &userLogin.UUID,
+	}
+
+	var thumb []byte
+	if mediaType.Kind == models.MediaKindImage {
+		media.Width, media.Height, thumb, err = s.makeThumbnail(content, contentType)
+		if err != nil {
+			return nil, err
+		}
+		media.ThumbnailKey = fmt.Sprintf("media/thumbnails/%s.jpg", mediaID)
+	}
+
+	store, err := storage.New(config.Config.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := store.Put(ctx, media.StorageKey, bytes.NewReader(content), contentType); err != nil {
+		return nil, err
+	}
+	if thumb != nil {
+		if err := store.Put(ctx, media.ThumbnailKey, bytes.NewReader(thumb), "image/jpeg"); err != nil {
+			_ = store.Delete(ctx, media.StorageKey)
+			return nil, err
+		}
+	}
+
+	created, err := s.repository.GetMedia().Create(ctx, media)
+	if err != nil {
+		// Do not keep files without a media record
+		_ = store.Delete(ctx, media.StorageKey)
+		if media.ThumbnailKey != "" {
+			_ = store.Delete(ctx, media.ThumbnailKey)
+		}
+		return nil, err
+	}
+
+	return s.toMediaResponse(created), nil
+}
+
+// fileName keeps the base name of an uploaded file, truncated to fit the column
+func (s *MediaService) fileName(name string) string {
+	name = filepath.Base(filepath.ToSlash(name))
+	if name == "." || name == "/" {
+		return ""
+	}
+	runes := []rune(name)
+	if len(runes) > 255 {
+		return string(runes[:255])
+	}
+	return name
+}
+
+func (s *MediaService) GetByID(ctx context.Context, id uuid.UUID) (*dto.MediaResponse, error) {
+	media, err := s.repository.GetMedia().GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toMediaResponse(media), nil
+}
+
+func (s *MediaService) Open(ctx context.Context, id uuid.UUID, thumb bool) (io.ReadCloser, string, error) {
+	media, err := s.repository.GetMedia().GetByID(ctx, id)
+	if err != nil {
+		return nil, "", err
+	}
+
+	key, contentType := media.StorageKey, media.ContentType
+	if thumb {
+		if media.ThumbnailKey == "" {
+			return nil, "", errConstant.ErrStorageObjectNotFound
+		}
+		key, contentType = media.ThumbnailKey, "image/jpeg"
+	}
+
+	store, err := storage.New(config.Config.Storage)
+	if err != nil {
+		return nil, "", err
+	}
+
+	file, err := store.Get(ctx, key)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return file, contentType, nil
+}
+
+func (s *MediaService) Resolve(ctx context.Context, id uuid.UUID, kind string) (*dto.MediaResponse, error) {
+	media, err := s.repository.GetMedia().GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if media.Kind != kind {
+		return nil, errConstant.ErrMediaKindMismatch
+	}
+
+	return s.toMediaResponse(media), nil
+}
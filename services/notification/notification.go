@@ -0,0 +1,138 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"manabu-service/constants"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+	"manabu-service/repositories"
+	"math"
+)
+
+type NotificationService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+// INotificationService defines the contract for the authenticated user's notifications.
+type INotificationService interface {
+	// GetAll retrieves the user's notifications, newest first, with filtering and pagination.
+	GetAll(context.Context, *dto.NotificationFilterRequest) (*dto.NotificationListResponse, error)
+
+	// MarkAsRead marks one of the user's notifications as read.
+	MarkAsRead(context.Context, uint) (*dto.NotificationResponse, error)
+
+	// MarkAllAsRead marks every unread notification of the user as read.
+	MarkAllAsRead(context.Context) error
+}
+
+func NewNotificationService(repository repositories.IRepositoryRegistry) INotificationService {
+	return &NotificationService{repository: repository}
+}
+
+func (s *NotificationService) getUserLogin(ctx context.Context) (*dto.UserResponse, error) {
+	userLogin, ok := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	if !ok || userLogin == nil {
+		return nil, errConstant.ErrUnauthorized
+	}
+	return userLogin, nil
+}
+
+// toNotificationResponse converts a Notification model to NotificationResponse DTO
+func (s *NotificationService) toNotificationResponse(notification *models.Notification) *dto.NotificationResponse {
+	response := &dto.NotificationResponse{
+		ID:      notification.ID,
+		Type:    notification.Type,
+		Title:   notification.Title,
+		Message: notification.Message,
+		IsRead:  notification.ReadAt != nil,
+	}
+
+	if notification.Data != nil {
+		var data map[string]interface{}
+		if err := json.Unmarshal([]byte(*notification.Data), &data); err == nil {
+			response.Data = data
+		}
+	}
+	if notification.ReadAt != nil {
+		readAtStr := notification.ReadAt.Format("2006-01-02T15:04:05Z07:00")
+		response.ReadAt = &readAtStr
+	}
+	if notification.CreatedAt != nil {
+		response.CreatedAt = notification.CreatedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	return response
+}
+
+func (s *NotificationService) GetAll(ctx context.Context, filter *dto.NotificationFilterRequest) (*dto.NotificationListResponse, error) {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set default pagination values
+	if filter == nil {
+		filter = &dto.NotificationFilterRequest{
+			PaginationRequest: dto.PaginationRequest{
+				Page:  1,
+				Limit: 10,
+			},
+		}
+	}
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.Limit < 1 {
+		filter.Limit = 10
+	}
+	if filter.Limit > 100 {
+		filter.Limit = 100
+	}
+
+	notifications, total, err := s.repository.GetNotification().GetAll(ctx, userLogin.UUID.String(), filter)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dto.NotificationResponse, 0, len(notifications))
+	for _, notification := range notifications {
+		responses = append(responses, *s.toNotificationResponse(&notification))
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(filter.Limit)))
+
+	return &dto.NotificationListResponse{
+		Data: responses,
+		Pagination: dto.PaginationResponse{
+			Page:       filter.Page,
+			Limit:      filter.Limit,
+			TotalPages: totalPages,
+			TotalItems: total,
+		},
+	}, nil
+}
+
+func (s *NotificationService) MarkAsRead(ctx context.Context, id uint) (*dto.NotificationResponse, error) {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	notification, err := s.repository.GetNotification().MarkAsRead(ctx, userLogin.UUID.String(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toNotificationResponse(notification), nil
+}
+
+func (s *NotificationService) MarkAllAsRead(ctx context.Context) error {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.repository.GetNotification().MarkAllAsRead(ctx, userLogin.UUID.String())
+}
@@ -0,0 +1,301 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"manabu-service/common/answer"
+	"manabu-service/common/placement"
+	"manabu-service/constants"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+	"manabu-service/repositories"
+	courseService "manabu-service/services/course"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PlacementService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+// IPlacementService defines the contract for adaptive placement tests of the authenticated user.
+// Questions are served one at a time from increasing JLPT level orders until the level is estimated.
+type IPlacementService interface {
+	// Start serves the first question of a new placement test.
+	// An unfinished placement test is resumed instead.
+	Start(context.Context) (*dto.PlacementTestResponse, error)
+
+	// GetByID retrieves a placement test with its current question, or its result once completed.
+	GetByID(context.Context, uuid.UUID) (*dto.PlacementTestResponse, error)
+
+	// SubmitAnswer grades the current question and serves the next one,
+	// or completes the test and recommends courses at the estimated level.
+	SubmitAnswer(context.Context, uuid.UUID, *dto.SubmitPlacementAnswerRequest) (*dto.PlacementTestResponse, error)
+}
+
+func NewPlacementService(repository repositories.IRepositoryRegistry) IPlacementService {
+	return &PlacementService{repository: repository}
+}
+
+func (s *PlacementService) getUserLogin(ctx context.Context) (*dto.UserResponse, error) {
+	userLogin, ok := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	if !ok || userLogin == nil {
+		return nil, errConstant.ErrUnauthorized
+	}
+	return userLogin, nil
+}
+
+// getOwnedTest loads a placement test of the authenticated user
+func (s *PlacementService) getOwnedTest(ctx context.Context, id uuid.UUID) (*models.PlacementTest, error) {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	test, err := s.repository.GetPlacement().GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify ownership - return not found error if the test doesn't belong to the user
+	if test.UserID != userLogin.UUID {
+		return nil, errConstant.ErrPlacementTestNotFound
+	}
+
+	return test, nil
+}
+
+// pendingQuestion returns the question served but not answered yet
+func (s *PlacementService) pendingQuestion(test *models.PlacementTest) *models.PlacementTestAnswer {
+	for i := range test.Answers {
+		if test.Answers[i].AnsweredAt == nil {
+			return &test.Answers[i]
+		}
+	}
+	return nil
+}
+
+// advance serves the next question of an in-progress test, or completes it once
+// the level is estimated or no question is left at the level being assessed
+func (s *PlacementService) advance(ctx context.Context, test *models.PlacementTest) (*models.PlacementTest, error) {
+	if test.Status != models.PlacementTestStatusInProgress || s.pendingQuestion(test) != nil {
+		return test, nil
+	}
+
+	levels, err := s.repository.GetJlptLevel().GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(levels) == 0 {
+		return nil, errConstant.ErrPlacementJlptLevelsNotFound
+	}
+
+	// Levels are listed hardest first, the test starts at the easiest one
+	levelOrders := make([]int, 0, len(levels))
+	levelsByOrder := make(map[int]models.JlptLevel, len(levels))
+	for _, level := range levels {
+		levelOrders = append(levelOrders, level.LevelOrder)
+		levelsByOrder[level.LevelOrder] = level
+	}
+	levelOrders = placement.EasiestFirst(levelOrders)
+
+	responses := make([]placement.Response, 0, len(test.Answers))
+	servedIDs := make([]uint, 0, len(test.Answers))
+	for _, item := range test.Answers {
+		servedIDs = append(servedIDs, item.QuestionID)
+		responses = append(responses, placement.Response{
+			LevelOrder: item.LevelOrder,
+			Correct:    item.IsCorrect != nil && *item.IsCorrect,
+		})
+	}
+
+	decision := placement.Evaluate(levelOrders, responses)
+	if !decision.Finished {
+		level := levelsByOrder[decision.NextLevelOrder]
+		question, err := s.repository.GetExerciseQuestion().GetRandomForPlacement(ctx, level.ID, servedIDs)
+		if err != nil && err != errConstant.ErrExerciseQuestionNotFound {
+			return nil, err
+		}
+		if question != nil {
+			err = s.repository.GetPlacement().AddQuestion(ctx, &models.PlacementTestAnswer{
+				PlacementTestID: test.ID,
+				QuestionID:      question.ID,
+				JlptLevelID:     level.ID,
+				LevelOrder:      level.LevelOrder,
+				OrderIndex:      len(test.Answers),
+			})
+			if err != nil {
+				return nil, err
+			}
+			return s.repository.GetPlacement().GetByID(ctx, test.ID)
+		}
+
+		// The level cannot be assessed further without questions
+		decision = placement.Stop(levelOrders, responses)
+	}
+
+	estimatedLevel := levelsByOrder[decision.EstimatedLevelOrder]
+	completedAt := time.Now()
+	test.CompletedAt = &completedAt
+	test.EstimatedJlptLevelID = &estimatedLevel.ID
+	if err := s.repository.GetPlacement().Complete(ctx, test); err != nil {
+		return nil, err
+	}
+
+	return s.repository.GetPlacement().GetByID(ctx, test.ID)
+}
+
+// toPlacementTestResponse converts a PlacementTest model to PlacementTestResponse DTO.
+// Completed tests include published courses recommended at the estimated level.
+func (s *PlacementService) toPlacementTestResponse(ctx context.Context, test *models.PlacementTest) (*dto.PlacementTestResponse, error) {
+	response := &dto.PlacementTestResponse{
+		ID:        test.ID.String(),
+		Status:    test.Status,
+		StartedAt: test.StartedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if test.CompletedAt != nil {
+		completedAtStr := test.CompletedAt.Format("2006-01-02T15:04:05Z07:00")
+		response.CompletedAt = &completedAtStr
+	}
+
+	for _, item := range test.Answers {
+		if item.AnsweredAt == nil {
+			continue
+		}
+		response.QuestionsAnswered++
+		if item.IsCorrect != nil && *item.IsCorrect {
+			response.CorrectAnswers++
+		}
+		response.LastAnswerCorrect = item.IsCorrect
+	}
+
+	if pending := s.pendingQuestion(test); pending != nil {
+		response.CurrentQuestion = &dto.PlacementQuestionResponse{
+			QuestionID:   pending.QuestionID,
+			JlptLevelID:  pending.JlptLevelID,
+			QuestionText: pending.Question.QuestionText,
+			QuestionType: pending.Question.QuestionType,
+			Options:      answer.DecodeOptions(pending.Question.Options),
+			AudioURL:     pending.Question.AudioURL,
+			ImageURL:     pending.Question.ImageURL,
+		}
+	}
+
+	if test.EstimatedJlptLevel != nil {
+		response.EstimatedJlptLevel = &dto.JlptLevelResponse{
+			ID:          test.EstimatedJlptLevel.ID,
+			Code:        test.EstimatedJlptLevel.Code,
+			Name:        test.EstimatedJlptLevel.Name,
+			Description: test.EstimatedJlptLevel.Description,
+			LevelOrder:  test.EstimatedJlptLevel.LevelOrder,
+		}
+
+		courses, err := courseService.NewCourseService(s.repository).GetPublished(ctx, &dto.CourseFilterRequest{
+			JlptLevelID: test.EstimatedJlptLevel.ID,
+			PaginationRequest: dto.PaginationRequest{
+				Page:  1,
+				Limit: constants.PlacementRecommendedCourses,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		response.RecommendedCourses = courses.Data
+	}
+
+	return response, nil
+}
+
+func (s *PlacementService) Start(ctx context.Context) (*dto.PlacementTestResponse, error) {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	existingTest, err := s.repository.GetPlacement().GetInProgressByUser(ctx, userLogin.UUID.String())
+	if err != nil && err != errConstant.ErrPlacementTestNotFound {
+		return nil, err
+	}
+
+	var test *models.PlacementTest
+	if existingTest != nil {
+		test, err = s.repository.GetPlacement().GetByID(ctx, existingTest.ID)
+	} else {
+		test, err = s.repository.GetPlacement().Create(ctx, &models.PlacementTest{
+			UserID:    userLogin.UUID,
+			Status:    models.PlacementTestStatusInProgress,
+			StartedAt: time.Now(),
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	test, err = s.advance(ctx, test)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toPlacementTestResponse(ctx, test)
+}
+
+func (s *PlacementService) GetByID(ctx context.Context, id uuid.UUID) (*dto.PlacementTestResponse, error) {
+	test, err := s.getOwnedTest(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Recover a test left without a current question
+	test, err = s.advance(ctx, test)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toPlacementTestResponse(ctx, test)
+}
+
+func (s *PlacementService) SubmitAnswer(ctx context.Context, id uuid.UUID, req *dto.SubmitPlacementAnswerRequest) (*dto.PlacementTestResponse, error) {
+	test, err := s.getOwnedTest(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if test.Status != models.PlacementTestStatusInProgress {
+		return nil, errConstant.ErrPlacementTestCompleted
+	}
+
+	pending := s.pendingQuestion(test)
+	if pending == nil || pending.QuestionID != req.QuestionID {
+		return nil, errConstant.ErrPlacementQuestionMismatch
+	}
+
+	correct := answer.DecodeCorrectAnswer(pending.Question.CorrectAnswer)
+	result, err := answer.Grade(pending.Question.QuestionType, correct, req.Answer, pending.Question.AnswerStrictness)
+	if err != nil {
+		return nil, err
+	}
+
+	answerJSON, err := json.Marshal(req.Answer)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repository.GetPlacement().SaveAnswer(ctx, test.ID, pending.QuestionID, string(answerJSON), result.Correct)
+	if err != nil {
+		return nil, err
+	}
+
+	test, err = s.repository.GetPlacement().GetByID(ctx, test.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	test, err = s.advance(ctx, test)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toPlacementTestResponse(ctx, test)
+}
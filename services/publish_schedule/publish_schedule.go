@@ -0,0 +1,313 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"manabu-service/common/workflow"
+	"manabu-service/constants"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+	"manabu-service/repositories"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Schedule parts, named after the columns holding their status
+const (
+	partPublish   = "publish"
+	partUnpublish = "unpublish"
+)
+
+type PublishScheduleService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+// IPublishScheduleService defines the contract for scheduled publishing of courses and lessons.
+// Schedules are set by teachers and admins and applied by an in-process scheduler as
+// publish and archive workflow transitions.
+type IPublishScheduleService interface {
+	// GetByContent retrieves the schedule of a course or lesson (reviewers only).
+	GetByContent(context.Context, string, uint) (*dto.PublishScheduleResponse, error)
+
+	// Schedule sets the publish and unpublish times of a course or lesson, replacing any previous schedule.
+	Schedule(context.Context, string, uint, *dto.PublishScheduleRequest) (*dto.PublishScheduleResponse, error)
+
+	// Cancel removes the schedule of a course or lesson.
+	Cancel(context.Context, string, uint) error
+
+	// ApplyDue applies every publish and unpublish time that has passed. It does nothing
+	// when another instance is already applying schedules.
+	ApplyDue(context.Context) error
+
+	// Run applies due schedules at every interval until the context is cancelled.
+	Run(context.Context, time.Duration)
+}
+
+func NewPublishScheduleService(repository repositories.IRepositoryRegistry) IPublishScheduleService {
+	return &PublishScheduleService{repository: repository}
+}
+
+// getReviewer returns the authenticated user if they can publish content
+func (s *PublishScheduleService) getReviewer(ctx context.Context) (*dto.UserResponse, error) {
+	userLogin, ok := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	if !ok || userLogin == nil {
+		return nil, errConstant.ErrUnauthorized
+	}
+	if userLogin.Role != constants.RoleTeacher && userLogin.Role != constants.RoleAdmin {
+		return nil, errConstant.ErrWorkflowReviewerOnly
+	}
+	return userLogin, nil
+}
+
+// checkContent verifies that the content can be scheduled and exists
+func (s *PublishScheduleService) checkContent(ctx context.Context, contentType string, contentID uint) error {
+	if contentType != models.ContentTypeCourse && contentType != models.ContentTypeLesson {
+		if _, ok := models.ContentTypeTables[contentType]; !ok {
+			return errConstant.ErrInvalidContentType
+		}
+		return errConstant.ErrPublishScheduleNotSupported
+	}
+
+	_, err := s.repository.GetContentWorkflow().IsContentPublished(ctx, contentType, contentID)
+	return err
+}
+
+// toPublishScheduleResponse converts a PublishSchedule model to PublishScheduleResponse DTO
+func (s *PublishScheduleService) toPublishScheduleResponse(schedule *models.PublishSchedule) *dto.PublishScheduleResponse {
+	response := &dto.PublishScheduleResponse{
+		ContentType:     schedule.ContentType,
+		ContentID:       schedule.ContentID,
+		PublishStatus:   schedule.PublishStatus,
+		Cascade:         schedule.Cascade,
+		UnpublishStatus: schedule.UnpublishStatus,
+		LastError:       schedule.LastError,
+		ScheduledBy:     schedule.ScheduledBy.String(),
+	}
+
+	if schedule.PublishAt != nil {
+		publishAtStr := schedule.PublishAt.Format("2006-01-02T15:04:05Z07:00")
+		response.PublishAt = &publishAtStr
+	}
+	if schedule.UnpublishAt != nil {
+		unpublishAtStr := schedule.UnpublishAt.Format("2006-01-02T15:04:05Z07:00")
+		response.UnpublishAt = &unpublishAtStr
+	}
+	if schedule.UpdatedAt != nil {
+		updatedAtStr := schedule.UpdatedAt.Format("2006-01-02T15:04:05Z07:00")
+		response.UpdatedAt = &updatedAtStr
+	}
+
+	return response
+}
+
+func (s *PublishScheduleService) GetByContent(ctx context.Context, contentType string, contentID uint) (*dto.PublishScheduleResponse, error) {
+	if _, err := s.getReviewer(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.checkContent(ctx, contentType, contentID); err != nil {
+		return nil, err
+	}
+
+	schedule, err := s.repository.GetPublishSchedule().GetByContent(ctx, contentType, contentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toPublishScheduleResponse(schedule), nil
+}
+
+func (s *PublishScheduleService) Schedule(ctx context.Context, contentType string, contentID uint, req *dto.PublishScheduleRequest) (*dto.PublishScheduleResponse, error) {
+	userLogin, err := s.getReviewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate the schedule
+	if req.PublishAt == nil && req.UnpublishAt == nil {
+		return nil, errConstant.ErrPublishScheduleEmpty
+	}
+	now := time.Now()
+	if (req.PublishAt != nil && !req.PublishAt.After(now)) || (req.UnpublishAt != nil && !req.UnpublishAt.After(now)) {
+		return nil, errConstant.ErrPublishScheduleInPast
+	}
+	if req.PublishAt != nil && req.UnpublishAt != nil && !req.UnpublishAt.After(*req.PublishAt) {
+		return nil, errConstant.ErrInvalidPublishSchedule
+	}
+
+	if err := s.checkContent(ctx, contentType, contentID); err != nil {
+		return nil, err
+	}
+
+	schedule := &models.PublishSchedule{
+		ContentType: contentType,
+		ContentID:   contentID,
+		PublishAt:   req.PublishAt,
+		UnpublishAt: req.UnpublishAt,
+		Cascade:     req.Cascade,
+		ScheduledBy: userLogin.UUID,
+	}
+	if req.PublishAt != nil {
+		schedule.PublishStatus = models.PublishScheduleStatusPending
+	}
+	if req.UnpublishAt != nil {
+		schedule.UnpublishStatus = models.PublishScheduleStatusPending
+	}
+
+	schedule, err = s.repository.GetPublishSchedule().Upsert(ctx, schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toPublishScheduleResponse(schedule), nil
+}
+
+func (s *PublishScheduleService) Cancel(ctx context.Context, contentType string, contentID uint) error {
+	if _, err := s.getReviewer(ctx); err != nil {
+		return err
+	}
+	if err := s.checkContent(ctx, contentType, contentID); err != nil {
+		return err
+	}
+
+	return s.repository.GetPublishSchedule().Delete(ctx, contentType, contentID)
+}
+
+// publish publishes the scheduled content, and its approved descendants in cascade mode,
+// on behalf of the scheduling user
+func (s *PublishScheduleService) publish(ctx context.Context, schedule *models.PublishSchedule) error {
+	root, parentPublished, err := s.repository.GetContentWorkflow().GetContentTree(ctx, schedule.ContentType, schedule.ContentID)
+	if err != nil {
+		return err
+	}
+	if root.Status != models.WorkflowStatusApproved && !(schedule.Cascade && root.Status == models.WorkflowStatusPublished) {
+		return errConstant.ErrInvalidWorkflowTransition
+	}
+
+	publish, issues := workflow.Plan(root, parentPublished, schedule.Cascade)
+	if len(issues) > 0 {
+		return errConstant.ErrContentNotReadyToPublish
+	}
+	if len(publish) == 0 {
+		return nil
+	}
+
+	return s.repository.GetContentWorkflow().Publish(ctx, publish, schedule.ScheduledBy, "Scheduled publish")
+}
+
+// unpublish archives the scheduled content on behalf of the scheduling user
+func (s *PublishScheduleService) unpublish(ctx context.Context, schedule *models.PublishSchedule) error {
+	isPublished, err := s.repository.GetContentWorkflow().IsContentPublished(ctx, schedule.ContentType, schedule.ContentID)
+	if err != nil {
+		return err
+	}
+	initialStatus := models.WorkflowStatusDraft
+	if isPublished {
+		initialStatus = models.WorkflowStatusPublished
+	}
+
+	contentWorkflow, err := s.repository.GetContentWorkflow().GetOrCreate(ctx, schedule.ContentType, schedule.ContentID, initialStatus)
+	if err != nil {
+		return err
+	}
+	if contentWorkflow.Status != models.WorkflowStatusPublished {
+		return errConstant.ErrInvalidWorkflowTransition
+	}
+
+	fromStatus := contentWorkflow.Status
+	contentWorkflow.Status = models.WorkflowStatusArchived
+
+	return s.repository.GetContentWorkflow().ApplyTransition(ctx, contentWorkflow, fromStatus, &models.ContentWorkflowTransition{
+		Action:     workflow.ActionArchive,
+		FromStatus: fromStatus,
+		ToStatus:   models.WorkflowStatusArchived,
+		ActorID:    schedule.ScheduledBy,
+		Reason:     "Scheduled unpublish",
+	})
+}
+
+// process applies one part of a due schedule and records the outcome. A failed part is
+// not retried; the scheduling user is notified instead.
+func (s *PublishScheduleService) process(ctx context.Context, schedule *models.PublishSchedule, part string) error {
+	var err error
+	if part == partPublish {
+		err = s.publish(ctx, schedule)
+	} else {
+		err = s.unpublish(ctx, schedule)
+	}
+	if err == nil {
+		return s.repository.GetPublishSchedule().MarkProcessed(ctx, schedule.ID, part, models.PublishScheduleStatusDone, "")
+	}
+
+	err = s.repository.GetPublishSchedule().MarkProcessed(ctx, schedule.ID, part, models.PublishScheduleStatusFailed, err.Error())
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(map[string]interface{}{
+		"contentType": schedule.ContentType,
+		"contentId":   schedule.ContentID,
+		"action":      part,
+	})
+	if err != nil {
+		return err
+	}
+	dataStr := string(data)
+
+	return s.repository.GetNotification().Create(ctx, &models.Notification{
+		UserID:  schedule.ScheduledBy,
+		Type:    models.NotificationTypePublishScheduleFailed,
+		Title:   "Scheduled " + part + " failed",
+		Message: fmt.Sprintf("The scheduled %s of %s %d could not be applied. Check its review status and publish readiness, then schedule it again.", part, schedule.ContentType, schedule.ContentID),
+		Data:    &dataStr,
+	})
+}
+
+func (s *PublishScheduleService) ApplyDue(ctx context.Context) error {
+	_, err := s.repository.GetPublishSchedule().WithSchedulerLock(ctx, func(ctx context.Context) error {
+		now := time.Now()
+		schedules, err := s.repository.GetPublishSchedule().GetDue(ctx, now)
+		if err != nil {
+			return err
+		}
+
+		for i := range schedules {
+			schedule := &schedules[i]
+
+			// Publish first so a schedule whose both times passed ends up unpublished
+			if schedule.PublishStatus == models.PublishScheduleStatusPending && !schedule.PublishAt.After(now) {
+				if err := s.process(ctx, schedule, partPublish); err != nil {
+					return err
+				}
+			}
+			if schedule.UnpublishStatus == models.PublishScheduleStatusPending && !schedule.UnpublishAt.After(now) {
+				if err := s.process(ctx, schedule, partUnpublish); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+
+	return err
+}
+
+func (s *PublishScheduleService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.ApplyDue(ctx); err != nil {
+			logrus.Errorf("failed to apply publish schedules: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
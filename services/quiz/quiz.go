@@ -0,0 +1,247 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"manabu-service/common/quiz"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+	"manabu-service/repositories"
+	exerciseQuestionService "manabu-service/services/exercise_question"
+	"math/rand/v2"
+)
+
+const (
+	// defaultQuizCount is the number of questions generated when no count is given
+	defaultQuizCount = 10
+	// defaultQuizChoices is the number of choices of a question when none is given
+	defaultQuizChoices = 4
+	// defaultQuizPoints is the number of points of a generated exercise question when none is given
+	defaultQuizPoints = 10
+	// quizPoolSize is the number of words distractors are drawn from
+	quizPoolSize = 200
+)
+
+type QuizService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+// IQuizService defines the contract for generating questions from vocabulary.
+type IQuizService interface {
+	// Generate creates an ad-hoc quiz from the selected vocabulary without persisting it.
+	Generate(context.Context, *dto.VocabularyQuizRequest) ([]dto.QuizQuestionResponse, error)
+
+	// CreateQuestions generates questions from the selected vocabulary and adds them to an exercise
+	// as unpublished draft questions, after its existing questions.
+	CreateQuestions(context.Context, uint, *dto.GenerateExerciseQuestionsRequest) ([]dto.ExerciseQuestionResponse, error)
+}
+
+func NewQuizService(repository repositories.IRepositoryRegistry) IQuizService {
+	return &QuizService{repository: repository}
+}
+
+// toWord converts a Vocabulary model to a quiz word
+func (s *QuizService) toWord(vocabulary *models.Vocabulary) quiz.Word {
+	return quiz.Word{
+		ID:           vocabulary.ID,
+		Word:         vocabulary.Word,
+		Reading:      vocabulary.Reading,
+		Meaning:      vocabulary.Meaning,
+		PartOfSpeech: vocabulary.PartOfSpeech,
+		JlptLevelID:  vocabulary.JlptLevelID,
+		AudioURL:     vocabulary.AudioURL,
+	}
+}
+
+// toQuizQuestionResponse converts a generated question to QuizQuestionResponse DTO
+func (s *QuizService) toQuizQuestionResponse(question *quiz.Question) dto.QuizQuestionResponse {
+	return dto.QuizQuestionResponse{
+		VocabularyID:  question.VocabularyID,
+		Kind:          question.Kind,
+		QuestionType:  question.QuestionType,
+		QuestionText:  question.QuestionText,
+		AudioURL:      question.AudioURL,
+		Options:       &dto.QuestionOptions{Choices: question.Choices},
+		CorrectAnswer: &dto.QuestionAnswer{Key: question.AnswerKey},
+	}
+}
+
+// words retrieves the words questions are asked about, in random order. Lesson words are
+// narrowed down to the category and JLPT level when those are given too.
+func (s *QuizService) words(ctx context.Context, categoryID, jlptLevelID, lessonID uint, count int) ([]quiz.Word, error) {
+	if categoryID == 0 && jlptLevelID == 0 && lessonID == 0 {
+		return nil, errConstant.ErrQuizSourceRequired
+	}
+
+	words := make([]quiz.Word, 0)
+	if lessonID == 0 {
+		var jlptLevelIDs []uint
+		if jlptLevelID > 0 {
+			jlptLevelIDs = []uint{jlptLevelID}
+		}
+		// Some words may not fit any kind of question, so more words than needed are drawn
+		vocabularies, err := s.repository.GetVocabulary().GetRandom(ctx, categoryID, jlptLevelIDs, count*2)
+		if err != nil {
+			return nil, err
+		}
+		for _, vocabulary := range vocabularies {
+			words = append(words, s.toWord(&vocabulary))
+		}
+		return words, nil
+	}
+
+	// Check if lesson exists
+	_, err := s.repository.GetLesson().GetByID(ctx, lessonID)
+	if err != nil {
+		return nil, err
+	}
+
+	vocabularies, err := s.repository.GetLessonVocabulary().GetByLessonID(ctx, lessonID)
+	if err != nil {
+		return nil, err
+	}
+	for _, vocabulary := range vocabularies {
+		if categoryID > 0 && vocabulary.Vocabulary.CategoryID != categoryID {
+			continue
+		}
+		if jlptLevelID > 0 && vocabulary.Vocabulary.JlptLevelID != jlptLevelID {
+			continue
+		}
+		words = append(words, s.toWord(&vocabulary.Vocabulary))
+	}
+	rand.Shuffle(len(words), func(i, j int) { words[i], words[j] = words[j], words[i] })
+	return words, nil
+}
+
+// generate builds up to count questions of the given kinds with the given number of choices.
+// Distractors are drawn from the words themselves and from other words of the same JLPT levels.
+func (s *QuizService) generate(ctx context.Context, categoryID, jlptLevelID, lessonID uint, kinds []string, count, choices int) ([]quiz.Question, error) {
+	if count == 0 {
+		count = defaultQuizCount
+	}
+	if choices == 0 {
+		choices = defaultQuizChoices
+	}
+	if len(kinds) == 0 {
+		kinds = quiz.Kinds
+	}
+
+	words, err := s.words(ctx, categoryID, jlptLevelID, lessonID, count)
+	if err != nil {
+		return nil, err
+	}
+	if len(words) == 0 {
+		return nil, errConstant.ErrQuizNoVocabulary
+	}
+
+	levels := make(map[uint]bool)
+	jlptLevelIDs := make([]uint, 0)
+	for _, word := range words {
+		if !levels[word.JlptLevelID] {
+			levels[word.JlptLevelID] = true
+			jlptLevelIDs = append(jlptLevelIDs, word.JlptLevelID)
+		}
+	}
+	vocabularies, err := s.repository.GetVocabulary().GetRandom(ctx, 0, jlptLevelIDs, quizPoolSize)
+	if err != nil {
+		return nil, err
+	}
+	pool := append([]quiz.Word{}, words...)
+	for _, vocabulary := range vocabularies {
+		pool = append(pool, s.toWord(&vocabulary))
+	}
+
+	generator := quiz.NewGenerator(rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64())), pool, choices)
+	questions := generator.Generate(words, kinds, count)
+	if len(questions) == 0 {
+		return nil, errConstant.ErrQuizNoVocabulary
+	}
+	return questions, nil
+}
+
+func (s *QuizService) Generate(ctx context.Context, req *dto.VocabularyQuizRequest) ([]dto.QuizQuestionResponse, error) {
+	questions, err := s.generate(ctx, req.CategoryID, req.JlptLevelID, req.LessonID, req.Types, req.Count, req.Choices)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dto.QuizQuestionResponse, 0, len(questions))
+	for _, question := range questions {
+		responses = append(responses, s.toQuizQuestionResponse(&question))
+	}
+	return responses, nil
+}
+
+func (s *QuizService) CreateQuestions(ctx context.Context, exerciseID uint, req *dto.GenerateExerciseQuestionsRequest) ([]dto.ExerciseQuestionResponse, error) {
+	// Check if exercise exists
+	_, err := s.repository.GetExercise().GetByID(ctx, exerciseID)
+	if err != nil {
+		return nil, err
+	}
+
+	questions, err := s.generate(ctx, req.CategoryID, req.JlptLevelID, req.LessonID, req.Types, req.Count, req.Choices)
+	if err != nil {
+		return nil, err
+	}
+
+	points := req.Points
+	if points == 0 {
+		points = defaultQuizPoints
+	}
+
+	// Generated questions are placed after the existing questions of the exercise
+	existing, err := s.repository.GetExerciseQuestion().GetByExerciseID(ctx, exerciseID)
+	if err != nil {
+		return nil, err
+	}
+	orderIndex := 0
+	for _, question := range existing {
+		orderIndex = max(orderIndex, question.OrderIndex)
+	}
+
+	words := make(map[uint]*models.Vocabulary)
+	vocabularyIDs := make([]uint, 0, len(questions))
+	for _, question := range questions {
+		vocabularyIDs = append(vocabularyIDs, question.VocabularyID)
+	}
+	vocabularies, err := s.repository.GetVocabulary().GetByIDs(ctx, vocabularyIDs)
+	if err != nil {
+		return nil, err
+	}
+	for i := range vocabularies {
+		words[vocabularies[i].ID] = &vocabularies[i]
+	}
+
+	createRequests := make([]*dto.CreateExerciseQuestionRequest, 0, len(questions))
+	for _, question := range questions {
+		orderIndex++
+		jlptSection := "vocabulary"
+		if question.Kind == quiz.KindListening {
+			jlptSection = "listening"
+		}
+
+		createRequest := &dto.CreateExerciseQuestionRequest{
+			ExerciseID:    exerciseID,
+			QuestionText:  question.QuestionText,
+			QuestionType:  question.QuestionType,
+			Options:       &dto.QuestionOptions{Choices: question.Choices},
+			CorrectAnswer: &dto.QuestionAnswer{Key: question.AnswerKey},
+			JlptSection:   jlptSection,
+			AudioURL:      question.AudioURL,
+			OrderIndex:    orderIndex,
+			Points:        points,
+		}
+		if word, ok := words[question.VocabularyID]; ok {
+			createRequest.Explanation = fmt.Sprintf("%s (%s): %s", word.Word, word.Reading, word.Meaning)
+			if word.Reading == "" {
+				createRequest.Explanation = fmt.Sprintf("%s: %s", word.Word, word.Meaning)
+			}
+		}
+
+		createRequests = append(createRequests, createRequest)
+	}
+
+	// The questions are created together so a failure leaves the exercise unchanged
+	return exerciseQuestionService.NewExerciseQuestionService(s.repository).CreateBatch(ctx, createRequests)
+}
@@ -2,17 +2,39 @@ package services
 
 import (
 	"manabu-service/repositories"
+	bookmarkService "manabu-service/services/bookmark"
 	categoryService "manabu-service/services/category"
+	contentRevisionService "manabu-service/services/content_revision"
+	contentWorkflowService "manabu-service/services/content_workflow"
 	courseService "manabu-service/services/course"
+	courseBundleService "manabu-service/services/course_bundle"
+	coursePrerequisiteService "manabu-service/services/course_prerequisite"
+	deckService "manabu-service/services/deck"
+	deckCardService "manabu-service/services/deck_card"
+	examService "manabu-service/services/exam"
+	examAttemptService "manabu-service/services/exam_attempt"
+	exampleSentenceService "manabu-service/services/example_sentence"
 	exerciseService "manabu-service/services/exercise"
+	exerciseAttemptService "manabu-service/services/exercise_attempt"
 	exerciseQuestionService "manabu-service/services/exercise_question"
+	furiganaService "manabu-service/services/furigana"
 	jlptLevelService "manabu-service/services/jlpt_level"
 	lessonService "manabu-service/services/lesson"
+	lessonVocabularyService "manabu-service/services/lesson_vocabulary"
+	mediaService "manabu-service/services/media"
+	notificationService "manabu-service/services/notification"
+	placementService "manabu-service/services/placement"
+	publishScheduleService "manabu-service/services/publish_schedule"
+	quizService "manabu-service/services/quiz"
+	speakingSubmissionService "manabu-service/services/speaking_submission"
 	tagService "manabu-service/services/tag"
+	translationService "manabu-service/services/translation"
+	trashService "manabu-service/services/trash"
 	services "manabu-service/services/user"
 	userCourseProgressService "manabu-service/services/user_course_progress"
 	userVocabStatusService "manabu-service/services/user_vocabulary_status"
 	vocabularyService "manabu-service/services/vocabulary"
+	vocabularyNoteService "manabu-service/services/vocabulary_note"
 )
 
 type Registry struct {
@@ -31,6 +53,28 @@ type IServiceRegistry interface {
 	GetExercise() exerciseService.IExerciseService
 	GetExerciseQuestion() exerciseQuestionService.IExerciseQuestionService
 	GetUserCourseProgress() userCourseProgressService.IUserCourseProgressService
+	GetExampleSentence() exampleSentenceService.IExampleSentenceService
+	GetFurigana() furiganaService.IFuriganaService
+	GetTranslation() translationService.ITranslationService
+	GetExam() examService.IExamService
+	GetExamAttempt() examAttemptService.IExamAttemptService
+	GetPlacement() placementService.IPlacementService
+	GetExerciseAttempt() exerciseAttemptService.IExerciseAttemptService
+	GetSpeakingSubmission() speakingSubmissionService.ISpeakingSubmissionService
+	GetNotification() notificationService.INotificationService
+	GetMedia() mediaService.IMediaService
+	GetContentWorkflow() contentWorkflowService.IContentWorkflowService
+	GetPublishSchedule() publishScheduleService.IPublishScheduleService
+	GetContentRevision() contentRevisionService.IContentRevisionService
+	GetTrash() trashService.ITrashService
+	GetCourseBundle() courseBundleService.ICourseBundleService
+	GetCoursePrerequisite() coursePrerequisiteService.ICoursePrerequisiteService
+	GetLessonVocabulary() lessonVocabularyService.ILessonVocabularyService
+	GetQuiz() quizService.IQuizService
+	GetVocabularyNote() vocabularyNoteService.IVocabularyNoteService
+	GetDeck() deckService.IDeckService
+	GetDeckCard() deckCardService.IDeckCardService
+	GetBookmark() bookmarkService.IBookmarkService
 }
 
 func NewServiceRegistry(repository repositories.IRepositoryRegistry) IServiceRegistry {
@@ -80,3 +124,91 @@ func (r *Registry) GetExerciseQuestion() exerciseQuestionService.IExerciseQuesti
 func (r *Registry) GetUserCourseProgress() userCourseProgressService.IUserCourseProgressService {
 	return userCourseProgressService.NewUserCourseProgressService(r.repository)
 }
+
+func (r *Registry) GetExampleSentence() exampleSentenceService.IExampleSentenceService {
+	return exampleSentenceService.NewExampleSentenceService(r.repository)
+}
+
+func (r *Registry) GetFurigana() furiganaService.IFuriganaService {
+	return furiganaService.NewFuriganaService(r.repository)
+}
+
+func (r *Registry) GetTranslation() translationService.ITranslationService {
+	return translationService.NewTranslationService(r.repository)
+}
+
+func (r *Registry) GetExam() examService.IExamService {
+	return examService.NewExamService(r.repository)
+}
+
+func (r *Registry) GetExamAttempt() examAttemptService.IExamAttemptService {
+	return examAttemptService.NewExamAttemptService(r.repository)
+}
+
+func (r *Registry) GetPlacement() placementService.IPlacementService {
+	return placementService.NewPlacementService(r.repository)
+}
+
+func (r *Registry) GetExerciseAttempt() exerciseAttemptService.IExerciseAttemptService {
+	return exerciseAttemptService.NewExerciseAttemptService(r.repository)
+}
+
+func (r *Registry) GetSpeakingSubmission() speakingSubmissionService.ISpeakingSubmissionService {
+	return speakingSubmissionService.NewSpeakingSubmissionService(r.repository)
+}
+
+func (r *Registry) GetNotification() notificationService.INotificationService {
+	return notificationService.NewNotificationService(r.repository)
+}
+
+func (r *Registry) GetMedia() mediaService.IMediaService {
+	return mediaService.NewMediaService(r.repository)
+}
+
+func (r *Registry) GetContentWorkflow() contentWorkflowService.IContentWorkflowService {
+	return contentWorkflowService.NewContentWorkflowService(r.repository)
+}
+
+func (r *Registry) GetPublishSchedule() publishScheduleService.IPublishScheduleService {
+	return publishScheduleService.NewPublishScheduleService(r.repository)
+}
+
+func (r *Registry) GetContentRevision() contentRevisionService.IContentRevisionService {
+	return contentRevisionService.NewContentRevisionService(r.repository)
+}
+
+func (r *Registry) GetTrash() trashService.ITrashService {
+	return trashService.NewTrashService(r.repository)
+}
+
+func (r *Registry) GetCourseBundle() courseBundleService.ICourseBundleService {
+	return courseBundleService.NewCourseBundleService(r.repository)
+}
+
+func (r *Registry) GetCoursePrerequisite() coursePrerequisiteService.ICoursePrerequisiteService {
+	return coursePrerequisiteService.NewCoursePrerequisiteService(r.repository)
+}
+
+func (r *Registry) GetLessonVocabulary() lessonVocabularyService.ILessonVocabularyService {
+	return lessonVocabularyService.NewLessonVocabularyService(r.repository)
+}
+
+func (r *Registry) GetQuiz() quizService.IQuizService {
+	return quizService.NewQuizService(r.repository)
+}
+
+func (r *Registry) GetVocabularyNote() vocabularyNoteService.IVocabularyNoteService {
+	return vocabularyNoteService.NewVocabularyNoteService(r.repository)
+}
+
+func (r *Registry) GetDeck() deckService.IDeckService {
+	return deckService.NewDeckService(r.repository)
+}
+
+func (r *Registry) GetDeckCard() deckCardService.IDeckCardService {
+	return deckCardService.NewDeckCardService(r.repository)
+}
+
+func (r *Registry) GetBookmark() bookmarkService.IBookmarkService {
+	return bookmarkService.NewBookmarkService(r.repository)
+}
@@ -0,0 +1,351 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"manabu-service/common/storage"
+	"manabu-service/config"
+	"manabu-service/constants"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+	"manabu-service/repositories"
+	"math"
+	"mime"
+	"mime/multipart"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SpeakingSubmissionService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+// ISpeakingSubmissionService defines the contract for spoken answers and their teacher review.
+// Learners see their own submissions; teachers and admins review every learner's submissions.
+type ISpeakingSubmissionService interface {
+	// Submit stores a recording answering a published speaking question.
+	Submit(context.Context, uint, *multipart.FileHeader) (*dto.SpeakingSubmissionResponse, error)
+
+	// GetAll retrieves the authenticated learner's submissions with filtering and pagination.
+	GetAll(context.Context, *dto.SpeakingSubmissionFilterRequest) (*dto.SpeakingSubmissionListResponse, error)
+
+	// GetByID retrieves a submission of the learner, or of any learner for reviewers.
+	GetByID(context.Context, uuid.UUID) (*dto.SpeakingSubmissionResponse, error)
+
+	// GetAudio opens the recording of a submission and returns it with its content type.
+	GetAudio(context.Context, uuid.UUID) (io.ReadCloser, string, error)
+
+	// GetReviewQueue retrieves submissions waiting for review, oldest first (reviewers only).
+	GetReviewQueue(context.Context, *dto.SpeakingSubmissionFilterRequest) (*dto.SpeakingSubmissionListResponse, error)
+
+	// Review scores a pending submission with feedback and notifies the learner (reviewers only).
+	Review(context.Context, uuid.UUID, *dto.ReviewSpeakingSubmissionRequest) (*dto.SpeakingSubmissionResponse, error)
+}
+
+func NewSpeakingSubmissionService(repository repositories.IRepositoryRegistry) ISpeakingSubmissionService {
+	return &SpeakingSubmissionService{repository: repository}
+}
+
+func (s *SpeakingSubmissionService) getUserLogin(ctx context.Context) (*dto.UserResponse, error) {
+	userLogin, ok := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	if !ok || userLogin == nil {
+		return nil, errConstant.ErrUnauthorized
+	}
+	return userLogin, nil
+}
+
+// isReviewer reports whether the user can review speaking submissions
+func (s *SpeakingSubmissionService) isReviewer(userLogin *dto.UserResponse) bool {
+	return userLogin.Role == constants.RoleTeacher || userLogin.Role == constants.RoleAdmin
+}
+
+// getReviewer returns the authenticated user if they can review submissions
+func (s *SpeakingSubmissionService) getReviewer(ctx context.Context) (*dto.UserResponse, error) {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !s.isReviewer(userLogin) {
+		return nil, errConstant.ErrForbidden
+	}
+	return userLogin, nil
+}
+
+// getVisibleSubmission loads a submission of the authenticated learner, or any submission for reviewers
+func (s *SpeakingSubmissionService) getVisibleSubmission(ctx context.Context, id uuid.UUID) (*models.SpeakingSubmission, error) {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	submission, err := s.repository.GetSpeakingSubmission().GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return not found error if the submission doesn't belong to the learner
+	if submission.UserID != userLogin.UUID && !s.isReviewer(userLogin) {
+		return nil, errConstant.ErrSpeakingSubmissionNotFound
+	}
+
+	return submission, nil
+}
+
+// toSpeakingSubmissionResponse converts a SpeakingSubmission model to SpeakingSubmissionResponse DTO
+func (s *SpeakingSubmissionService) toSpeakingSubmissionResponse(submission *models.SpeakingSubmission) *dto.SpeakingSubmissionResponse {
+	response := &dto.SpeakingSubmissionResponse{
+		ID:          submission.ID.String(),
+		QuestionID:  submission.QuestionID,
+		UserID:      submission.UserID.String(),
+		Status:      submission.Status,
+		AudioURL:    fmt.Sprintf("/api/v1/speaking-submissions/%s/audio", submission.ID),
+		ContentType: submission.ContentType,
+		SizeBytes:   submission.SizeBytes,
+		Score:       submission.Score,
+		Feedback:    submission.Feedback,
+	}
+
+	if submission.Question.ID > 0 {
+		response.QuestionText = submission.Question.QuestionText
+		response.MaxScore = submission.Question.Points
+	}
+
+	if submission.ReviewedAt != nil {
+		reviewedAtStr := submission.ReviewedAt.Format("2006-01-02T15:04:05Z07:00")
+		response.ReviewedAt = &reviewedAtStr
+	}
+	if submission.CreatedAt != nil {
+		response.CreatedAt = submission.CreatedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	return response
+}
+
+// toListResponse converts submissions and their total count to a paginated list response
+func (s *SpeakingSubmissionService) toListResponse(submissions []models.SpeakingSubmission, total int64, filter *dto.SpeakingSubmissionFilterRequest) *dto.SpeakingSubmissionListResponse {
+	responses := make([]dto.SpeakingSubmissionResponse, 0, len(submissions))
+	for _, submission := range submissions {
+		responses = append(responses, *s.toSpeakingSubmissionResponse(&submission))
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(filter.Limit)))
+
+	return &dto.SpeakingSubmissionListResponse{
+		Data: responses,
+		Pagination: dto.PaginationResponse{
+			Page:       filter.Page,
+			Limit:      filter.Limit,
+			TotalPages: totalPages,
+			TotalItems: total,
+		},
+	}
+}
+
+// normalizeFilter sets default pagination values
+func (s *SpeakingSubmissionService) normalizeFilter(filter *dto.SpeakingSubmissionFilterRequest) *dto.SpeakingSubmissionFilterRequest {
+	if filter == nil {
+		filter = &dto.SpeakingSubmissionFilterRequest{
+			PaginationRequest: dto.PaginationRequest{
+				Page:  1,
+				Limit: 10,
+			},
+		}
+	}
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.Limit < 1 {
+		filter.Limit = 10
+	}
+	if filter.Limit > 100 {
+		filter.Limit = 100
+	}
+	return filter
+}
+
+func (s *SpeakingSubmissionService) Submit(ctx context.Context, questionID uint, file *multipart.FileHeader) (*dto.SpeakingSubmissionResponse, error) {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	question, err := s.repository.GetExerciseQuestion().GetByID(ctx, questionID)
+	if err != nil {
+		return nil, err
+	}
+	if !question.IsPublished || question.QuestionType != "speaking" {
+		return nil, errConstant.ErrNotSpeakingQuestion
+	}
+
+	// Validate the recording
+	if file.Size > constants.MaxRecordingSize {
+		return nil, errConstant.ErrRecordingTooLarge
+	}
+	contentType, _, err := mime.ParseMediaType(file.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, errConstant.ErrInvalidRecording
+	}
+	extension, ok := constants.RecordingExtensions[contentType]
+	if !ok {
+		return nil, errConstant.ErrInvalidRecording
+	}
+
+	store, err := storage.New(config.Config.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := file.Open()
+	if err != nil {
+		return nil, errConstant.ErrInvalidRecording
+	}
+	defer body.Close()
+
+	submissionID := uuid.New()
+	audioKey := fmt.Sprintf("recordings/%d/%s%s", questionID, submissionID, extension)
+	if err := store.Put(ctx, audioKey, body, contentType); err != nil {
+		return nil, err
+	}
+
+	submission, err := s.repository.GetSpeakingSubmission().Create(ctx, &models.SpeakingSubmission{
+		ID:          submissionID,
+		QuestionID:  questionID,
+		UserID:      userLogin.UUID,
+		AudioKey:    audioKey,
+		ContentType: contentType,
+		SizeBytes:   file.Size,
+		Status:      models.SpeakingSubmissionStatusPending,
+	})
+	if err != nil {
+		// Do not keep recordings without a submission
+		_ = store.Delete(ctx, audioKey)
+		return nil, err
+	}
+
+	return s.toSpeakingSubmissionResponse(submission), nil
+}
+
+func (s *SpeakingSubmissionService) GetAll(ctx context.Context, filter *dto.SpeakingSubmissionFilterRequest) (*dto.SpeakingSubmissionListResponse, error) {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter = s.normalizeFilter(filter)
+	submissions, total, err := s.repository.GetSpeakingSubmission().GetAllByUser(ctx, userLogin.UUID.String(), filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toListResponse(submissions, total, filter), nil
+}
+
+func (s *SpeakingSubmissionService) GetByID(ctx context.Context, id uuid.UUID) (*dto.SpeakingSubmissionResponse, error) {
+	submission, err := s.getVisibleSubmission(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toSpeakingSubmissionResponse(submission), nil
+}
+
+func (s *SpeakingSubmissionService) GetAudio(ctx context.Context, id uuid.UUID) (io.ReadCloser, string, error) {
+	submission, err := s.getVisibleSubmission(ctx, id)
+	if err != nil {
+		return nil, "", err
+	}
+
+	store, err := storage.New(config.Config.Storage)
+	if err != nil {
+		return nil, "", err
+	}
+
+	audio, err := store.Get(ctx, submission.AudioKey)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return audio, submission.ContentType, nil
+}
+
+func (s *SpeakingSubmissionService) GetReviewQueue(ctx context.Context, filter *dto.SpeakingSubmissionFilterRequest) (*dto.SpeakingSubmissionListResponse, error) {
+	if _, err := s.getReviewer(ctx); err != nil {
+		return nil, err
+	}
+
+	// The queue shows pending submissions unless another status is requested
+	filter = s.normalizeFilter(filter)
+	if filter.Status == "" {
+		filter.Status = models.SpeakingSubmissionStatusPending
+	}
+
+	submissions, total, err := s.repository.GetSpeakingSubmission().GetReviewQueue(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toListResponse(submissions, total, filter), nil
+}
+
+func (s *SpeakingSubmissionService) Review(ctx context.Context, id uuid.UUID, req *dto.ReviewSpeakingSubmissionRequest) (*dto.SpeakingSubmissionResponse, error) {
+	reviewer, err := s.getReviewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	submission, err := s.repository.GetSpeakingSubmission().GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if submission.Status != models.SpeakingSubmissionStatusPending {
+		return nil, errConstant.ErrSpeakingAlreadyReviewed
+	}
+
+	// Validate score against the question points
+	if *req.Score < 0 || *req.Score > submission.Question.Points {
+		return nil, errConstant.ErrInvalidSpeakingReviewScore
+	}
+
+	reviewedAt := time.Now()
+	submission.Status = models.SpeakingSubmissionStatusReviewed
+	submission.Score = req.Score
+	submission.Feedback = req.Feedback
+	submission.ReviewerID = &reviewer.UUID
+	submission.ReviewedAt = &reviewedAt
+
+	// Notify the learner of the graded result
+	message := fmt.Sprintf("You scored %d/%d.", *req.Score, submission.Question.Points)
+	if req.Feedback != "" {
+		message = fmt.Sprintf("%s %s", message, req.Feedback)
+	}
+	if len([]rune(message)) > 1000 {
+		message = string([]rune(message)[:997]) + "..."
+	}
+	data, err := json.Marshal(map[string]interface{}{
+		"submissionId": submission.ID.String(),
+		"questionId":   submission.QuestionID,
+		"score":        *req.Score,
+		"maxScore":     submission.Question.Points,
+	})
+	if err != nil {
+		return nil, err
+	}
+	dataStr := string(data)
+
+	err = s.repository.GetSpeakingSubmission().Review(ctx, submission, &models.Notification{
+		UserID:  submission.UserID,
+		Type:    models.NotificationTypeSpeakingReviewed,
+		Title:   "Your speaking answer was reviewed",
+		Message: message,
+		Data:    &dataStr,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toSpeakingSubmissionResponse(submission), nil
+}
@@ -0,0 +1,394 @@
+package services
+
+import (
+	"context"
+	"manabu-service/common/locale"
+	"manabu-service/common/markdown"
+	"manabu-service/constants"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+	"manabu-service/repositories"
+	"math"
+)
+
+type TranslationService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+// ITranslationService defines the contract for translation business logic operations.
+// Entity columns hold the default locale text; translations override it per locale.
+type ITranslationService interface {
+	// GetAll retrieves all translations with filtering and pagination.
+	GetAll(context.Context, *dto.TranslationFilterRequest) (*dto.TranslationListResponse, error)
+
+	// GetMissing retrieves entities of a type that have no translation for a locale.
+	GetMissing(context.Context, *dto.MissingTranslationFilterRequest) (*dto.MissingTranslationListResponse, error)
+
+	// Upsert validates and creates or replaces a translation.
+	// Validates the entity type, field and locale, and that the entity exists.
+	Upsert(context.Context, *dto.UpsertTranslationRequest) (*dto.TranslationResponse, error)
+
+	// Delete removes a translation by ID if it exists.
+	Delete(context.Context, uint) error
+
+	// LocalizeVocabularies replaces vocabulary meanings with their translation for the locale.
+	// Entries without a translation keep the default locale text.
+	LocalizeVocabularies(context.Context, string, []dto.VocabularyResponse) error
+
+	// LocalizeCourses replaces course descriptions with their translation for the locale.
+	// Entries without a translation keep the default locale text.
+	LocalizeCourses(context.Context, string, []dto.CourseResponse) error
+
+	// LocalizeLessons replaces lesson contents with their translation for the locale.
+	// Entries without a translation keep the default locale text.
+	LocalizeLessons(context.Context, string, []dto.LessonResponse) error
+
+	// LocalizeAnswerResults replaces the question explanations of graded answers with their translation for the locale.
+	// Entries without a translation keep the default locale text.
+	LocalizeAnswerResults(context.Context, string, []dto.CheckAnswerResponse) error
+
+	// LocalizeExamAttemptQuestions replaces the explanations of exam attempt questions with their translation for the locale.
+	// Entries without a translation keep the default locale text.
+	LocalizeExamAttemptQuestions(context.Context, string, []dto.ExamAttemptQuestionResponse) error
+}
+
+func NewTranslationService(repository repositories.IRepositoryRegistry) ITranslationService {
+	return &TranslationService{repository: repository}
+}
+
+// toTranslationResponse converts a Translation model to TranslationResponse DTO
+func (s *TranslationService) toTranslationResponse(translation *models.Translation) *dto.TranslationResponse {
+	response := &dto.TranslationResponse{
+		ID:         translation.ID,
+		EntityType: translation.EntityType,
+		EntityID:   translation.EntityID,
+		Field:      translation.Field,
+		Locale:     translation.Locale,
+		Value:      translation.Value,
+	}
+
+	// Format UpdatedAt as string if present
+	if translation.UpdatedAt != nil {
+		updatedAtStr := translation.UpdatedAt.Format("2006-01-02T15:04:05Z07:00")
+		response.UpdatedAt = &updatedAtStr
+	}
+
+	return response
+}
+
+// checkAdmin ensures the logged in user is an admin; translations are managed by admins only
+func (s *TranslationService) checkAdmin(ctx context.Context) error {
+	userLogin, ok := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	if !ok || userLogin == nil {
+		return errConstant.ErrUnauthorized
+	}
+	if userLogin.Role != constants.RoleAdmin {
+		return errConstant.ErrTranslationAdminOnly
+	}
+	return nil
+}
+
+// validateLocale ensures translations are only stored for supported, non-default locales
+func (s *TranslationService) validateLocale(lang string) error {
+	if !locale.IsSupported(lang) || lang == constants.DefaultLocale {
+		return errConstant.ErrInvalidTranslationLocale
+	}
+	return nil
+}
+
+func (s *TranslationService) validateEntityField(entityType, field string) error {
+	expected, ok := constants.TranslatableFields[entityType]
+	if !ok || expected != field {
+		return errConstant.ErrInvalidTranslationEntityType
+	}
+	return nil
+}
+
+func (s *TranslationService) isEntityExist(ctx context.Context, entityType string, entityID uint) error {
+	var err error
+	switch entityType {
+	case constants.TranslationEntityVocabulary:
+		_, err = s.repository.GetVocabulary().GetByID(ctx, entityID)
+	case constants.TranslationEntityCourse:
+		_, err = s.repository.GetCourse().GetByID(ctx, entityID)
+	case constants.TranslationEntityLesson:
+		_, err = s.repository.GetLesson().GetByID(ctx, entityID)
+	case constants.TranslationEntityExerciseQuestion:
+		_, err = s.repository.GetExerciseQuestion().GetByID(ctx, entityID)
+	default:
+		return errConstant.ErrInvalidTranslationEntityType
+	}
+	if err != nil {
+		return errConstant.ErrTranslationEntityNotFound
+	}
+	return nil
+}
+
+// loadTranslations returns the translated values of an entity type keyed by entity ID
+func (s *TranslationService) loadTranslations(ctx context.Context, entityType, lang string, entityIDs []uint) (map[uint]string, error) {
+	values := make(map[uint]string)
+	if lang == constants.DefaultLocale || len(entityIDs) == 0 {
+		return values, nil
+	}
+
+	translations, err := s.repository.GetTranslation().GetByEntities(ctx, entityType, constants.TranslatableFields[entityType], entityIDs, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, translation := range translations {
+		values[translation.EntityID] = translation.Value
+	}
+	return values, nil
+}
+
+func (s *TranslationService) GetAll(ctx context.Context, filter *dto.TranslationFilterRequest) (*dto.TranslationListResponse, error) {
+	if err := s.checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	// Set default pagination values
+	if filter == nil {
+		filter = &dto.TranslationFilterRequest{
+			PaginationRequest: dto.PaginationRequest{
+				Page:  1,
+				Limit: 10,
+			},
+		}
+	}
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.Limit < 1 {
+		filter.Limit = 10
+	}
+	if filter.Limit > 100 {
+		filter.Limit = 100
+	}
+
+	translations, total, err := s.repository.GetTranslation().GetAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dto.TranslationResponse, 0, len(translations))
+	for _, translation := range translations {
+		responses = append(responses, *s.toTranslationResponse(&translation))
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(filter.Limit)))
+
+	return &dto.TranslationListResponse{
+		Data: responses,
+		Pagination: dto.PaginationResponse{
+			Page:       filter.Page,
+			Limit:      filter.Limit,
+			TotalPages: totalPages,
+			TotalItems: total,
+		},
+	}, nil
+}
+
+func (s *TranslationService) GetMissing(ctx context.Context, filter *dto.MissingTranslationFilterRequest) (*dto.MissingTranslationListResponse, error) {
+	if err := s.checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	// Set default pagination values
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.Limit < 1 {
+		filter.Limit = 10
+	}
+	if filter.Limit > 100 {
+		filter.Limit = 100
+	}
+
+	if err := s.validateLocale(filter.Locale); err != nil {
+		return nil, err
+	}
+
+	field, ok := constants.TranslatableFields[filter.EntityType]
+	if !ok {
+		return nil, errConstant.ErrInvalidTranslationEntityType
+	}
+
+	missing, total, err := s.repository.GetTranslation().GetMissing(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dto.MissingTranslationResponse, 0, len(missing))
+	for _, entry := range missing {
+		responses = append(responses, dto.MissingTranslationResponse{
+			EntityType: filter.EntityType,
+			EntityID:   entry.EntityID,
+			Field:      field,
+			Locale:     filter.Locale,
+			SourceText: entry.SourceText,
+		})
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(filter.Limit)))
+
+	return &dto.MissingTranslationListResponse{
+		Data: responses,
+		Pagination: dto.PaginationResponse{
+			Page:       filter.Page,
+			Limit:      filter.Limit,
+			TotalPages: totalPages,
+			TotalItems: total,
+		},
+	}, nil
+}
+
+func (s *TranslationService) Upsert(ctx context.Context, req *dto.UpsertTranslationRequest) (*dto.TranslationResponse, error) {
+	if err := s.checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	// Validate entity type and field pair
+	if err := s.validateEntityField(req.EntityType, req.Field); err != nil {
+		return nil, err
+	}
+
+	// Validate locale
+	if err := s.validateLocale(req.Locale); err != nil {
+		return nil, err
+	}
+
+	// Check if translated entity exists
+	if err := s.isEntityExist(ctx, req.EntityType, req.EntityID); err != nil {
+		return nil, err
+	}
+
+	translation, err := s.repository.GetTranslation().Upsert(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toTranslationResponse(translation), nil
+}
+
+func (s *TranslationService) Delete(ctx context.Context, id uint) error {
+	if err := s.checkAdmin(ctx); err != nil {
+		return err
+	}
+
+	// Check if translation exists
+	_, err := s.repository.GetTranslation().GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return s.repository.GetTranslation().Delete(ctx, id)
+}
+
+func (s *TranslationService) LocalizeVocabularies(ctx context.Context, lang string, vocabularies []dto.VocabularyResponse) error {
+	entityIDs := make([]uint, 0, len(vocabularies))
+	for _, vocabulary := range vocabularies {
+		entityIDs = append(entityIDs, vocabulary.ID)
+	}
+
+	values, err := s.loadTranslations(ctx, constants.TranslationEntityVocabulary, lang, entityIDs)
+	if err != nil {
+		return err
+	}
+
+	for i := range vocabularies {
+		if value, ok := values[vocabularies[i].ID]; ok {
+			vocabularies[i].Meaning = value
+		}
+	}
+	return nil
+}
+
+func (s *TranslationService) LocalizeCourses(ctx context.Context, lang string, courses []dto.CourseResponse) error {
+	entityIDs := make([]uint, 0, len(courses))
+	for _, course := range courses {
+		entityIDs = append(entityIDs, course.ID)
+	}
+
+	values, err := s.loadTranslations(ctx, constants.TranslationEntityCourse, lang, entityIDs)
+	if err != nil {
+		return err
+	}
+
+	for i := range courses {
+		if value, ok := values[courses[i].ID]; ok {
+			courses[i].Description = value
+		}
+	}
+	return nil
+}
+
+func (s *TranslationService) LocalizeLessons(ctx context.Context, lang string, lessons []dto.LessonResponse) error {
+	entityIDs := make([]uint, 0, len(lessons))
+	for _, lesson := range lessons {
+		entityIDs = append(entityIDs, lesson.ID)
+	}
+
+	values, err := s.loadTranslations(ctx, constants.TranslationEntityLesson, lang, entityIDs)
+	if err != nil {
+		return err
+	}
+
+	for i := range lessons {
+		// Locked lessons are returned without their content
+		if lessons[i].Access != nil && lessons[i].Access.Locked {
+			continue
+		}
+		if value, ok := values[lessons[i].ID]; ok {
+			// Lessons without content blocks show their content as a single markdown block
+			blocks := lessons[i].Blocks
+			if len(blocks) == 1 && blocks[0].Type == models.LessonBlockMarkdown && blocks[0].Markdown == lessons[i].Content {
+				blocks[0].Markdown = value
+				blocks[0].HTML = markdown.Render(value)
+			}
+			lessons[i].Content = value
+		}
+	}
+	return nil
+}
+
+func (s *TranslationService) LocalizeAnswerResults(ctx context.Context, lang string, results []dto.CheckAnswerResponse) error {
+	entityIDs := make([]uint, 0, len(results))
+	for _, result := range results {
+		entityIDs = append(entityIDs, result.QuestionID)
+	}
+
+	values, err := s.loadTranslations(ctx, constants.TranslationEntityExerciseQuestion, lang, entityIDs)
+	if err != nil {
+		return err
+	}
+
+	for i := range results {
+		// Questions without an explanation have nothing to translate
+		if value, ok := values[results[i].QuestionID]; ok && results[i].Explanation != "" {
+			results[i].Explanation = value
+		}
+	}
+	return nil
+}
+
+func (s *TranslationService) LocalizeExamAttemptQuestions(ctx context.Context, lang string, questions []dto.ExamAttemptQuestionResponse) error {
+	entityIDs := make([]uint, 0, len(questions))
+	for _, question := range questions {
+		entityIDs = append(entityIDs, question.QuestionID)
+	}
+
+	values, err := s.loadTranslations(ctx, constants.TranslationEntityExerciseQuestion, lang, entityIDs)
+	if err != nil {
+		return err
+	}
+
+	for i := range questions {
+		// Explanations are only revealed once the attempt is finished
+		if value, ok := values[questions[i].QuestionID]; ok && questions[i].Explanation != "" {
+			questions[i].Explanation = value
+		}
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package services
+
+import (
+	"context"
+	"manabu-service/config"
+	"manabu-service/constants"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+	"manabu-service/repositories"
+	trashRepo "manabu-service/repositories/trash"
+	"math"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// defaultTrashRetentionDays is how long trashed content is kept when no retention is configured
+const defaultTrashRetentionDays = 30
+
+type TrashService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+// ITrashService defines the contract for the trash of courses, lessons, exercises and questions.
+// Deleting content moves it to the trash with its descendants; admins can list and restore
+// trashed content until it is purged after the retention window.
+type ITrashService interface {
+	// GetAll retrieves the trash with filtering and pagination (admins only).
+	GetAll(context.Context, *dto.TrashFilterRequest) (*dto.TrashListResponse, error)
+
+	// Restore takes a content item and the descendants deleted with it out of the trash (admins only).
+	Restore(context.Context, string, uint) error
+
+	// Purge permanently deletes content that has been in the trash longer than the retention window.
+	Purge(context.Context) error
+
+	// Run purges the trash at every interval until the context is cancelled.
+	Run(context.Context, time.Duration)
+}
+
+func NewTrashService(repository repositories.IRepositoryRegistry) ITrashService {
+	return &TrashService{repository: repository}
+}
+
+// checkAdmin verifies that the authenticated user is an admin
+func (s *TrashService) checkAdmin(ctx context.Context) error {
+	userLogin, ok := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	if !ok || userLogin == nil {
+		return errConstant.ErrUnauthorized
+	}
+	if userLogin.Role != constants.RoleAdmin {
+		return errConstant.ErrTrashAdminOnly
+	}
+	return nil
+}
+
+// retention returns how long trashed content is kept
+func (s *TrashService) retention() time.Duration {
+	days := config.Config.TrashRetentionDays
+	if days <= 0 {
+		days = defaultTrashRetentionDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// toTrashItemResponse converts a trashed item to TrashItemResponse DTO
+func (s *TrashService) toTrashItemResponse(item *trashRepo.TrashItem) dto.TrashItemResponse {
+	return dto.TrashItemResponse{
+		ContentType: item.ContentType,
+		ContentID:   item.ContentID,
+		Title:       item.Title,
+		ParentID:    item.ParentID,
+		DeletedAt:   item.DeletedAt.Format("2006-01-02T15:04:05Z07:00"),
+		PurgeAt:     item.DeletedAt.Add(s.retention()).Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func (s *TrashService) GetAll(ctx context.Context, filter *dto.TrashFilterRequest) (*dto.TrashListResponse, error) {
+	if err := s.checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	// Set default pagination values
+	if filter == nil {
+		filter = &dto.TrashFilterRequest{
+			PaginationRequest: dto.PaginationRequest{
+				Page:  1,
+				Limit: 10,
+			},
+		}
+	}
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.Limit < 1 {
+		filter.Limit = 10
+	}
+	if filter.Limit > 100 {
+		filter.Limit = 100
+	}
+
+	items, total, err := s.repository.GetTrash().GetAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dto.TrashItemResponse, 0, len(items))
+	for _, item := range items {
+		responses = append(responses, s.toTrashItemResponse(&item))
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(filter.Limit)))
+
+	return &dto.TrashListResponse{
+		Data: responses,
+		Pagination: dto.PaginationResponse{
+			Page:       filter.Page,
+			Limit:      filter.Limit,
+			TotalPages: totalPages,
+			TotalItems: total,
+		},
+	}, nil
+}
+
+func (s *TrashService) Restore(ctx context.Context, contentType string, contentID uint) error {
+	if err := s.checkAdmin(ctx); err != nil {
+		return err
+	}
+	if _, ok := models.ContentTypeTables[contentType]; !ok {
+		return errConstant.ErrInvalidContentType
+	}
+
+	return s.repository.GetTrash().Restore(ctx, contentType, contentID)
+}
+
+func (s *TrashService) Purge(ctx context.Context) error {
+	purged, err := s.repository.GetTrash().Purge(ctx, time.Now().Add(-s.retention()))
+	if err != nil {
+		return err
+	}
+	if purged > 0 {
+		logrus.Infof("purged %d content items from the trash", purged)
+	}
+	return nil
+}
+
+func (s *TrashService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Purge(ctx); err != nil {
+			logrus.Errorf("failed to purge the trash: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
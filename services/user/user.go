@@ -49,12 +49,13 @@ func (u *UserService) Login(ctx context.Context, req *dto.LoginRequest) (*dto.Lo
 
 	expirationTime := time.Now().Add(time.Duration(config.Config.JwtExpirationTime) * time.Minute).Unix()
 	data := &dto.UserResponse{
-		ID:       user.ID,
-		UUID:     user.UUID,
-		Name:     user.Name,
-		Username: user.Username,
-		Email:    user.Email,
-		Role:     strings.ToLower(user.Role.Code),
+		ID:          user.ID,
+		UUID:        user.UUID,
+		Name:        user.Name,
+		Username:    user.Username,
+		Email:       user.Email,
+		Role:        strings.ToLower(user.Role.Code),
+		JlptLevelID: user.JlptLevelID,
 	}
 
 	claims := &Claims{
@@ -207,10 +208,11 @@ func (u *UserService) Update(ctx context.Context, request *dto.UpdateRequest, uu
 	}
 
 	data = dto.UserResponse{
-		UUID:     userResult.UUID,
-		Name:     userResult.Name,
-		Username: userResult.Username,
-		Email:    userResult.Email,
+		UUID:        userResult.UUID,
+		Name:        userResult.Name,
+		Username:    userResult.Username,
+		Email:       userResult.Email,
+		JlptLevelID: userResult.JlptLevelID,
 	}
 
 	return &data, nil
@@ -240,10 +242,11 @@ func (u *UserService) GetUserByUUID(ctx context.Context, uuid string) (*dto.User
 	}
 
 	data := dto.UserResponse{
-		UUID:     user.UUID,
-		Name:     user.Name,
-		Username: user.Username,
-		Email:    user.Email,
+		UUID:        user.UUID,
+		Name:        user.Name,
+		Username:    user.Username,
+		Email:       user.Email,
+		JlptLevelID: user.JlptLevelID,
 	}
 
 	return &data, nil
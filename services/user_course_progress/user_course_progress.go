@@ -6,6 +6,7 @@ import (
 	"manabu-service/domain/dto"
 	"manabu-service/domain/models"
 	"manabu-service/repositories"
+	lessonVocabularyService "manabu-service/services/lesson_vocabulary"
 	"math"
 
 	"github.com/google/uuid"
@@ -30,6 +31,7 @@ type IUserCourseProgressService interface {
 
 	// Update validates and updates an existing user course progress entry.
 	// Validates completed lessons count and auto-updates status and progress percentage.
+	// The vocabulary of newly completed lessons is added to the user's vocabulary deck.
 	Update(context.Context, *dto.UpdateUserCourseProgressRequest, uuid.UUID, uint) (*dto.UserCourseProgressResponse, error)
 }
 
@@ -232,5 +234,38 @@ func (s *UserCourseProgressService) Update(ctx context.Context, req *dto.UpdateU
 		return nil, err
 	}
 
+	if progress.CompletedLessons > existingProgress.CompletedLessons {
+		err = s.studyCompletedLessons(ctx, progress, existingProgress.CompletedLessons)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return s.toUserCourseProgressResponse(progress), nil
 }
+
+// studyCompletedLessons adds the vocabulary of the lessons completed since previousCompleted to
+// the deck of the user. Lessons are completed in course order.
+func (s *UserCourseProgressService) studyCompletedLessons(ctx context.Context, progress *models.UserCourseProgress, previousCompleted int) error {
+	lessons, err := s.repository.GetLesson().GetByCourseID(ctx, progress.CourseID)
+	if err != nil {
+		return err
+	}
+
+	completed := min(progress.CompletedLessons, len(lessons))
+	if previousCompleted >= completed {
+		return nil
+	}
+	lessonIDs := make([]uint, 0, completed-previousCompleted)
+	for _, lesson := range lessons[previousCompleted:completed] {
+		lessonIDs = append(lessonIDs, lesson.ID)
+	}
+
+	user, err := s.repository.GetUser().FindByID(ctx, progress.UserID)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = lessonVocabularyService.NewLessonVocabularyService(s.repository).AddToDeck(ctx, user.UUID, lessonIDs)
+	return err
+}
@@ -7,7 +7,10 @@ import (
 	"manabu-service/domain/dto"
 	"manabu-service/domain/models"
 	"manabu-service/repositories"
+	vocabularyNoteService "manabu-service/services/vocabulary_note"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 type UserVocabularyStatusService struct {
@@ -71,7 +74,11 @@ func (s *UserVocabularyStatusService) Create(ctx context.Context, req *dto.Creat
 	}
 
 	// Map to response DTO (use helper for consistent mapping)
-	return s.mapStatusToResponse(createdStatus, userLogin.UUID.String()), nil
+	response := s.mapStatusToResponse(createdStatus, userLogin.UUID.String())
+	if err := s.addNotes(ctx, userLogin.UUID, response); err != nil {
+		return nil, err
+	}
+	return response, nil
 }
 
 // GetByID retrieves user vocabulary status by ID
@@ -94,7 +101,11 @@ func (s *UserVocabularyStatusService) GetByID(ctx context.Context, id uint) (*dt
 	}
 
 	// Map to response DTO using helper function (includes vocabulary data)
-	return s.mapStatusToResponse(status, userLogin.UUID.String()), nil
+	response := s.mapStatusToResponse(status, userLogin.UUID.String())
+	if err := s.addNotes(ctx, userLogin.UUID, response); err != nil {
+		return nil, err
+	}
+	return response, nil
 }
 
 // mapStatusToResponse maps a model to response DTO with vocabulary data
@@ -132,6 +143,23 @@ func (s *UserVocabularyStatusService) mapStatusToResponse(status *models.UserVoc
 	return response
 }
 
+// addNotes attaches the user's own notes to the responses of the words they have a note for
+func (s *UserVocabularyStatusService) addNotes(ctx context.Context, userID uuid.UUID, responses ...*dto.UserVocabStatusResponse) error {
+	vocabularyIDs := make([]uint, 0, len(responses))
+	for _, response := range responses {
+		vocabularyIDs = append(vocabularyIDs, response.VocabularyID)
+	}
+
+	notes, err := vocabularyNoteService.NewVocabularyNoteService(s.repository).GetByVocabularyIDs(ctx, userID, vocabularyIDs)
+	if err != nil {
+		return err
+	}
+	for _, response := range responses {
+		response.Note = notes[response.VocabularyID]
+	}
+	return nil
+}
+
 // GetAll retrieves all vocabulary statuses for the authenticated user
 func (s *UserVocabularyStatusService) GetAll(ctx context.Context, req *dto.UserVocabStatusListRequest) (*dto.UserVocabStatusListResponse, error) {
 	// Get user from context
@@ -166,6 +194,13 @@ func (s *UserVocabularyStatusService) GetAll(ctx context.Context, req *dto.UserV
 	for _, status := range statuses {
 		responses = append(responses, *s.mapStatusToResponse(status, userUUID))
 	}
+	pointers := make([]*dto.UserVocabStatusResponse, 0, len(responses))
+	for i := range responses {
+		pointers = append(pointers, &responses[i])
+	}
+	if err := s.addNotes(ctx, userLogin.UUID, pointers...); err != nil {
+		return nil, err
+	}
 
 	// Calculate pagination
 	totalPages := int(total) / req.Limit
@@ -204,6 +239,13 @@ func (s *UserVocabularyStatusService) GetDueForReview(ctx context.Context) ([]dt
 	for _, status := range statuses {
 		responses = append(responses, *s.mapStatusToResponse(status, userUUID))
 	}
+	pointers := make([]*dto.UserVocabStatusResponse, 0, len(responses))
+	for i := range responses {
+		pointers = append(pointers, &responses[i])
+	}
+	if err := s.addNotes(ctx, userLogin.UUID, pointers...); err != nil {
+		return nil, err
+	}
 
 	return responses, nil
 }
@@ -255,5 +297,9 @@ func (s *UserVocabularyStatusService) Review(ctx context.Context, vocabularyID u
 	updatedStatus.Vocabulary = vocabulary
 
 	// Map to response DTO
-	return s.mapStatusToResponse(updatedStatus, userLogin.UUID.String()), nil
+	response := s.mapStatusToResponse(updatedStatus, userLogin.UUID.String())
+	if err := s.addNotes(ctx, userLogin.UUID, response); err != nil {
+		return nil, err
+	}
+	return response, nil
 }
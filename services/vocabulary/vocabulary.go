@@ -2,11 +2,16 @@ package services
 
 import (
 	"context"
+	"manabu-service/constants"
 	errConstant "manabu-service/constants/error"
 	"manabu-service/domain/dto"
 	"manabu-service/domain/models"
 	"manabu-service/repositories"
+	revisionService "manabu-service/services/content_revision"
+	mediaService "manabu-service/services/media"
 	"math"
+
+	"github.com/google/uuid"
 )
 
 type VocabularyService struct {
@@ -29,6 +34,9 @@ type IVocabularyService interface {
 	// Validates JLPT level and category existence, checks for duplicates.
 	Update(context.Context, *dto.UpdateVocabularyRequest, uint) (*dto.VocabularyResponse, error)
 
+	// RestoreRevision updates a vocabulary entry with the snapshot of one of its revisions, recording a new revision.
+	RestoreRevision(context.Context, uint, int) (*dto.VocabularyResponse, error)
+
 	// Delete removes a vocabulary entry by ID if it exists.
 	Delete(context.Context, uint) error
 }
@@ -55,6 +63,15 @@ func (s *VocabularyService) toVocabularyResponse(vocabulary *models.Vocabulary)
 		Difficulty:             vocabulary.Difficulty,
 	}
 
+	if vocabulary.AudioMediaID != nil {
+		audioMediaID := vocabulary.AudioMediaID.String()
+		response.AudioMediaID = &audioMediaID
+	}
+	if vocabulary.ImageMediaID != nil {
+		imageMediaID := vocabulary.ImageMediaID.String()
+		response.ImageMediaID = &imageMediaID
+	}
+
 	if vocabulary.JlptLevel.ID > 0 {
 		response.JlptLevel = &dto.JlptLevelResponse{
 			ID:          vocabulary.JlptLevel.ID,
@@ -85,9 +102,61 @@ func (s *VocabularyService) toVocabularyResponse(vocabulary *models.Vocabulary)
 		}
 	}
 
+	// Include linked example sentences if preloaded
+	if len(vocabulary.ExampleSentences) > 0 {
+		response.ExampleSentences = make([]dto.ExampleSentenceResponse, 0, len(vocabulary.ExampleSentences))
+		for _, sentence := range vocabulary.ExampleSentences {
+			response.ExampleSentences = append(response.ExampleSentences, dto.ExampleSentenceResponse{
+				ID:          sentence.ID,
+				Text:        sentence.Text,
+				Reading:     sentence.Reading,
+				Translation: sentence.Translation,
+				AudioURL:    sentence.AudioURL,
+				Source:      sentence.Source,
+				Difficulty:  sentence.Difficulty,
+			})
+		}
+	}
+
 	return response
 }
 
+// responsePointers returns pointers to the given vocabulary responses
+func (s *VocabularyService) responsePointers(responses []dto.VocabularyResponse) []*dto.VocabularyResponse {
+	pointers := make([]*dto.VocabularyResponse, len(responses))
+	for i := range responses {
+		pointers[i] = &responses[i]
+	}
+	return pointers
+}
+
+// addBookmarks marks which of the words the logged in user has bookmarked. Responses to anonymous requests are left unmarked.
+func (s *VocabularyService) addBookmarks(ctx context.Context, responses ...*dto.VocabularyResponse) error {
+	userLogin, ok := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	if !ok || userLogin == nil || len(responses) == 0 {
+		return nil
+	}
+
+	ids := make([]uint, 0, len(responses))
+	for _, response := range responses {
+		ids = append(ids, response.ID)
+	}
+	bookmarkedIDs, err := s.repository.GetBookmark().GetBookmarkedIDs(ctx, userLogin.UUID.String(), models.ContentTypeVocabulary, ids)
+	if err != nil {
+		return err
+	}
+	bookmarked := make(map[uint]bool, len(bookmarkedIDs))
+	for _, id := range bookmarkedIDs {
+		bookmarked[id] = true
+	}
+
+	for _, response := range responses {
+		isBookmarked := bookmarked[response.ID]
+		response.IsBookmarked = &isBookmarked
+	}
+	return nil
+}
+
 func (s *VocabularyService) isVocabularyExist(ctx context.Context, word string, jlptLevelID uint) bool {
 	vocabulary, err := s.repository.GetVocabulary().GetByWordAndJlptLevel(ctx, word, jlptLevelID)
 	if err != nil {
@@ -119,6 +188,46 @@ func (s *VocabularyService) validateDifficulty(difficulty int) error {
 	return nil
 }
 
+// applyMedia points the audio and image URLs at the referenced media files
+func (s *VocabularyService) applyMedia(ctx context.Context, audioMediaID, imageMediaID *uuid.UUID, audioURL, imageURL *string) error {
+	media := mediaService.NewMediaService(s.repository)
+	if audioMediaID != nil {
+		audio, err := media.Resolve(ctx, *audioMediaID, models.MediaKindAudio)
+		if err != nil {
+			return err
+		}
+		*audioURL = audio.URL
+	}
+	if imageMediaID != nil {
+		image, err := media.Resolve(ctx, *imageMediaID, models.MediaKindImage)
+		if err != nil {
+			return err
+		}
+		*imageURL = image.URL
+	}
+	return nil
+}
+
+// toRevisionSnapshot captures the editable fields of a vocabulary entry for its revision history
+func (s *VocabularyService) toRevisionSnapshot(vocabulary *models.Vocabulary) *dto.UpdateVocabularyRequest {
+	return &dto.UpdateVocabularyRequest{
+		Word:                   vocabulary.Word,
+		Reading:                vocabulary.Reading,
+		Meaning:                vocabulary.Meaning,
+		PartOfSpeech:           vocabulary.PartOfSpeech,
+		JlptLevelID:            vocabulary.JlptLevelID,
+		CategoryID:             vocabulary.CategoryID,
+		ExampleSentence:        vocabulary.ExampleSentence,
+		ExampleSentenceReading: vocabulary.ExampleSentenceReading,
+		ExampleSentenceMeaning: vocabulary.ExampleSentenceMeaning,
+		AudioURL:               vocabulary.AudioURL,
+		ImageURL:               vocabulary.ImageURL,
+		AudioMediaID:           vocabulary.AudioMediaID,
+		ImageMediaID:           vocabulary.ImageMediaID,
+		Difficulty:             vocabulary.Difficulty,
+	}
+}
+
 func (s *VocabularyService) Create(ctx context.Context, req *dto.CreateVocabularyRequest) (*dto.VocabularyResponse, error) {
 	// Validate JLPT level exists
 	if !s.isJlptLevelExist(ctx, req.JlptLevelID) {
@@ -142,7 +251,21 @@ func (s *VocabularyService) Create(ctx context.Context, req *dto.CreateVocabular
 		return nil, errConstant.ErrVocabularyDuplicate
 	}
 
-	vocabulary, err := s.repository.GetVocabulary().Create(ctx, req)
+	// Resolve referenced media files
+	if err := s.applyMedia(ctx, req.AudioMediaID, req.ImageMediaID, &req.AudioURL, &req.ImageURL); err != nil {
+		return nil, err
+	}
+
+	// The vocabulary and its first revision are stored together
+	var vocabulary *models.Vocabulary
+	err := s.repository.Transaction(ctx, func(tx repositories.IRepositoryRegistry) error {
+		var err error
+		vocabulary, err = tx.GetVocabulary().Create(ctx, req)
+		if err != nil {
+			return err
+		}
+		return revisionService.NewContentRevisionService(tx).Record(ctx, models.ContentTypeVocabulary, vocabulary.ID, models.RevisionActionCreate, s.toRevisionSnapshot(vocabulary), nil)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -186,6 +309,9 @@ func (s *VocabularyService) GetAll(ctx context.Context, filter *dto.VocabularyFi
 	for _, vocabulary := range vocabularies {
 		responses = append(responses, *s.toVocabularyResponse(&vocabulary))
 	}
+	if err := s.addBookmarks(ctx, s.responsePointers(responses)...); err != nil {
+		return nil, err
+	}
 
 	totalPages := int(math.Ceil(float64(total) / float64(filter.Limit)))
 
@@ -206,10 +332,29 @@ func (s *VocabularyService) GetByID(ctx context.Context, id uint) (*dto.Vocabula
 		return nil, err
 	}
 
-	return s.toVocabularyResponse(vocabulary), nil
+	response := s.toVocabularyResponse(vocabulary)
+	if err := s.addBookmarks(ctx, response); err != nil {
+		return nil, err
+	}
+	return response, nil
 }
 
 func (s *VocabularyService) Update(ctx context.Context, req *dto.UpdateVocabularyRequest, id uint) (*dto.VocabularyResponse, error) {
+	return s.update(ctx, req, id, nil)
+}
+
+func (s *VocabularyService) RestoreRevision(ctx context.Context, id uint, version int) (*dto.VocabularyResponse, error) {
+	req := &dto.UpdateVocabularyRequest{}
+	err := revisionService.NewContentRevisionService(s.repository).LoadSnapshot(ctx, models.ContentTypeVocabulary, id, version, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.update(ctx, req, id, &version)
+}
+
+// update validates and applies an update, recording it as a restore of the given version if set
+func (s *VocabularyService) update(ctx context.Context, req *dto.UpdateVocabularyRequest, id uint, restoredFrom *int) (*dto.VocabularyResponse, error) {
 	// Check if vocabulary exists
 	existingVocabulary, err := s.repository.GetVocabulary().GetByID(ctx, id)
 	if err != nil {
@@ -245,7 +390,26 @@ func (s *VocabularyService) Update(ctx context.Context, req *dto.UpdateVocabular
 		}
 	}
 
-	vocabulary, err := s.repository.GetVocabulary().Update(ctx, req, id)
+	// Resolve referenced media files
+	if err := s.applyMedia(ctx, req.AudioMediaID, req.ImageMediaID, &req.AudioURL, &req.ImageURL); err != nil {
+		return nil, err
+	}
+
+	action := models.RevisionActionUpdate
+	if restoredFrom != nil {
+		action = models.RevisionActionRestore
+	}
+
+	// The change and its revision are stored together
+	var vocabulary *models.Vocabulary
+	err = s.repository.Transaction(ctx, func(tx repositories.IRepositoryRegistry) error {
+		var err error
+		vocabulary, err = tx.GetVocabulary().Update(ctx, req, id)
+		if err != nil {
+			return err
+		}
+		return revisionService.NewContentRevisionService(tx).Record(ctx, models.ContentTypeVocabulary, vocabulary.ID, action, s.toRevisionSnapshot(vocabulary), restoredFrom)
+	})
 	if err != nil {
 		return nil, err
 	}
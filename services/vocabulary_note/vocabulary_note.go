@@ -0,0 +1,406 @@
+package services
+
+import (
+	"context"
+	"manabu-service/common/markdown"
+	"manabu-service/constants"
+	errConstant "manabu-service/constants/error"
+	"manabu-service/domain/dto"
+	"manabu-service/domain/models"
+	"manabu-service/repositories"
+	mediaService "manabu-service/services/media"
+	"math"
+
+	"github.com/google/uuid"
+)
+
+type VocabularyNoteService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+// IVocabularyNoteService defines the contract for learners' personal notes and shared mnemonics.
+type IVocabularyNoteService interface {
+	// Create adds a note of the logged in user to a word.
+	Create(context.Context, *dto.CreateVocabularyNoteRequest) (*dto.VocabularyNoteResponse, error)
+
+	// GetAll retrieves the notes of the logged in user, newest first, with filtering and pagination.
+	GetAll(context.Context, *dto.VocabularyNoteFilterRequest) (*dto.VocabularyNoteListResponse, error)
+
+	// GetByID retrieves one of the notes of the logged in user.
+	GetByID(context.Context, uint) (*dto.VocabularyNoteResponse, error)
+
+	// Update replaces the content of one of the notes of the logged in user.
+	Update(context.Context, uint, *dto.UpdateVocabularyNoteRequest) (*dto.VocabularyNoteResponse, error)
+
+	// Delete removes one of the notes of the logged in user.
+	Delete(context.Context, uint) error
+
+	// GetByVocabularyIDs retrieves a user's notes for the given words, keyed by vocabulary ID.
+	GetByVocabularyIDs(context.Context, uuid.UUID, []uint) (map[uint]*dto.VocabularyNoteResponse, error)
+
+	// GetShared retrieves the mnemonics learners shared for a word, most upvoted first.
+	GetShared(context.Context, uint, *dto.PaginationRequest) (*dto.SharedMnemonicListResponse, error)
+
+	// Upvote adds the upvote of the logged in user to a shared mnemonic. Upvoting twice has no effect.
+	Upvote(context.Context, uint) (*dto.SharedMnemonicResponse, error)
+
+	// RemoveUpvote withdraws the upvote of the logged in user from a shared mnemonic.
+	RemoveUpvote(context.Context, uint) (*dto.SharedMnemonicResponse, error)
+}
+
+func NewVocabularyNoteService(repository repositories.IRepositoryRegistry) IVocabularyNoteService {
+	return &VocabularyNoteService{repository: repository}
+}
+
+func (s *VocabularyNoteService) getUserLogin(ctx context.Context) (*dto.UserResponse, error) {
+	userLogin, ok := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	if !ok || userLogin == nil {
+		return nil, errConstant.ErrUnauthorized
+	}
+	return userLogin, nil
+}
+
+// toVocabularyNoteResponse converts a VocabularyNote model to VocabularyNoteResponse DTO
+func (s *VocabularyNoteService) toVocabularyNoteResponse(note *models.VocabularyNote) *dto.VocabularyNoteResponse {
+	response := &dto.VocabularyNoteResponse{
+		ID:           note.ID,
+		VocabularyID: note.VocabularyID,
+		Note:         note.Note,
+		Mnemonic:     note.Mnemonic,
+		ImageURL:     note.ImageURL,
+		IsShared:     note.IsShared,
+		Upvotes:      note.Upvotes,
+	}
+
+	if note.ImageMediaID != nil {
+		imageMediaID := note.ImageMediaID.String()
+		response.ImageMediaID = &imageMediaID
+	}
+	if note.CreatedAt != nil {
+		response.CreatedAt = note.CreatedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	if note.UpdatedAt != nil {
+		response.UpdatedAt = note.UpdatedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	return response
+}
+
+// toSharedMnemonicResponse converts a shared VocabularyNote model to SharedMnemonicResponse DTO
+func (s *VocabularyNoteService) toSharedMnemonicResponse(note *models.VocabularyNote, userID *uuid.UUID, upvoted bool) *dto.SharedMnemonicResponse {
+	response := &dto.SharedMnemonicResponse{
+		ID:           note.ID,
+		VocabularyID: note.VocabularyID,
+		Mnemonic:     note.Mnemonic,
+		ImageURL:     note.ImageURL,
+		Author:       note.User.Name,
+		Upvotes:      note.Upvotes,
+		IsUpvoted:    upvoted,
+		IsOwn:        userID != nil && *userID == note.UserID,
+	}
+
+	if note.CreatedAt != nil {
+		response.CreatedAt = note.CreatedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	return response
+}
+
+// applyContent sanitises and validates the content of a note and resolves its image
+func (s *VocabularyNoteService) applyContent(ctx context.Context, note *models.VocabularyNote, text, mnemonic, imageURL string, imageMediaID *uuid.UUID, isShared bool) error {
+	note.Note = markdown.Sanitize(text)
+	note.Mnemonic = markdown.Sanitize(mnemonic)
+	note.ImageURL = imageURL
+	note.ImageMediaID = imageMediaID
+	note.IsShared = isShared
+
+	if imageMediaID != nil {
+		image, err := mediaService.NewMediaService(s.repository).Resolve(ctx, *imageMediaID, models.MediaKindImage)
+		if err != nil {
+			return err
+		}
+		note.ImageURL = image.URL
+	}
+
+	if note.Note == "" && note.Mnemonic == "" && note.ImageURL == "" {
+		return errConstant.ErrVocabularyNoteEmpty
+	}
+	if note.IsShared && note.Mnemonic == "" {
+		return errConstant.ErrVocabularyNoteNotShared
+	}
+	return nil
+}
+
+// getOwnNote retrieves a note and checks it belongs to the given user. Notes of other users are
+// reported as not found.
+func (s *VocabularyNoteService) getOwnNote(ctx context.Context, id uint, userID uuid.UUID) (*models.VocabularyNote, error) {
+	note, err := s.repository.GetVocabularyNote().GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if note.UserID != userID {
+		return nil, errConstant.ErrVocabularyNoteNotFound
+	}
+	return note, nil
+}
+
+// getSharedNote retrieves a shared mnemonic. Notes that are not shared are reported as not found.
+func (s *VocabularyNoteService) getSharedNote(ctx context.Context, id uint) (*models.VocabularyNote, error) {
+	note, err := s.repository.GetVocabularyNote().GetByID(ctx, id)
+	if err != nil {
+		if err == errConstant.ErrVocabularyNoteNotFound {
+			return nil, errConstant.ErrMnemonicNotFound
+		}
+		return nil, err
+	}
+	if !note.IsShared || note.Mnemonic == "" {
+		return nil, errConstant.ErrMnemonicNotFound
+	}
+	return note, nil
+}
+
+func (s *VocabularyNoteService) Create(ctx context.Context, req *dto.CreateVocabularyNoteRequest) (*dto.VocabularyNoteResponse, error) {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if vocabulary exists
+	_, err = s.repository.GetVocabulary().GetByID(ctx, req.VocabularyID)
+	if err != nil {
+		return nil, err
+	}
+
+	note := &models.VocabularyNote{
+		UserID:       userLogin.UUID,
+		VocabularyID: req.VocabularyID,
+	}
+	err = s.applyContent(ctx, note, req.Note, req.Mnemonic, req.ImageURL, req.ImageMediaID, req.IsShared)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repository.GetVocabularyNote().Create(ctx, note)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toVocabularyNoteResponse(note), nil
+}
+
+func (s *VocabularyNoteService) GetAll(ctx context.Context, filter *dto.VocabularyNoteFilterRequest) (*dto.VocabularyNoteListResponse, error) {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set default pagination values
+	if filter == nil {
+		filter = &dto.VocabularyNoteFilterRequest{}
+	}
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.Limit < 1 {
+		filter.Limit = 10
+	}
+	if filter.Limit > 100 {
+		filter.Limit = 100
+	}
+
+	notes, total, err := s.repository.GetVocabularyNote().GetAll(ctx, userLogin.UUID.String(), filter)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dto.VocabularyNoteResponse, 0, len(notes))
+	for _, note := range notes {
+		responses = append(responses, *s.toVocabularyNoteResponse(&note))
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(filter.Limit)))
+
+	return &dto.VocabularyNoteListResponse{
+		Data: responses,
+		Pagination: dto.PaginationResponse{
+			Page:       filter.Page,
+			Limit:      filter.Limit,
+			TotalPages: totalPages,
+			TotalItems: total,
+		},
+	}, nil
+}
+
+func (s *VocabularyNoteService) GetByID(ctx context.Context, id uint) (*dto.VocabularyNoteResponse, error) {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	note, err := s.getOwnNote(ctx, id, userLogin.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toVocabularyNoteResponse(note), nil
+}
+
+func (s *VocabularyNoteService) Update(ctx context.Context, id uint, req *dto.UpdateVocabularyNoteRequest) (*dto.VocabularyNoteResponse, error) {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	note, err := s.getOwnNote(ctx, id, userLogin.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.applyContent(ctx, note, req.Note, req.Mnemonic, req.ImageURL, req.ImageMediaID, req.IsShared)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repository.GetVocabularyNote().Update(ctx, note)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toVocabularyNoteResponse(note), nil
+}
+
+func (s *VocabularyNoteService) Delete(ctx context.Context, id uint) error {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.getOwnNote(ctx, id, userLogin.UUID)
+	if err != nil {
+		return err
+	}
+
+	return s.repository.GetVocabularyNote().Delete(ctx, id)
+}
+
+func (s *VocabularyNoteService) GetByVocabularyIDs(ctx context.Context, userID uuid.UUID, vocabularyIDs []uint) (map[uint]*dto.VocabularyNoteResponse, error) {
+	notes, err := s.repository.GetVocabularyNote().GetByUserAndVocabularyIDs(ctx, userID.String(), vocabularyIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make(map[uint]*dto.VocabularyNoteResponse, len(notes))
+	for _, note := range notes {
+		responses[note.VocabularyID] = s.toVocabularyNoteResponse(&note)
+	}
+	return responses, nil
+}
+
+func (s *VocabularyNoteService) GetShared(ctx context.Context, vocabularyID uint, pagination *dto.PaginationRequest) (*dto.SharedMnemonicListResponse, error) {
+	// Check if vocabulary exists
+	_, err := s.repository.GetVocabulary().GetByID(ctx, vocabularyID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set default pagination values
+	if pagination == nil {
+		pagination = &dto.PaginationRequest{}
+	}
+	if pagination.Page < 1 {
+		pagination.Page = 1
+	}
+	if pagination.Limit < 1 {
+		pagination.Limit = 10
+	}
+	if pagination.Limit > 100 {
+		pagination.Limit = 100
+	}
+
+	notes, total, err := s.repository.GetVocabularyNote().GetShared(ctx, vocabularyID, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	// Upvotes of the logged in user are marked when the request is authenticated
+	var userID *uuid.UUID
+	upvoted := make(map[uint]bool)
+	if userLogin, err := s.getUserLogin(ctx); err == nil {
+		userID = &userLogin.UUID
+		noteIDs := make([]uint, 0, len(notes))
+		for _, note := range notes {
+			noteIDs = append(noteIDs, note.ID)
+		}
+		upvotedIDs, err := s.repository.GetVocabularyNote().GetUpvotedNoteIDs(ctx, userLogin.UUID.String(), noteIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range upvotedIDs {
+			upvoted[id] = true
+		}
+	}
+
+	responses := make([]dto.SharedMnemonicResponse, 0, len(notes))
+	for _, note := range notes {
+		responses = append(responses, *s.toSharedMnemonicResponse(&note, userID, upvoted[note.ID]))
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(pagination.Limit)))
+
+	return &dto.SharedMnemonicListResponse{
+		Data: responses,
+		Pagination: dto.PaginationResponse{
+			Page:       pagination.Page,
+			Limit:      pagination.Limit,
+			TotalPages: totalPages,
+			TotalItems: total,
+		},
+	}, nil
+}
+
+func (s *VocabularyNoteService) Upvote(ctx context.Context, id uint) (*dto.SharedMnemonicResponse, error) {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	note, err := s.getSharedNote(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if note.UserID == userLogin.UUID {
+		return nil, errConstant.ErrMnemonicOwnUpvote
+	}
+
+	added, err := s.repository.GetVocabularyNote().Upvote(ctx, id, userLogin.UUID)
+	if err != nil {
+		return nil, err
+	}
+	if added {
+		note.Upvotes++
+	}
+
+	return s.toSharedMnemonicResponse(note, &userLogin.UUID, true), nil
+}
+
+func (s *VocabularyNoteService) RemoveUpvote(ctx context.Context, id uint) (*dto.SharedMnemonicResponse, error) {
+	userLogin, err := s.getUserLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	note, err := s.getSharedNote(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	removed, err := s.repository.GetVocabularyNote().RemoveUpvote(ctx, id, userLogin.UUID)
+	if err != nil {
+		return nil, err
+	}
+	if removed && note.Upvotes > 0 {
+		note.Upvotes--
+	}
+
+	return s.toSharedMnemonicResponse(note, &userLogin.UUID, false), nil
+}